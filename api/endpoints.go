@@ -15,6 +15,12 @@ func put(i *jsonAPIHandler, path string, w http.ResponseWriter, r *http.Request)
 		i.PUTModerator(w, r)
 	case strings.HasPrefix(path, "/ob/listing"):
 		i.PUTListing(w, r)
+	case strings.HasPrefix(path, "/ob/shippingprofiles"):
+		i.PUTShippingProfile(w, r)
+	case strings.HasPrefix(path, "/ob/resolutiontemplates"):
+		i.PUTResolutionTemplate(w, r)
+	case strings.HasPrefix(path, "/ob/posts"):
+		i.PUTPost(w, r)
 	default:
 		ErrorResponse(w, http.StatusNotFound, "Not Found")
 	}
@@ -32,6 +38,8 @@ func post(i *jsonAPIHandler, path string, w http.ResponseWriter, r *http.Request
 		i.POSTUnfollow(w, r)
 	case strings.HasPrefix(path, "/ob/profile"):
 		i.POSTProfile(w, r)
+	case strings.HasPrefix(path, "/ob/identity/rotate"):
+		i.POSTRotateIdentity(w, r)
 	case strings.HasPrefix(path, "/ob/images"):
 		i.POSTImage(w, r)
 	case strings.HasPrefix(path, "/wallet/spend"):
@@ -62,8 +70,20 @@ func post(i *jsonAPIHandler, path string, w http.ResponseWriter, r *http.Request
 		i.POSTOpenDispute(w, r)
 	case strings.HasPrefix(path, "/ob/closedispute"):
 		i.POSTCloseDispute(w, r)
+	case strings.HasPrefix(path, "/ob/escalatedispute"):
+		i.POSTEscalateDispute(w, r)
+	case strings.HasPrefix(path, "/ob/endorsedispute"):
+		i.POSTEndorseDispute(w, r)
+	case strings.HasPrefix(path, "/ob/disputeevidence"):
+		i.POSTDisputeEvidence(w, r)
 	case strings.HasPrefix(path, "/ob/releasefunds"):
 		i.POSTReleaseFunds(w, r)
+	case strings.HasPrefix(path, "/ob/payoutproposal"):
+		i.POSTPayoutProposal(w, r)
+	case strings.HasPrefix(path, "/ob/acceptpayoutproposal"):
+		i.POSTAcceptPayoutProposal(w, r)
+	case strings.HasPrefix(path, "/ob/casechat"):
+		i.POSTCaseChat(w, r)
 	case strings.HasPrefix(path, "/ob/chat"):
 		i.POSTChat(w, r)
 	case strings.HasPrefix(path, "/ob/markchatasread"):
@@ -76,6 +96,36 @@ func post(i *jsonAPIHandler, path string, w http.ResponseWriter, r *http.Request
 		i.POSTBlockNode(w, r)
 	case strings.HasPrefix(path, "/ob/shutdown"):
 		i.POSTShutdown(w, r)
+	case strings.HasPrefix(path, "/ob/shippingprofiles"):
+		i.POSTShippingProfile(w, r)
+	case strings.HasPrefix(path, "/ob/vouchers"):
+		i.POSTVoucher(w, r)
+	case strings.HasPrefix(path, "/ob/subscriptions"):
+		i.POSTSubscription(w, r)
+	case strings.HasPrefix(path, "/ob/subscriptionpause"):
+		i.POSTSubscriptionPause(w, r)
+	case strings.HasPrefix(path, "/ob/subscriptionresume"):
+		i.POSTSubscriptionResume(w, r)
+	case strings.HasPrefix(path, "/ob/subscriptioncancel"):
+		i.POSTSubscriptionCancel(w, r)
+	case strings.HasPrefix(path, "/ob/subscriptioncap"):
+		i.POSTSubscriptionCap(w, r)
+	case strings.HasPrefix(path, "/ob/resolutiontemplates"):
+		i.POSTResolutionTemplate(w, r)
+	case strings.HasPrefix(path, "/ob/previewdisputeresolution"):
+		i.POSTPreviewDisputeResolution(w, r)
+	case strings.HasPrefix(path, "/ob/report"):
+		i.POSTReport(w, r)
+	case strings.HasPrefix(path, "/ob/blocklist"):
+		i.POSTBlocklist(w, r)
+	case strings.HasPrefix(path, "/ob/ratingreply"):
+		i.POSTRatingReply(w, r)
+	case strings.HasPrefix(path, "/ob/buyerrating"):
+		i.POSTBuyerRating(w, r)
+	case strings.HasPrefix(path, "/ob/reputation/import"):
+		i.POSTReputationImport(w, r)
+	case strings.HasPrefix(path, "/ob/posts"):
+		i.POSTPost(w, r)
 	default:
 		ErrorResponse(w, http.StatusNotFound, "Not Found")
 	}
@@ -109,6 +159,8 @@ func get(i *jsonAPIHandler, path string, w http.ResponseWriter, r *http.Request)
 		i.GETFollowing(w, r)
 	case strings.HasPrefix(path, "/ob/inventory"):
 		i.GETInventory(w, r)
+	case strings.HasPrefix(path, "/ob/profile") && strings.HasSuffix(strings.TrimSuffix(path, "/"), "/history"):
+		i.GETProfileHistory(w, r)
 	case strings.HasPrefix(path, "/ob/profile"):
 		i.GETProfile(w, r)
 	case strings.HasPrefix(path, "/ob/listings"):
@@ -123,6 +175,12 @@ func get(i *jsonAPIHandler, path string, w http.ResponseWriter, r *http.Request)
 		i.GETOrder(w, r)
 	case strings.HasPrefix(path, "/ob/moderators"):
 		i.GETModerators(w, r)
+	case strings.HasPrefix(path, "/ob/moderator/stats"):
+		i.GETModeratorStats(w, r)
+	case strings.HasPrefix(path, "/ob/casechat"):
+		i.GETCaseChat(w, r)
+	case strings.HasPrefix(path, "/ob/disputeevidence"):
+		i.GETDisputeEvidence(w, r)
 	case strings.HasPrefix(path, "/ob/case"):
 		i.GETCase(w, r)
 	case strings.HasPrefix(path, "/ob/chatmessages"):
@@ -131,6 +189,8 @@ func get(i *jsonAPIHandler, path string, w http.ResponseWriter, r *http.Request)
 		i.GETChatConversations(w, r)
 	case strings.HasPrefix(path, "/ob/notifications"):
 		i.GETNotifications(w, r)
+	case strings.HasPrefix(path, "/ob/feed"):
+		i.GETFeed(w, r)
 	case strings.HasPrefix(path, "/ob/images"):
 		i.GETImage(w, r)
 	case strings.HasPrefix(path, "/ob/purchases"):
@@ -141,6 +201,28 @@ func get(i *jsonAPIHandler, path string, w http.ResponseWriter, r *http.Request)
 		i.GETCases(w, r)
 	case strings.HasPrefix(path, "/wallet/estimatefee"):
 		i.GETEstimateFee(w, r)
+	case strings.HasPrefix(path, "/ob/shippingprofiles"):
+		i.GETShippingProfiles(w, r)
+	case strings.HasPrefix(path, "/ob/vouchers"):
+		i.GETVouchers(w, r)
+	case strings.HasPrefix(path, "/ob/subscriptions"):
+		i.GETSubscriptions(w, r)
+	case strings.HasPrefix(path, "/ob/resolutiontemplates"):
+		i.GETResolutionTemplates(w, r)
+	case strings.HasPrefix(path, "/ob/reports"):
+		i.GETReports(w, r)
+	case strings.HasPrefix(path, "/ob/blocklist"):
+		i.GETBlocklist(w, r)
+	case strings.HasPrefix(path, "/ob/ratings"):
+		i.GETRatings(w, r)
+	case strings.HasPrefix(path, "/ob/buyerreputation"):
+		i.GETBuyerReputation(w, r)
+	case strings.HasPrefix(path, "/ob/reputation/export"):
+		i.GETReputationExport(w, r)
+	case strings.HasPrefix(path, "/ob/socialproof"):
+		i.GETSocialProof(w, r)
+	case strings.HasPrefix(path, "/ob/posts"):
+		i.GETPosts(w, r)
 	default:
 		ErrorResponse(w, http.StatusNotFound, "Not Found")
 	}
@@ -171,6 +253,18 @@ func deleter(i *jsonAPIHandler, path string, w http.ResponseWriter, r *http.Requ
 		i.DELETENotification(w, r)
 	case strings.HasPrefix(path, "/ob/blocknode"):
 		i.DELETEBlockNode(w, r)
+	case strings.HasPrefix(path, "/ob/shippingprofiles"):
+		i.DELETEShippingProfile(w, r)
+	case strings.HasPrefix(path, "/ob/vouchers"):
+		i.DELETEVoucher(w, r)
+	case strings.HasPrefix(path, "/ob/resolutiontemplates"):
+		i.DELETEResolutionTemplate(w, r)
+	case strings.HasPrefix(path, "/ob/reports"):
+		i.DELETEReport(w, r)
+	case strings.HasPrefix(path, "/ob/blocklist"):
+		i.DELETEBlocklist(w, r)
+	case strings.HasPrefix(path, "/ob/posts"):
+		i.DELETEPost(w, r)
 	default:
 		ErrorResponse(w, http.StatusNotFound, "Not Found")
 	}
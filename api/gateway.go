@@ -3,10 +3,12 @@ package api
 import (
 	"net"
 	"net/http"
+	"strings"
 	"time"
 
 	"github.com/OpenBazaar/openbazaar-go/core"
 	"github.com/OpenBazaar/openbazaar-go/repo"
+	ipfscore "github.com/ipfs/go-ipfs/core"
 	"github.com/ipfs/go-ipfs/core/corehttp"
 	"github.com/op/go-logging"
 )
@@ -55,6 +57,28 @@ func NewGateway(n *core.OpenBazaarNode, authCookie http.Cookie, l net.Listener,
 	}, nil
 }
 
+// HandleResolutionOption resolves @handles in /ipns paths to peer IDs with the node's
+// resolvers so the IPFS gateway options which follow only see peer IDs
+func HandleResolutionOption(resolver core.NameResolver) corehttp.ServeOption {
+	return func(_ *ipfscore.IpfsNode, _ net.Listener, mux *http.ServeMux) (*http.ServeMux, error) {
+		childMux := http.NewServeMux()
+		mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+			segments := strings.SplitN(r.URL.Path, "/", 4)
+			if len(segments) > 2 && segments[1] == "ipns" && strings.HasPrefix(segments[2], "@") {
+				peerID, err := resolver.Resolve(segments[2])
+				if err != nil {
+					http.Error(w, "Path Resolve error: "+err.Error(), http.StatusBadRequest)
+					return
+				}
+				segments[2] = peerID
+				r.URL.Path = strings.Join(segments, "/")
+			}
+			childMux.ServeHTTP(w, r)
+		})
+		return childMux, nil
+	}
+}
+
 // Close shutsdown the Gateway listener
 func (g *Gateway) Close() error {
 	log.Infof("server at %s terminating...", g.listener.Addr())
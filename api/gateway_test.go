@@ -0,0 +1,54 @@
+package api
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/OpenBazaar/openbazaar-go/core"
+)
+
+type handleResolver map[string]string
+
+func (h handleResolver) Resolve(handle string) (string, error) {
+	peerID, ok := h[handle]
+	if !ok {
+		return "", core.ErrHandleNotFound
+	}
+	return peerID, nil
+}
+
+func TestHandleResolutionOption(t *testing.T) {
+	topMux := http.NewServeMux()
+	option := HandleResolutionOption(handleResolver{"@ronswanson": "QmYwAPJzv5CZsnA625s3Xf2nemtYgPpHdWEz79ojWnPbdG"})
+	childMux, err := option(nil, nil, topMux)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var served string
+	childMux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		served = r.URL.Path
+	})
+
+	for _, c := range []struct {
+		path   string
+		served string
+		status int
+	}{
+		{"/ipns/@ronswanson/listings.json", "/ipns/QmYwAPJzv5CZsnA625s3Xf2nemtYgPpHdWEz79ojWnPbdG/listings.json", http.StatusOK},
+		{"/ipns/@ronswanson", "/ipns/QmYwAPJzv5CZsnA625s3Xf2nemtYgPpHdWEz79ojWnPbdG", http.StatusOK},
+		{"/ipns/QmYwAPJzv5CZsnA625s3Xf2nemtYgPpHdWEz79ojWnPbdG/profile.json", "/ipns/QmYwAPJzv5CZsnA625s3Xf2nemtYgPpHdWEz79ojWnPbdG/profile.json", http.StatusOK},
+		{"/ipfs/@ronswanson", "/ipfs/@ronswanson", http.StatusOK},
+		{"/ipns/@leslieknope/profile.json", "", http.StatusBadRequest},
+	} {
+		served = ""
+		w := httptest.NewRecorder()
+		topMux.ServeHTTP(w, httptest.NewRequest("GET", c.path, nil))
+		if w.Code != c.status {
+			t.Errorf("Expected %s to return status %d, got %d", c.path, c.status, w.Code)
+		}
+		if served != c.served {
+			t.Errorf("Expected %s to be served as %q, got %q", c.path, c.served, served)
+		}
+	}
+}
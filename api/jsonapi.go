@@ -1,6 +1,7 @@
 package api
 
 import (
+	"bytes"
 	"crypto/rand"
 	"encoding/json"
 	"fmt"
@@ -698,6 +699,10 @@ func (i *jsonAPIHandler) POSTPurchase(w http.ResponseWriter, r *http.Request) {
 
 func (i *jsonAPIHandler) GETStatus(w http.ResponseWriter, r *http.Request) {
 	_, peerId := path.Split(r.URL.Path)
+	peerId, ok := i.resolvePeerID(w, peerId)
+	if !ok {
+		return
+	}
 	status, err := i.node.GetPeerStatus(peerId)
 	if err != nil {
 		ErrorResponse(w, http.StatusBadRequest, err.Error())
@@ -733,6 +738,10 @@ func (i *jsonAPIHandler) POSTFollow(w http.ResponseWriter, r *http.Request) {
 		ErrorResponse(w, http.StatusBadRequest, err.Error())
 		return
 	}
+	var ok bool
+	if pid.ID, ok = i.resolvePeerID(w, pid.ID); !ok {
+		return
+	}
 	if err := i.node.Follow(pid.ID); err != nil {
 		ErrorResponse(w, http.StatusInternalServerError, err.Error())
 		return
@@ -752,6 +761,10 @@ func (i *jsonAPIHandler) POSTUnfollow(w http.ResponseWriter, r *http.Request) {
 		ErrorResponse(w, http.StatusBadRequest, err.Error())
 		return
 	}
+	var ok bool
+	if pid.ID, ok = i.resolvePeerID(w, pid.ID); !ok {
+		return
+	}
 	if err := i.node.Unfollow(pid.ID); err != nil {
 		ErrorResponse(w, http.StatusInternalServerError, err.Error())
 		return
@@ -920,6 +933,7 @@ func (i *jsonAPIHandler) POSTSettings(w http.ResponseWriter, r *http.Request) {
 		ErrorResponse(w, http.StatusInternalServerError, err.Error())
 		return
 	}
+	go i.node.UpdateBlocklists()
 	SanitizedResponse(w, `{}`)
 	return
 }
@@ -958,6 +972,7 @@ func (i *jsonAPIHandler) PUTSettings(w http.ResponseWriter, r *http.Request) {
 		ErrorResponse(w, http.StatusInternalServerError, err.Error())
 		return
 	}
+	go i.node.UpdateBlocklists()
 	SanitizedResponse(w, `{}`)
 	return
 }
@@ -1019,6 +1034,9 @@ func (i *jsonAPIHandler) PATCHSettings(w http.ResponseWriter, r *http.Request) {
 		ErrorResponse(w, http.StatusInternalServerError, err.Error())
 		return
 	}
+	if settings.BlocklistSubscriptions != nil {
+		go i.node.UpdateBlocklists()
+	}
 	SanitizedResponse(w, `{}`)
 }
 
@@ -1065,7 +1083,6 @@ func (i *jsonAPIHandler) GETExchangeRate(w http.ResponseWriter, r *http.Request)
 
 func (i *jsonAPIHandler) GETFollowers(w http.ResponseWriter, r *http.Request) {
 	_, peerId := path.Split(r.URL.Path)
-	var err error
 	if peerId == "" || strings.ToLower(peerId) == "followers" || peerId == i.node.IpfsNode.Identity.Pretty() {
 		offset := r.URL.Query().Get("offsetId")
 		limit := r.URL.Query().Get("limit")
@@ -1088,12 +1105,9 @@ func (i *jsonAPIHandler) GETFollowers(w http.ResponseWriter, r *http.Request) {
 		}
 		SanitizedResponse(w, string(ret))
 	} else {
-		if strings.HasPrefix(peerId, "@") {
-			peerId, err = i.node.Resolver.Resolve(peerId)
-			if err != nil {
-				ErrorResponse(w, http.StatusNotFound, err.Error())
-				return
-			}
+		var ok bool
+		if peerId, ok = i.resolvePeerID(w, peerId); !ok {
+			return
 		}
 		followBytes, err := ipfs.ResolveThenCat(i.node.Context, ipnspath.FromString(path.Join(peerId, "followers")))
 		if err != nil {
@@ -1107,7 +1121,6 @@ func (i *jsonAPIHandler) GETFollowers(w http.ResponseWriter, r *http.Request) {
 
 func (i *jsonAPIHandler) GETFollowing(w http.ResponseWriter, r *http.Request) {
 	_, peerId := path.Split(r.URL.Path)
-	var err error
 	if peerId == "" || strings.ToLower(peerId) == "following" || peerId == i.node.IpfsNode.Identity.Pretty() {
 		offset := r.URL.Query().Get("offsetId")
 		limit := r.URL.Query().Get("limit")
@@ -1130,12 +1143,9 @@ func (i *jsonAPIHandler) GETFollowing(w http.ResponseWriter, r *http.Request) {
 		}
 		SanitizedResponse(w, string(ret))
 	} else {
-		if strings.HasPrefix(peerId, "@") {
-			peerId, err = i.node.Resolver.Resolve(peerId)
-			if err != nil {
-				ErrorResponse(w, http.StatusNotFound, err.Error())
-				return
-			}
+		var ok bool
+		if peerId, ok = i.resolvePeerID(w, peerId); !ok {
+			return
 		}
 		followBytes, err := ipfs.ResolveThenCat(i.node.Context, ipnspath.FromString(path.Join(peerId, "following")))
 		if err != nil {
@@ -1187,12 +1197,35 @@ func (i *jsonAPIHandler) POSTInventory(w http.ResponseWriter, r *http.Request) {
 		ErrorResponse(w, http.StatusBadRequest, err.Error())
 		return
 	}
+	indexChanged := false
 	for _, in := range invList {
 		err = i.node.Datastore.Inventory().Put(in.Slug, in.Variant, in.Quantity)
 		if err != nil {
 			ErrorResponse(w, http.StatusInternalServerError, err.Error())
 			return
 		}
+		filled, err := i.node.FillBackorders(in.Slug, in.Variant)
+		if err != nil {
+			ErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+		bundles, err := i.node.UpdateBundleInventory(in.Slug)
+		if err != nil {
+			ErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+		changed, err := i.node.UpdateStockInListingIndex(append(bundles, in.Slug)...)
+		if err != nil {
+			ErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+		indexChanged = indexChanged || filled || changed
+	}
+	if indexChanged {
+		if err := i.node.SeedNode(); err != nil {
+			ErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
 	}
 	SanitizedResponse(w, `{}`)
 	return
@@ -1269,7 +1302,6 @@ func (i *jsonAPIHandler) DELETEModerator(w http.ResponseWriter, r *http.Request)
 
 func (i *jsonAPIHandler) GETListings(w http.ResponseWriter, r *http.Request) {
 	_, peerId := path.Split(r.URL.Path)
-	var err error
 	if peerId == "" || strings.ToLower(peerId) == "listings" || peerId == i.node.IpfsNode.Identity.Pretty() {
 		listingsBytes, err := i.node.GetListings()
 		if err != nil {
@@ -1278,18 +1310,24 @@ func (i *jsonAPIHandler) GETListings(w http.ResponseWriter, r *http.Request) {
 		}
 		SanitizedResponse(w, string(listingsBytes))
 	} else {
-		if strings.HasPrefix(peerId, "@") {
-			peerId, err = i.node.Resolver.Resolve(peerId)
-			if err != nil {
-				ErrorResponse(w, http.StatusNotFound, err.Error())
-				return
-			}
+		var ok bool
+		if peerId, ok = i.resolvePeerID(w, peerId); !ok {
+			return
+		}
+		if i.isBlocklisted(peerId) {
+			ErrorResponse(w, http.StatusNotFound, "Store has been blocked by a moderator")
+			return
 		}
 		listingsBytes, err := ipfs.ResolveThenCat(i.node.Context, ipnspath.FromString(path.Join(peerId, "listings", "index.json")))
 		if err != nil {
 			ErrorResponse(w, http.StatusNotFound, err.Error())
 			return
 		}
+		listingsBytes, err = i.removeBlocklistedListings(listingsBytes)
+		if err != nil {
+			ErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
 		SanitizedResponse(w, string(listingsBytes))
 		w.Header().Set("Cache-Control", "public, max-age=600, immutable")
 	}
@@ -1338,9 +1376,21 @@ func (i *jsonAPIHandler) GETListing(w http.ResponseWriter, r *http.Request) {
 		SanitizedResponseM(w, string(out), new(pb.RicardianContract))
 		return
 	} else {
+		var ok bool
+		if peerId, ok = i.resolvePeerID(w, peerId); !ok {
+			return
+		}
+		if i.isBlocklisted(peerId) {
+			ErrorResponse(w, http.StatusNotFound, "Store has been blocked by a moderator")
+			return
+		}
 		var listingsBytes []byte
 		_, err := mh.FromB58String(listingId)
 		if err == nil {
+			if i.node.BanManager.IsListingBlocklisted(listingId) {
+				ErrorResponse(w, http.StatusNotFound, "Listing has been blocked by a moderator")
+				return
+			}
 			listingsBytes, err = ipfs.Cat(i.node.Context, listingId)
 			if err != nil {
 				ErrorResponse(w, http.StatusNotFound, err.Error())
@@ -1348,14 +1398,17 @@ func (i *jsonAPIHandler) GETListing(w http.ResponseWriter, r *http.Request) {
 			}
 			w.Header().Set("Cache-Control", "public, max-age=29030400, immutable")
 		} else {
-			if strings.HasPrefix(peerId, "@") {
-				peerId, err = i.node.Resolver.Resolve(peerId)
-				if err != nil {
-					ErrorResponse(w, http.StatusNotFound, err.Error())
-					return
-				}
+			// Look up the listing's hash so a blocked listing can't be fetched by its slug
+			hash, err := ipfs.ResolvePath(i.node.Context, ipnspath.FromString(path.Join(peerId, "listings", listingId+".json")))
+			if err != nil {
+				ErrorResponse(w, http.StatusNotFound, err.Error())
+				return
 			}
-			listingsBytes, err = ipfs.ResolveThenCat(i.node.Context, ipnspath.FromString(path.Join(peerId, "listings", listingId+".json")))
+			if i.node.BanManager.IsListingBlocklisted(hash) {
+				ErrorResponse(w, http.StatusNotFound, "Listing has been blocked by a moderator")
+				return
+			}
+			listingsBytes, err = ipfs.Cat(i.node.Context, hash)
 			if err != nil {
 				ErrorResponse(w, http.StatusNotFound, err.Error())
 				return
@@ -1379,13 +1432,15 @@ func (i *jsonAPIHandler) GETProfile(w http.ResponseWriter, r *http.Request) {
 			ErrorResponse(w, http.StatusInternalServerError, err.Error())
 			return
 		}
+		i.node.SetSocialProofStatus(&profile, i.node.IpfsNode.Identity.Pretty())
 	} else {
-		if strings.HasPrefix(peerId, "@") {
-			peerId, err = i.node.Resolver.Resolve(peerId)
-			if err != nil {
-				ErrorResponse(w, http.StatusNotFound, err.Error())
-				return
-			}
+		var ok bool
+		if peerId, ok = i.resolvePeerID(w, peerId); !ok {
+			return
+		}
+		if i.isBlocklisted(peerId) {
+			ErrorResponse(w, http.StatusNotFound, "Store has been blocked by a moderator")
+			return
 		}
 		profile, err = i.node.FetchProfile(peerId)
 		if err != nil {
@@ -1414,11 +1469,19 @@ func (i *jsonAPIHandler) GETProfile(w http.ResponseWriter, r *http.Request) {
 
 func (i *jsonAPIHandler) GETFollowsMe(w http.ResponseWriter, r *http.Request) {
 	_, peerId := path.Split(r.URL.Path)
+	peerId, ok := i.resolvePeerID(w, peerId)
+	if !ok {
+		return
+	}
 	SanitizedResponse(w, fmt.Sprintf(`{"followsMe": "%t"}`, i.node.Datastore.Followers().FollowsMe(peerId)))
 }
 
 func (i *jsonAPIHandler) GETIsFollowing(w http.ResponseWriter, r *http.Request) {
 	_, peerId := path.Split(r.URL.Path)
+	peerId, ok := i.resolvePeerID(w, peerId)
+	if !ok {
+		return
+	}
 	SanitizedResponse(w, fmt.Sprintf(`{"isFollowing": "%t"}`, i.node.Datastore.Following().IsFollowing(peerId)))
 }
 
@@ -1542,6 +1605,25 @@ func (i *jsonAPIHandler) GETOrder(w http.ResponseWriter, r *http.Request) {
 
 	resp.Transactions = txs
 
+	// Resolve the selected sku for each item in the order
+	for _, item := range contract.BuyerOrder.Items {
+		listing, err := core.GetListingFromHash(item.ListingHash, contract)
+		if err != nil {
+			continue
+		}
+		variant, err := core.GetSelectedVariant(listing, item)
+		if err != nil {
+			continue
+		}
+		resp.Variants = append(resp.Variants, variant)
+	}
+
+	// Show the vendor how other vendors rated the buyer. Fetching it can take a while so
+	// serve what we have cached; /ob/buyerreputation fetches it fresh.
+	if isSale {
+		resp.BuyerReputation = i.node.CachedBuyerReputation(contract.BuyerOrder.BuyerID.PeerID)
+	}
+
 	m := jsonpb.Marshaler{
 		EnumsAsInts:  false,
 		EmitDefaults: true,
@@ -1653,6 +1735,10 @@ func (i *jsonAPIHandler) GETModerators(w http.ResponseWriter, r *http.Request) {
 						wg.Done()
 						return
 					}
+					// Only show stats actually signed by the moderator
+					if profile.ModeratorInfo != nil && profile.ModeratorInfo.Stats != nil && core.VerifyModeratorStats(profile.ModeratorInfo.Stats, m) != nil {
+						profile.ModeratorInfo.Stats = nil
+					}
 					resp := &pb.PeerAndProfile{m, &profile}
 					mar := jsonpb.Marshaler{
 						EnumsAsInts:  false,
@@ -1832,8 +1918,9 @@ func (i *jsonAPIHandler) POSTOrderComplete(w http.ResponseWriter, r *http.Reques
 
 func (i *jsonAPIHandler) POSTOpenDispute(w http.ResponseWriter, r *http.Request) {
 	type dispute struct {
-		OrderID string `json:"orderId"`
-		Claim   string `json:"claim"`
+		OrderID     string                       `json:"orderId"`
+		Claim       string                       `json:"claim"`
+		Attachments []core.DisputeAttachmentData `json:"attachments"`
 	}
 	decoder := json.NewDecoder(r.Body)
 	var d dispute
@@ -1869,7 +1956,7 @@ func (i *jsonAPIHandler) POSTOpenDispute(w http.ResponseWriter, r *http.Request)
 		return
 	}
 
-	err = i.node.OpenDispute(d.OrderID, contract, records, d.Claim)
+	err = i.node.OpenDispute(d.OrderID, contract, records, d.Claim, d.Attachments)
 	if err != nil {
 		ErrorResponse(w, http.StatusInternalServerError, err.Error())
 		return
@@ -1878,20 +1965,29 @@ func (i *jsonAPIHandler) POSTOpenDispute(w http.ResponseWriter, r *http.Request)
 	return
 }
 
+// A moderator's ruling on a dispute. Either the percentages and resolution or the
+// name of a stored resolution template must be given.
+type disputeRuling struct {
+	OrderID          string  `json:"orderId"`
+	Resolution       string  `json:"resolution"`
+	BuyerPercentage  float32 `json:"buyerPercentage"`
+	VendorPercentage float32 `json:"vendorPercentage"`
+	Template         string  `json:"template"`
+}
+
 func (i *jsonAPIHandler) POSTCloseDispute(w http.ResponseWriter, r *http.Request) {
-	type dispute struct {
-		OrderID          string  `json:"orderId"`
-		Resolution       string  `json:"resolution"`
-		BuyerPercentage  float32 `json:"buyerPercentage"`
-		VendorPercentage float32 `json:"vendorPercentage"`
-	}
 	decoder := json.NewDecoder(r.Body)
-	var d dispute
+	var d disputeRuling
 	err := decoder.Decode(&d)
 	if err != nil {
 		ErrorResponse(w, http.StatusBadRequest, err.Error())
 		return
 	}
+	d.BuyerPercentage, d.VendorPercentage, d.Resolution, err = i.node.ApplyResolutionTemplate(d.Template, d.BuyerPercentage, d.VendorPercentage, d.Resolution)
+	if err != nil {
+		ErrorResponse(w, http.StatusNotFound, err.Error())
+		return
+	}
 
 	err = i.node.CloseDispute(d.OrderID, d.BuyerPercentage, d.VendorPercentage, d.Resolution)
 	if err != nil && err == core.ErrCaseNotFound {
@@ -1901,11 +1997,23 @@ func (i *jsonAPIHandler) POSTCloseDispute(w http.ResponseWriter, r *http.Request
 		ErrorResponse(w, http.StatusInternalServerError, err.Error())
 		return
 	}
+
+	// Republish to IPNS with the updated moderator stats. The dispute is already
+	// closed so a failure here is only logged.
+	if err := i.node.SeedNode(); err != nil {
+		log.Error("Error republishing moderator stats:", err)
+	}
 	SanitizedResponse(w, `{}`)
 	return
 }
 
 func (i *jsonAPIHandler) GETCase(w http.ResponseWriter, r *http.Request) {
+	// Attachments are fetched from /ob/case/{orderId}/attachments/{hash}
+	parts := strings.Split(strings.TrimPrefix(r.URL.Path, "/ob/case/"), "/")
+	if len(parts) == 3 && parts[1] == "attachments" {
+		i.getCaseAttachment(w, r, parts[0], parts[2])
+		return
+	}
 	_, orderId := path.Split(r.URL.Path)
 	buyerContract, vendorContract, buyerErrors, vendorErrors, state, read, date, buyerOpened, claim, resolution, err := i.node.Datastore.Cases().GetCaseMetadata(orderId)
 	if err != nil {
@@ -1929,6 +2037,11 @@ func (i *jsonAPIHandler) GETCase(w http.ResponseWriter, r *http.Request) {
 	resp.Claim = claim
 	resp.Resolution = resolution
 	resp.Timestamp = ts
+	resp.Attachments, err = i.node.Datastore.Cases().GetAttachments(orderId)
+	if err != nil {
+		ErrorResponse(w, http.StatusInternalServerError, err.Error())
+		return
+	}
 
 	m := jsonpb.Marshaler{
 		EnumsAsInts:  false,
@@ -2031,6 +2144,10 @@ func (i *jsonAPIHandler) POSTChat(w http.ResponseWriter, r *http.Request) {
 		Timestamp: ts,
 		Flag:      flag,
 	}
+	var ok bool
+	if chat.PeerId, ok = i.resolvePeerID(w, chat.PeerId); !ok {
+		return
+	}
 	err = i.node.SendChat(chat.PeerId, chatPb)
 	if err != nil {
 		ErrorResponse(w, http.StatusInternalServerError, err.Error())
@@ -2050,6 +2167,10 @@ func (i *jsonAPIHandler) POSTChat(w http.ResponseWriter, r *http.Request) {
 
 func (i *jsonAPIHandler) GETChatMessages(w http.ResponseWriter, r *http.Request) {
 	_, peerId := path.Split(r.URL.Path)
+	peerId, ok := i.resolvePeerID(w, peerId)
+	if !ok {
+		return
+	}
 	limit := r.URL.Query().Get("limit")
 	if limit == "" {
 		limit = "-1"
@@ -2090,6 +2211,10 @@ func (i *jsonAPIHandler) GETChatConversations(w http.ResponseWriter, r *http.Req
 
 func (i *jsonAPIHandler) POSTMarkChatAsRead(w http.ResponseWriter, r *http.Request) {
 	_, peerId := path.Split(r.URL.Path)
+	peerId, ok := i.resolvePeerID(w, peerId)
+	if !ok {
+		return
+	}
 	lastId, updated, err := i.node.Datastore.Chat().MarkAsRead(peerId, r.URL.Query().Get("subject"), false, "")
 	if err != nil {
 		ErrorResponse(w, http.StatusInternalServerError, err.Error())
@@ -2122,6 +2247,10 @@ func (i *jsonAPIHandler) DELETEChatMessage(w http.ResponseWriter, r *http.Reques
 
 func (i *jsonAPIHandler) DELETEChatConversation(w http.ResponseWriter, r *http.Request) {
 	_, peerId := path.Split(r.URL.Path)
+	peerId, ok := i.resolvePeerID(w, peerId)
+	if !ok {
+		return
+	}
 	err := i.node.Datastore.Chat().DeleteConversation(peerId)
 	if err != nil {
 		ErrorResponse(w, http.StatusInternalServerError, err.Error())
@@ -2225,7 +2354,12 @@ func (i *jsonAPIHandler) POSTFetchProfiles(w http.ResponseWriter, r *http.Reques
 		for _, p := range pids {
 			wg.Add(1)
 			go func(pid string) {
-				pro, err := i.node.FetchProfile(pid)
+				peerId, err := i.node.ResolvePeerID(pid)
+				if err != nil {
+					wg.Done()
+					return
+				}
+				pro, err := i.node.FetchProfile(peerId)
 				if err != nil {
 					wg.Done()
 					return
@@ -2283,7 +2417,17 @@ func (i *jsonAPIHandler) POSTFetchProfiles(w http.ResponseWriter, r *http.Reques
 			}
 			for _, p := range pids {
 				go func(pid string) {
-					pro, err := i.node.FetchProfile(pid)
+					peerId, err := i.node.ResolvePeerID(pid)
+					if err != nil {
+						e := profileError{pid, err.Error()}
+						ret, err := json.MarshalIndent(e, "", "    ")
+						if err != nil {
+							return
+						}
+						i.node.Broadcast <- ret
+						return
+					}
+					pro, err := i.node.FetchProfile(peerId)
 					if err != nil {
 						e := profileError{pid, "Not found"}
 						ret, err := json.MarshalIndent(e, "", "    ")
@@ -2524,6 +2668,10 @@ func (i *jsonAPIHandler) GETCases(w http.ResponseWriter, r *http.Request) {
 
 func (i *jsonAPIHandler) POSTBlockNode(w http.ResponseWriter, r *http.Request) {
 	_, peerId := path.Split(r.URL.Path)
+	peerId, ok := i.resolvePeerID(w, peerId)
+	if !ok {
+		return
+	}
 	settings, err := i.node.Datastore.Settings().Get()
 	if err != nil {
 		ErrorResponse(w, http.StatusInternalServerError, err.Error())
@@ -2556,6 +2704,10 @@ func (i *jsonAPIHandler) POSTBlockNode(w http.ResponseWriter, r *http.Request) {
 
 func (i *jsonAPIHandler) DELETEBlockNode(w http.ResponseWriter, r *http.Request) {
 	_, peerId := path.Split(r.URL.Path)
+	peerId, ok := i.resolvePeerID(w, peerId)
+	if !ok {
+		return
+	}
 	settings, err := i.node.Datastore.Settings().Get()
 	if err != nil {
 		ErrorResponse(w, http.StatusInternalServerError, err.Error())
@@ -2635,3 +2787,1544 @@ func (i *jsonAPIHandler) GETEstimateFee(w http.ResponseWriter, r *http.Request)
 	fmt.Fprintf(w, "%d", int(i.node.Wallet.GetFeePerByte(feeLevel)))
 	return
 }
+
+func (i *jsonAPIHandler) GETShippingProfiles(w http.ResponseWriter, r *http.Request) {
+	_, name := path.Split(r.URL.Path)
+	m := jsonpb.Marshaler{
+		EnumsAsInts:  false,
+		EmitDefaults: true,
+		Indent:       "    ",
+		OrigName:     false,
+	}
+	if name != "" && strings.ToLower(name) != "shippingprofiles" {
+		profile, err := i.node.Datastore.ShippingProfiles().Get(name)
+		if err != nil {
+			ErrorResponse(w, http.StatusNotFound, "Shipping profile not found.")
+			return
+		}
+		out, err := m.MarshalToString(profile)
+		if err != nil {
+			ErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+		SanitizedResponseM(w, out, new(pb.ShippingProfile))
+		return
+	}
+	profiles, err := i.node.Datastore.ShippingProfiles().GetAll()
+	if err != nil {
+		ErrorResponse(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+	var ret []json.RawMessage
+	for _, profile := range profiles {
+		out, err := m.MarshalToString(profile)
+		if err != nil {
+			ErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+		ret = append(ret, json.RawMessage(out))
+	}
+	out, err := json.MarshalIndent(ret, "", "    ")
+	if err != nil {
+		ErrorResponse(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+	if string(out) == "null" {
+		out = []byte("[]")
+	}
+	SanitizedResponse(w, string(out))
+}
+
+func (i *jsonAPIHandler) POSTShippingProfile(w http.ResponseWriter, r *http.Request) {
+	profile := new(pb.ShippingProfile)
+	err := jsonpb.Unmarshal(r.Body, profile)
+	if err != nil {
+		ErrorResponse(w, http.StatusBadRequest, err.Error())
+		return
+	}
+	if err := core.ValidateShippingProfile(profile); err != nil {
+		ErrorResponse(w, http.StatusBadRequest, err.Error())
+		return
+	}
+	if _, err := i.node.Datastore.ShippingProfiles().Get(profile.Name); err == nil {
+		ErrorResponse(w, http.StatusConflict, "Shipping profile already exists. Use PUT.")
+		return
+	}
+	if err := i.node.Datastore.ShippingProfiles().Put(profile); err != nil {
+		ErrorResponse(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+	SanitizedResponse(w, fmt.Sprintf(`{"name": "%s"}`, profile.Name))
+}
+
+func (i *jsonAPIHandler) PUTShippingProfile(w http.ResponseWriter, r *http.Request) {
+	profile := new(pb.ShippingProfile)
+	err := jsonpb.Unmarshal(r.Body, profile)
+	if err != nil {
+		ErrorResponse(w, http.StatusBadRequest, err.Error())
+		return
+	}
+	if err := core.ValidateShippingProfile(profile); err != nil {
+		ErrorResponse(w, http.StatusBadRequest, err.Error())
+		return
+	}
+	if _, err := i.node.Datastore.ShippingProfiles().Get(profile.Name); err != nil {
+		ErrorResponse(w, http.StatusNotFound, "Shipping profile not found.")
+		return
+	}
+	if err := i.node.Datastore.ShippingProfiles().Put(profile); err != nil {
+		ErrorResponse(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	// Re-sign and republish every listing using this profile
+	updated, failed, err := i.node.UpdateShippingProfileListings(profile.Name)
+	if err != nil {
+		ErrorResponse(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+	if updated == nil {
+		updated = []string{}
+	}
+	ret, err := json.MarshalIndent(struct {
+		UpdatedListings []string          `json:"updatedListings"`
+		FailedListings  map[string]string `json:"failedListings,omitempty"`
+	}{updated, failed}, "", "    ")
+	if err != nil {
+		ErrorResponse(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+	SanitizedResponse(w, string(ret))
+}
+
+func (i *jsonAPIHandler) DELETEShippingProfile(w http.ResponseWriter, r *http.Request) {
+	_, name := path.Split(r.URL.Path)
+	if _, err := i.node.Datastore.ShippingProfiles().Get(name); err != nil {
+		ErrorResponse(w, http.StatusNotFound, "Shipping profile not found.")
+		return
+	}
+	slugs, err := i.node.GetShippingProfileListings(name)
+	if err != nil {
+		ErrorResponse(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+	if len(slugs) > 0 {
+		ErrorResponse(w, http.StatusConflict, fmt.Sprintf("Shipping profile is in use by %d listings", len(slugs)))
+		return
+	}
+	if err := i.node.Datastore.ShippingProfiles().Delete(name); err != nil {
+		ErrorResponse(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+	SanitizedResponse(w, `{}`)
+}
+
+func (i *jsonAPIHandler) GETVouchers(w http.ResponseWriter, r *http.Request) {
+	_, id := path.Split(r.URL.Path)
+	m := jsonpb.Marshaler{
+		EnumsAsInts:  false,
+		EmitDefaults: true,
+		Indent:       "    ",
+		OrigName:     false,
+	}
+	if id != "" && strings.ToLower(id) != "vouchers" {
+		vb, err := i.node.Datastore.Vouchers().Get(id)
+		if err != nil {
+			ErrorResponse(w, http.StatusNotFound, "Voucher not found.")
+			return
+		}
+		out, err := m.MarshalToString(vb)
+		if err != nil {
+			ErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+		SanitizedResponseM(w, out, new(pb.VoucherBalance))
+		return
+	}
+	vouchers, err := i.node.Datastore.Vouchers().GetAll()
+	if err != nil {
+		ErrorResponse(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+	var ret []json.RawMessage
+	for _, vb := range vouchers {
+		out, err := m.MarshalToString(vb)
+		if err != nil {
+			ErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+		ret = append(ret, json.RawMessage(out))
+	}
+	out, err := json.MarshalIndent(ret, "", "    ")
+	if err != nil {
+		ErrorResponse(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+	if string(out) == "null" {
+		out = []byte("[]")
+	}
+	SanitizedResponse(w, string(out))
+}
+
+func (i *jsonAPIHandler) POSTVoucher(w http.ResponseWriter, r *http.Request) {
+	type voucherRequest struct {
+		Amount  uint64 `json:"amount"`
+		BuyerID string `json:"buyerID"`
+		Code    string `json:"code"`
+		Memo    string `json:"memo"`
+	}
+	decoder := json.NewDecoder(r.Body)
+	var req voucherRequest
+	err := decoder.Decode(&req)
+	if err != nil {
+		ErrorResponse(w, http.StatusBadRequest, err.Error())
+		return
+	}
+	voucher, err := i.node.IssueVoucher(req.Amount, req.BuyerID, req.Code, req.Memo)
+	if err != nil {
+		ErrorResponse(w, http.StatusBadRequest, err.Error())
+		return
+	}
+	m := jsonpb.Marshaler{
+		EnumsAsInts:  false,
+		EmitDefaults: false,
+		Indent:       "    ",
+		OrigName:     false,
+	}
+	out, err := m.MarshalToString(voucher)
+	if err != nil {
+		ErrorResponse(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+	SanitizedResponseM(w, out, new(pb.Voucher))
+}
+
+func (i *jsonAPIHandler) DELETEVoucher(w http.ResponseWriter, r *http.Request) {
+	_, id := path.Split(r.URL.Path)
+	if _, err := i.node.Datastore.Vouchers().Get(id); err != nil {
+		ErrorResponse(w, http.StatusNotFound, "Voucher not found.")
+		return
+	}
+	if err := i.node.Datastore.Vouchers().Delete(id); err != nil {
+		ErrorResponse(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+	SanitizedResponse(w, `{}`)
+}
+
+func (i *jsonAPIHandler) GETSubscriptions(w http.ResponseWriter, r *http.Request) {
+	_, id := path.Split(r.URL.Path)
+	m := jsonpb.Marshaler{
+		EnumsAsInts:  false,
+		EmitDefaults: true,
+		Indent:       "    ",
+		OrigName:     false,
+	}
+	if id != "" && strings.ToLower(id) != "subscriptions" {
+		sub, err := i.node.Datastore.Subscriptions().Get(id)
+		if err != nil {
+			ErrorResponse(w, http.StatusNotFound, "Subscription not found.")
+			return
+		}
+		out, err := m.MarshalToString(sub)
+		if err != nil {
+			ErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+		SanitizedResponseM(w, out, new(pb.Subscription))
+		return
+	}
+	subs, err := i.node.Datastore.Subscriptions().GetAll()
+	if err != nil {
+		ErrorResponse(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+	var ret []json.RawMessage
+	for _, sub := range subs {
+		out, err := m.MarshalToString(sub)
+		if err != nil {
+			ErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+		ret = append(ret, json.RawMessage(out))
+	}
+	out, err := json.MarshalIndent(ret, "", "    ")
+	if err != nil {
+		ErrorResponse(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+	if string(out) == "null" {
+		out = []byte("[]")
+	}
+	SanitizedResponse(w, string(out))
+}
+
+func (i *jsonAPIHandler) POSTSubscription(w http.ResponseWriter, r *http.Request) {
+	decoder := json.NewDecoder(r.Body)
+	var data core.SubscriptionData
+	err := decoder.Decode(&data)
+	if err != nil {
+		ErrorResponse(w, http.StatusBadRequest, err.Error())
+		return
+	}
+	sub, err := i.node.Subscribe(&data)
+	if err != nil {
+		ErrorResponse(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+	m := jsonpb.Marshaler{
+		EnumsAsInts:  false,
+		EmitDefaults: true,
+		Indent:       "    ",
+		OrigName:     false,
+	}
+	out, err := m.MarshalToString(sub)
+	if err != nil {
+		ErrorResponse(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+	SanitizedResponseM(w, out, new(pb.Subscription))
+}
+
+func (i *jsonAPIHandler) POSTSubscriptionPause(w http.ResponseWriter, r *http.Request) {
+	i.setSubscriptionStatus(w, r, pb.Subscription_PAUSED)
+}
+
+func (i *jsonAPIHandler) POSTSubscriptionResume(w http.ResponseWriter, r *http.Request) {
+	i.setSubscriptionStatus(w, r, pb.Subscription_ACTIVE)
+}
+
+func (i *jsonAPIHandler) POSTSubscriptionCancel(w http.ResponseWriter, r *http.Request) {
+	i.setSubscriptionStatus(w, r, pb.Subscription_CANCELED)
+}
+
+func (i *jsonAPIHandler) POSTSubscriptionCap(w http.ResponseWriter, r *http.Request) {
+	type capUpdate struct {
+		SubscriptionId string `json:"subscriptionId"`
+		SpendingCap    uint64 `json:"spendingCap"`
+	}
+	decoder := json.NewDecoder(r.Body)
+	var update capUpdate
+	err := decoder.Decode(&update)
+	if err != nil {
+		ErrorResponse(w, http.StatusBadRequest, err.Error())
+		return
+	}
+	err = i.node.SetSubscriptionSpendingCap(update.SubscriptionId, update.SpendingCap)
+	if err == core.ErrSubscriptionNotFound {
+		ErrorResponse(w, http.StatusNotFound, "Subscription not found.")
+		return
+	} else if err != nil {
+		ErrorResponse(w, http.StatusBadRequest, err.Error())
+		return
+	}
+	SanitizedResponse(w, `{}`)
+}
+
+func (i *jsonAPIHandler) setSubscriptionStatus(w http.ResponseWriter, r *http.Request, status pb.Subscription_Status) {
+	type subscriptionUpdate struct {
+		SubscriptionId string `json:"subscriptionId"`
+	}
+	decoder := json.NewDecoder(r.Body)
+	var update subscriptionUpdate
+	err := decoder.Decode(&update)
+	if err != nil {
+		ErrorResponse(w, http.StatusBadRequest, err.Error())
+		return
+	}
+	err = i.node.SetSubscriptionStatus(update.SubscriptionId, status)
+	if err == core.ErrSubscriptionNotFound {
+		ErrorResponse(w, http.StatusNotFound, "Subscription not found.")
+		return
+	} else if err != nil {
+		ErrorResponse(w, http.StatusBadRequest, err.Error())
+		return
+	}
+	SanitizedResponse(w, `{}`)
+}
+
+func (i *jsonAPIHandler) getCaseAttachment(w http.ResponseWriter, r *http.Request, orderId, hash string) {
+	attachments, err := i.node.Datastore.Cases().GetAttachments(orderId)
+	if err != nil {
+		ErrorResponse(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+	i.serveDisputeAttachment(w, r, attachments, hash)
+}
+
+func (i *jsonAPIHandler) serveDisputeAttachment(w http.ResponseWriter, r *http.Request, attachments []*pb.DisputeAttachment, hash string) {
+	var attachment *pb.DisputeAttachment
+	for _, a := range attachments {
+		if a.Hash == hash {
+			attachment = a
+		}
+	}
+	if attachment == nil {
+		ErrorResponse(w, http.StatusNotFound, "Attachment not found.")
+		return
+	}
+	thumbnail, _ := strconv.ParseBool(r.URL.Query().Get("thumbnail"))
+	b, err := i.node.GetDisputeAttachment(attachment, thumbnail)
+	if err != nil {
+		ErrorResponse(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+	filename := attachment.Filename
+	if thumbnail {
+		filename = "thumbnail.jpg"
+	}
+	w.Header().Set("Content-Disposition", `inline; filename="`+strings.Replace(filename, `"`, "", -1)+`"`)
+	http.ServeContent(w, r, filename, time.Now(), bytes.NewReader(b))
+}
+
+// Lists the evidence attached to a dispute at /ob/disputeevidence/{orderId} and fetches
+// an attached file at /ob/disputeevidence/{orderId}/{hash}
+func (i *jsonAPIHandler) GETDisputeEvidence(w http.ResponseWriter, r *http.Request) {
+	parts := strings.Split(strings.Trim(strings.TrimPrefix(r.URL.Path, "/ob/disputeevidence"), "/"), "/")
+	if parts[0] == "" || len(parts) > 2 {
+		ErrorResponse(w, http.StatusBadRequest, "Order ID must be provided")
+		return
+	}
+	attachments, err := i.node.GetDisputeAttachments(parts[0])
+	if err != nil {
+		ErrorResponse(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+	if len(parts) == 2 {
+		i.serveDisputeAttachment(w, r, attachments, parts[1])
+		return
+	}
+	m := jsonpb.Marshaler{
+		EnumsAsInts:  false,
+		EmitDefaults: true,
+		Indent:       "    ",
+		OrigName:     false,
+	}
+	ret := []json.RawMessage{}
+	for _, a := range attachments {
+		out, err := m.MarshalToString(a)
+		if err != nil {
+			ErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+		ret = append(ret, json.RawMessage(out))
+	}
+	out, err := json.MarshalIndent(ret, "", "    ")
+	if err != nil {
+		ErrorResponse(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+	SanitizedResponse(w, string(out))
+}
+
+func (i *jsonAPIHandler) POSTDisputeEvidence(w http.ResponseWriter, r *http.Request) {
+	type evidence struct {
+		OrderID     string                       `json:"orderId"`
+		Attachments []core.DisputeAttachmentData `json:"attachments"`
+	}
+	decoder := json.NewDecoder(r.Body)
+	var e evidence
+	err := decoder.Decode(&e)
+	if err != nil {
+		ErrorResponse(w, http.StatusBadRequest, err.Error())
+		return
+	}
+	err = i.node.AddDisputeEvidence(e.OrderID, e.Attachments)
+	if err != nil {
+		ErrorResponse(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+	SanitizedResponse(w, `{}`)
+	return
+}
+
+func (i *jsonAPIHandler) GETCaseChat(w http.ResponseWriter, r *http.Request) {
+	_, orderId := path.Split(r.URL.Path)
+	messages, err := i.node.Datastore.CaseChat().GetMessages(orderId)
+	if err != nil {
+		ErrorResponse(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+	m := jsonpb.Marshaler{
+		EnumsAsInts:  false,
+		EmitDefaults: true,
+		Indent:       "    ",
+		OrigName:     false,
+	}
+	var ret []json.RawMessage
+	for _, chat := range messages {
+		out, err := m.MarshalToString(chat)
+		if err != nil {
+			ErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+		ret = append(ret, json.RawMessage(out))
+	}
+	out, err := json.MarshalIndent(ret, "", "    ")
+	if err != nil {
+		ErrorResponse(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+	if string(out) == "null" {
+		out = []byte("[]")
+	}
+	SanitizedResponse(w, string(out))
+}
+
+func (i *jsonAPIHandler) POSTCaseChat(w http.ResponseWriter, r *http.Request) {
+	type caseChat struct {
+		OrderID string `json:"orderId"`
+		Message string `json:"message"`
+	}
+	decoder := json.NewDecoder(r.Body)
+	var c caseChat
+	err := decoder.Decode(&c)
+	if err != nil {
+		ErrorResponse(w, http.StatusBadRequest, err.Error())
+		return
+	}
+	chat, err := i.node.SendCaseChat(c.OrderID, c.Message)
+	if err != nil && err == core.ErrCaseNotFound {
+		ErrorResponse(w, http.StatusNotFound, err.Error())
+		return
+	} else if err != nil {
+		ErrorResponse(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+	m := jsonpb.Marshaler{
+		EnumsAsInts:  false,
+		EmitDefaults: true,
+		Indent:       "    ",
+		OrigName:     false,
+	}
+	out, err := m.MarshalToString(chat)
+	if err != nil {
+		ErrorResponse(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+	SanitizedResponseM(w, out, new(pb.CaseChat))
+}
+
+func (i *jsonAPIHandler) POSTPayoutProposal(w http.ResponseWriter, r *http.Request) {
+	type proposal struct {
+		OrderID          string  `json:"orderId"`
+		BuyerPercentage  float32 `json:"buyerPercentage"`
+		VendorPercentage float32 `json:"vendorPercentage"`
+	}
+	decoder := json.NewDecoder(r.Body)
+	var p proposal
+	err := decoder.Decode(&p)
+	if err != nil {
+		ErrorResponse(w, http.StatusBadRequest, err.Error())
+		return
+	}
+	err = i.node.ProposeDisputePayout(p.OrderID, p.BuyerPercentage, p.VendorPercentage)
+	if err != nil && err == core.ErrOrderNotFound {
+		ErrorResponse(w, http.StatusNotFound, err.Error())
+		return
+	} else if err != nil {
+		ErrorResponse(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+	SanitizedResponse(w, `{}`)
+}
+
+func (i *jsonAPIHandler) POSTAcceptPayoutProposal(w http.ResponseWriter, r *http.Request) {
+	type accept struct {
+		OrderID string `json:"orderId"`
+	}
+	decoder := json.NewDecoder(r.Body)
+	var a accept
+	err := decoder.Decode(&a)
+	if err != nil {
+		ErrorResponse(w, http.StatusBadRequest, err.Error())
+		return
+	}
+	err = i.node.AcceptPayoutProposal(a.OrderID)
+	if err != nil && (err == core.ErrOrderNotFound || err == core.ErrNoPayoutProposal) {
+		ErrorResponse(w, http.StatusNotFound, err.Error())
+		return
+	} else if err != nil {
+		ErrorResponse(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+	SanitizedResponse(w, `{}`)
+}
+
+func (i *jsonAPIHandler) POSTEscalateDispute(w http.ResponseWriter, r *http.Request) {
+	type escalation struct {
+		OrderID   string `json:"orderId"`
+		Moderator string `json:"moderator"`
+	}
+	decoder := json.NewDecoder(r.Body)
+	var e escalation
+	err := decoder.Decode(&e)
+	if err != nil {
+		ErrorResponse(w, http.StatusBadRequest, err.Error())
+		return
+	}
+	var contract *pb.RicardianContract
+	var state pb.OrderState
+	var records []*spvwallet.TransactionRecord
+	contract, state, _, records, _, err = i.node.Datastore.Purchases().GetByOrderId(e.OrderID)
+	if err != nil {
+		contract, state, _, records, _, err = i.node.Datastore.Sales().GetByOrderId(e.OrderID)
+		if err != nil {
+			ErrorResponse(w, http.StatusNotFound, "Order not found")
+			return
+		}
+	}
+	if state != pb.OrderState_DISPUTED {
+		ErrorResponse(w, http.StatusBadRequest, "Order must be in DISPUTED state to escalate the dispute")
+		return
+	}
+	err = i.node.EscalateDispute(e.OrderID, contract, records, e.Moderator)
+	if err != nil {
+		ErrorResponse(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+	SanitizedResponse(w, `{}`)
+}
+
+func (i *jsonAPIHandler) POSTEndorseDispute(w http.ResponseWriter, r *http.Request) {
+	type endorsement struct {
+		OrderID string `json:"orderId"`
+	}
+	decoder := json.NewDecoder(r.Body)
+	var e endorsement
+	err := decoder.Decode(&e)
+	if err != nil {
+		ErrorResponse(w, http.StatusBadRequest, err.Error())
+		return
+	}
+	err = i.node.EndorseDisputeResolution(e.OrderID)
+	if err != nil && err == core.ErrCaseNotFound {
+		ErrorResponse(w, http.StatusNotFound, err.Error())
+		return
+	} else if err != nil {
+		ErrorResponse(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+	SanitizedResponse(w, `{}`)
+}
+
+func (i *jsonAPIHandler) GETModeratorStats(w http.ResponseWriter, r *http.Request) {
+	period := r.URL.Query().Get("period")
+	if period == "" {
+		period = "month"
+	}
+	earnings, total, err := i.node.GetModeratorEarnings(period)
+	if err != nil && err == core.ErrInvalidEarningsPeriod {
+		ErrorResponse(w, http.StatusBadRequest, err.Error())
+		return
+	} else if err != nil {
+		ErrorResponse(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+	if earnings == nil {
+		earnings = []core.ModeratorEarnings{}
+	}
+	stats, err := i.node.GetModeratorStats()
+	if err != nil {
+		ErrorResponse(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+	m := jsonpb.Marshaler{
+		EnumsAsInts:  false,
+		EmitDefaults: true,
+		Indent:       "    ",
+		OrigName:     false,
+	}
+	statsJSON, err := m.MarshalToString(stats)
+	if err != nil {
+		ErrorResponse(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+	type moderatorStats struct {
+		Stats      json.RawMessage          `json:"stats"`
+		FeesEarned uint64                   `json:"feesEarned"`
+		Earnings   []core.ModeratorEarnings `json:"earnings"`
+	}
+	out, err := json.MarshalIndent(moderatorStats{json.RawMessage(statsJSON), total, earnings}, "", "    ")
+	if err != nil {
+		ErrorResponse(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+	SanitizedResponse(w, string(out))
+}
+
+func (i *jsonAPIHandler) POSTPreviewDisputeResolution(w http.ResponseWriter, r *http.Request) {
+	decoder := json.NewDecoder(r.Body)
+	var d disputeRuling
+	err := decoder.Decode(&d)
+	if err != nil {
+		ErrorResponse(w, http.StatusBadRequest, err.Error())
+		return
+	}
+	d.BuyerPercentage, d.VendorPercentage, d.Resolution, err = i.node.ApplyResolutionTemplate(d.Template, d.BuyerPercentage, d.VendorPercentage, d.Resolution)
+	if err != nil {
+		ErrorResponse(w, http.StatusNotFound, err.Error())
+		return
+	}
+
+	payout, fee, err := i.node.PreviewDisputeResolution(d.OrderID, d.BuyerPercentage, d.VendorPercentage)
+	if err != nil && err == core.ErrCaseNotFound {
+		ErrorResponse(w, http.StatusNotFound, err.Error())
+		return
+	} else if err != nil {
+		ErrorResponse(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+	m := jsonpb.Marshaler{
+		EnumsAsInts:  false,
+		EmitDefaults: true,
+		Indent:       "    ",
+		OrigName:     false,
+	}
+	payoutJSON, err := m.MarshalToString(payout)
+	if err != nil {
+		ErrorResponse(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+	ret, err := json.MarshalIndent(struct {
+		BuyerPercentage  float32         `json:"buyerPercentage"`
+		VendorPercentage float32         `json:"vendorPercentage"`
+		Resolution       string          `json:"resolution"`
+		Payout           json.RawMessage `json:"payout"`
+		TransactionFee   uint64          `json:"transactionFee"`
+	}{d.BuyerPercentage, d.VendorPercentage, d.Resolution, json.RawMessage(payoutJSON), fee}, "", "    ")
+	if err != nil {
+		ErrorResponse(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+	SanitizedResponse(w, string(ret))
+}
+
+func (i *jsonAPIHandler) GETResolutionTemplates(w http.ResponseWriter, r *http.Request) {
+	_, name := path.Split(r.URL.Path)
+	m := jsonpb.Marshaler{
+		EnumsAsInts:  false,
+		EmitDefaults: true,
+		Indent:       "    ",
+		OrigName:     false,
+	}
+	if name != "" && strings.ToLower(name) != "resolutiontemplates" {
+		template, err := i.node.Datastore.ResolutionTemplates().Get(name)
+		if err != nil {
+			ErrorResponse(w, http.StatusNotFound, "Resolution template not found.")
+			return
+		}
+		out, err := m.MarshalToString(template)
+		if err != nil {
+			ErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+		SanitizedResponseM(w, out, new(pb.ResolutionTemplate))
+		return
+	}
+	templates, err := i.node.Datastore.ResolutionTemplates().GetAll()
+	if err != nil {
+		ErrorResponse(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+	var ret []json.RawMessage
+	for _, template := range templates {
+		out, err := m.MarshalToString(template)
+		if err != nil {
+			ErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+		ret = append(ret, json.RawMessage(out))
+	}
+	out, err := json.MarshalIndent(ret, "", "    ")
+	if err != nil {
+		ErrorResponse(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+	if string(out) == "null" {
+		out = []byte("[]")
+	}
+	SanitizedResponse(w, string(out))
+}
+
+func (i *jsonAPIHandler) POSTResolutionTemplate(w http.ResponseWriter, r *http.Request) {
+	template := new(pb.ResolutionTemplate)
+	err := jsonpb.Unmarshal(r.Body, template)
+	if err != nil {
+		ErrorResponse(w, http.StatusBadRequest, err.Error())
+		return
+	}
+	if err := core.ValidateResolutionTemplate(template); err != nil {
+		ErrorResponse(w, http.StatusBadRequest, err.Error())
+		return
+	}
+	if _, err := i.node.Datastore.ResolutionTemplates().Get(template.Name); err == nil {
+		ErrorResponse(w, http.StatusConflict, "Resolution template already exists. Use PUT.")
+		return
+	}
+	if err := i.node.Datastore.ResolutionTemplates().Put(template); err != nil {
+		ErrorResponse(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+	SanitizedResponse(w, fmt.Sprintf(`{"name": "%s"}`, template.Name))
+}
+
+func (i *jsonAPIHandler) PUTResolutionTemplate(w http.ResponseWriter, r *http.Request) {
+	template := new(pb.ResolutionTemplate)
+	err := jsonpb.Unmarshal(r.Body, template)
+	if err != nil {
+		ErrorResponse(w, http.StatusBadRequest, err.Error())
+		return
+	}
+	if err := core.ValidateResolutionTemplate(template); err != nil {
+		ErrorResponse(w, http.StatusBadRequest, err.Error())
+		return
+	}
+	if _, err := i.node.Datastore.ResolutionTemplates().Get(template.Name); err != nil {
+		ErrorResponse(w, http.StatusNotFound, "Resolution template not found.")
+		return
+	}
+	if err := i.node.Datastore.ResolutionTemplates().Put(template); err != nil {
+		ErrorResponse(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+	SanitizedResponse(w, `{}`)
+}
+
+func (i *jsonAPIHandler) DELETEResolutionTemplate(w http.ResponseWriter, r *http.Request) {
+	_, name := path.Split(r.URL.Path)
+	if _, err := i.node.Datastore.ResolutionTemplates().Get(name); err != nil {
+		ErrorResponse(w, http.StatusNotFound, "Resolution template not found.")
+		return
+	}
+	if err := i.node.Datastore.ResolutionTemplates().Delete(name); err != nil {
+		ErrorResponse(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+	SanitizedResponse(w, `{}`)
+}
+
+func (i *jsonAPIHandler) POSTReport(w http.ResponseWriter, r *http.Request) {
+	type report struct {
+		Moderators  []string `json:"moderators"`
+		ListingHash string   `json:"listingHash"`
+		PeerID      string   `json:"peerId"`
+		Reason      string   `json:"reason"`
+		Evidence    string   `json:"evidence"`
+	}
+	decoder := json.NewDecoder(r.Body)
+	var rep report
+	err := decoder.Decode(&rep)
+	if err != nil {
+		ErrorResponse(w, http.StatusBadRequest, err.Error())
+		return
+	}
+	reason, err := core.ParseReportReason(rep.Reason)
+	if err != nil {
+		ErrorResponse(w, http.StatusBadRequest, err.Error())
+		return
+	}
+	if strings.HasPrefix(rep.PeerID, "@") {
+		var ok bool
+		if rep.PeerID, ok = i.resolvePeerID(w, rep.PeerID); !ok {
+			return
+		}
+	}
+	if err := core.ValidateReportTarget(rep.ListingHash, rep.PeerID); err != nil {
+		ErrorResponse(w, http.StatusBadRequest, err.Error())
+		return
+	}
+	if len(rep.Moderators) == 0 {
+		ErrorResponse(w, http.StatusBadRequest, "At least one moderator must be given")
+		return
+	}
+	sent, sentTo, err := i.node.SendReport(rep.Moderators, rep.ListingHash, rep.PeerID, reason, rep.Evidence)
+	if err != nil && err == core.ErrReportsNotAccepted {
+		ErrorResponse(w, http.StatusBadRequest, "None of the moderators accept reports")
+		return
+	} else if err != nil {
+		ErrorResponse(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+	ret, err := json.MarshalIndent(struct {
+		ReportId string   `json:"reportId"`
+		SentTo   []string `json:"sentTo"`
+	}{sent.ReportId, sentTo}, "", "    ")
+	if err != nil {
+		ErrorResponse(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+	SanitizedResponse(w, string(ret))
+}
+
+func (i *jsonAPIHandler) GETReports(w http.ResponseWriter, r *http.Request) {
+	reports, err := i.node.Datastore.Reports().GetAll()
+	if err != nil {
+		ErrorResponse(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+	m := jsonpb.Marshaler{
+		EnumsAsInts:  false,
+		EmitDefaults: true,
+		Indent:       "    ",
+		OrigName:     false,
+	}
+	var ret []json.RawMessage
+	for _, report := range reports {
+		out, err := m.MarshalToString(report)
+		if err != nil {
+			ErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+		ret = append(ret, json.RawMessage(out))
+	}
+	out, err := json.MarshalIndent(ret, "", "    ")
+	if err != nil {
+		ErrorResponse(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+	if string(out) == "null" {
+		out = []byte("[]")
+	}
+	SanitizedResponse(w, string(out))
+}
+
+func (i *jsonAPIHandler) DELETEReport(w http.ResponseWriter, r *http.Request) {
+	_, reportId := path.Split(r.URL.Path)
+	if err := i.node.Datastore.Reports().Delete(reportId); err != nil {
+		ErrorResponse(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+	SanitizedResponse(w, `{}`)
+}
+
+func (i *jsonAPIHandler) GETBlocklist(w http.ResponseWriter, r *http.Request) {
+	_, peerId := path.Split(r.URL.Path)
+	var blocklist *pb.Blocklist
+	var err error
+	if peerId != "" && strings.ToLower(peerId) != "blocklist" {
+		var ok bool
+		if peerId, ok = i.resolvePeerID(w, peerId); !ok {
+			return
+		}
+	}
+	if peerId == "" || strings.ToLower(peerId) == "blocklist" || peerId == i.node.IpfsNode.Identity.Pretty() {
+		blocklist, err = i.node.GetBlocklist()
+		if err != nil {
+			ErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+	} else {
+		blocklist, err = i.node.FetchBlocklist(peerId)
+		if err != nil {
+			ErrorResponse(w, http.StatusNotFound, err.Error())
+			return
+		}
+		w.Header().Set("Cache-Control", "public, max-age=600, immutable")
+	}
+	m := jsonpb.Marshaler{
+		EnumsAsInts:  false,
+		EmitDefaults: true,
+		Indent:       "    ",
+		OrigName:     false,
+	}
+	out, err := m.MarshalToString(blocklist)
+	if err != nil {
+		ErrorResponse(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+	SanitizedResponseM(w, out, new(pb.Blocklist))
+}
+
+func (i *jsonAPIHandler) POSTBlocklist(w http.ResponseWriter, r *http.Request) {
+	type entry struct {
+		ListingHash string `json:"listingHash"`
+		PeerID      string `json:"peerId"`
+		Reason      string `json:"reason"`
+	}
+	decoder := json.NewDecoder(r.Body)
+	var e entry
+	err := decoder.Decode(&e)
+	if err != nil {
+		ErrorResponse(w, http.StatusBadRequest, err.Error())
+		return
+	}
+	reason, err := core.ParseReportReason(e.Reason)
+	if err != nil {
+		ErrorResponse(w, http.StatusBadRequest, err.Error())
+		return
+	}
+	if strings.HasPrefix(e.PeerID, "@") {
+		var ok bool
+		if e.PeerID, ok = i.resolvePeerID(w, e.PeerID); !ok {
+			return
+		}
+	}
+	if err := core.ValidateReportTarget(e.ListingHash, e.PeerID); err != nil {
+		ErrorResponse(w, http.StatusBadRequest, err.Error())
+		return
+	}
+	blocklist, err := i.node.AddBlocklistEntry(e.ListingHash, e.PeerID, reason)
+	if err != nil {
+		ErrorResponse(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+	if err := i.node.SeedNode(); err != nil {
+		ErrorResponse(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+	m := jsonpb.Marshaler{
+		EnumsAsInts:  false,
+		EmitDefaults: true,
+		Indent:       "    ",
+		OrigName:     false,
+	}
+	out, err := m.MarshalToString(blocklist)
+	if err != nil {
+		ErrorResponse(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+	SanitizedResponseM(w, out, new(pb.Blocklist))
+}
+
+func (i *jsonAPIHandler) DELETEBlocklist(w http.ResponseWriter, r *http.Request) {
+	_, target := path.Split(r.URL.Path)
+	err := i.node.RemoveBlocklistEntry(target)
+	if err != nil && err == core.ErrBlocklistEntryNotFound {
+		ErrorResponse(w, http.StatusNotFound, err.Error())
+		return
+	} else if err != nil {
+		ErrorResponse(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+	if err := i.node.SeedNode(); err != nil {
+		ErrorResponse(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+	SanitizedResponse(w, `{}`)
+}
+
+func (i *jsonAPIHandler) GETRatings(w http.ResponseWriter, r *http.Request) {
+	var peerId, slug string
+	params := strings.Split(strings.Trim(strings.TrimPrefix(r.URL.Path, "/ob/ratings"), "/"), "/")
+	if len(params) > 2 {
+		ErrorResponse(w, http.StatusNotFound, "Not Found")
+		return
+	}
+	peerId = params[0]
+	if len(params) == 2 {
+		slug = params[1]
+	}
+	var err error
+	if peerId == "" {
+		peerId = i.node.IpfsNode.Identity.Pretty()
+	} else {
+		var ok bool
+		if peerId, ok = i.resolvePeerID(w, peerId); !ok {
+			return
+		}
+	}
+	summary, err := i.node.GetRatings(peerId, slug)
+	if err != nil {
+		ErrorResponse(w, http.StatusNotFound, err.Error())
+		return
+	}
+	m := jsonpb.Marshaler{
+		EnumsAsInts:  false,
+		EmitDefaults: false,
+		Indent:       "    ",
+		OrigName:     false,
+	}
+	type rating struct {
+		Hash   string          `json:"hash"`
+		Rating json.RawMessage `json:"rating"`
+		Reply  json.RawMessage `json:"reply,omitempty"`
+
+		ReplyRevisions []string `json:"replyRevisions,omitempty"`
+	}
+	ratings := []rating{}
+	for _, vr := range summary.Ratings {
+		out, err := m.MarshalToString(vr.Rating)
+		if err != nil {
+			ErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+		rt := rating{Hash: vr.Hash, Rating: json.RawMessage(out)}
+		if vr.Reply != nil {
+			out, err = m.MarshalToString(vr.Reply)
+			if err != nil {
+				ErrorResponse(w, http.StatusInternalServerError, err.Error())
+				return
+			}
+			rt.Reply = json.RawMessage(out)
+			rt.ReplyRevisions = vr.ReplyRevisions
+		}
+		ratings = append(ratings, rt)
+	}
+	ret, err := json.MarshalIndent(struct {
+		*core.RatingsSummary
+		Ratings []rating `json:"ratings"`
+	}{summary, ratings}, "", "    ")
+	if err != nil {
+		ErrorResponse(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+	SanitizedResponse(w, string(ret))
+}
+
+func (i *jsonAPIHandler) POSTRatingReply(w http.ResponseWriter, r *http.Request) {
+	type ratingReply struct {
+		RatingHash string `json:"ratingHash"`
+		Reply      string `json:"reply"`
+	}
+	decoder := json.NewDecoder(r.Body)
+	var rr ratingReply
+	err := decoder.Decode(&rr)
+	if err != nil {
+		ErrorResponse(w, http.StatusBadRequest, err.Error())
+		return
+	}
+	if err := core.ValidateRatingReply(rr.Reply); err != nil {
+		ErrorResponse(w, http.StatusBadRequest, err.Error())
+		return
+	}
+	reply, err := i.node.ReplyToRating(rr.RatingHash, rr.Reply)
+	if err != nil && err == core.ErrRatingNotFound {
+		ErrorResponse(w, http.StatusNotFound, err.Error())
+		return
+	} else if err != nil {
+		ErrorResponse(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+	if err := i.node.SeedNode(); err != nil {
+		ErrorResponse(w, http.StatusInternalServerError, "IPNS Error: "+err.Error())
+		return
+	}
+	m := jsonpb.Marshaler{
+		EnumsAsInts:  false,
+		EmitDefaults: false,
+		Indent:       "    ",
+		OrigName:     false,
+	}
+	out, err := m.MarshalToString(reply)
+	if err != nil {
+		ErrorResponse(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+	SanitizedResponseM(w, out, new(pb.RatingReply))
+}
+
+// Resolve a peer ID or @handle passed to the API. If it can't be resolved the error
+// response is written and false returned: a handle which doesn't resolve is a 404 and
+// anything else which isn't a peer ID is a 400.
+func (i *jsonAPIHandler) resolvePeerID(w http.ResponseWriter, peerId string) (string, bool) {
+	if strings.HasPrefix(peerId, "@") {
+		resolved, err := i.node.ResolvePeerID(peerId)
+		if err != nil {
+			ErrorResponse(w, http.StatusNotFound, err.Error())
+			return "", false
+		}
+		return resolved, true
+	}
+	if _, err := peer.IDB58Decode(peerId); err != nil {
+		ErrorResponse(w, http.StatusBadRequest, "Invalid peer ID")
+		return "", false
+	}
+	return peerId, true
+}
+
+// Returns true if the store was flagged in a blocklist we subscribe to
+func (i *jsonAPIHandler) isBlocklisted(peerId string) bool {
+	pid, err := peer.IDB58Decode(peerId)
+	if err != nil {
+		return false
+	}
+	return i.node.BanManager.IsBlocklisted(pid)
+}
+
+// Remove the listings flagged in the blocklists we subscribe to from a listing index
+func (i *jsonAPIHandler) removeBlocklistedListings(index []byte) ([]byte, error) {
+	var listings []json.RawMessage
+	if err := json.Unmarshal(index, &listings); err != nil {
+		return nil, err
+	}
+	ret := []json.RawMessage{}
+	for _, l := range listings {
+		var ld struct {
+			Hash string `json:"hash"`
+		}
+		if err := json.Unmarshal(l, &ld); err != nil {
+			return nil, err
+		}
+		if !i.node.BanManager.IsListingBlocklisted(ld.Hash) {
+			ret = append(ret, l)
+		}
+	}
+	if len(ret) == len(listings) {
+		return index, nil
+	}
+	return json.MarshalIndent(ret, "", "    ")
+}
+
+func (i *jsonAPIHandler) POSTBuyerRating(w http.ResponseWriter, r *http.Request) {
+	type buyerRating struct {
+		OrderId string `json:"orderId"`
+		Overall uint32 `json:"overall"`
+		Review  string `json:"review"`
+	}
+	decoder := json.NewDecoder(r.Body)
+	var br buyerRating
+	err := decoder.Decode(&br)
+	if err != nil {
+		ErrorResponse(w, http.StatusBadRequest, err.Error())
+		return
+	}
+	if br.Overall < core.RatingMin || br.Overall > core.RatingMax {
+		ErrorResponse(w, http.StatusBadRequest, "Rating not within valid range")
+		return
+	}
+	_, _, _, _, _, err = i.node.Datastore.Sales().GetByOrderId(br.OrderId)
+	if err != nil {
+		ErrorResponse(w, http.StatusNotFound, "Order not found")
+		return
+	}
+	rating, err := i.node.RateBuyer(br.OrderId, br.Overall, br.Review)
+	if err != nil {
+		ErrorResponse(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+	if err := i.node.SeedNode(); err != nil {
+		ErrorResponse(w, http.StatusInternalServerError, "IPNS Error: "+err.Error())
+		return
+	}
+	m := jsonpb.Marshaler{
+		EnumsAsInts:  false,
+		EmitDefaults: false,
+		Indent:       "    ",
+		OrigName:     false,
+	}
+	out, err := m.MarshalToString(rating)
+	if err != nil {
+		ErrorResponse(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+	SanitizedResponseM(w, out, new(pb.BuyerRating))
+}
+
+func (i *jsonAPIHandler) GETBuyerReputation(w http.ResponseWriter, r *http.Request) {
+	_, peerId := path.Split(r.URL.Path)
+	peerId, ok := i.resolvePeerID(w, peerId)
+	if !ok {
+		return
+	}
+	reputation, err := i.node.GetBuyerReputation(peerId)
+	if err != nil {
+		ErrorResponse(w, http.StatusNotFound, err.Error())
+		return
+	}
+	m := jsonpb.Marshaler{
+		EnumsAsInts:  false,
+		EmitDefaults: true,
+		Indent:       "    ",
+		OrigName:     false,
+	}
+	out, err := m.MarshalToString(reputation)
+	if err != nil {
+		ErrorResponse(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+	SanitizedResponseM(w, out, new(pb.BuyerReputation))
+}
+
+func (i *jsonAPIHandler) GETReputationExport(w http.ResponseWriter, r *http.Request) {
+	bundle, err := i.node.ExportReputation()
+	if err != nil {
+		ErrorResponse(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+	m := jsonpb.Marshaler{
+		EnumsAsInts:  false,
+		EmitDefaults: false,
+		Indent:       "    ",
+		OrigName:     false,
+	}
+	out, err := m.MarshalToString(bundle)
+	if err != nil {
+		ErrorResponse(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+	SanitizedResponseM(w, out, new(pb.ReputationBundle))
+}
+
+func (i *jsonAPIHandler) POSTReputationImport(w http.ResponseWriter, r *http.Request) {
+	bundle := new(pb.ReputationBundle)
+	err := jsonpb.Unmarshal(r.Body, bundle)
+	if err != nil {
+		ErrorResponse(w, http.StatusBadRequest, err.Error())
+		return
+	}
+	imported, err := i.node.ImportReputation(bundle)
+	if err != nil {
+		ErrorResponse(w, http.StatusBadRequest, err.Error())
+		return
+	}
+	if imported > 0 {
+		if err := i.node.SeedNode(); err != nil {
+			ErrorResponse(w, http.StatusInternalServerError, "IPNS Error: "+err.Error())
+			return
+		}
+	}
+	SanitizedResponse(w, fmt.Sprintf(`{"imported": %d}`, imported))
+}
+
+func (i *jsonAPIHandler) GETSocialProof(w http.ResponseWriter, r *http.Request) {
+	params := strings.Split(strings.Trim(strings.TrimPrefix(r.URL.Path, "/ob/socialproof"), "/"), "/")
+	if len(params) != 2 || params[0] == "" || params[1] == "" {
+		ErrorResponse(w, http.StatusBadRequest, "Account type and username must be set")
+		return
+	}
+	proof, err := i.node.SignSocialProof(params[0], params[1])
+	if err != nil {
+		ErrorResponse(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+	ser, err := json.Marshal(proof)
+	if err != nil {
+		ErrorResponse(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+	SanitizedResponse(w, fmt.Sprintf(`{"proof": %s}`, ser))
+}
+
+func (i *jsonAPIHandler) GETProfileHistory(w http.ResponseWriter, r *http.Request) {
+	peerId := strings.Trim(strings.TrimPrefix(r.URL.Path, "/ob/profile"), "/")
+	peerId = strings.Trim(strings.TrimSuffix(peerId, "history"), "/")
+	var err error
+	if peerId == "" {
+		peerId = i.node.IpfsNode.Identity.Pretty()
+	} else {
+		var ok bool
+		if peerId, ok = i.resolvePeerID(w, peerId); !ok {
+			return
+		}
+	}
+	history, err := i.node.GetProfileHistory(peerId)
+	if err != nil {
+		ErrorResponse(w, http.StatusNotFound, err.Error())
+		return
+	}
+	m := jsonpb.Marshaler{
+		EnumsAsInts:  false,
+		EmitDefaults: true,
+		Indent:       "    ",
+		OrigName:     false,
+	}
+	profiles := []json.RawMessage{}
+	for _, profile := range history {
+		out, err := m.MarshalToString(profile)
+		if err != nil {
+			ErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+		profiles = append(profiles, json.RawMessage(out))
+	}
+	ret, err := json.MarshalIndent(profiles, "", "    ")
+	if err != nil {
+		ErrorResponse(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+	SanitizedResponse(w, string(ret))
+}
+
+func (i *jsonAPIHandler) POSTRotateIdentity(w http.ResponseWriter, r *http.Request) {
+	chain, err := i.node.RotateIdentityKey()
+	if err != nil {
+		ErrorResponse(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+	m := jsonpb.Marshaler{
+		EnumsAsInts:  false,
+		EmitDefaults: false,
+		Indent:       "    ",
+		OrigName:     false,
+	}
+	out, err := m.MarshalToString(chain)
+	if err != nil {
+		ErrorResponse(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+	succession := chain.Successions[len(chain.Successions)-1]
+	SanitizedResponse(w, fmt.Sprintf(`{"successorID": "%s", "restartRequired": true, "chain": %s}`, succession.SuccessorID, out))
+}
+
+func (i *jsonAPIHandler) GETFeed(w http.ResponseWriter, r *http.Request) {
+	limit := r.URL.Query().Get("limit")
+	if limit == "" {
+		limit = "-1"
+	}
+	l, err := strconv.Atoi(limit)
+	if err != nil {
+		ErrorResponse(w, http.StatusBadRequest, err.Error())
+		return
+	}
+	offset := r.URL.Query().Get("offsetId")
+	offsetId := 0
+	if offset != "" {
+		offsetId, err = strconv.Atoi(offset)
+		if err != nil {
+			ErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+	}
+	events, err := i.node.Datastore.Feed().GetAll(offsetId, l)
+	if err != nil {
+		ErrorResponse(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+	ret, err := json.MarshalIndent(events, "", "    ")
+	if err != nil {
+		ErrorResponse(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+	if string(ret) == "null" {
+		ret = []byte("[]")
+	}
+	SanitizedResponse(w, string(ret))
+}
+
+func (i *jsonAPIHandler) POSTPost(w http.ResponseWriter, r *http.Request) {
+	post := new(pb.Post)
+	err := jsonpb.Unmarshal(r.Body, post)
+	if err != nil {
+		ErrorResponse(w, http.StatusBadRequest, err.Error())
+		return
+	}
+	// If the post already exists tell them to use PUT
+	if post.Slug != "" {
+		if _, err := i.node.GetPost(post.Slug); err == nil {
+			ErrorResponse(w, http.StatusConflict, "Post already exists. Use PUT.")
+			return
+		}
+	} else {
+		post.Slug, err = i.node.GeneratePostSlug(post.Title)
+		if err != nil {
+			ErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+	}
+	if err := i.node.SavePost(post); err != nil {
+		ErrorResponse(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+	if err := i.node.SeedNode(); err != nil {
+		ErrorResponse(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+	go i.node.SendPostToFollowers(post)
+	SanitizedResponse(w, fmt.Sprintf(`{"slug": "%s"}`, post.Slug))
+}
+
+func (i *jsonAPIHandler) PUTPost(w http.ResponseWriter, r *http.Request) {
+	post := new(pb.Post)
+	err := jsonpb.Unmarshal(r.Body, post)
+	if err != nil {
+		ErrorResponse(w, http.StatusBadRequest, err.Error())
+		return
+	}
+	_, err = i.node.GetPost(post.Slug)
+	if err != nil && err == core.ErrPostNotFound {
+		ErrorResponse(w, http.StatusNotFound, err.Error())
+		return
+	} else if err != nil {
+		ErrorResponse(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+	if err := i.node.SavePost(post); err != nil {
+		ErrorResponse(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+	if err := i.node.SeedNode(); err != nil {
+		ErrorResponse(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+	SanitizedResponse(w, `{}`)
+}
+
+func (i *jsonAPIHandler) DELETEPost(w http.ResponseWriter, r *http.Request) {
+	_, slug := path.Split(r.URL.Path)
+	err := i.node.DeletePost(slug)
+	if err != nil && err == core.ErrPostNotFound {
+		ErrorResponse(w, http.StatusNotFound, err.Error())
+		return
+	} else if err != nil {
+		ErrorResponse(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+	if err := i.node.SeedNode(); err != nil {
+		ErrorResponse(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+	SanitizedResponse(w, `{}`)
+}
+
+func (i *jsonAPIHandler) GETPosts(w http.ResponseWriter, r *http.Request) {
+	params := strings.Split(strings.Trim(strings.TrimPrefix(r.URL.Path, "/ob/posts"), "/"), "/")
+	if len(params) > 2 {
+		ErrorResponse(w, http.StatusNotFound, "Not Found")
+		return
+	}
+	peerId := i.node.IpfsNode.Identity.Pretty()
+	if params[0] != "" {
+		var ok bool
+		if peerId, ok = i.resolvePeerID(w, params[0]); !ok {
+			return
+		}
+	}
+	own := peerId == i.node.IpfsNode.Identity.Pretty()
+	if !own && i.isBlocklisted(peerId) {
+		ErrorResponse(w, http.StatusNotFound, "Store has been blocked by a moderator")
+		return
+	}
+	if len(params) == 1 {
+		index, err := i.node.GetPosts(peerId)
+		if err != nil {
+			ErrorResponse(w, http.StatusNotFound, err.Error())
+			return
+		}
+		if !own {
+			w.Header().Set("Cache-Control", "public, max-age=600, immutable")
+		}
+		SanitizedResponse(w, string(index))
+		return
+	}
+
+	var post *pb.Post
+	var err error
+	if own {
+		post, err = i.node.GetPost(params[1])
+	} else {
+		post, err = i.node.FetchPost(peerId, params[1])
+	}
+	if err != nil && err == core.ErrPostNotFound {
+		ErrorResponse(w, http.StatusNotFound, err.Error())
+		return
+	} else if err != nil {
+		ErrorResponse(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+	m := jsonpb.Marshaler{
+		EnumsAsInts:  false,
+		EmitDefaults: false,
+		Indent:       "    ",
+		OrigName:     false,
+	}
+	out, err := m.MarshalToString(post)
+	if err != nil {
+		ErrorResponse(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+	if !own {
+		w.Header().Set("Cache-Control", "public, max-age=600, immutable")
+	}
+	SanitizedResponseM(w, out, new(pb.Post))
+}
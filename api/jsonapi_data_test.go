@@ -49,6 +49,7 @@ const settingsJSON = `{
     "refundPolicy": "All sales are final.",
     "blockedNodes": ["QmecpJrN9RJ7smyYByQdZUy5mF6aapgCfKLKRmDtycv9aG", "QmamudHQGtztShX7Nc9HcczehdpGGWpFBWu2JvKWcpELxr", "QmPDLS7TV9Q3gtxRXQVqrm2RpEtz1Mq6u2YGeuEJWCqu6B"],
     "storeModerators": ["QmNedYJ6WmLhacAL2ozxb4k33Gxd9wmKB7HyoxZCwXid1e", "QmQdi7EaJUmuRUtSaCPkijw5cptFfNcX2EPvMyQwR117Y2"],
+    "blocklistSubscriptions": ["QmQdi7EaJUmuRUtSaCPkijw5cptFfNcX2EPvMyQwR117Y2"],
 	"mispaymentBuffer": 1,
     "smtpSettings": {
         "notifications": true,
@@ -57,6 +58,10 @@ const settingsJSON = `{
         "password": "letmein",
         "senderEmail": "notifications@urbanart.com",
         "recipientEmail": "Dave@gmail.com"
+    },
+    "disputeDeadlines": {
+        "counterpartyUpdate": 72,
+        "moderatorDecision": 168
     }
 }`
 
@@ -83,6 +88,7 @@ const settingsUpdateJSON = `{
     "refundPolicy": "All sales are final.",
     "blockedNodes": ["QmecpJrN9RJ7smyYByQdZUy5mF6aapgCfKLKRmDtycv9aG", "QmamudHQGtztShX7Nc9HcczehdpGGWpFBWu2JvKWcpELxr", "QmPDLS7TV9Q3gtxRXQVqrm2RpEtz1Mq6u2YGeuEJWCqu6B"],
     "storeModerators": ["QmNedYJ6WmLhacAL2ozxb4k33Gxd9wmKB7HyoxZCwXid1e", "QmQdi7EaJUmuRUtSaCPkijw5cptFfNcX2EPvMyQwR117Y2"],
+    "blocklistSubscriptions": ["QmQdi7EaJUmuRUtSaCPkijw5cptFfNcX2EPvMyQwR117Y2"],
 	"mispaymentBuffer": 1,
     "smtpSettings": {
         "notifications": true,
@@ -91,6 +97,10 @@ const settingsUpdateJSON = `{
         "password": "letmein",
         "senderEmail": "notifications@urbanart.com",
         "recipientEmail": "Dave@gmail.com"
+    },
+    "disputeDeadlines": {
+        "counterpartyUpdate": 72,
+        "moderatorDecision": 168
     }
 }`
 
@@ -127,6 +137,7 @@ const settingsPatchedJSON = `{
     "refundPolicy": "All sales are final.",
     "blockedNodes": ["QmecpJrN9RJ7smyYByQdZUy5mF6aapgCfKLKRmDtycv9aG", "QmamudHQGtztShX7Nc9HcczehdpGGWpFBWu2JvKWcpELxr", "QmPDLS7TV9Q3gtxRXQVqrm2RpEtz1Mq6u2YGeuEJWCqu6B"],
     "storeModerators": ["QmNedYJ6WmLhacAL2ozxb4k33Gxd9wmKB7HyoxZCwXid1e", "QmQdi7EaJUmuRUtSaCPkijw5cptFfNcX2EPvMyQwR117Y2"],
+    "blocklistSubscriptions": ["QmQdi7EaJUmuRUtSaCPkijw5cptFfNcX2EPvMyQwR117Y2"],
 	"mispaymentBuffer": 1,
     "smtpSettings": {
         "notifications": true,
@@ -135,6 +146,10 @@ const settingsPatchedJSON = `{
         "password": "letmein",
         "senderEmail": "notifications@urbanart.com",
         "recipientEmail": "Dave@gmail.com"
+    },
+    "disputeDeadlines": {
+        "counterpartyUpdate": 72,
+        "moderatorDecision": 168
     }
 }`
 
@@ -716,3 +731,313 @@ const peerNotFoundInTableJSON = `{
     "success": false,
     "reason": "failed to find any peer in table"
 }`
+
+//
+// Shipping profiles
+//
+
+const shippingProfileJSON = `{
+	"name": "Domestic",
+	"shippingOptions": [
+		{
+			"name": "USPS",
+			"type": "FIXED_PRICE",
+			"regions": ["UNITED_STATES"],
+			"services": [
+				{
+					"name": "Standard shipping",
+					"price": 20000,
+					"estimatedDelivery": "4-6 days"
+				}
+			]
+		}
+	]
+}`
+
+const shippingProfileJSONResponse = `{"name": "Domestic"}`
+
+const shippingProfileUpdateJSON = `{
+	"name": "Domestic",
+	"shippingOptions": [
+		{
+			"name": "USPS",
+			"type": "FIXED_PRICE",
+			"regions": ["UNITED_STATES"],
+			"services": [
+				{
+					"name": "Standard shipping",
+					"price": 25000,
+					"estimatedDelivery": "4-6 days"
+				},
+				{
+					"name": "Express shipping",
+					"price": 50000,
+					"estimatedDelivery": "1-2 days"
+				}
+			]
+		}
+	]
+}`
+
+const shippingProfileUpdateJSONResponse = `{"updatedListings": []}`
+
+const shippingProfileNoOptionsJSON = `{"name": "Empty"}`
+
+const shippingProfileNoOptionsJSONResponse = `{
+    "success": false,
+    "reason": "Shipping profile must contain at least one shipping option"
+}`
+
+//
+// Vouchers
+//
+
+const voucherJSON = `{
+	"amount": 50000,
+	"code": "GIFT-7fQ2-xK9m-Lp4w",
+	"memo": "Holiday gift card"
+}`
+
+const voucherWeakCodeJSON = `{"amount": 50000, "code": "GIFT-1234"}`
+
+const voucherWeakCodeJSONResponse = `{
+    "success": false,
+    "reason": "Voucher code must have at least 64 bits of entropy. Use a longer code mixing upper and lower case letters, digits and symbols."
+}`
+
+const voucherNoAmountJSON = `{"code": "GIFT-0000"}`
+
+const voucherNoAmountJSONResponse = `{
+    "success": false,
+    "reason": "Voucher amount must be greater than zero"
+}`
+
+const voucherNoHolderJSON = `{"amount": 50000}`
+
+const voucherNoHolderJSONResponse = `{
+    "success": false,
+    "reason": "Voucher must have either a buyer ID or a bearer code"
+}`
+
+const voucherCodeExistsJSONResponse = `{
+    "success": false,
+    "reason": "A voucher with this code already exists"
+}`
+
+//
+// Subscriptions
+//
+
+const subscriptionNoItemsJSON = `{"purchase": {"items": []}, "spendingCap": 100000}`
+
+const subscriptionNoItemsJSONResponse = `{
+    "success": false,
+    "reason": "Subscription hasn't selected any items"
+}`
+
+const subscriptionMissingJSON = `{"subscriptionId": "QmMissing"}`
+
+//
+// Disputes
+//
+
+const disputeEvidenceNoAttachmentsJSON = `{"orderId": "QmMissing", "attachments": []}`
+
+const disputeEvidenceNoAttachmentsJSONResponse = `{
+    "success": false,
+    "reason": "No attachments were provided"
+}`
+
+const disputeEvidenceMissingOrderJSON = `{"orderId": "QmMissing", "attachments": [{"filename": "receipt.txt", "data": "cmVjZWlwdA=="}]}`
+
+const disputeEvidenceMissingOrderJSONResponse = `{
+    "success": false,
+    "reason": "Order not found"
+}`
+
+const caseChatMissingCaseJSON = `{"orderId": "QmMissing", "message": "Hello"}`
+
+const caseChatMissingCaseJSONResponse = `{
+    "success": false,
+    "reason": "Case not found"
+}`
+
+const caseChatEmptyJSON = `{"orderId": "QmMissing", "message": ""}`
+
+const caseChatEmptyJSONResponse = `{
+    "success": false,
+    "reason": "Message is empty"
+}`
+
+const payoutProposalBadSplitJSON = `{"orderId": "QmMissing", "buyerPercentage": 60, "vendorPercentage": 60}`
+
+const payoutProposalBadSplitJSONResponse = `{
+    "success": false,
+    "reason": "Payout percentages must sum to 100"
+}`
+
+const payoutProposalMissingOrderJSON = `{"orderId": "QmMissing", "buyerPercentage": 60, "vendorPercentage": 40}`
+
+const acceptPayoutProposalMissingOrderJSON = `{"orderId": "QmMissing"}`
+
+const orderNotFoundJSONResponse = `{
+    "success": false,
+    "reason": "Order not found"
+}`
+
+const escalateDisputeMissingOrderJSON = `{"orderId": "QmMissing", "moderator": "QmQdi7EaJUmuRUtSaCPkijw5cptFfNcX2EPvMyQwR117Y2"}`
+
+const endorseDisputeMissingCaseJSON = `{"orderId": "QmMissing"}`
+
+const invalidEarningsPeriodJSONResponse = `{
+    "success": false,
+    "reason": "Period must be one of day, week, month or year"
+}`
+
+//
+// Resolution templates
+//
+
+const resolutionTemplateJSON = `{
+    "name": "Refund",
+    "buyerPercentage": 100,
+    "vendorPercentage": 0,
+    "resolution": "The item was not received so the buyer is refunded in full."
+}`
+
+const resolutionTemplateJSONResponse = `{"name": "Refund"}`
+
+const resolutionTemplateUpdateJSON = `{
+    "name": "Refund",
+    "buyerPercentage": 90,
+    "vendorPercentage": 10,
+    "resolution": "The item was not received. The vendor keeps shipping costs."
+}`
+
+const resolutionTemplateBadSplitJSON = `{
+    "name": "Split",
+    "buyerPercentage": 50,
+    "vendorPercentage": 40,
+    "resolution": "Partial damage"
+}`
+
+const resolutionTemplateBadSplitJSONResponse = `{
+    "success": false,
+    "reason": "Payout percentages must sum to 100"
+}`
+
+const previewDisputeResolutionJSON = `{"orderId": "QmMissing", "template": "Refund"}`
+
+const previewDisputeResolutionMissingTemplateJSON = `{"orderId": "QmMissing", "template": "Missing"}`
+
+const resolutionTemplateNotFoundJSONResponse = `{
+    "success": false,
+    "reason": "Resolution template not found"
+}`
+
+const caseNotFoundJSONResponse = `{
+    "success": false,
+    "reason": "Case not found"
+}`
+
+//
+// Reports
+//
+
+const reportNoTargetJSON = `{"moderators": ["QmQdi7EaJUmuRUtSaCPkijw5cptFfNcX2EPvMyQwR117Y2"], "reason": "scam"}`
+
+const reportNoTargetJSONResponse = `{
+    "success": false,
+    "reason": "A listing hash or peer ID must be given"
+}`
+
+const reportBadReasonJSON = `{"moderators": ["QmQdi7EaJUmuRUtSaCPkijw5cptFfNcX2EPvMyQwR117Y2"], "peerId": "QmecpJrN9RJ7smyYByQdZUy5mF6aapgCfKLKRmDtycv9aG", "reason": "rude"}`
+
+const reportBadReasonJSONResponse = `{
+    "success": false,
+    "reason": "Invalid report reason"
+}`
+
+const reportNoModeratorsJSON = `{"peerId": "QmecpJrN9RJ7smyYByQdZUy5mF6aapgCfKLKRmDtycv9aG", "reason": "scam"}`
+
+const reportNoModeratorsJSONResponse = `{
+    "success": false,
+    "reason": "At least one moderator must be given"
+}`
+
+const blocklistEntryNotFoundJSONResponse = `{
+    "success": false,
+    "reason": "Blocklist entry not found"
+}`
+
+//
+// Ratings
+//
+
+const invalidPeerIDJSONResponse = `{
+    "success": false,
+    "reason": "Invalid peer ID"
+}`
+
+const ratingReplyEmptyJSON = `{"ratingHash": "QmMissing", "reply": ""}`
+
+const ratingReplyEmptyJSONResponse = `{
+    "success": false,
+    "reason": "Reply is empty"
+}`
+
+const ratingReplyMissingRatingJSON = `{"ratingHash": "QmMissing", "reply": "Sorry it arrived late, we have changed couriers."}`
+
+const ratingNotFoundJSONResponse = `{
+    "success": false,
+    "reason": "Rating not found"
+}`
+
+const buyerRatingOutOfRangeJSON = `{"orderId": "QmMissing", "overall": 6, "review": "Paid promptly"}`
+
+const ratingOutOfRangeJSONResponse = `{
+    "success": false,
+    "reason": "Rating not within valid range"
+}`
+
+const reputationBundleOtherStoreJSON = `{"peerID": "QmecpJrN9RJ7smyYByQdZUy5mF6aapgCfKLKRmDtycv9aG", "ratings": []}`
+
+const reputationBundleOtherStoreJSONResponse = `{
+    "success": false,
+    "reason": "Reputation bundle is for a different store"
+}`
+
+const socialProofMissingUsernameJSONResponse = `{
+    "success": false,
+    "reason": "Account type and username must be set"
+}`
+
+const buyerRatingMissingOrderJSON = `{"orderId": "QmMissing", "overall": 5, "review": "Paid promptly"}`
+
+const handleNotResolvedJSONResponse = `{
+    "success": false,
+    "reason": "Could not resolve @nobody (petnames: Handle not found)"
+}`
+
+const postJSON = `{
+    "slug": "spring-sale",
+    "title": "Spring sale",
+    "longForm": "Everything is 20% off this week."
+}`
+
+const postJSONResponse = `{"slug": "spring-sale"}`
+
+const postMissingJSON = `{
+    "slug": "missing-post",
+    "title": "Missing post"
+}`
+
+const postAlreadyExistsJSONResponse = `{
+    "success": false,
+    "reason": "Post already exists. Use PUT."
+}`
+
+const postNotFoundJSONResponse = `{
+    "success": false,
+    "reason": "Post not found"
+}`
@@ -69,6 +69,16 @@ func TestProfile(t *testing.T) {
 	})
 }
 
+func TestProfileHistory(t *testing.T) {
+	runAPITests(t, apiTests{
+		{"GET", "/ob/profile/history", "", 200, `[]`},
+		{"POST", "/ob/profile", profileJSON, 200, profileJSON},
+		{"PUT", "/ob/profile", profileUpdateJSON, 200, profileUpdatedJSON},
+		{"GET", "/ob/profile/history", "", 200, anyResponseJSON},
+		{"GET", "/ob/profile/notapeer/history", "", 400, invalidPeerIDJSONResponse},
+	})
+}
+
 func TestAvatar(t *testing.T) {
 	// Setting an avatar fails if we don't have a profile
 	runAPITests(t, apiTests{
@@ -178,6 +188,239 @@ func TestListings(t *testing.T) {
 	})
 }
 
+func TestShippingProfiles(t *testing.T) {
+	runAPITests(t, apiTests{
+		{"GET", "/ob/shippingprofiles", "", 200, `[]`},
+
+		// Invalid creates
+		{"POST", "/ob/shippingprofiles", `{`, 400, jsonUnexpectedEOF},
+		{"POST", "/ob/shippingprofiles", shippingProfileNoOptionsJSON, 400, shippingProfileNoOptionsJSONResponse},
+
+		// Create/Get
+		{"GET", "/ob/shippingprofiles/Domestic", "", 404, NotFoundJSON("Shipping profile")},
+		{"POST", "/ob/shippingprofiles", shippingProfileJSON, 200, shippingProfileJSONResponse},
+		{"POST", "/ob/shippingprofiles", shippingProfileJSON, 409, AlreadyExistsUsePUTJSON("Shipping profile")},
+		{"GET", "/ob/shippingprofiles/Domestic", "", 200, anyResponseJSON},
+		{"GET", "/ob/shippingprofiles", "", 200, anyResponseJSON},
+
+		// Update
+		{"PUT", "/ob/shippingprofiles", shippingProfileUpdateJSON, 200, shippingProfileUpdateJSONResponse},
+
+		// Delete/Get
+		{"DELETE", "/ob/shippingprofiles/Domestic", "", 200, `{}`},
+		{"DELETE", "/ob/shippingprofiles/Domestic", "", 404, NotFoundJSON("Shipping profile")},
+		{"GET", "/ob/shippingprofiles/Domestic", "", 404, NotFoundJSON("Shipping profile")},
+		{"PUT", "/ob/shippingprofiles", shippingProfileUpdateJSON, 404, NotFoundJSON("Shipping profile")},
+	})
+}
+
+func TestResolutionTemplates(t *testing.T) {
+	runAPITests(t, apiTests{
+		{"GET", "/ob/resolutiontemplates", "", 200, `[]`},
+
+		// Invalid creates
+		{"POST", "/ob/resolutiontemplates", `{`, 400, jsonUnexpectedEOF},
+		{"POST", "/ob/resolutiontemplates", resolutionTemplateBadSplitJSON, 400, resolutionTemplateBadSplitJSONResponse},
+
+		// Create/Get
+		{"GET", "/ob/resolutiontemplates/Refund", "", 404, NotFoundJSON("Resolution template")},
+		{"POST", "/ob/resolutiontemplates", resolutionTemplateJSON, 200, resolutionTemplateJSONResponse},
+		{"POST", "/ob/resolutiontemplates", resolutionTemplateJSON, 409, AlreadyExistsUsePUTJSON("Resolution template")},
+		{"GET", "/ob/resolutiontemplates/Refund", "", 200, resolutionTemplateJSON},
+		{"GET", "/ob/resolutiontemplates", "", 200, anyResponseJSON},
+
+		// Update
+		{"PUT", "/ob/resolutiontemplates", resolutionTemplateUpdateJSON, 200, `{}`},
+		{"GET", "/ob/resolutiontemplates/Refund", "", 200, resolutionTemplateUpdateJSON},
+
+		// Apply to missing cases
+		{"POST", "/ob/previewdisputeresolution", previewDisputeResolutionMissingTemplateJSON, 404, resolutionTemplateNotFoundJSONResponse},
+		{"POST", "/ob/previewdisputeresolution", previewDisputeResolutionJSON, 404, caseNotFoundJSONResponse},
+		{"POST", "/ob/closedispute", previewDisputeResolutionJSON, 404, caseNotFoundJSONResponse},
+
+		// Delete/Get
+		{"DELETE", "/ob/resolutiontemplates/Refund", "", 200, `{}`},
+		{"DELETE", "/ob/resolutiontemplates/Refund", "", 404, NotFoundJSON("Resolution template")},
+		{"GET", "/ob/resolutiontemplates/Refund", "", 404, NotFoundJSON("Resolution template")},
+		{"PUT", "/ob/resolutiontemplates", resolutionTemplateUpdateJSON, 404, NotFoundJSON("Resolution template")},
+	})
+}
+
+func TestVouchers(t *testing.T) {
+	runAPITests(t, apiTests{
+		{"GET", "/ob/vouchers", "", 200, `[]`},
+
+		// Invalid creates
+		{"POST", "/ob/vouchers", `{`, 400, jsonUnexpectedEOF},
+		{"POST", "/ob/vouchers", voucherNoAmountJSON, 400, voucherNoAmountJSONResponse},
+		{"POST", "/ob/vouchers", voucherNoHolderJSON, 400, voucherNoHolderJSONResponse},
+		{"POST", "/ob/vouchers", voucherWeakCodeJSON, 400, voucherWeakCodeJSONResponse},
+
+		// Create/Get
+		{"POST", "/ob/vouchers", voucherJSON, 200, anyResponseJSON},
+		{"POST", "/ob/vouchers", voucherJSON, 400, voucherCodeExistsJSONResponse},
+		{"GET", "/ob/vouchers", "", 200, anyResponseJSON},
+		{"GET", "/ob/vouchers/QmMissing", "", 404, NotFoundJSON("Voucher")},
+
+		// Delete
+		{"DELETE", "/ob/vouchers/QmMissing", "", 404, NotFoundJSON("Voucher")},
+	})
+}
+
+func TestSubscriptions(t *testing.T) {
+	runAPITests(t, apiTests{
+		{"GET", "/ob/subscriptions", "", 200, `[]`},
+		{"GET", "/ob/subscriptions/QmMissing", "", 404, NotFoundJSON("Subscription")},
+
+		// Invalid creates
+		{"POST", "/ob/subscriptions", `{`, 400, jsonUnexpectedEOF},
+		{"POST", "/ob/subscriptions", subscriptionNoItemsJSON, 500, subscriptionNoItemsJSONResponse},
+
+		// Status changes
+		{"POST", "/ob/subscriptionpause", subscriptionMissingJSON, 404, NotFoundJSON("Subscription")},
+		{"POST", "/ob/subscriptionresume", subscriptionMissingJSON, 404, NotFoundJSON("Subscription")},
+		{"POST", "/ob/subscriptioncancel", subscriptionMissingJSON, 404, NotFoundJSON("Subscription")},
+		{"POST", "/ob/subscriptioncap", subscriptionMissingJSON, 404, NotFoundJSON("Subscription")},
+	})
+}
+
+func TestDisputeEvidence(t *testing.T) {
+	runAPITests(t, apiTests{
+		{"POST", "/ob/disputeevidence", `{`, 400, jsonUnexpectedEOF},
+		{"POST", "/ob/disputeevidence", disputeEvidenceNoAttachmentsJSON, 500, disputeEvidenceNoAttachmentsJSONResponse},
+		{"POST", "/ob/disputeevidence", disputeEvidenceMissingOrderJSON, 500, disputeEvidenceMissingOrderJSONResponse},
+		{"GET", "/ob/case/QmMissing/attachments/QmAttachment", "", 404, NotFoundJSON("Attachment")},
+		{"GET", "/ob/disputeevidence/QmMissing", "", 200, `[]`},
+		{"GET", "/ob/disputeevidence/QmMissing/QmAttachment", "", 404, NotFoundJSON("Attachment")},
+	})
+}
+
+func TestCaseChat(t *testing.T) {
+	runAPITests(t, apiTests{
+		{"GET", "/ob/casechat/QmMissing", "", 200, `[]`},
+		{"POST", "/ob/casechat", `{`, 400, jsonUnexpectedEOF},
+		{"POST", "/ob/casechat", caseChatEmptyJSON, 500, caseChatEmptyJSONResponse},
+		{"POST", "/ob/casechat", caseChatMissingCaseJSON, 404, caseChatMissingCaseJSONResponse},
+	})
+}
+
+func TestPayoutProposal(t *testing.T) {
+	runAPITests(t, apiTests{
+		{"POST", "/ob/payoutproposal", `{`, 400, jsonUnexpectedEOF},
+		{"POST", "/ob/payoutproposal", payoutProposalBadSplitJSON, 500, payoutProposalBadSplitJSONResponse},
+		{"POST", "/ob/payoutproposal", payoutProposalMissingOrderJSON, 404, orderNotFoundJSONResponse},
+		{"POST", "/ob/acceptpayoutproposal", `{`, 400, jsonUnexpectedEOF},
+		{"POST", "/ob/acceptpayoutproposal", acceptPayoutProposalMissingOrderJSON, 404, orderNotFoundJSONResponse},
+	})
+}
+
+func TestEscalateDispute(t *testing.T) {
+	runAPITests(t, apiTests{
+		{"POST", "/ob/escalatedispute", `{`, 400, jsonUnexpectedEOF},
+		{"POST", "/ob/escalatedispute", escalateDisputeMissingOrderJSON, 404, orderNotFoundJSONResponse},
+	})
+}
+
+func TestModeratorStats(t *testing.T) {
+	runAPITests(t, apiTests{
+		{"GET", "/ob/moderator/stats", "", 200, anyResponseJSON},
+		{"GET", "/ob/moderator/stats?period=week", "", 200, anyResponseJSON},
+		{"GET", "/ob/moderator/stats?period=fortnight", "", 400, invalidEarningsPeriodJSONResponse},
+	})
+}
+
+func TestReports(t *testing.T) {
+	runAPITests(t, apiTests{
+		{"POST", "/ob/report", `{`, 400, jsonUnexpectedEOF},
+		{"POST", "/ob/report", reportNoTargetJSON, 400, reportNoTargetJSONResponse},
+		{"POST", "/ob/report", reportBadReasonJSON, 400, reportBadReasonJSONResponse},
+		{"POST", "/ob/report", reportNoModeratorsJSON, 400, reportNoModeratorsJSONResponse},
+		{"GET", "/ob/reports", "", 200, `[]`},
+		{"DELETE", "/ob/reports/QmMissing", "", 200, `{}`},
+	})
+}
+
+func TestBlocklist(t *testing.T) {
+	runAPITests(t, apiTests{
+		{"GET", "/ob/blocklist", "", 200, anyResponseJSON},
+		{"POST", "/ob/blocklist", `{`, 400, jsonUnexpectedEOF},
+		{"POST", "/ob/blocklist", reportNoTargetJSON, 400, reportNoTargetJSONResponse},
+		{"DELETE", "/ob/blocklist/QmYwAPJzv5CZsnA625s3Xf2nemtYgPpHdWEz79ojWnPbdG", "", 404, blocklistEntryNotFoundJSONResponse},
+	})
+}
+
+func TestRatings(t *testing.T) {
+	runAPITests(t, apiTests{
+		{"GET", "/ob/ratings", "", 200, anyResponseJSON},
+		{"GET", "/ob/ratings/notapeer/slug", "", 400, invalidPeerIDJSONResponse},
+		{"POST", "/ob/ratingreply", `{`, 400, jsonUnexpectedEOF},
+		{"POST", "/ob/ratingreply", ratingReplyEmptyJSON, 400, ratingReplyEmptyJSONResponse},
+		{"POST", "/ob/ratingreply", ratingReplyMissingRatingJSON, 404, ratingNotFoundJSONResponse},
+	})
+}
+
+func TestFeed(t *testing.T) {
+	runAPITests(t, apiTests{
+		{"GET", "/ob/feed", "", 200, `[]`},
+		{"GET", "/ob/feed?limit=10&offsetId=5", "", 200, `[]`},
+		{"GET", "/ob/feed?limit=ten", "", 400, anyResponseJSON},
+	})
+}
+
+func TestPosts(t *testing.T) {
+	runAPITests(t, apiTests{
+		{"GET", "/ob/posts", "", 200, `[]`},
+		{"POST", "/ob/posts", `{`, 400, jsonUnexpectedEOF},
+		{"POST", "/ob/posts", postJSON, 200, postJSONResponse},
+		{"POST", "/ob/posts", postJSON, 409, postAlreadyExistsJSONResponse},
+		{"GET", "/ob/posts", "", 200, anyResponseJSON},
+		{"PUT", "/ob/posts", postJSON, 200, `{}`},
+		{"PUT", "/ob/posts", postMissingJSON, 404, postNotFoundJSONResponse},
+		{"DELETE", "/ob/posts/spring-sale", "", 200, `{}`},
+		{"DELETE", "/ob/posts/spring-sale", "", 404, postNotFoundJSONResponse},
+		{"GET", "/ob/posts", "", 200, `[]`},
+	})
+}
+
+func TestResolvePeerID(t *testing.T) {
+	runAPITests(t, apiTests{
+		{"GET", "/ob/followsme/notapeer", "", 400, invalidPeerIDJSONResponse},
+		{"GET", "/ob/followsme/@nobody", "", 404, handleNotResolvedJSONResponse},
+		{"GET", "/ob/ratings/@nobody", "", 404, handleNotResolvedJSONResponse},
+		{"POST", "/ob/follow", `{"id":"@nobody"}`, 404, handleNotResolvedJSONResponse},
+	})
+}
+
+func TestBuyerRating(t *testing.T) {
+	runAPITests(t, apiTests{
+		{"POST", "/ob/buyerrating", `{`, 400, jsonUnexpectedEOF},
+		{"POST", "/ob/buyerrating", buyerRatingOutOfRangeJSON, 400, ratingOutOfRangeJSONResponse},
+		{"POST", "/ob/buyerrating", buyerRatingMissingOrderJSON, 404, orderNotFoundJSONResponse},
+	})
+}
+
+func TestReputation(t *testing.T) {
+	runAPITests(t, apiTests{
+		{"GET", "/ob/reputation/export", "", 200, anyResponseJSON},
+		{"POST", "/ob/reputation/import", `{`, 400, anyResponseJSON},
+		{"POST", "/ob/reputation/import", reputationBundleOtherStoreJSON, 400, reputationBundleOtherStoreJSONResponse},
+	})
+}
+
+func TestSocialProof(t *testing.T) {
+	runAPITests(t, apiTests{
+		{"GET", "/ob/socialproof/twitter/ronswanson", "", 200, anyResponseJSON},
+		{"GET", "/ob/socialproof/twitter", "", 400, socialProofMissingUsernameJSONResponse},
+	})
+}
+
+func TestEndorseDispute(t *testing.T) {
+	runAPITests(t, apiTests{
+		{"POST", "/ob/endorsedispute", `{`, 400, jsonUnexpectedEOF},
+		{"POST", "/ob/endorsedispute", endorseDisputeMissingCaseJSON, 404, caseNotFoundJSONResponse},
+	})
+}
+
 func TestStatus(t *testing.T) {
 	runAPITests(t, apiTests{
 		{"GET", "/ob/status", "", 400, anyResponseJSON},
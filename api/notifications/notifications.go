@@ -30,6 +30,10 @@ type messageWrapper struct {
 	Message Data `json:"message"`
 }
 
+type caseMessageWrapper struct {
+	Message Data `json:"caseMessage"`
+}
+
 type walletWrapper struct {
 	Message Data `json:"wallet"`
 }
@@ -82,6 +86,38 @@ type disputeCloseWrapper struct {
 	DisputeCloseNotification `json:"disputeClose"`
 }
 
+type payoutProposalWrapper struct {
+	PayoutProposalNotification `json:"payoutProposal"`
+}
+
+type backorderStockWrapper struct {
+	BackorderStockNotification `json:"backorderStock"`
+}
+
+type disputeDeadlineWrapper struct {
+	DisputeDeadlineNotification `json:"disputeDeadline"`
+}
+
+type reportWrapper struct {
+	ReportNotification `json:"report"`
+}
+
+type panelResolutionWrapper struct {
+	PanelResolutionNotification `json:"panelResolution"`
+}
+
+type disputeEndorsementWrapper struct {
+	DisputeEndorsementNotification `json:"disputeEndorsement"`
+}
+
+type buyerRatingWrapper struct {
+	BuyerRatingNotification `json:"buyerRating"`
+}
+
+type identitySuccessionWrapper struct {
+	IdentitySuccessionNotification `json:"identitySuccession"`
+}
+
 type OrderNotification struct {
 	Title             string `json:"title"`
 	BuyerId           string `json:"buyerId"`
@@ -128,6 +164,53 @@ type DisputeCloseNotification struct {
 	OrderId string `json:"orderId"`
 }
 
+type PayoutProposalNotification struct {
+	OrderId    string `json:"orderId"`
+	ProposedBy string `json:"proposedBy"`
+}
+
+type BackorderStockNotification struct {
+	Slug      string `json:"slug"`
+	Variant   int    `json:"variant"`
+	Filled    int    `json:"filled"`
+	Remaining int    `json:"remaining"`
+}
+
+type DisputeDeadlineNotification struct {
+	OrderId  string    `json:"orderId"`
+	Phase    string    `json:"phase"` // counterpartyUpdate or moderatorDecision
+	Deadline time.Time `json:"deadline"`
+	Missed   bool      `json:"missed"`
+}
+
+type ReportNotification struct {
+	ReportId    string `json:"reportId"`
+	ReporterId  string `json:"reporterId"`
+	ListingHash string `json:"listingHash"`
+	PeerId      string `json:"peerId"`
+	Reason      string `json:"reason"`
+}
+
+type PanelResolutionNotification struct {
+	OrderId string `json:"orderId"`
+}
+
+type DisputeEndorsementNotification struct {
+	OrderId   string `json:"orderId"`
+	Moderator string `json:"moderator"`
+}
+
+type BuyerRatingNotification struct {
+	OrderId  string `json:"orderId"`
+	VendorId string `json:"vendorId"`
+	Overall  uint32 `json:"overall"`
+}
+
+type IdentitySuccessionNotification struct {
+	PredecessorId string `json:"predecessorId"`
+	SuccessorId   string `json:"successorId"`
+}
+
 type FollowNotification struct {
 	Follow string `json:"follow"`
 }
@@ -156,6 +239,14 @@ type ChatMessage struct {
 	Timestamp time.Time `json:"timestamp"`
 }
 
+type CaseChatMessage struct {
+	MessageId string    `json:"messageId"`
+	CaseId    string    `json:"caseId"`
+	PeerId    string    `json:"peerId"`
+	Message   string    `json:"message"`
+	Timestamp time.Time `json:"timestamp"`
+}
+
 type ChatRead struct {
 	MessageId string `json:"messageId"`
 	PeerId    string `json:"peerId"`
@@ -244,6 +335,54 @@ func Serialize(i interface{}) []byte {
 				DisputeCloseNotification: i.(DisputeCloseNotification),
 			},
 		}
+	case PayoutProposalNotification:
+		n = notificationWrapper{
+			payoutProposalWrapper{
+				PayoutProposalNotification: i.(PayoutProposalNotification),
+			},
+		}
+	case BackorderStockNotification:
+		n = notificationWrapper{
+			backorderStockWrapper{
+				BackorderStockNotification: i.(BackorderStockNotification),
+			},
+		}
+	case DisputeDeadlineNotification:
+		n = notificationWrapper{
+			disputeDeadlineWrapper{
+				DisputeDeadlineNotification: i.(DisputeDeadlineNotification),
+			},
+		}
+	case ReportNotification:
+		n = notificationWrapper{
+			reportWrapper{
+				ReportNotification: i.(ReportNotification),
+			},
+		}
+	case PanelResolutionNotification:
+		n = notificationWrapper{
+			panelResolutionWrapper{
+				PanelResolutionNotification: i.(PanelResolutionNotification),
+			},
+		}
+	case DisputeEndorsementNotification:
+		n = notificationWrapper{
+			disputeEndorsementWrapper{
+				DisputeEndorsementNotification: i.(DisputeEndorsementNotification),
+			},
+		}
+	case BuyerRatingNotification:
+		n = notificationWrapper{
+			buyerRatingWrapper{
+				BuyerRatingNotification: i.(BuyerRatingNotification),
+			},
+		}
+	case IdentitySuccessionNotification:
+		n = notificationWrapper{
+			identitySuccessionWrapper{
+				IdentitySuccessionNotification: i.(IdentitySuccessionNotification),
+			},
+		}
 	case FollowNotification:
 		n = notificationWrapper{
 			i.(FollowNotification),
@@ -270,6 +409,12 @@ func Serialize(i interface{}) []byte {
 		}
 		b, _ := json.MarshalIndent(m, "", "    ")
 		return b
+	case CaseChatMessage:
+		m := caseMessageWrapper{
+			i.(CaseChatMessage),
+		}
+		b, _ := json.MarshalIndent(m, "", "    ")
+		return b
 	case ChatRead:
 		m := messageReadWrapper{
 			i.(ChatRead),
@@ -374,6 +519,78 @@ func Describe(i interface{}) (string, string) {
 		n := i.(DisputeCloseNotification)
 		form := "Dispute around order \"%s\" was closed."
 		body = fmt.Sprintf(form, n.OrderId)
+
+	case PayoutProposalNotification:
+		head = "Dispute payout proposed"
+
+		n := i.(PayoutProposalNotification)
+		form := "%s proposed a different payout for the dispute around order \"%s\"."
+		body = fmt.Sprintf(form, n.ProposedBy, n.OrderId)
+
+	case BackorderStockNotification:
+		head = "Backorders ready to ship"
+
+		n := i.(BackorderStockNotification)
+		form := "Stock arrived for \"%s\" variant %d. %d backordered units can now ship, %d are still waiting."
+		body = fmt.Sprintf(form, n.Slug, n.Variant, n.Filled, n.Remaining)
+
+	case DisputeDeadlineNotification:
+		n := i.(DisputeDeadlineNotification)
+		deadline := n.Deadline.Format(time.RFC1123)
+		switch {
+		case n.Phase == "counterpartyUpdate":
+			head = "Dispute response overdue"
+			form := "A party to the dispute around order \"%s\" did not respond by %s. The dispute can be decided on the evidence available."
+			body = fmt.Sprintf(form, n.OrderId, deadline)
+		case n.Missed:
+			head = "Dispute decision overdue"
+			form := "The moderator did not decide the dispute around order \"%s\" by %s. The buyer or vendor may now escalate it to a backup moderator."
+			body = fmt.Sprintf(form, n.OrderId, deadline)
+		default:
+			head = "Dispute decision due"
+			form := "The dispute around order \"%s\" must be decided by %s."
+			body = fmt.Sprintf(form, n.OrderId, deadline)
+		}
+
+	case ReportNotification:
+		head = "Abuse report received"
+
+		n := i.(ReportNotification)
+		if n.ListingHash != "" {
+			form := "%s reported listing %s for %s."
+			body = fmt.Sprintf(form, n.ReporterId, n.ListingHash, n.Reason)
+		} else {
+			form := "%s reported store %s for %s."
+			body = fmt.Sprintf(form, n.ReporterId, n.PeerId, n.Reason)
+		}
+
+	case PanelResolutionNotification:
+		head = "Panel resolution to endorse"
+
+		n := i.(PanelResolutionNotification)
+		form := "The lead moderator decided the dispute around order \"%s\". Endorse the resolution if the panel agrees."
+		body = fmt.Sprintf(form, n.OrderId)
+
+	case DisputeEndorsementNotification:
+		head = "Dispute resolution endorsed"
+
+		n := i.(DisputeEndorsementNotification)
+		form := "%s endorsed the resolution of the dispute around order \"%s\"."
+		body = fmt.Sprintf(form, n.Moderator, n.OrderId)
+
+	case BuyerRatingNotification:
+		head = "Buyer rating received"
+
+		n := i.(BuyerRatingNotification)
+		form := "%s rated you %d out of 5 for order \"%s\"."
+		body = fmt.Sprintf(form, n.VendorId, n.Overall, n.OrderId)
+
+	case IdentitySuccessionNotification:
+		head = "Peer moved to a new identity"
+
+		n := i.(IdentitySuccessionNotification)
+		form := "%s rotated their identity key and is now %s."
+		body = fmt.Sprintf(form, n.PredecessorId, n.SuccessorId)
 	}
 	return head, body
 }
@@ -445,6 +445,11 @@ func (w *BitcoindWallet) Multisign(ins []spvwallet.TransactionInput, outs []spvw
 	return nil
 }
 
+func (w *BitcoindWallet) Broadcast(tx *wire.MsgTx) error {
+	_, err := w.rpcClient.SendRawTransaction(tx, false)
+	return err
+}
+
 func (w *BitcoindWallet) SweepAddress(utxos []spvwallet.Utxo, address *btc.Address, key *hd.ExtendedKey, redeemScript *[]byte, feeLevel spvwallet.FeeLevel) (*chainhash.Hash, error) {
 	var internalAddr btc.Address
 	if address != nil {
@@ -21,14 +21,15 @@ import (
 var log = logging.MustGetLogger("transaction-listener")
 
 type TransactionListener struct {
-	db        repo.Datastore
-	broadcast chan interface{}
-	params    *chaincfg.Params
+	db                repo.Datastore
+	broadcast         chan interface{}
+	params            *chaincfg.Params
+	onInventoryChange func(slugs []string)
 	*sync.Mutex
 }
 
-func NewTransactionListener(db repo.Datastore, broadcast chan interface{}, params *chaincfg.Params) *TransactionListener {
-	l := &TransactionListener{db, broadcast, params, new(sync.Mutex)}
+func NewTransactionListener(db repo.Datastore, broadcast chan interface{}, params *chaincfg.Params, onInventoryChange func(slugs []string)) *TransactionListener {
+	l := &TransactionListener{db, broadcast, params, onInventoryChange, new(sync.Mutex)}
 	return l
 }
 
@@ -222,11 +223,25 @@ func (l *TransactionListener) processPurchasePayment(txid []byte, output spvwall
 }
 
 func (l *TransactionListener) adjustInventory(contract *pb.RicardianContract) {
+	// The listings whose inventory changed so the bundles containing them can be recomputed
+	var changed []string
+	defer func() {
+		if len(changed) > 0 && l.onInventoryChange != nil {
+			go l.onInventoryChange(changed)
+		}
+	}()
 	for _, item := range contract.BuyerOrder.Items {
 		listing, err := core.GetListingFromHash(item.ListingHash, contract)
 		if err != nil {
 			continue
 		}
+		if len(listing.Item.Bundle) > 0 {
+			l.adjustBundleInventory(contract, listing, int(item.Quantity))
+			for _, component := range listing.Item.Bundle {
+				changed = append(changed, component.Slug)
+			}
+			continue
+		}
 		variant, err := core.GetSelectedSku(listing, item.Options)
 		if err != nil {
 			continue
@@ -236,18 +251,64 @@ func (l *TransactionListener) adjustInventory(contract *pb.RicardianContract) {
 			continue
 		}
 		q := int(item.Quantity)
-		if c-q < 0 {
+		if c >= 0 && c-q < 0 && (listing.Metadata.Preorder || listing.Metadata.MaxBackorder > 0) {
+			l.backorder(listing.Slug, variant, q-c)
+			q = c
+		} else if c-q < 0 {
 			q = 0
 			orderId, err := calcOrderId(contract.BuyerOrder)
 			if err != nil {
 				continue
 			}
 			log.Warning("Order %s purchased more inventory for %s than we have on hand", orderId, listing.Slug)
-			l.broadcast <- []byte(`{"warning": "order ` + orderId + ` exceeded on hand inventory for ` + listing.Slug + `"`)
+			l.broadcast <- []byte(`{"warning": "order ` + orderId + ` exceeded on hand inventory for ` + listing.Slug + `"}`)
 		}
 		l.db.Inventory().Put(listing.Slug, variant, c-q)
 		log.Debugf("Adjusting inventory for %s:%d to %d\n", listing.Slug, variant, c-q)
+		changed = append(changed, listing.Slug)
+	}
+}
+
+// Draws down the inventory of each bundle component then recomputes the bundle availability.
+// Other bundles sharing the components are recomputed by onInventoryChange.
+func (l *TransactionListener) adjustBundleInventory(contract *pb.RicardianContract, listing *pb.Listing, quantity int) {
+	for _, component := range listing.Item.Bundle {
+		c, err := l.db.Inventory().GetSpecific(component.Slug, int(component.Variant))
+		if err != nil || c < 0 {
+			continue
+		}
+		q := quantity * int(component.Quantity)
+		if c-q < 0 && (listing.Metadata.Preorder || listing.Metadata.MaxBackorder > 0) {
+			l.backorder(component.Slug, int(component.Variant), q-c)
+			q = c
+		} else if c-q < 0 {
+			q = c
+			orderId, err := calcOrderId(contract.BuyerOrder)
+			if err != nil {
+				continue
+			}
+			log.Warningf("Order %s purchased more inventory for bundle component %s than we have on hand", orderId, component.Slug)
+			l.broadcast <- []byte(`{"warning": "order ` + orderId + ` exceeded on hand inventory for ` + component.Slug + `"}`)
+		}
+		l.db.Inventory().Put(component.Slug, int(component.Variant), c-q)
+		log.Debugf("Adjusting inventory for %s:%d to %d\n", component.Slug, component.Variant, c-q)
+	}
+	count, err := core.GetBundleInventory(l.db, listing.Item.Bundle)
+	if err != nil {
+		return
+	}
+	l.db.Inventory().Put(listing.Slug, 0, count)
+}
+
+// Records units sold beyond the stock on hand so they can be filled when stock arrives
+func (l *TransactionListener) backorder(slug string, variant int, count int) {
+	backorders, err := l.db.Inventory().GetBackorders(slug, variant)
+	if err != nil {
+		log.Error(err)
+		return
 	}
+	l.db.Inventory().PutBackorders(slug, variant, backorders+count)
+	log.Debugf("Backordered %d of %s:%d\n", count, slug, variant)
 }
 
 func calcOrderId(order *pb.Order) (string, error) {
@@ -0,0 +1,196 @@
+package core
+
+import (
+	"crypto/sha256"
+	mh "gx/ipfs/QmbZ6Cee2uHjG7hf19qLHppgKDRtaG4CVtMzdmK9VCVqLu/go-multihash"
+	"time"
+
+	"github.com/OpenBazaar/openbazaar-go/api/notifications"
+	"github.com/OpenBazaar/openbazaar-go/pb"
+	"github.com/golang/protobuf/ptypes"
+	"github.com/golang/protobuf/ptypes/timestamp"
+)
+
+// Return the latest expected ship date of the order's pre-order items and of any
+// items which will be backordered because there isn't enough stock to cover them.
+// Returns nil if every item can ship from stock.
+func (n *OpenBazaarNode) getExpectedShipDate(contract *pb.RicardianContract) (*timestamp.Timestamp, error) {
+	var expected *timestamp.Timestamp
+	later := func(ts *timestamp.Timestamp) {
+		if ts == nil {
+			return
+		}
+		if expected == nil || ts.Seconds > expected.Seconds {
+			expected = ts
+		}
+	}
+	for _, item := range contract.BuyerOrder.Items {
+		l, err := GetListingFromHash(item.ListingHash, contract)
+		if err != nil {
+			return nil, err
+		}
+		if l.Metadata.Preorder {
+			later(l.Metadata.ExpectedShipDate)
+			continue
+		}
+		if l.Metadata.MaxBackorder == 0 {
+			continue
+		}
+		backordered := false
+		if len(l.Item.Bundle) > 0 {
+			for _, component := range l.Item.Bundle {
+				amt, err := n.Datastore.Inventory().GetSpecific(component.Slug, int(component.Variant))
+				if err == nil && amt >= 0 && amt < int(item.Quantity*component.Quantity) {
+					backordered = true
+				}
+			}
+		} else {
+			variant, err := GetSelectedSku(l, item.Options)
+			if err != nil {
+				return nil, err
+			}
+			amt, err := n.Datastore.Inventory().GetSpecific(l.Slug, variant)
+			if err == nil && amt >= 0 && amt < int(item.Quantity) {
+				backordered = true
+			}
+		}
+		if backordered {
+			later(l.Metadata.ExpectedShipDate)
+		}
+	}
+	return expected, nil
+}
+
+// Allocate newly arrived stock to outstanding backorders for a variant and notify
+// the vendor how many backordered units can now ship and the buyers whose orders
+// the stock covers. Returns whether the listing's
+// stock changed in the listing index; the caller is responsible for republishing.
+func (n *OpenBazaarNode) FillBackorders(slug string, variant int) (bool, error) {
+	backorders, err := n.Datastore.Inventory().GetBackorders(slug, variant)
+	if err != nil || backorders == 0 {
+		return false, err
+	}
+	amt, err := n.Datastore.Inventory().GetSpecific(slug, variant)
+	if err != nil || amt <= 0 {
+		return false, err
+	}
+	filled := amt
+	if backorders < filled {
+		filled = backorders
+	}
+	if err := n.Datastore.Inventory().Put(slug, variant, amt-filled); err != nil {
+		return false, err
+	}
+	if err := n.Datastore.Inventory().PutBackorders(slug, variant, backorders-filled); err != nil {
+		return false, err
+	}
+	notif := notifications.BackorderStockNotification{
+		Slug:      slug,
+		Variant:   variant,
+		Filled:    filled,
+		Remaining: backorders - filled,
+	}
+	n.Broadcast <- notif
+	n.Datastore.Notifications().Put(notif, time.Now())
+	n.notifyBackorderedBuyers(slug, variant, filled)
+	return n.UpdateStockInListingIndex(slug)
+}
+
+// Stock is allocated to backorders in the order they were placed so message the
+// buyers of the oldest funded orders waiting on the variant until the filled units
+// are accounted for.
+func (n *OpenBazaarNode) notifyBackorderedBuyers(slug string, variant int, filled int) {
+	sales, err := n.Datastore.Sales().GetAll("", -1)
+	if err != nil {
+		log.Error(err)
+		return
+	}
+	for _, sale := range sales {
+		if filled <= 0 {
+			return
+		}
+		if sale.State != pb.OrderState_FUNDED.String() {
+			continue
+		}
+		contract, _, _, _, _, err := n.Datastore.Sales().GetByOrderId(sale.OrderId)
+		if err != nil || contract.VendorOrderConfirmation == nil || contract.VendorOrderConfirmation.ExpectedShipDate == nil {
+			continue
+		}
+		units := backorderedUnits(contract, slug, variant)
+		if units == 0 {
+			continue
+		}
+		filled -= units
+		message := "Stock for your backordered " + sale.Title + " has arrived. Your order will ship soon."
+		if err := n.sendOrderChat(contract.BuyerOrder.BuyerID.PeerID, sale.OrderId, message); err != nil {
+			log.Errorf("Error notifying buyer of order %s that stock arrived: %s", sale.OrderId, err.Error())
+		}
+	}
+}
+
+// Return how many units of the variant the order takes, including as a bundle component
+func backorderedUnits(contract *pb.RicardianContract, slug string, variant int) int {
+	units := 0
+	for _, item := range contract.BuyerOrder.Items {
+		l, err := GetListingFromHash(item.ListingHash, contract)
+		if err != nil {
+			continue
+		}
+		if len(l.Item.Bundle) > 0 {
+			for _, component := range l.Item.Bundle {
+				if component.Slug == slug && int(component.Variant) == variant {
+					units += int(item.Quantity * component.Quantity)
+				}
+			}
+			continue
+		}
+		if l.Slug != slug {
+			continue
+		}
+		if v, err := GetSelectedSku(l, item.Options); err == nil && v == variant {
+			units += int(item.Quantity)
+		}
+	}
+	return units
+}
+
+// Send a chat message in an order's conversation and save it as outgoing
+func (n *OpenBazaarNode) sendOrderChat(peerId, orderId, message string) error {
+	t := time.Now()
+	ts, err := ptypes.TimestampProto(t)
+	if err != nil {
+		return err
+	}
+	h := sha256.Sum256([]byte(message + orderId + ptypes.TimestampString(ts)))
+	encoded, err := mh.Encode(h[:], mh.SHA2_256)
+	if err != nil {
+		return err
+	}
+	msgId, err := mh.Cast(encoded)
+	if err != nil {
+		return err
+	}
+	chat := &pb.Chat{
+		MessageId: msgId.B58String(),
+		Subject:   orderId,
+		Message:   message,
+		Timestamp: ts,
+		Flag:      pb.Chat_MESSAGE,
+	}
+	if err := n.SendChat(peerId, chat); err != nil {
+		return err
+	}
+	return n.Datastore.Chat().Put(chat.MessageId, peerId, orderId, message, t, false, true)
+}
+
+// Format an expected ship date for the listing index
+func formatShipDate(ts *timestamp.Timestamp) string {
+	if ts == nil {
+		return ""
+	}
+	t, err := ptypes.Timestamp(ts)
+	if err != nil {
+		return ""
+	}
+	return t.Format(time.RFC3339)
+}
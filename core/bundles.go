@@ -0,0 +1,119 @@
+package core
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/OpenBazaar/openbazaar-go/pb"
+	"github.com/OpenBazaar/openbazaar-go/repo"
+)
+
+// Returns the number of bundles which can be assembled from the inventory of the component
+// skus. Components with unlimited inventory (-1) do not constrain the bundle so -1 is
+// returned if every component is unlimited.
+func GetBundleInventory(db repo.Datastore, bundle []*pb.Listing_Item_BundleItem) (int, error) {
+	available := -1
+	for _, component := range bundle {
+		count, err := db.Inventory().GetSpecific(component.Slug, int(component.Variant))
+		if err != nil {
+			return 0, fmt.Errorf("Bundle component %s:%d does not exist", component.Slug, component.Variant)
+		}
+		if count < 0 || component.Quantity == 0 {
+			continue
+		}
+		c := count / int(component.Quantity)
+		if available < 0 || c < available {
+			available = c
+		}
+	}
+	return available, nil
+}
+
+// Checks each bundle component is an existing listing variant and is not a bundle itself
+func (n *OpenBazaarNode) validateBundle(listing *pb.Listing) error {
+	for _, component := range listing.Item.Bundle {
+		contract, err := n.GetListingFromSlug(component.Slug)
+		if err != nil {
+			return fmt.Errorf("Bundle component %s not found", component.Slug)
+		}
+		cl := contract.VendorListings[0]
+		if len(cl.Item.Bundle) > 0 {
+			return errors.New("Bundles cannot contain other bundles")
+		}
+		if int(component.Variant) >= len(cl.Item.Skus) && !(component.Variant == 0 && len(cl.Item.Skus) == 0) {
+			return fmt.Errorf("Bundle component %s does not have variant %d", component.Slug, component.Variant)
+		}
+	}
+	return nil
+}
+
+// Stores the availability computed from the components as the bundle's inventory
+func (n *OpenBazaarNode) setBundleInventory(listing *pb.Listing) error {
+	count, err := GetBundleInventory(n.Datastore, listing.Item.Bundle)
+	if err != nil {
+		return err
+	}
+	currentInv, err := n.Datastore.Inventory().Get(listing.Slug)
+	if err != nil {
+		return err
+	}
+	for i := range currentInv {
+		if i == 0 {
+			continue
+		}
+		if err := n.Datastore.Inventory().Delete(listing.Slug, i); err != nil {
+			return err
+		}
+	}
+	return n.Datastore.Inventory().Put(listing.Slug, 0, count)
+}
+
+// Recomputes the stored availability of every bundle which contains any of the given
+// listings. Returns the slugs of the bundles which were recomputed.
+func (n *OpenBazaarNode) UpdateBundleInventory(slugs ...string) ([]string, error) {
+	changed := make(map[string]bool)
+	for _, slug := range slugs {
+		changed[slug] = true
+	}
+	index, err := n.getListingIndex()
+	if err != nil {
+		return nil, err
+	}
+	var updated []string
+	for _, ld := range index {
+		contract, err := n.GetListingFromSlug(ld.Slug)
+		if err != nil {
+			continue
+		}
+		for _, component := range contract.VendorListings[0].Item.Bundle {
+			if changed[component.Slug] {
+				if err := n.setBundleInventory(contract.VendorListings[0]); err != nil {
+					log.Errorf("Error updating inventory for bundle %s: %s", ld.Slug, err.Error())
+				} else {
+					updated = append(updated, ld.Slug)
+				}
+				break
+			}
+		}
+	}
+	return updated, nil
+}
+
+// Recomputes the bundles containing listings whose inventory changed outside of the
+// listing and inventory APIs, such as when a sale is funded, and republishes if any
+// of them went in or out of stock
+func (n *OpenBazaarNode) InventoryChanged(slugs []string) {
+	bundles, err := n.UpdateBundleInventory(slugs...)
+	if err != nil {
+		log.Errorf("Error updating bundle inventory: %s", err.Error())
+	}
+	changed, err := n.UpdateStockInListingIndex(append(slugs, bundles...)...)
+	if err != nil {
+		log.Errorf("Error updating stock in listing index: %s", err.Error())
+	}
+	if changed {
+		if err := n.SeedNode(); err != nil {
+			log.Error(err)
+		}
+	}
+}
@@ -0,0 +1,528 @@
+package core
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path"
+	"sync"
+	"time"
+
+	libp2p "gx/ipfs/QmPGxZ1DP2w45WcogpW1h43BvseXbfke9N91qotpoQcUeS/go-libp2p-crypto"
+	ma "gx/ipfs/QmSWLfmj5frN9xVLMMN846dMDriy5wN5jeghUm7aTW3DAG/go-multiaddr"
+	peer "gx/ipfs/QmWUswjn261LSyVxWAEpMVtPdy8zmKBJJfBpG3Qdpa8ZsE/go-libp2p-peer"
+	multihash "gx/ipfs/QmbZ6Cee2uHjG7hf19qLHppgKDRtaG4CVtMzdmK9VCVqLu/go-multihash"
+
+	"github.com/OpenBazaar/jsonpb"
+	"github.com/OpenBazaar/openbazaar-go/api/notifications"
+	"github.com/OpenBazaar/openbazaar-go/ipfs"
+	"github.com/OpenBazaar/openbazaar-go/pb"
+	"github.com/golang/protobuf/proto"
+	"github.com/golang/protobuf/ptypes"
+	ipnspath "github.com/ipfs/go-ipfs/path"
+	"github.com/ipfs/go-ipfs/routing/dht"
+	"golang.org/x/net/context"
+)
+
+// How long to look for the ratings vendors have published for a buyer
+const BuyerRatingPointerTimeout = time.Second * 30
+
+// How long a buyer's reputation is served from the cache before it is fetched again
+const BuyerReputationCacheTTL = time.Hour
+
+type cachedBuyerReputation struct {
+	reputation *pb.BuyerReputation
+	fetched    time.Time
+	refreshing bool
+}
+
+var (
+	buyerReputations   = make(map[string]*cachedBuyerReputation)
+	buyerReputationsMu sync.Mutex
+)
+
+// An entry in the index of ratings vendors have left the buyer. There is at most one
+// per order.
+type buyerRatingShort struct {
+	Hash     string `json:"hash"`
+	OrderId  string `json:"orderId"`
+	VendorId string `json:"vendorId"`
+	Overall  uint32 `json:"overall"`
+}
+
+// Rate the buyer of a sale which was completed or whose dispute was resolved. The
+// rating carries the rating ticket from the buyer's order and is sent to the buyer to
+// publish. We also publish it under a pointer to the buyer so the buyer can't hide it.
+// The caller is responsible for republishing.
+func (n *OpenBazaarNode) RateBuyer(orderId string, overall uint32, review string) (*pb.BuyerRating, error) {
+	if overall < RatingMin || overall > RatingMax {
+		return nil, errors.New("Rating not within valid range")
+	}
+	if len(review) > ReviewMaxCharacters {
+		return nil, fmt.Errorf("Review is longer than the max of %d characters", ReviewMaxCharacters)
+	}
+	contract, state, _, _, _, err := n.Datastore.Sales().GetByOrderId(orderId)
+	if err != nil {
+		return nil, errors.New("Order not found")
+	}
+	if state != pb.OrderState_COMPLETE && state != pb.OrderState_RESOLVED {
+		return nil, errors.New("Buyers can only be rated once the order is complete or the dispute is resolved")
+	}
+	ticket := contract.BuyerOrder.RatingTicket
+	if ticket == nil {
+		return nil, errors.New("Buyer did not issue a rating ticket for this order")
+	}
+	if err := checkRatingTicket(ticket, contract.BuyerOrder, contract.VendorListings[0].VendorID.PeerID); err != nil {
+		return nil, err
+	}
+
+	rating := &pb.BuyerRating{
+		OrderId:   orderId,
+		BuyerID:   contract.BuyerOrder.BuyerID.PeerID,
+		VendorID:  contract.VendorListings[0].VendorID,
+		RatingKey: ticket.RatingKey,
+		Overall:   overall,
+		Review:    review,
+		Disputed:  contract.Dispute != nil,
+		Ticket:    ticket,
+	}
+	if err := signBuyerRating(rating, n.IpfsNode.PrivateKey); err != nil {
+		return nil, err
+	}
+	if err := n.publishBuyerRating(rating); err != nil {
+		return nil, err
+	}
+	buyerKey, err := libp2p.UnmarshalPublicKey(contract.BuyerOrder.BuyerID.Pubkeys.Identity)
+	if err != nil {
+		return nil, err
+	}
+	if err := n.SendBuyerRating(rating.BuyerID, &buyerKey, rating); err != nil {
+		return nil, err
+	}
+	return rating, nil
+}
+
+// Save a rating we left a buyer in our ratings folder and point to it from the key
+// other vendors look up for the buyer
+func (n *OpenBazaarNode) publishBuyerRating(rating *pb.BuyerRating) error {
+	m := jsonpb.Marshaler{
+		EnumsAsInts:  false,
+		EmitDefaults: false,
+		Indent:       "    ",
+		OrigName:     false,
+	}
+	out, err := m.MarshalToString(rating)
+	if err != nil {
+		return err
+	}
+	ratingPath := path.Join(n.RepoPath, "root", "ratings", "rated_buyer_"+rating.OrderId)
+	if err := ioutil.WriteFile(ratingPath, []byte(out), os.FileMode(0644)); err != nil {
+		return err
+	}
+	hash, err := ipfs.AddFile(n.Context, ratingPath)
+	if err != nil {
+		return err
+	}
+	key, err := buyerRatingPointerKey(rating.BuyerID)
+	if err != nil {
+		return err
+	}
+	addr, err := ma.NewMultiaddr("/ipfs/" + hash)
+	if err != nil {
+		return err
+	}
+	pointer, err := ipfs.PublishPointer(n.IpfsNode, context.Background(), key, 64, addr)
+	if err != nil {
+		return err
+	}
+	pointer.Purpose = ipfs.BUYER_RATING
+	return n.Datastore.Pointers().Put(pointer)
+}
+
+// The key vendors publish their ratings of a buyer under
+func buyerRatingPointerKey(buyerID string) (multihash.Multihash, error) {
+	h := sha256.Sum256([]byte("buyerratings" + buyerID))
+	encoded, err := multihash.Encode(h[:], multihash.SHA2_256)
+	if err != nil {
+		return nil, err
+	}
+	return multihash.Cast(encoded)
+}
+
+// Issue a ticket binding one of our order's rating keys to us and the vendor
+func (n *OpenBazaarNode) newBuyerRatingTicket(ratingKey []byte, vendorID string) (*pb.BuyerRatingTicket, error) {
+	ticket := &pb.BuyerRatingTicket{
+		RatingKey: ratingKey,
+		BuyerID:   n.IpfsNode.Identity.Pretty(),
+		VendorID:  vendorID,
+	}
+	if err := signBuyerRatingTicket(ticket, n.IpfsNode.PrivateKey); err != nil {
+		return nil, err
+	}
+	return ticket, nil
+}
+
+// Store a rating a vendor left us in our ratings folder so other vendors can see it.
+// The caller is responsible for republishing.
+func (n *OpenBazaarNode) ProcessBuyerRating(peerID string, rating *pb.BuyerRating) error {
+	if rating.VendorID == nil || rating.VendorID.PeerID != peerID {
+		return errors.New("Buyer rating was not sent by the rating vendor")
+	}
+	if err := VerifyBuyerRating(rating, n.IpfsNode.Identity.Pretty()); err != nil {
+		return err
+	}
+	contract, state, _, _, _, err := n.Datastore.Purchases().GetByOrderId(rating.OrderId)
+	if err != nil {
+		return errors.New("Order not found")
+	}
+	if contract.VendorListings[0].VendorID.PeerID != peerID {
+		return errors.New("Buyer rating was not sent by the vendor of this order")
+	}
+	if state != pb.OrderState_COMPLETE && state != pb.OrderState_RESOLVED {
+		return errors.New("Order is not complete")
+	}
+	if err := checkRatingTicket(rating.Ticket, contract.BuyerOrder, peerID); err != nil {
+		return err
+	}
+
+	m := jsonpb.Marshaler{
+		EnumsAsInts:  false,
+		EmitDefaults: false,
+		Indent:       "    ",
+		OrigName:     false,
+	}
+	out, err := m.MarshalToString(rating)
+	if err != nil {
+		return err
+	}
+	ratingPath := path.Join(n.RepoPath, "root", "ratings", "buyer_"+rating.OrderId)
+	if err := ioutil.WriteFile(ratingPath, []byte(out), os.FileMode(0644)); err != nil {
+		return err
+	}
+	hash, err := ipfs.GetHash(n.Context, ratingPath)
+	if err != nil {
+		return err
+	}
+	index, err := n.fetchBuyerRatingIndex(n.IpfsNode.Identity.Pretty())
+	if err != nil {
+		return err
+	}
+
+	// A vendor rating the order again replaces their earlier rating
+	entry := buyerRatingShort{hash, rating.OrderId, peerID, rating.Overall}
+	replaced := false
+	for i, e := range index {
+		if e.OrderId == rating.OrderId {
+			index[i] = entry
+			replaced = true
+		}
+	}
+	if !replaced {
+		index = append(index, entry)
+	}
+	j, err := json.MarshalIndent(index, "", "    ")
+	if err != nil {
+		return err
+	}
+	if err := ioutil.WriteFile(path.Join(n.RepoPath, "root", "ratings", "buyer_index.json"), j, os.FileMode(0644)); err != nil {
+		return err
+	}
+
+	notif := notifications.BuyerRatingNotification{rating.OrderId, peerID, rating.Overall}
+	n.Broadcast <- notif
+	n.Datastore.Notifications().Put(notif, time.Now())
+	return nil
+}
+
+// Summarize the ratings vendors have left a buyer, both those the buyer published and
+// those the vendors point to. Ratings which fail to verify are left out and only the
+// latest rating counts per rating key.
+func (n *OpenBazaarNode) GetBuyerReputation(peerId string) (*pb.BuyerReputation, error) {
+	index, err := n.fetchBuyerRatingIndex(peerId)
+	if err != nil {
+		return nil, err
+	}
+	var hashes []string
+	for _, entry := range index {
+		hashes = append(hashes, entry.Hash)
+	}
+	hashes = append(hashes, n.findBuyerRatingPointers(peerId)...)
+
+	var ratings []*pb.BuyerRating
+	seen := make(map[string]bool)
+	for _, hash := range hashes {
+		if seen[hash] {
+			continue
+		}
+		seen[hash] = true
+		b, err := ipfs.Cat(n.Context, hash)
+		if err != nil {
+			log.Warningf("Skipping buyer rating %s for %s: %s", hash, peerId, err.Error())
+			continue
+		}
+		rating := new(pb.BuyerRating)
+		if err := jsonpb.UnmarshalString(string(b), rating); err != nil {
+			log.Warningf("Skipping buyer rating %s for %s: %s", hash, peerId, err.Error())
+			continue
+		}
+		if err := VerifyBuyerRating(rating, peerId); err != nil {
+			log.Warningf("Skipping buyer rating %s for %s: %s", hash, peerId, err.Error())
+			continue
+		}
+		ratings = append(ratings, rating)
+	}
+	reputation := summarizeBuyerRatings(latestBuyerRatings(ratings))
+	cacheBuyerReputation(peerId, reputation)
+	return reputation, nil
+}
+
+// Return the hashes of the ratings vendors have published under the buyer's pointer key
+func (n *OpenBazaarNode) findBuyerRatingPointers(peerId string) []string {
+	var hashes []string
+	ipfsDHT, ok := n.IpfsNode.Routing.(*dht.IpfsDHT)
+	if !ok {
+		return hashes
+	}
+	key, err := buyerRatingPointerKey(peerId)
+	if err != nil {
+		return hashes
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), BuyerRatingPointerTimeout)
+	defer cancel()
+	for p := range ipfs.FindPointersAsync(ipfsDHT, ctx, key, 64) {
+		if len(p.Addrs) == 0 {
+			continue
+		}
+		hash, err := p.Addrs[0].ValueForProtocol(ma.P_IPFS)
+		if err != nil {
+			continue
+		}
+		hashes = append(hashes, hash)
+	}
+	return hashes
+}
+
+// Keep the latest rating for each rating key so a buyer can't show an earlier rating
+// the vendor has since replaced
+func latestBuyerRatings(ratings []*pb.BuyerRating) []*pb.BuyerRating {
+	latest := make(map[string]*pb.BuyerRating)
+	var keys []string
+	for _, rating := range ratings {
+		key := string(rating.RatingKey)
+		current, ok := latest[key]
+		if !ok {
+			keys = append(keys, key)
+		} else if !ratingIsNewer(rating, current) {
+			continue
+		}
+		latest[key] = rating
+	}
+	var ret []*pb.BuyerRating
+	for _, key := range keys {
+		ret = append(ret, latest[key])
+	}
+	return ret
+}
+
+func ratingIsNewer(rating, other *pb.BuyerRating) bool {
+	if rating.Timestamp == nil || other.Timestamp == nil {
+		return other.Timestamp == nil && rating.Timestamp != nil
+	}
+	if rating.Timestamp.Seconds != other.Timestamp.Seconds {
+		return rating.Timestamp.Seconds > other.Timestamp.Seconds
+	}
+	return rating.Timestamp.Nanos > other.Timestamp.Nanos
+}
+
+// Return the buyer's reputation from the cache, refreshing it in the background when
+// it is missing or older than BuyerReputationCacheTTL. Returns nil until it has been
+// fetched once.
+func (n *OpenBazaarNode) CachedBuyerReputation(peerId string) *pb.BuyerReputation {
+	buyerReputationsMu.Lock()
+	defer buyerReputationsMu.Unlock()
+	cached, ok := buyerReputations[peerId]
+	if !ok {
+		cached = new(cachedBuyerReputation)
+		buyerReputations[peerId] = cached
+	}
+	if !cached.refreshing && time.Since(cached.fetched) > BuyerReputationCacheTTL {
+		cached.refreshing = true
+		go func() {
+			if _, err := n.GetBuyerReputation(peerId); err != nil {
+				log.Errorf("Error fetching buyer reputation for %s: %s", peerId, err.Error())
+				buyerReputationsMu.Lock()
+				cached.refreshing = false
+				buyerReputationsMu.Unlock()
+			}
+		}()
+	}
+	return cached.reputation
+}
+
+func cacheBuyerReputation(peerId string, reputation *pb.BuyerReputation) {
+	buyerReputationsMu.Lock()
+	defer buyerReputationsMu.Unlock()
+	buyerReputations[peerId] = &cachedBuyerReputation{reputation: reputation, fetched: time.Now()}
+}
+
+// Read our own buyer ratings index from disk or resolve another peer's
+func (n *OpenBazaarNode) fetchBuyerRatingIndex(peerId string) ([]buyerRatingShort, error) {
+	var index []buyerRatingShort
+	var b []byte
+	var err error
+	if peerId == n.IpfsNode.Identity.Pretty() {
+		b, err = ioutil.ReadFile(path.Join(n.RepoPath, "root", "ratings", "buyer_index.json"))
+		if os.IsNotExist(err) {
+			return index, nil
+		}
+	} else {
+		b, err = ipfs.ResolveThenCat(n.Context, ipnspath.FromString(path.Join(peerId, "ratings", "buyer_index.json")))
+	}
+	if err != nil {
+		return nil, err
+	}
+	if err := json.Unmarshal(b, &index); err != nil {
+		return nil, err
+	}
+	return index, nil
+}
+
+func signBuyerRating(rating *pb.BuyerRating, privKey libp2p.PrivKey) error {
+	ts, err := ptypes.TimestampProto(time.Now())
+	if err != nil {
+		return err
+	}
+	rating.Timestamp = ts
+	rating.Signature = nil
+	ser, err := proto.Marshal(rating)
+	if err != nil {
+		return err
+	}
+	rating.Signature, err = privKey.Sign(ser)
+	return err
+}
+
+func signBuyerRatingTicket(ticket *pb.BuyerRatingTicket, privKey libp2p.PrivKey) error {
+	var err error
+	ticket.BuyerPubkey, err = privKey.GetPublic().Bytes()
+	if err != nil {
+		return err
+	}
+	ticket.Signature = nil
+	ser, err := proto.Marshal(ticket)
+	if err != nil {
+		return err
+	}
+	ticket.Signature, err = privKey.Sign(ser)
+	return err
+}
+
+// Checks a rating ticket was signed by the buyer it names
+func VerifyBuyerRatingTicket(ticket *pb.BuyerRatingTicket) error {
+	if ticket == nil || len(ticket.RatingKey) == 0 {
+		return errors.New("Buyer rating has no rating ticket")
+	}
+	pubkey, err := libp2p.UnmarshalPublicKey(ticket.BuyerPubkey)
+	if err != nil {
+		return err
+	}
+	pid, err := peer.IDFromPublicKey(pubkey)
+	if err != nil {
+		return err
+	}
+	if pid.Pretty() != ticket.BuyerID {
+		return errors.New("Public key in rating ticket does not match the buyer's peer ID")
+	}
+	unsigned := *ticket
+	unsigned.Signature = nil
+	ser, err := proto.Marshal(&unsigned)
+	if err != nil {
+		return err
+	}
+	valid, err := pubkey.Verify(ser, ticket.Signature)
+	if err != nil || !valid {
+		return errors.New("Signature on rating ticket failed to verify")
+	}
+	return nil
+}
+
+// Checks a rating ticket is for one of the order's rating keys and was issued by the
+// order's buyer to the given vendor
+func checkRatingTicket(ticket *pb.BuyerRatingTicket, order *pb.Order, vendorID string) error {
+	if err := VerifyBuyerRatingTicket(ticket); err != nil {
+		return err
+	}
+	if ticket.BuyerID != order.BuyerID.PeerID || ticket.VendorID != vendorID {
+		return errors.New("Rating ticket was not issued for this order")
+	}
+	for _, key := range order.RatingKeys {
+		if bytes.Equal(key, ticket.RatingKey) {
+			return nil
+		}
+	}
+	return errors.New("Rating ticket is not bound to one of the order's rating keys")
+}
+
+// Checks a buyer rating was left for the buyer by the vendor the buyer issued the
+// rating ticket to, and was signed by that vendor
+func VerifyBuyerRating(rating *pb.BuyerRating, buyerPeerID string) error {
+	if rating.VendorID == nil || rating.VendorID.Pubkeys == nil || len(rating.RatingKey) == 0 {
+		return errors.New("Buyer rating is missing required fields")
+	}
+	if rating.BuyerID != buyerPeerID {
+		return errors.New("Buyer rating was left for a different buyer")
+	}
+	if err := VerifyBuyerRatingTicket(rating.Ticket); err != nil {
+		return err
+	}
+	if rating.Ticket.BuyerID != rating.BuyerID || rating.Ticket.VendorID != rating.VendorID.PeerID || !bytes.Equal(rating.Ticket.RatingKey, rating.RatingKey) {
+		return errors.New("Buyer rating does not match its rating ticket")
+	}
+	if rating.Overall < RatingMin || rating.Overall > RatingMax {
+		return errors.New("Rating not within valid range")
+	}
+	pubkey, err := libp2p.UnmarshalPublicKey(rating.VendorID.Pubkeys.Identity)
+	if err != nil {
+		return err
+	}
+	pid, err := peer.IDFromPublicKey(pubkey)
+	if err != nil {
+		return err
+	}
+	if pid.Pretty() != rating.VendorID.PeerID {
+		return errors.New("Public key in buyer rating does not match the vendor's peer ID")
+	}
+	unsigned := *rating
+	unsigned.Signature = nil
+	ser, err := proto.Marshal(&unsigned)
+	if err != nil {
+		return err
+	}
+	valid, err := pubkey.Verify(ser, rating.Signature)
+	if err != nil || !valid {
+		return errors.New("Signature on buyer rating failed to verify")
+	}
+	return nil
+}
+
+func summarizeBuyerRatings(ratings []*pb.BuyerRating) *pb.BuyerReputation {
+	reputation := &pb.BuyerReputation{
+		RatingCount: uint32(len(ratings)),
+	}
+	if len(ratings) == 0 {
+		return reputation
+	}
+	var total uint32
+	for _, rating := range ratings {
+		total += rating.Overall
+		if rating.Disputed {
+			reputation.DisputedOrders++
+		}
+	}
+	reputation.AverageRating = float32(total) / float32(len(ratings))
+	return reputation
+}
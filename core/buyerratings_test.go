@@ -0,0 +1,157 @@
+package core
+
+import (
+	"testing"
+
+	libp2p "gx/ipfs/QmPGxZ1DP2w45WcogpW1h43BvseXbfke9N91qotpoQcUeS/go-libp2p-crypto"
+	peer "gx/ipfs/QmWUswjn261LSyVxWAEpMVtPdy8zmKBJJfBpG3Qdpa8ZsE/go-libp2p-peer"
+
+	"github.com/OpenBazaar/openbazaar-go/pb"
+	"github.com/golang/protobuf/ptypes/timestamp"
+)
+
+func newKeyPair(t *testing.T) (libp2p.PrivKey, *pb.ID) {
+	priv, pub, err := libp2p.GenerateKeyPair(libp2p.Ed25519, 256)
+	if err != nil {
+		t.Fatal(err)
+	}
+	pid, err := peer.IDFromPublicKey(pub)
+	if err != nil {
+		t.Fatal(err)
+	}
+	pubBytes, err := pub.Bytes()
+	if err != nil {
+		t.Fatal(err)
+	}
+	return priv, &pb.ID{PeerID: pid.Pretty(), Pubkeys: &pb.ID_Pubkeys{Identity: pubBytes}}
+}
+
+func newSignedBuyerRating(t *testing.T) *pb.BuyerRating {
+	vendorPriv, vendorID := newKeyPair(t)
+	buyerPriv, buyerID := newKeyPair(t)
+	ticket := &pb.BuyerRatingTicket{
+		RatingKey: []byte("ratingKey"),
+		BuyerID:   buyerID.PeerID,
+		VendorID:  vendorID.PeerID,
+	}
+	if err := signBuyerRatingTicket(ticket, buyerPriv); err != nil {
+		t.Fatal(err)
+	}
+	rating := &pb.BuyerRating{
+		OrderId:   "QmOrder",
+		BuyerID:   buyerID.PeerID,
+		VendorID:  vendorID,
+		RatingKey: ticket.RatingKey,
+		Overall:   4,
+		Review:    "Paid promptly",
+		Ticket:    ticket,
+	}
+	if err := signBuyerRating(rating, vendorPriv); err != nil {
+		t.Fatal(err)
+	}
+	return rating
+}
+
+func TestVerifyBuyerRating(t *testing.T) {
+	rating := newSignedBuyerRating(t)
+	if err := VerifyBuyerRating(rating, rating.BuyerID); err != nil {
+		t.Error(err)
+	}
+	if err := VerifyBuyerRating(rating, reportedStore); err == nil {
+		t.Error("Expected a rating listed by another buyer to fail verification")
+	}
+	rating.Disputed = true
+	if err := VerifyBuyerRating(rating, rating.BuyerID); err == nil {
+		t.Error("Expected a modified rating to fail verification")
+	}
+
+	rating = newSignedBuyerRating(t)
+	rating.VendorID.PeerID = reportedStore
+	if err := VerifyBuyerRating(rating, rating.BuyerID); err == nil {
+		t.Error("Expected a rating claiming another vendor to fail verification")
+	}
+}
+
+func TestVerifyBuyerRatingTicket(t *testing.T) {
+	// A vendor can't rate a buyer who didn't issue them a ticket
+	rating := newSignedBuyerRating(t)
+	vendorPriv, _ := newKeyPair(t)
+	rating.Ticket = nil
+	if err := signBuyerRating(rating, vendorPriv); err != nil {
+		t.Fatal(err)
+	}
+	if err := VerifyBuyerRating(rating, rating.BuyerID); err == nil {
+		t.Error("Expected a rating without a ticket to fail verification")
+	}
+
+	// Nor reuse a ticket issued to another vendor
+	rating = newSignedBuyerRating(t)
+	otherPriv, otherID := newKeyPair(t)
+	rating.VendorID = otherID
+	if err := signBuyerRating(rating, otherPriv); err != nil {
+		t.Fatal(err)
+	}
+	if err := VerifyBuyerRating(rating, rating.BuyerID); err == nil {
+		t.Error("Expected a rating using another vendor's ticket to fail verification")
+	}
+
+	// Nor forge a ticket for the buyer
+	rating = newSignedBuyerRating(t)
+	rating.Ticket.RatingKey = []byte("otherKey")
+	if err := VerifyBuyerRatingTicket(rating.Ticket); err == nil {
+		t.Error("Expected a modified ticket to fail verification")
+	}
+}
+
+func TestCheckRatingTicket(t *testing.T) {
+	rating := newSignedBuyerRating(t)
+	order := &pb.Order{
+		BuyerID:    &pb.ID{PeerID: rating.BuyerID},
+		RatingKeys: [][]byte{[]byte("otherKey"), []byte("ratingKey")},
+	}
+	if err := checkRatingTicket(rating.Ticket, order, rating.VendorID.PeerID); err != nil {
+		t.Error(err)
+	}
+	if err := checkRatingTicket(rating.Ticket, order, reportedStore); err == nil {
+		t.Error("Expected a ticket issued to another vendor to be rejected")
+	}
+	order.RatingKeys = [][]byte{[]byte("otherKey")}
+	if err := checkRatingTicket(rating.Ticket, order, rating.VendorID.PeerID); err == nil {
+		t.Error("Expected a ticket for a key outside the order to be rejected")
+	}
+}
+
+func TestLatestBuyerRatings(t *testing.T) {
+	ratings := latestBuyerRatings([]*pb.BuyerRating{
+		{RatingKey: []byte("a"), Overall: 5, Timestamp: &timestamp.Timestamp{Seconds: 1}},
+		{RatingKey: []byte("b"), Overall: 3, Timestamp: &timestamp.Timestamp{Seconds: 1}},
+		{RatingKey: []byte("a"), Overall: 1, Timestamp: &timestamp.Timestamp{Seconds: 2}},
+		{RatingKey: []byte("b"), Overall: 4},
+	})
+	if len(ratings) != 2 {
+		t.Fatal("Expected one rating per rating key")
+	}
+	if ratings[0].Overall != 1 || ratings[1].Overall != 3 {
+		t.Error("Expected the latest rating for each rating key")
+	}
+}
+
+func TestSummarizeBuyerRatings(t *testing.T) {
+	reputation := summarizeBuyerRatings([]*pb.BuyerRating{
+		{Overall: 5},
+		{Overall: 2, Disputed: true},
+		{Overall: 4},
+	})
+	if reputation.RatingCount != 3 {
+		t.Error("Returned incorrect rating count")
+	}
+	if reputation.AverageRating != float32(11)/3 {
+		t.Error("Returned incorrect average rating")
+	}
+	if reputation.DisputedOrders != 1 {
+		t.Error("Returned incorrect disputed order count")
+	}
+	if summarizeBuyerRatings(nil).RatingCount != 0 {
+		t.Error("Expected no ratings to summarize to zero")
+	}
+}
@@ -0,0 +1,202 @@
+package core
+
+import (
+	"crypto/sha256"
+	"errors"
+	"fmt"
+	libp2p "gx/ipfs/QmPGxZ1DP2w45WcogpW1h43BvseXbfke9N91qotpoQcUeS/go-libp2p-crypto"
+	peer "gx/ipfs/QmWUswjn261LSyVxWAEpMVtPdy8zmKBJJfBpG3Qdpa8ZsE/go-libp2p-peer"
+	mh "gx/ipfs/QmbZ6Cee2uHjG7hf19qLHppgKDRtaG4CVtMzdmK9VCVqLu/go-multihash"
+	"time"
+
+	"github.com/OpenBazaar/openbazaar-go/api/notifications"
+	"github.com/OpenBazaar/openbazaar-go/pb"
+	"github.com/golang/protobuf/proto"
+	"github.com/golang/protobuf/ptypes"
+)
+
+// Post a message to the group conversation for a disputed order. The message is
+// signed and sent to each of the other parties to the dispute.
+func (n *OpenBazaarNode) SendCaseChat(caseId, message string) (*pb.CaseChat, error) {
+	if message == "" {
+		return nil, errors.New("Message is empty")
+	}
+	if len(message) > CHAT_MESSAGE_MAX_CHARACTERS {
+		return nil, fmt.Errorf("Message is longer than the max of %d characters", CHAT_MESSAGE_MAX_CHARACTERS)
+	}
+	participants, state, err := n.getCaseParticipants(caseId)
+	if err != nil {
+		return nil, err
+	}
+	if state != pb.OrderState_DISPUTED {
+		return nil, errors.New("A dispute for this order is not open")
+	}
+
+	ts, err := ptypes.TimestampProto(time.Now())
+	if err != nil {
+		return nil, err
+	}
+	pubkey, err := n.IpfsNode.PrivateKey.GetPublic().Bytes()
+	if err != nil {
+		return nil, err
+	}
+	h := sha256.Sum256([]byte(message + caseId + ptypes.TimestampString(ts)))
+	encoded, err := mh.Encode(h[:], mh.SHA2_256)
+	if err != nil {
+		return nil, err
+	}
+	msgId, err := mh.Cast(encoded)
+	if err != nil {
+		return nil, err
+	}
+	chat := &pb.CaseChat{
+		MessageId: msgId.B58String(),
+		CaseId:    caseId,
+		PeerID:    n.IpfsNode.Identity.Pretty(),
+		Message:   message,
+		Timestamp: ts,
+		Pubkey:    pubkey,
+	}
+	ser, err := proto.Marshal(chat)
+	if err != nil {
+		return nil, err
+	}
+	chat.Signature, err = n.IpfsNode.PrivateKey.Sign(ser)
+	if err != nil {
+		return nil, err
+	}
+
+	// Save the message before sending so a party we can't reach doesn't lose it for
+	// everyone else
+	if err := n.Datastore.CaseChat().Put(chat); err != nil {
+		return nil, err
+	}
+	for _, p := range participants {
+		if n.isOwnID(p) {
+			continue
+		}
+		if err := n.SendCaseChatMessage(p, chat); err != nil {
+			log.Errorf("Error sending case chat message to %s: %s", p, err.Error())
+		}
+	}
+	return chat, nil
+}
+
+// Save a case chat message sent to us by one of the other parties to the dispute
+func (n *OpenBazaarNode) HandleCaseChat(peerId string, chat *pb.CaseChat) error {
+	if chat.PeerID != peerId {
+		return errors.New("Case chat message was not sent by its author")
+	}
+	if len(chat.Message) > CHAT_MESSAGE_MAX_CHARACTERS {
+		return errors.New("Chat message over max characters")
+	}
+	if err := VerifyCaseChat(chat); err != nil {
+		return err
+	}
+	participants, _, err := n.getCaseParticipants(chat.CaseId)
+	if err != nil {
+		return err
+	}
+	isParticipant := false
+	for _, p := range participants {
+		if p == peerId {
+			isParticipant = true
+		}
+	}
+	if !isParticipant {
+		return errors.New("Peer is not a party to the dispute")
+	}
+	if err := n.Datastore.CaseChat().Put(chat); err != nil {
+		return err
+	}
+	t, err := ptypes.Timestamp(chat.Timestamp)
+	if err != nil {
+		return err
+	}
+	n.Broadcast <- notifications.CaseChatMessage{
+		MessageId: chat.MessageId,
+		CaseId:    chat.CaseId,
+		PeerId:    chat.PeerID,
+		Message:   chat.Message,
+		Timestamp: t,
+	}
+	return nil
+}
+
+// Checks the case chat message was signed by the peer it claims to be from
+func VerifyCaseChat(chat *pb.CaseChat) error {
+	if chat.Timestamp == nil {
+		return errors.New("Invalid timestamp")
+	}
+	pubkey, err := libp2p.UnmarshalPublicKey(chat.Pubkey)
+	if err != nil {
+		return err
+	}
+	pid, err := peer.IDFromPublicKey(pubkey)
+	if err != nil {
+		return err
+	}
+	if pid.Pretty() != chat.PeerID {
+		return errors.New("Public key in case chat does not match reported peer ID")
+	}
+	unsigned := *chat
+	unsigned.Signature = nil
+	ser, err := proto.Marshal(&unsigned)
+	if err != nil {
+		return err
+	}
+	valid, err := pubkey.Verify(ser, chat.Signature)
+	if err != nil || !valid {
+		return errors.New("Signature on case chat failed to verify")
+	}
+	return nil
+}
+
+// Return the buyer, vendor and moderators of a disputed order and the state of the
+// dispute, whether we are a moderator or one of the parties to the order
+func (n *OpenBazaarNode) getCaseParticipants(caseId string) ([]string, pb.OrderState, error) {
+	var contract *pb.RicardianContract
+	buyerContract, vendorContract, _, _, _, _, state, err := n.Datastore.Cases().GetPayoutDetails(caseId)
+	isCase := err == nil
+	if isCase {
+		contract = buyerContract
+		if contract == nil {
+			contract = vendorContract
+		}
+	} else {
+		contract, state, _, _, _, err = n.Datastore.Purchases().GetByOrderId(caseId)
+		if err != nil {
+			contract, state, _, _, _, err = n.Datastore.Sales().GetByOrderId(caseId)
+		}
+	}
+	if err != nil || contract == nil {
+		return nil, state, ErrCaseNotFound
+	}
+	if contract.BuyerOrder.Payment == nil || contract.BuyerOrder.Payment.Moderator == "" {
+		return nil, state, errors.New("Order is not moderated")
+	}
+	participants := caseParticipants(contract)
+	// We moderate the case even if the order names one of our earlier identities
+	if isCase {
+		ours := false
+		for _, p := range participants {
+			if n.isOwnID(p) {
+				ours = true
+			}
+		}
+		if !ours {
+			participants = append(participants, n.IpfsNode.Identity.Pretty())
+		}
+	}
+	return participants, state, nil
+}
+
+// The buyer and vendor followed by every moderator deciding the dispute. That's the
+// whole panel for a panel order or the backup moderator the dispute was escalated to.
+func caseParticipants(contract *pb.RicardianContract) []string {
+	participants := []string{
+		contract.BuyerOrder.BuyerID.PeerID,
+		contract.VendorListings[0].VendorID.PeerID,
+	}
+	return append(participants, disputePanel(contract.BuyerOrder, contract.Dispute)...)
+}
@@ -0,0 +1,92 @@
+package core
+
+import (
+	crypto "gx/ipfs/QmPGxZ1DP2w45WcogpW1h43BvseXbfke9N91qotpoQcUeS/go-libp2p-crypto"
+	peer "gx/ipfs/QmWUswjn261LSyVxWAEpMVtPdy8zmKBJJfBpG3Qdpa8ZsE/go-libp2p-peer"
+	"testing"
+	"time"
+
+	"github.com/OpenBazaar/openbazaar-go/pb"
+	"github.com/golang/protobuf/proto"
+	"github.com/golang/protobuf/ptypes"
+)
+
+func TestVerifyCaseChat(t *testing.T) {
+	priv, pub, err := crypto.GenerateKeyPair(crypto.Ed25519, 256)
+	if err != nil {
+		t.Fatal(err)
+	}
+	pid, err := peer.IDFromPublicKey(pub)
+	if err != nil {
+		t.Fatal(err)
+	}
+	pubBytes, err := pub.Bytes()
+	if err != nil {
+		t.Fatal(err)
+	}
+	ts, err := ptypes.TimestampProto(time.Now())
+	if err != nil {
+		t.Fatal(err)
+	}
+	chat := &pb.CaseChat{
+		MessageId: "QmMessage",
+		CaseId:    "QmOrder",
+		PeerID:    pid.Pretty(),
+		Message:   "The item arrived broken",
+		Timestamp: ts,
+		Pubkey:    pubBytes,
+	}
+	ser, err := proto.Marshal(chat)
+	if err != nil {
+		t.Fatal(err)
+	}
+	chat.Signature, err = priv.Sign(ser)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := VerifyCaseChat(chat); err != nil {
+		t.Error(err)
+	}
+
+	chat.Message = "The item arrived fine"
+	if err := VerifyCaseChat(chat); err == nil {
+		t.Error("Expected a modified message to fail verification")
+	}
+	chat.Message = "The item arrived broken"
+
+	chat.PeerID = "QmYwAPJzv5CZsnA625s3Xf2nemtYgPpHdWEz79ojWnPbdG"
+	if err := VerifyCaseChat(chat); err == nil {
+		t.Error("Expected a mismatched peer ID to fail verification")
+	}
+}
+
+func TestCaseParticipants(t *testing.T) {
+	contract := &pb.RicardianContract{
+		VendorListings: []*pb.Listing{{VendorID: &pb.ID{PeerID: "QmVendor"}}},
+		BuyerOrder: &pb.Order{
+			BuyerID: &pb.ID{PeerID: "QmBuyer"},
+			Payment: &pb.Order_Payment{
+				Moderator:        "QmLead",
+				PanelModerators:  []string{"QmPanel1", "QmPanel2"},
+				BackupModerators: []string{"QmBackup"},
+			},
+		},
+		Dispute: &pb.Dispute{Claim: "Item never arrived"},
+	}
+	participants := caseParticipants(contract)
+	expected := []string{"QmBuyer", "QmVendor", "QmLead", "QmPanel1", "QmPanel2"}
+	if len(participants) != len(expected) {
+		t.Fatalf("Expected %d participants in a panel dispute, got %d", len(expected), len(participants))
+	}
+	for i := range expected {
+		if participants[i] != expected[i] {
+			t.Errorf("Expected participant %s, got %s", expected[i], participants[i])
+		}
+	}
+
+	contract.Dispute.Moderator = "QmBackup"
+	participants = caseParticipants(contract)
+	if len(participants) != 3 || participants[2] != "QmBackup" {
+		t.Error("Expected an escalated dispute to include only the backup moderator")
+	}
+}
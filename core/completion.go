@@ -47,7 +47,6 @@ type RatingData struct {
 }
 
 func (n *OpenBazaarNode) CompleteOrder(orderRatings *OrderRatings, contract *pb.RicardianContract, records []*spvwallet.TransactionRecord) error {
-
 	orderId, err := n.CalcOrderId(contract.BuyerOrder)
 	if err != nil {
 		return err
@@ -196,7 +195,7 @@ func (n *OpenBazaarNode) CompleteOrder(orderRatings *OrderRatings, contract *pb.
 			sig := spvwallet.Signature{InputIndex: s.InputIndex, Signature: s.Signature}
 			vendorSignatures = append(vendorSignatures, sig)
 		}
-		err = n.Wallet.Multisign(ins, []spvwallet.TransactionOutput{output}, buyerSignatures, vendorSignatures, redeemScript, contract.VendorOrderFulfillment[0].Payout.PayoutFeePerByte)
+		err = n.MultisignEscrow(contract.BuyerOrder.Payment, ins, []spvwallet.TransactionOutput{output}, buyerSignatures, vendorSignatures, redeemScript, contract.VendorOrderFulfillment[0].Payout.PayoutFeePerByte)
 		if err != nil {
 			return err
 		}
@@ -247,6 +246,7 @@ func (n *OpenBazaarNode) SignOrderCompletion(contract *pb.RicardianContract) (*p
 		return contract, err
 	}
 	s.SignatureBytes = guidSig
+	s.Successions = n.identitySuccessions()
 	contract.Signatures = append(contract.Signatures, s)
 	return contract, nil
 }
@@ -293,56 +293,15 @@ func (n *OpenBazaarNode) ValidateAndSaveRating(contract *pb.RicardianContract) e
 			return errors.New("Invalid vendor signature on rating")
 		}
 
-		if rating.RatingData.Overall < RatingMin || rating.RatingData.Overall > RatingMax {
-			return errors.New("Rating not within valid range")
-		}
-		if rating.RatingData.Quality < RatingMin || rating.RatingData.Quality > RatingMax {
-			return errors.New("Rating not within valid range")
-		}
-		if rating.RatingData.Description < RatingMin || rating.RatingData.Description > RatingMax {
-			return errors.New("Rating not within valid range")
-		}
-		if rating.RatingData.DeliverySpeed < RatingMin || rating.RatingData.DeliverySpeed > RatingMax {
-			return errors.New("Rating not within valid range")
-		}
-		if rating.RatingData.CustomerService < RatingMin || rating.RatingData.CustomerService > RatingMax {
-			return errors.New("Rating not within valid range")
-		}
-
-		m := jsonpb.Marshaler{
-			EnumsAsInts:  false,
-			EmitDefaults: false,
-			Indent:       "    ",
-			OrigName:     false,
-		}
-		ratingJson, err := m.MarshalToString(rating)
-		if err != nil {
+		if err := validateRatingRanges(rating.RatingData); err != nil {
 			return err
 		}
 
-		sha := sha256.Sum256([]byte(ratingJson))
-		h, err := multihash.Encode(sha[:], multihash.SHA2_256)
+		ratingPath, err := n.saveRating(rating)
 		if err != nil {
 			return err
 		}
 
-		mh, err := multihash.Cast(h)
-		if err != nil {
-			return err
-		}
-
-		ratingPath := path.Join(n.RepoPath, "root", "ratings", "rating_"+mh.B58String()[:12])
-		f, err := os.Create(ratingPath)
-		if err != nil {
-			return err
-		}
-		defer f.Close()
-
-		_, werr := f.Write([]byte(ratingJson))
-		if werr != nil {
-			return werr
-		}
-
 		profile, err := n.GetProfile()
 		if err != nil {
 			return err
@@ -367,14 +326,57 @@ func (n *OpenBazaarNode) ValidateAndSaveRating(contract *pb.RicardianContract) e
 	return nil
 }
 
-func (n *OpenBazaarNode) updateRatingIndex(rating *pb.OrderCompletion_Rating, ratingPath string) error {
-	indexPath := path.Join(n.RepoPath, "root", "ratings", "index.json")
+// Write a rating to the ratings folder, named after the hash of its contents
+func (n *OpenBazaarNode) saveRating(rating *pb.OrderCompletion_Rating) (string, error) {
+	m := jsonpb.Marshaler{
+		EnumsAsInts:  false,
+		EmitDefaults: false,
+		Indent:       "    ",
+		OrigName:     false,
+	}
+	ratingJson, err := m.MarshalToString(rating)
+	if err != nil {
+		return "", err
+	}
 
-	type ratingShort struct {
-		Hash string `json:"hash"`
-		Slug string `json:"slug"`
+	sha := sha256.Sum256([]byte(ratingJson))
+	h, err := multihash.Encode(sha[:], multihash.SHA2_256)
+	if err != nil {
+		return "", err
 	}
 
+	mh, err := multihash.Cast(h)
+	if err != nil {
+		return "", err
+	}
+
+	ratingPath := path.Join(n.RepoPath, "root", "ratings", "rating_"+mh.B58String()[:12])
+	f, err := os.Create(ratingPath)
+	if err != nil {
+		return "", err
+	}
+	defer f.Close()
+
+	_, werr := f.Write([]byte(ratingJson))
+	if werr != nil {
+		return "", werr
+	}
+	return ratingPath, nil
+}
+
+// An entry in the ratings index
+type ratingShort struct {
+	Hash  string `json:"hash"`
+	Slug  string `json:"slug"`
+	Reply string `json:"reply,omitempty"` // Hash of the vendor's reply to the rating
+
+	// Hashes of the earlier revisions of the reply, oldest first
+	ReplyRevisions []string `json:"replyRevisions,omitempty"`
+}
+
+func (n *OpenBazaarNode) updateRatingIndex(rating *pb.OrderCompletion_Rating, ratingPath string) error {
+	indexPath := path.Join(n.RepoPath, "root", "ratings", "index.json")
+
 	var index []ratingShort
 
 	ratingHash, err := ipfs.GetHash(n.Context, ratingPath)
@@ -405,6 +407,7 @@ func (n *OpenBazaarNode) updateRatingIndex(rating *pb.OrderCompletion_Rating, ra
 		if d.Hash != rs.Hash {
 			continue
 		}
+		rs.Reply = d.Reply
 
 		if len(index) == 1 {
 			index = []ratingShort{}
@@ -74,6 +74,10 @@ func (n *OpenBazaarNode) NewOrderConfirmation(contract *pb.RicardianContract, ad
 	if err != nil {
 		return nil, err
 	}
+	oc.ExpectedShipDate, err = n.getExpectedShipDate(contract)
+	if err != nil {
+		return nil, err
+	}
 	contract.VendorOrderConfirmation = oc
 	contract, err = n.SignOrderConfirmation(contract)
 	if err != nil {
@@ -237,6 +241,9 @@ func (n *OpenBazaarNode) RejectOfflineOrder(contract *pb.RicardianContract, reco
 	if err != nil {
 		return err
 	}
+	if err := n.RestoreCredit(contract.BuyerOrder); err != nil {
+		log.Error(err)
+	}
 	n.Datastore.Sales().Put(orderId, *contract, pb.OrderState_REJECTED, true)
 	return nil
 }
@@ -324,6 +331,7 @@ func (n *OpenBazaarNode) SignOrderConfirmation(contract *pb.RicardianContract) (
 		return contract, err
 	}
 	s.SignatureBytes = guidSig
+	s.Successions = n.identitySuccessions()
 	contract.Signatures = append(contract.Signatures, s)
 	return contract, nil
 }
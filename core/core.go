@@ -12,7 +12,6 @@ import (
 	"path"
 	"time"
 
-	bstk "github.com/OpenBazaar/go-blockstackclient"
 	"github.com/OpenBazaar/openbazaar-go/api/notifications"
 	"github.com/OpenBazaar/openbazaar-go/bitcoin"
 	"github.com/OpenBazaar/openbazaar-go/ipfs"
@@ -75,8 +74,8 @@ type OpenBazaarNode struct {
 	// A service that periodically republishes active pointers
 	PointerRepublisher *rep.PointerRepublisher
 
-	// Used to resolve blockchainIDs to OpenBazaar IDs
-	Resolver *bstk.BlockstackClient
+	// Used to resolve @handles to OpenBazaar IDs
+	Resolver NameResolver
 
 	// A service that periodically fetches and caches the bitcoin exchange rates
 	ExchangeRates bitcoin.ExchangeRates
@@ -90,6 +89,9 @@ type OpenBazaarNode struct {
 	// A dialer for Tor if available
 	TorDialer proxy.Dialer
 
+	// Verifiers for the proofs of social accounts in profiles, by account type
+	SocialProofVerifiers map[string]SocialProofVerifier
+
 	// Manage blocked peers
 	BanManager *net.BanManager
 }
@@ -0,0 +1,304 @@
+package core
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/OpenBazaar/openbazaar-go/api/notifications"
+	"github.com/OpenBazaar/openbazaar-go/pb"
+	"github.com/OpenBazaar/spvwallet"
+	"github.com/golang/protobuf/proto"
+	"github.com/golang/protobuf/ptypes"
+)
+
+// Limits how many moderators a dispute can be escalated to
+const MaxBackupModerators = 3
+
+// How long the counterparty has to respond to a dispute and the moderator then has
+// to decide it, unless set in the buyer's settings when the order was placed
+const (
+	DefaultCounterpartyUpdateDeadline = time.Hour * 72
+	DefaultModeratorDecisionDeadline  = time.Hour * 24 * 7
+)
+
+// The shortest deadlines a vendor will accept in an order
+const (
+	MinCounterpartyUpdateDeadline = time.Hour * 24
+	MinModeratorDecisionDeadline  = time.Hour * 72
+)
+
+// How often deadlines are checked and how long before the decision deadline the
+// moderator is reminded
+const (
+	DisputeDeadlineCheckPeriod = time.Hour
+	DisputeDecisionReminder    = time.Hour * 24
+)
+
+// Reassign a dispute to one of the order's backup moderators after the moderator
+// missed the deadline to decide it. A fresh dispute is signed and sent to the backup
+// moderator and the counterparty. Backup moderators hold no key to the escrow so the
+// payout they decide is handed over to the buyer and vendor to sign.
+func (n *OpenBazaarNode) EscalateDispute(orderID string, contract *pb.RicardianContract, records []*spvwallet.TransactionRecord, moderator string) error {
+	if err := n.validateEscalation(contract, moderator); err != nil {
+		return err
+	}
+	return n.sendDispute(orderID, contract, records, contract.Dispute.Claim, nil, moderator)
+}
+
+// Check the moderator has missed the deadline for the open dispute in the contract
+// and the dispute can be reassigned to the given backup moderator
+func (n *OpenBazaarNode) validateEscalation(contract *pb.RicardianContract, moderator string) error {
+	if contract.Dispute == nil || contract.Dispute.Timestamp == nil {
+		return errors.New("A dispute for this order is not open")
+	}
+	opened, err := ptypes.Timestamp(contract.Dispute.Timestamp)
+	if err != nil {
+		return err
+	}
+	updateDeadline, decisionDeadline := orderDisputeDeadlines(contract.BuyerOrder.Payment)
+	_, decideBy := disputeDeadlines(opened, updateDeadline, decisionDeadline)
+	return canEscalate(contract, moderator, decideBy, time.Now())
+}
+
+func canEscalate(contract *pb.RicardianContract, moderator string, decideBy, now time.Time) error {
+	if !isBackupModerator(contract.BuyerOrder.Payment, moderator) {
+		return errors.New("Moderator is not a backup moderator for this order")
+	}
+	if moderator == disputeModerator(contract.BuyerOrder, contract.Dispute) {
+		return errors.New("Dispute is already assigned to this moderator")
+	}
+	if now.Before(decideBy) {
+		return errors.New("The moderator's deadline to decide this dispute has not passed")
+	}
+	return nil
+}
+
+// Send reminders for disputes we are moderating and notify the buyer or vendor when
+// the moderator of one of their disputes misses the deadline to decide it
+func (n *OpenBazaarNode) CheckDisputeDeadlines() {
+	now := time.Now()
+
+	cases, err := n.Datastore.Cases().GetAll("", -1)
+	if err != nil {
+		log.Error(err)
+		return
+	}
+	for _, c := range cases {
+		if c.State != pb.OrderState_DISPUTED.String() {
+			continue
+		}
+		buyerContract, vendorContract, _, _, _, _, _, err := n.Datastore.Cases().GetPayoutDetails(c.CaseId)
+		if err != nil {
+			continue
+		}
+		contract := buyerContract
+		if contract == nil {
+			contract = vendorContract
+		}
+		if contract == nil || contract.BuyerOrder == nil {
+			continue
+		}
+		updateDeadline, decisionDeadline := orderDisputeDeadlines(contract.BuyerOrder.Payment)
+		updateBy, decideBy := disputeDeadlines(c.Timestamp, updateDeadline, decisionDeadline)
+		if passedSinceLastCheck(updateBy, now) && (buyerContract == nil || vendorContract == nil) {
+			n.sendDisputeDeadlineNotification(c.CaseId, "counterpartyUpdate", updateBy, true)
+		}
+		if passedSinceLastCheck(decideBy.Add(-DisputeDecisionReminder), now) {
+			n.sendDisputeDeadlineNotification(c.CaseId, "moderatorDecision", decideBy, false)
+		}
+		if passedSinceLastCheck(decideBy, now) {
+			n.sendDisputeDeadlineNotification(c.CaseId, "moderatorDecision", decideBy, true)
+		}
+	}
+
+	var orderIDs []string
+	purchases, err := n.Datastore.Purchases().GetAll("", -1)
+	if err != nil {
+		log.Error(err)
+		return
+	}
+	for _, p := range purchases {
+		if p.State == pb.OrderState_DISPUTED.String() {
+			orderIDs = append(orderIDs, p.OrderId)
+		}
+	}
+	sales, err := n.Datastore.Sales().GetAll("", -1)
+	if err != nil {
+		log.Error(err)
+		return
+	}
+	for _, s := range sales {
+		if s.State == pb.OrderState_DISPUTED.String() {
+			orderIDs = append(orderIDs, s.OrderId)
+		}
+	}
+	for _, orderID := range orderIDs {
+		contract, _, _, err := n.getDisputedOrder(orderID)
+		if err != nil || contract.Dispute == nil || contract.Dispute.Timestamp == nil {
+			continue
+		}
+		opened, err := ptypes.Timestamp(contract.Dispute.Timestamp)
+		if err != nil {
+			continue
+		}
+		updateDeadline, decisionDeadline := orderDisputeDeadlines(contract.BuyerOrder.Payment)
+		_, decideBy := disputeDeadlines(opened, updateDeadline, decisionDeadline)
+		if passedSinceLastCheck(decideBy, now) {
+			n.sendDisputeDeadlineNotification(orderID, "moderatorDecision", decideBy, true)
+		}
+	}
+}
+
+// Check dispute deadlines periodically. Intended to be run in its own goroutine.
+func (n *OpenBazaarNode) StartDisputeDeadlineChecks() {
+	tick := time.NewTicker(DisputeDeadlineCheckPeriod)
+	defer tick.Stop()
+	for range tick.C {
+		n.CheckDisputeDeadlines()
+	}
+}
+
+func (n *OpenBazaarNode) sendDisputeDeadlineNotification(orderID, phase string, deadline time.Time, missed bool) {
+	notif := notifications.DisputeDeadlineNotification{
+		OrderId:  orderID,
+		Phase:    phase,
+		Deadline: deadline,
+		Missed:   missed,
+	}
+	n.Broadcast <- notif
+	n.Datastore.Notifications().Put(notif, time.Now())
+}
+
+// Return the time allowed for each phase of a dispute from our settings. These are
+// pinned in the orders we place so every party works to the same deadlines.
+func (n *OpenBazaarNode) getDisputeDeadlines() (updateDeadline, decisionDeadline time.Duration) {
+	updateDeadline = DefaultCounterpartyUpdateDeadline
+	decisionDeadline = DefaultModeratorDecisionDeadline
+	settings, err := n.Datastore.Settings().Get()
+	if err != nil || settings.DisputeDeadlines == nil {
+		return updateDeadline, decisionDeadline
+	}
+	if settings.DisputeDeadlines.CounterpartyUpdate > 0 {
+		updateDeadline = time.Hour * time.Duration(settings.DisputeDeadlines.CounterpartyUpdate)
+	}
+	if settings.DisputeDeadlines.ModeratorDecision > 0 {
+		decisionDeadline = time.Hour * time.Duration(settings.DisputeDeadlines.ModeratorDecision)
+	}
+	if updateDeadline < MinCounterpartyUpdateDeadline {
+		updateDeadline = MinCounterpartyUpdateDeadline
+	}
+	if decisionDeadline < MinModeratorDecisionDeadline {
+		decisionDeadline = MinModeratorDecisionDeadline
+	}
+	return updateDeadline, decisionDeadline
+}
+
+// Return the deadlines pinned in the order's payment. Orders which don't carry them
+// use the defaults.
+func orderDisputeDeadlines(payment *pb.Order_Payment) (updateDeadline, decisionDeadline time.Duration) {
+	updateDeadline = DefaultCounterpartyUpdateDeadline
+	decisionDeadline = DefaultModeratorDecisionDeadline
+	if payment == nil {
+		return updateDeadline, decisionDeadline
+	}
+	if payment.DisputeUpdateDeadline > 0 {
+		updateDeadline = time.Hour * time.Duration(payment.DisputeUpdateDeadline)
+	}
+	if payment.DisputeDecisionDeadline > 0 {
+		decisionDeadline = time.Hour * time.Duration(payment.DisputeDecisionDeadline)
+	}
+	return updateDeadline, decisionDeadline
+}
+
+// Checks the deadlines the buyer pinned in a moderated order aren't too short to respond to
+func validateDisputeDeadlines(payment *pb.Order_Payment) error {
+	if payment.DisputeUpdateDeadline > 0 && time.Hour*time.Duration(payment.DisputeUpdateDeadline) < MinCounterpartyUpdateDeadline {
+		return fmt.Errorf("Dispute update deadline is shorter than the min of %d hours", MinCounterpartyUpdateDeadline/time.Hour)
+	}
+	if payment.DisputeDecisionDeadline > 0 && time.Hour*time.Duration(payment.DisputeDecisionDeadline) < MinModeratorDecisionDeadline {
+		return fmt.Errorf("Dispute decision deadline is shorter than the min of %d hours", MinModeratorDecisionDeadline/time.Hour)
+	}
+	return nil
+}
+
+// The counterparty must respond to a dispute within the update deadline, then the
+// moderator must decide it within the decision deadline
+func disputeDeadlines(opened time.Time, updateDeadline, decisionDeadline time.Duration) (updateBy, decideBy time.Time) {
+	updateBy = opened.Add(updateDeadline)
+	return updateBy, updateBy.Add(decisionDeadline)
+}
+
+// Returns true if the time passed since the previous check so each reminder is only
+// sent once
+func passedSinceLastCheck(t, now time.Time) bool {
+	return !t.After(now) && t.After(now.Add(-DisputeDeadlineCheckPeriod))
+}
+
+// Return the moderator a dispute is assigned to. This is the order's moderator unless
+// the dispute was escalated to a backup moderator.
+func disputeModerator(order *pb.Order, dispute *pb.Dispute) string {
+	if dispute != nil && dispute.Moderator != "" {
+		return dispute.Moderator
+	}
+	return order.Payment.Moderator
+}
+
+func isBackupModerator(payment *pb.Order_Payment, moderator string) bool {
+	for _, backup := range payment.BackupModerators {
+		if backup == moderator {
+			return true
+		}
+	}
+	return false
+}
+
+func (n *OpenBazaarNode) isOwnBackupModerator(payment *pb.Order_Payment) bool {
+	for _, backup := range payment.BackupModerators {
+		if n.isOwnID(backup) {
+			return true
+		}
+	}
+	return false
+}
+
+// Returns true if the contract's dispute was escalated to a backup moderator
+func decidedByBackup(contract *pb.RicardianContract) bool {
+	return isBackupModerator(contract.BuyerOrder.Payment, disputeModerator(contract.BuyerOrder, contract.Dispute))
+}
+
+// Release the payout decided by a backup moderator. The first party to release signs
+// the payout and hands it to the other party as a payout proposal. The second party
+// adds its signatures and broadcasts the transaction.
+func (n *OpenBazaarNode) releaseBackupPayout(contract *pb.RicardianContract) error {
+	orderID := contract.DisputeResolution.OrderId
+	decided := contract.DisputeResolution.Payout
+	proposal := contract.PayoutProposal
+	if proposal != nil && !n.isOwnID(proposal.ProposedBy) && samePayout(proposal.Payout, decided) {
+		return n.AcceptPayoutProposal(orderID)
+	}
+	contract, state, isPurchase, err := n.getDisputedOrder(orderID)
+	if err != nil {
+		return err
+	}
+	return n.sendPayoutProposal(orderID, contract, state, isPurchase, proto.Clone(decided).(*pb.DisputeResolution_Payout))
+}
+
+// Compare two payouts ignoring their signatures
+func samePayout(a, b *pb.DisputeResolution_Payout) bool {
+	a = proto.Clone(a).(*pb.DisputeResolution_Payout)
+	b = proto.Clone(b).(*pb.DisputeResolution_Payout)
+	a.Sigs, b.Sigs = nil, nil
+	return proto.Equal(a, b)
+}
+
+// Remove the signatures of an earlier dispute from a contract
+func removeDisputeSignatures(sigs []*pb.Signature) []*pb.Signature {
+	var ret []*pb.Signature
+	for _, sig := range sigs {
+		if sig.Section != pb.Signature_DISPUTE {
+			ret = append(ret, sig)
+		}
+	}
+	return ret
+}
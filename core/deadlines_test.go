@@ -0,0 +1,158 @@
+package core
+
+import (
+	"testing"
+	"time"
+
+	"github.com/OpenBazaar/openbazaar-go/pb"
+)
+
+func newEscalationContract() *pb.RicardianContract {
+	return &pb.RicardianContract{
+		BuyerOrder: &pb.Order{
+			Payment: &pb.Order_Payment{
+				Moderator:        "QmModerator",
+				BackupModerators: []string{"QmBackup1", "QmBackup2"},
+			},
+		},
+		Dispute: &pb.Dispute{Claim: "Item never arrived"},
+	}
+}
+
+func TestDisputeDeadlines(t *testing.T) {
+	opened := time.Date(2017, 6, 1, 12, 0, 0, 0, time.UTC)
+	updateBy, decideBy := disputeDeadlines(opened, time.Hour*72, time.Hour*24*7)
+	if !updateBy.Equal(opened.Add(time.Hour * 72)) {
+		t.Error("Returned incorrect counterparty update deadline")
+	}
+	if !decideBy.Equal(opened.Add(time.Hour * 24 * 10)) {
+		t.Error("Returned incorrect moderator decision deadline")
+	}
+}
+
+func TestOrderDisputeDeadlines(t *testing.T) {
+	updateDeadline, decisionDeadline := orderDisputeDeadlines(&pb.Order_Payment{})
+	if updateDeadline != DefaultCounterpartyUpdateDeadline || decisionDeadline != DefaultModeratorDecisionDeadline {
+		t.Error("Expected an order without pinned deadlines to use the defaults")
+	}
+	updateDeadline, decisionDeadline = orderDisputeDeadlines(&pb.Order_Payment{DisputeUpdateDeadline: 48, DisputeDecisionDeadline: 120})
+	if updateDeadline != time.Hour*48 || decisionDeadline != time.Hour*120 {
+		t.Error("Expected the deadlines pinned in the order to be used")
+	}
+}
+
+func TestValidateDisputeDeadlines(t *testing.T) {
+	if err := validateDisputeDeadlines(&pb.Order_Payment{}); err != nil {
+		t.Error(err)
+	}
+	if err := validateDisputeDeadlines(&pb.Order_Payment{DisputeUpdateDeadline: 24, DisputeDecisionDeadline: 72}); err != nil {
+		t.Error(err)
+	}
+	if err := validateDisputeDeadlines(&pb.Order_Payment{DisputeUpdateDeadline: 1, DisputeDecisionDeadline: 72}); err == nil {
+		t.Error("Expected a counterparty update deadline under the min to fail validation")
+	}
+	if err := validateDisputeDeadlines(&pb.Order_Payment{DisputeUpdateDeadline: 24, DisputeDecisionDeadline: 1}); err == nil {
+		t.Error("Expected a moderator decision deadline under the min to fail validation")
+	}
+}
+
+func TestPassedSinceLastCheck(t *testing.T) {
+	now := time.Now()
+	if !passedSinceLastCheck(now.Add(-time.Minute), now) {
+		t.Error("Expected a deadline which just passed to be reported")
+	}
+	if passedSinceLastCheck(now.Add(time.Minute), now) {
+		t.Error("Expected a future deadline not to be reported")
+	}
+	if passedSinceLastCheck(now.Add(-DisputeDeadlineCheckPeriod-time.Minute), now) {
+		t.Error("Expected a deadline reported by an earlier check not to be reported again")
+	}
+}
+
+func TestDisputeModerator(t *testing.T) {
+	contract := newEscalationContract()
+	if disputeModerator(contract.BuyerOrder, contract.Dispute) != "QmModerator" {
+		t.Error("Expected the dispute to be assigned to the order's moderator")
+	}
+	if disputeModerator(contract.BuyerOrder, nil) != "QmModerator" {
+		t.Error("Expected an order without a dispute to return the order's moderator")
+	}
+	contract.Dispute.Moderator = "QmBackup1"
+	if disputeModerator(contract.BuyerOrder, contract.Dispute) != "QmBackup1" {
+		t.Error("Expected the dispute to be assigned to the backup moderator")
+	}
+}
+
+func TestCanEscalate(t *testing.T) {
+	now := time.Now()
+	contract := newEscalationContract()
+	if err := canEscalate(contract, "QmBackup1", now.Add(-time.Minute), now); err != nil {
+		t.Error(err)
+	}
+	if err := canEscalate(contract, "QmBackup1", now.Add(time.Minute), now); err == nil {
+		t.Error("Expected escalating before the decision deadline to fail")
+	}
+	if err := canEscalate(contract, "QmModerator", now.Add(-time.Minute), now); err == nil {
+		t.Error("Expected escalating to the order's moderator to fail")
+	}
+	if err := canEscalate(contract, "QmStranger", now.Add(-time.Minute), now); err == nil {
+		t.Error("Expected escalating to a moderator not listed in the order to fail")
+	}
+	contract.Dispute.Moderator = "QmBackup1"
+	if err := canEscalate(contract, "QmBackup1", now.Add(-time.Minute), now); err == nil {
+		t.Error("Expected escalating to the current moderator to fail")
+	}
+	if err := canEscalate(contract, "QmBackup2", now.Add(-time.Minute), now); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestRemoveDisputeSignatures(t *testing.T) {
+	sigs := []*pb.Signature{
+		{Section: pb.Signature_ORDER},
+		{Section: pb.Signature_DISPUTE},
+		{Section: pb.Signature_ORDER_CONFIRMATION},
+	}
+	sigs = removeDisputeSignatures(sigs)
+	if len(sigs) != 2 {
+		t.Error("Returned incorrect number of signatures")
+	}
+	for _, sig := range sigs {
+		if sig.Section == pb.Signature_DISPUTE {
+			t.Error("Dispute signature was not removed")
+		}
+	}
+}
+
+func TestDecidedByBackup(t *testing.T) {
+	contract := newEscalationContract()
+	if decidedByBackup(contract) {
+		t.Error("Expected a dispute with the order's moderator not to be decided by a backup")
+	}
+	contract.Dispute.Moderator = "QmBackup2"
+	if !decidedByBackup(contract) {
+		t.Error("Expected an escalated dispute to be decided by a backup")
+	}
+}
+
+func TestSamePayout(t *testing.T) {
+	payout := &pb.DisputeResolution_Payout{
+		Inputs:      []*pb.Outpoint{{Hash: "abcd", Index: 1}},
+		BuyerOutput: &pb.DisputeResolution_Payout_Output{Script: "76a9", Amount: 1000},
+	}
+	signed := &pb.DisputeResolution_Payout{
+		Inputs:      []*pb.Outpoint{{Hash: "abcd", Index: 1}},
+		BuyerOutput: &pb.DisputeResolution_Payout_Output{Script: "76a9", Amount: 1000},
+		Sigs:        []*pb.BitcoinSignature{{InputIndex: 0, Signature: []byte{0x01}}},
+	}
+	if !samePayout(payout, signed) {
+		t.Error("Expected payouts differing only by signatures to match")
+	}
+	if len(payout.Sigs) != 0 || len(signed.Sigs) != 1 {
+		t.Error("Comparing payouts modified their signatures")
+	}
+	signed.BuyerOutput.Amount = 900
+	if samePayout(payout, signed) {
+		t.Error("Expected payouts with different amounts not to match")
+	}
+}
@@ -28,9 +28,15 @@ var DisputeWg = new(sync.WaitGroup)
 
 var ErrCaseNotFound = errors.New("Case not found")
 
-func (n *OpenBazaarNode) OpenDispute(orderID string, contract *pb.RicardianContract, records []*spvwallet.TransactionRecord, claim string) error {
+func (n *OpenBazaarNode) OpenDispute(orderID string, contract *pb.RicardianContract, records []*spvwallet.TransactionRecord, claim string, attachments []DisputeAttachmentData) error {
+	return n.sendDispute(orderID, contract, records, claim, attachments, "")
+}
+
+// Sign a new dispute and send it to the moderators and the counterparty. If a backup
+// moderator is given the dispute is assigned to them instead of the order's moderator.
+func (n *OpenBazaarNode) sendDispute(orderID string, contract *pb.RicardianContract, records []*spvwallet.TransactionRecord, claim string, attachments []DisputeAttachmentData, backupModerator string) error {
 	var isPurchase bool
-	if n.IpfsNode.Identity.Pretty() == contract.BuyerOrder.BuyerID.PeerID {
+	if n.isOwnID(contract.BuyerOrder.BuyerID.PeerID) {
 		isPurchase = true
 	}
 
@@ -46,6 +52,18 @@ func (n *OpenBazaarNode) OpenDispute(orderID string, contract *pb.RicardianContr
 	// Add claim
 	dispute.Claim = claim
 
+	// Assign to a backup moderator
+	if backupModerator != "" {
+		dispute.Moderator = backupModerator
+	}
+	moderators := disputePanel(contract.BuyerOrder, dispute)
+
+	// Add evidence
+	dispute.Attachments, err = n.addDisputeAttachments(contract, moderators, attachments)
+	if err != nil {
+		return err
+	}
+
 	// Create outpoints
 	var outpoints []*pb.Outpoint
 	for _, r := range records {
@@ -75,12 +93,14 @@ func (n *OpenBazaarNode) OpenDispute(orderID string, contract *pb.RicardianContr
 		return err
 	}
 	contract.Dispute = dispute
-	contract.Signatures = append(contract.Signatures, rc.Signatures[0])
+	contract.Signatures = append(removeDisputeSignatures(contract.Signatures), rc.Signatures[0])
 
-	// Send to moderator
-	err = n.SendDisputeOpen(contract.BuyerOrder.Payment.Moderator, nil, rc)
-	if err != nil {
-		return err
+	// Send to moderators
+	for _, moderator := range moderators {
+		err = n.SendDisputeOpen(moderator, nil, rc)
+		if err != nil {
+			return err
+		}
 	}
 
 	// Send to counterparty
@@ -128,6 +148,7 @@ func (n *OpenBazaarNode) SignDispute(contract *pb.RicardianContract) (*pb.Ricard
 		return contract, err
 	}
 	s.SignatureBytes = guidSig
+	s.Successions = n.identitySuccessions()
 	contract.Signatures = append(contract.Signatures, s)
 	return contract, nil
 }
@@ -142,10 +163,13 @@ func (n *OpenBazaarNode) VerifySignatureOnDisputeOpen(contract *pb.RicardianCont
 	if len(deser.VendorListings) == 0 || deser.BuyerOrder == nil {
 		return errors.New("Invalid serialized contract")
 	}
-	if peerID == deser.BuyerOrder.BuyerID.PeerID {
+	var signerID string
+	if n.IsSamePeer(deser.BuyerOrder.BuyerID.PeerID, peerID) {
 		pubkey = deser.BuyerOrder.BuyerID.Pubkeys.Identity
-	} else if peerID == deser.VendorListings[0].VendorID.PeerID {
+		signerID = deser.BuyerOrder.BuyerID.PeerID
+	} else if n.IsSamePeer(deser.VendorListings[0].VendorID.PeerID, peerID) {
 		pubkey = deser.VendorListings[0].VendorID.Pubkeys.Identity
+		signerID = deser.VendorListings[0].VendorID.PeerID
 	} else {
 		return errors.New("Peer ID doesn't match either buyer or vendor")
 	}
@@ -155,7 +179,7 @@ func (n *OpenBazaarNode) VerifySignatureOnDisputeOpen(contract *pb.RicardianCont
 		pubkey,
 		contract.Signatures,
 		pb.Signature_DISPUTE,
-		peerID,
+		signerID,
 	); err != nil {
 		switch err.(type) {
 		case noSigError:
@@ -194,8 +218,16 @@ func (n *OpenBazaarNode) ProcessDisputeOpen(rc *pb.RicardianContract, peerID str
 		return err
 	}
 
+	if rc.Dispute.Moderator != "" && !isBackupModerator(contract.BuyerOrder.Payment, rc.Dispute.Moderator) {
+		return errors.New("Dispute is assigned to a moderator not listed in the order")
+	}
+
 	// Figure out what role we have in this dispute and process it
-	if contract.BuyerOrder.Payment.Moderator == n.IpfsNode.Identity.Pretty() { // Moderator
+	isModerator := n.isOwnID(disputeModerator(contract.BuyerOrder, rc.Dispute))
+	if rc.Dispute.Moderator == "" && n.isOwnPanelModerator(contract.BuyerOrder.Payment) {
+		isModerator = true
+	}
+	if isModerator { // Moderator
 		validationErrors := n.ValidateCaseContract(contract)
 		var err error
 		if contract.VendorListings[0].VendorID.PeerID == peerID {
@@ -222,14 +254,26 @@ func (n *OpenBazaarNode) ProcessDisputeOpen(rc *pb.RicardianContract, peerID str
 		if err != nil {
 			return err
 		}
-	} else if contract.VendorListings[0].VendorID.PeerID == n.IpfsNode.Identity.Pretty() { // Vendor
+		if len(rc.Dispute.Attachments) > 0 {
+			if err := validateDisputeAttachments(rc.Dispute.Attachments, peerID); err != nil {
+				return err
+			}
+			if err := n.Datastore.Cases().PutAttachments(orderId, rc.Dispute.Attachments); err != nil {
+				return err
+			}
+		}
+	} else if n.isOwnID(contract.VendorListings[0].VendorID.PeerID) { // Vendor
 		// Load out version of the contract from the db
 		myContract, state, _, records, _, err := n.Datastore.Sales().GetByOrderId(orderId)
 		if err != nil {
 			return err
 		}
 		// Check this order is currently in a state which can be disputed
-		if state == pb.OrderState_COMPLETE || state == pb.OrderState_DISPUTED || state == pb.OrderState_DECIDED || state == pb.OrderState_RESOLVED || state == pb.OrderState_REFUNDED || state == pb.OrderState_CANCELED || state == pb.OrderState_REJECTED {
+		if rc.Dispute.Moderator != "" && state == pb.OrderState_DISPUTED {
+			if err := n.validateEscalation(myContract, rc.Dispute.Moderator); err != nil {
+				return err
+			}
+		} else if state == pb.OrderState_COMPLETE || state == pb.OrderState_DISPUTED || state == pb.OrderState_DECIDED || state == pb.OrderState_RESOLVED || state == pb.OrderState_REFUNDED || state == pb.OrderState_CANCELED || state == pb.OrderState_REJECTED {
 			return errors.New("Contact can no longer be disputed")
 		}
 
@@ -254,13 +298,16 @@ func (n *OpenBazaarNode) ProcessDisputeOpen(rc *pb.RicardianContract, peerID str
 		update.Outpoints = outpoints
 
 		// Send the message
-		err = n.SendDisputeUpdate(myContract.BuyerOrder.Payment.Moderator, update)
-		if err != nil {
-			return err
+		for _, moderator := range disputePanel(myContract.BuyerOrder, rc.Dispute) {
+			err = n.SendDisputeUpdate(moderator, update)
+			if err != nil {
+				return err
+			}
 		}
 
 		// Append the dispute and signature
 		myContract.Dispute = rc.Dispute
+		myContract.Signatures = removeDisputeSignatures(myContract.Signatures)
 		for _, sig := range rc.Signatures {
 			if sig.Section == pb.Signature_DISPUTE {
 				myContract.Signatures = append(myContract.Signatures, sig)
@@ -271,14 +318,18 @@ func (n *OpenBazaarNode) ProcessDisputeOpen(rc *pb.RicardianContract, peerID str
 		if err != nil {
 			return err
 		}
-	} else if contract.BuyerOrder.BuyerID.PeerID == n.IpfsNode.Identity.Pretty() { // Buyer
+	} else if n.isOwnID(contract.BuyerOrder.BuyerID.PeerID) { // Buyer
 		// Load out version of the contract from the db
 		myContract, state, _, records, _, err := n.Datastore.Purchases().GetByOrderId(orderId)
 		if err != nil {
 			return err
 		}
 		// Check this order is currently in a state which can be disputed
-		if state == pb.OrderState_COMPLETE || state == pb.OrderState_DISPUTED || state == pb.OrderState_DECIDED || state == pb.OrderState_RESOLVED || state == pb.OrderState_REFUNDED || state == pb.OrderState_CANCELED || state == pb.OrderState_REJECTED {
+		if rc.Dispute.Moderator != "" && state == pb.OrderState_DISPUTED {
+			if err := n.validateEscalation(myContract, rc.Dispute.Moderator); err != nil {
+				return err
+			}
+		} else if state == pb.OrderState_COMPLETE || state == pb.OrderState_DISPUTED || state == pb.OrderState_DECIDED || state == pb.OrderState_RESOLVED || state == pb.OrderState_REFUNDED || state == pb.OrderState_CANCELED || state == pb.OrderState_REJECTED {
 			return errors.New("Contact can no longer be disputed")
 		}
 
@@ -303,13 +354,16 @@ func (n *OpenBazaarNode) ProcessDisputeOpen(rc *pb.RicardianContract, peerID str
 		update.Outpoints = outpoints
 
 		// Send the message
-		err = n.SendDisputeUpdate(myContract.BuyerOrder.Payment.Moderator, update)
-		if err != nil {
-			return err
+		for _, moderator := range disputePanel(myContract.BuyerOrder, rc.Dispute) {
+			err = n.SendDisputeUpdate(moderator, update)
+			if err != nil {
+				return err
+			}
 		}
 
 		// Append the dispute and signature
 		myContract.Dispute = rc.Dispute
+		myContract.Signatures = removeDisputeSignatures(myContract.Signatures)
 		for _, sig := range rc.Signatures {
 			if sig.Section == pb.Signature_DISPUTE {
 				myContract.Signatures = append(myContract.Signatures, sig)
@@ -331,36 +385,58 @@ func (n *OpenBazaarNode) ProcessDisputeOpen(rc *pb.RicardianContract, peerID str
 	return nil
 }
 
-func (n *OpenBazaarNode) CloseDispute(orderId string, buyerPercentage, vendorPercentage float32, resolution string) error {
+// The unsigned payout for a dispute resolution along with what is needed to sign it
+// and send it to the buyer and vendor
+type disputePayout struct {
+	payout          *pb.DisputeResolution_Payout
+	inputs          []spvwallet.TransactionInput
+	outputs         []spvwallet.TransactionOutput
+	fee             uint64
+	redeemScript    string
+	chaincode       string
+	buyerId         string
+	buyerKey        libp2p.PubKey
+	vendorId        string
+	vendorKey       libp2p.PubKey
+	panelModerators []string
+	backup          bool
+}
+
+// Build the payout transaction for closing a dispute with the given split. Nothing is
+// signed so this can be used to preview a resolution before the dispute is closed.
+func (n *OpenBazaarNode) buildDisputePayout(orderId string, buyerPercentage, vendorPercentage float32) (*disputePayout, error) {
 	if buyerPercentage+vendorPercentage != 100 {
-		return errors.New("Payout percentages must sum to 100")
+		return nil, errors.New("Payout percentages must sum to 100")
 	}
 
 	buyerContract, vendorContract, buyerPayoutAddress, vendorPayoutAddress, buyerOutpoints, vendorOutpoints, state, err := n.Datastore.Cases().GetPayoutDetails(orderId)
 	if err != nil {
-		return ErrCaseNotFound
+		return nil, ErrCaseNotFound
 	}
 	if state != pb.OrderState_DISPUTED {
-		return errors.New("A dispute for this order is not open")
+		return nil, errors.New("A dispute for this order is not open")
 	}
 
-	d := new(pb.DisputeResolution)
-
-	// Add timestamp
-	ts, err := ptypes.TimestampProto(time.Now())
-	if err != nil {
-		return err
+	// The lead moderator of a panel decides the dispute and the rest of the panel endorses it
+	var panelModerators []string
+	for _, c := range []*pb.RicardianContract{buyerContract, vendorContract} {
+		if c != nil && hasModeratorPanel(c.BuyerOrder.Payment) {
+			if !n.isOwnID(c.BuyerOrder.Payment.Moderator) {
+				return nil, errors.New("Only the lead moderator of a panel can decide the dispute")
+			}
+			panelModerators = c.BuyerOrder.Payment.PanelModerators
+			break
+		}
 	}
-	d.Timestamp = ts
 
-	// Add orderId
-	d.OrderId = orderId
-
-	// Set self (moderator) as the party that made the resolution proposal
-	d.ProposedBy = n.IpfsNode.Identity.Pretty()
-
-	// Set resolution
-	d.Resolution = resolution
+	// A backup moderator has no key in the escrow so its payout is left for the buyer
+	// and vendor to sign
+	var backup bool
+	for _, c := range []*pb.RicardianContract{buyerContract, vendorContract} {
+		if c != nil && n.isOwnBackupModerator(c.BuyerOrder.Payment) {
+			backup = true
+		}
+	}
 
 	// Decide whose contract to use
 	var buyerPayout bool
@@ -383,12 +459,12 @@ func (n *OpenBazaarNode) CloseDispute(orderId string, buyerPercentage, vendorPer
 		buyerId = buyerContract.BuyerOrder.BuyerID.PeerID
 		buyerKey, err = libp2p.UnmarshalPublicKey(buyerContract.BuyerOrder.BuyerID.Pubkeys.Identity)
 		if err != nil {
-			return err
+			return nil, err
 		}
 		vendorId = buyerContract.VendorListings[0].VendorID.PeerID
 		vendorKey, err = libp2p.UnmarshalPublicKey(buyerContract.VendorListings[0].VendorID.Pubkeys.Identity)
 		if err != nil {
-			return err
+			return nil, err
 		}
 	} else if vendorPercentage > 0 && buyerPercentage == 0 {
 		vendorPayout = true
@@ -403,12 +479,12 @@ func (n *OpenBazaarNode) CloseDispute(orderId string, buyerPercentage, vendorPer
 		buyerId = vendorContract.BuyerOrder.BuyerID.PeerID
 		buyerKey, err = libp2p.UnmarshalPublicKey(vendorContract.BuyerOrder.BuyerID.Pubkeys.Identity)
 		if err != nil {
-			return err
+			return nil, err
 		}
 		vendorId = vendorContract.VendorListings[0].VendorID.PeerID
 		vendorKey, err = libp2p.UnmarshalPublicKey(vendorContract.VendorListings[0].VendorID.Pubkeys.Identity)
 		if err != nil {
-			return err
+			return nil, err
 		}
 	} else if vendorPercentage > buyerPercentage {
 		buyerPayout = true
@@ -424,12 +500,12 @@ func (n *OpenBazaarNode) CloseDispute(orderId string, buyerPercentage, vendorPer
 		buyerId = vendorContract.BuyerOrder.BuyerID.PeerID
 		buyerKey, err = libp2p.UnmarshalPublicKey(vendorContract.BuyerOrder.BuyerID.Pubkeys.Identity)
 		if err != nil {
-			return err
+			return nil, err
 		}
 		vendorId = vendorContract.VendorListings[0].VendorID.PeerID
 		vendorKey, err = libp2p.UnmarshalPublicKey(vendorContract.VendorListings[0].VendorID.Pubkeys.Identity)
 		if err != nil {
-			return err
+			return nil, err
 		}
 	} else if buyerPercentage >= vendorPercentage {
 		buyerPayout = true
@@ -441,12 +517,12 @@ func (n *OpenBazaarNode) CloseDispute(orderId string, buyerPercentage, vendorPer
 		buyerId = buyerContract.BuyerOrder.BuyerID.PeerID
 		buyerKey, err = libp2p.UnmarshalPublicKey(buyerContract.BuyerOrder.BuyerID.Pubkeys.Identity)
 		if err != nil {
-			return err
+			return nil, err
 		}
 		vendorId = buyerContract.VendorListings[0].VendorID.PeerID
 		vendorKey, err = libp2p.UnmarshalPublicKey(buyerContract.VendorListings[0].VendorID.Pubkeys.Identity)
 		if err != nil {
-			return err
+			return nil, err
 		}
 	}
 
@@ -464,16 +540,41 @@ func (n *OpenBazaarNode) CloseDispute(orderId string, buyerPercentage, vendorPer
 	modValue, err = n.GetModeratorFee(totalOut)
 	var modOutputScript []byte
 	if err != nil {
-		return err
+		return nil, err
+	}
+
+	// Share the fee evenly with the rest of a panel
+	leadValue := modValue
+	var panelValues []uint64
+	var panelOutputScripts [][]byte
+	if modValue > 0 && len(panelModerators) > 0 {
+		panelAddrs, err := n.getPanelFeeAddresses(panelModerators)
+		if err != nil {
+			return nil, err
+		}
+		shares := splitPanelFee(modValue, len(panelModerators)+1)
+		leadValue, panelValues = shares[0], shares[1:]
+		for i, addr := range panelAddrs {
+			script, err := txscript.PayToAddrScript(addr)
+			if err != nil {
+				return nil, err
+			}
+			out := spvwallet.TransactionOutput{
+				ScriptPubKey: script,
+				Value:        int64(panelValues[i]),
+			}
+			outputs = append(outputs, out)
+			panelOutputScripts = append(panelOutputScripts, script)
+		}
 	}
 	if modValue > 0 {
 		modOutputScript, err = txscript.PayToAddrScript(modAddr)
 		if err != nil {
-			return err
+			return nil, err
 		}
 		out := spvwallet.TransactionOutput{
 			ScriptPubKey: modOutputScript,
-			Value:        int64(modValue),
+			Value:        int64(leadValue),
 		}
 		outputs = append(outputs, out)
 		moderatorPayout = true
@@ -485,12 +586,12 @@ func (n *OpenBazaarNode) CloseDispute(orderId string, buyerPercentage, vendorPer
 	if buyerPayout {
 		buyerAddr, err = btcutil.DecodeAddress(buyerPayoutAddress, n.Wallet.Params())
 		if err != nil {
-			return err
+			return nil, err
 		}
 		buyerValue = uint64((float64(totalOut) - float64(modValue)) * (float64(buyerPercentage) / 100))
 		buyerOutputScript, err = txscript.PayToAddrScript(buyerAddr)
 		if err != nil {
-			return err
+			return nil, err
 		}
 		out := spvwallet.TransactionOutput{
 			ScriptPubKey: buyerOutputScript,
@@ -504,12 +605,12 @@ func (n *OpenBazaarNode) CloseDispute(orderId string, buyerPercentage, vendorPer
 	if vendorPayout {
 		vendorAddr, err = btcutil.DecodeAddress(vendorPayoutAddress, n.Wallet.Params())
 		if err != nil {
-			return err
+			return nil, err
 		}
 		vendorValue = uint64((float64(totalOut) - float64(modValue)) * (float64(vendorPercentage) / 100))
 		vendorOutputScript, err = txscript.PayToAddrScript(vendorAddr)
 		if err != nil {
-			return err
+			return nil, err
 		}
 		out := spvwallet.TransactionOutput{
 			ScriptPubKey: vendorOutputScript,
@@ -519,7 +620,7 @@ func (n *OpenBazaarNode) CloseDispute(orderId string, buyerPercentage, vendorPer
 	}
 
 	if len(outputs) == 0 {
-		return errors.New("Transaction has no outputs")
+		return nil, errors.New("Transaction has no outputs")
 	}
 
 	// Create inputs
@@ -527,7 +628,7 @@ func (n *OpenBazaarNode) CloseDispute(orderId string, buyerPercentage, vendorPer
 	for _, o := range outpoints {
 		decodedHash, err := hex.DecodeString(o.Hash)
 		if err != nil {
-			return err
+			return nil, err
 		}
 		input := spvwallet.TransactionInput{
 			OutpointHash:  decodedHash,
@@ -537,7 +638,7 @@ func (n *OpenBazaarNode) CloseDispute(orderId string, buyerPercentage, vendorPer
 	}
 
 	if len(inputs) == 0 {
-		return errors.New("Transaction has no inputs")
+		return nil, errors.New("Transaction has no inputs")
 	}
 
 	// Calculate total fee
@@ -555,66 +656,129 @@ func (n *OpenBazaarNode) CloseDispute(orderId string, buyerPercentage, vendorPer
 		outs = append(outs, o)
 	}
 
-	// Create moderator key
-	parentFP := []byte{0x00, 0x00, 0x00, 0x00}
-	chaincodeBytes, err := hex.DecodeString(chaincode)
-	if err != nil {
-		return err
+	// Create payout object
+	payout := new(pb.DisputeResolution_Payout)
+	payout.Inputs = outpoints
+	if buyerPayout {
+		payout.BuyerOutput = &pb.DisputeResolution_Payout_Output{Script: hex.EncodeToString(buyerOutputScript), Amount: buyerValue - feePerOutput}
 	}
-	mPrivKey := n.Wallet.MasterPrivateKey()
-	if err != nil {
-		return err
+	if vendorPayout {
+		payout.VendorOutput = &pb.DisputeResolution_Payout_Output{Script: hex.EncodeToString(vendorOutputScript), Amount: vendorValue - feePerOutput}
 	}
-	mECKey, err := mPrivKey.ECPrivKey()
+	if moderatorPayout {
+		payout.ModeratorOutput = &pb.DisputeResolution_Payout_Output{Script: hex.EncodeToString(modOutputScript), Amount: leadValue - feePerOutput}
+	}
+	for i, script := range panelOutputScripts {
+		payout.PanelOutputs = append(payout.PanelOutputs, &pb.DisputeResolution_Payout_Output{Script: hex.EncodeToString(script), Amount: panelValues[i] - feePerOutput})
+	}
+
+	return &disputePayout{
+		payout:          payout,
+		inputs:          inputs,
+		outputs:         outs,
+		fee:             txFee,
+		redeemScript:    redeemScript,
+		chaincode:       chaincode,
+		buyerId:         buyerId,
+		buyerKey:        buyerKey,
+		vendorId:        vendorId,
+		vendorKey:       vendorKey,
+		panelModerators: panelModerators,
+		backup:          backup,
+	}, nil
+}
+
+// Return the payout and transaction fee closing the dispute with the given split would
+// produce without signing or sending anything
+func (n *OpenBazaarNode) PreviewDisputeResolution(orderId string, buyerPercentage, vendorPercentage float32) (*pb.DisputeResolution_Payout, uint64, error) {
+	dp, err := n.buildDisputePayout(orderId, buyerPercentage, vendorPercentage)
 	if err != nil {
-		return err
+		return nil, 0, err
 	}
-	hdKey := hd.NewExtendedKey(
-		n.Wallet.Params().HDPrivateKeyID[:],
-		mECKey.Serialize(),
-		chaincodeBytes,
-		parentFP,
-		0,
-		0,
-		true)
+	return dp.payout, dp.fee, nil
+}
 
-	moderatorKey, err := hdKey.Child(0)
+func (n *OpenBazaarNode) CloseDispute(orderId string, buyerPercentage, vendorPercentage float32, resolution string) error {
+	dp, err := n.buildDisputePayout(orderId, buyerPercentage, vendorPercentage)
 	if err != nil {
 		return err
 	}
 
-	// Create signatures
-	redeemScriptBytes, err := hex.DecodeString(redeemScript)
+	d := new(pb.DisputeResolution)
+
+	// Add timestamp
+	ts, err := ptypes.TimestampProto(time.Now())
 	if err != nil {
 		return err
 	}
-	sigs, err := n.Wallet.CreateMultisigSignature(inputs, outs, moderatorKey, redeemScriptBytes, 0)
+	d.Timestamp = ts
+
+	// Add orderId
+	d.OrderId = orderId
+
+	// Set self (moderator) as the party that made the resolution proposal
+	d.ProposedBy = n.IpfsNode.Identity.Pretty()
+
+	// Set resolution
+	d.Resolution = resolution
+
+	// Preserve the case chat as a transcript signed along with the resolution
+	d.Transcript, err = n.Datastore.CaseChat().GetMessages(orderId)
 	if err != nil {
 		return err
 	}
-	var bitcoinSigs []*pb.BitcoinSignature
-	for _, sig := range sigs {
-		s := new(pb.BitcoinSignature)
-		s.InputIndex = sig.InputIndex
-		s.Signature = sig.Signature
-		bitcoinSigs = append(bitcoinSigs, s)
-	}
 
-	// Create payout object
-	payout := new(pb.DisputeResolution_Payout)
-	payout.Inputs = outpoints
-	payout.Sigs = bitcoinSigs
-	if buyerPayout {
-		payout.BuyerOutput = &pb.DisputeResolution_Payout_Output{Script: hex.EncodeToString(buyerOutputScript), Amount: buyerValue - feePerOutput}
-	}
-	if vendorPayout {
-		payout.VendorOutput = &pb.DisputeResolution_Payout_Output{Script: hex.EncodeToString(vendorOutputScript), Amount: vendorValue - feePerOutput}
-	}
-	if moderatorPayout {
-		payout.ModeratorOutput = &pb.DisputeResolution_Payout_Output{Script: hex.EncodeToString(modOutputScript), Amount: modValue - feePerOutput}
-	}
+	// Sign the payout unless we are a backup moderator, whose decision is signed by the
+	// buyer and vendor instead
+	if !dp.backup {
+		// Create moderator key
+		parentFP := []byte{0x00, 0x00, 0x00, 0x00}
+		chaincodeBytes, err := hex.DecodeString(dp.chaincode)
+		if err != nil {
+			return err
+		}
+		mPrivKey := n.Wallet.MasterPrivateKey()
+		if err != nil {
+			return err
+		}
+		mECKey, err := mPrivKey.ECPrivKey()
+		if err != nil {
+			return err
+		}
+		hdKey := hd.NewExtendedKey(
+			n.Wallet.Params().HDPrivateKeyID[:],
+			mECKey.Serialize(),
+			chaincodeBytes,
+			parentFP,
+			0,
+			0,
+			true)
 
-	d.Payout = payout
+		moderatorKey, err := hdKey.Child(0)
+		if err != nil {
+			return err
+		}
+
+		// Create signatures
+		redeemScriptBytes, err := hex.DecodeString(dp.redeemScript)
+		if err != nil {
+			return err
+		}
+		sigs, err := n.Wallet.CreateMultisigSignature(dp.inputs, dp.outputs, moderatorKey, redeemScriptBytes, 0)
+		if err != nil {
+			return err
+		}
+		var bitcoinSigs []*pb.BitcoinSignature
+		for _, sig := range sigs {
+			s := new(pb.BitcoinSignature)
+			s.InputIndex = sig.InputIndex
+			s.Signature = sig.Signature
+			bitcoinSigs = append(bitcoinSigs, s)
+		}
+
+		dp.payout.Sigs = bitcoinSigs
+	}
+	d.Payout = dp.payout
 
 	rc := new(pb.RicardianContract)
 	rc.DisputeResolution = d
@@ -623,19 +787,32 @@ func (n *OpenBazaarNode) CloseDispute(orderId string, buyerPercentage, vendorPer
 		return err
 	}
 
-	err = n.SendDisputeClose(buyerId, &buyerKey, rc)
+	err = n.SendDisputeClose(dp.buyerId, &dp.buyerKey, rc)
 	if err != nil {
 		return err
 	}
-	err = n.SendDisputeClose(vendorId, &vendorKey, rc)
+	err = n.SendDisputeClose(dp.vendorId, &dp.vendorKey, rc)
 	if err != nil {
 		return err
 	}
 
+	// Ask the rest of the panel to endorse the resolution
+	for _, moderator := range dp.panelModerators {
+		err = n.SendDisputeClose(moderator, nil, rc)
+		if err != nil {
+			return err
+		}
+	}
+
 	err = n.Datastore.Cases().MarkAsClosed(orderId, d)
 	if err != nil {
 		return err
 	}
+
+	// Refresh the stats published in our profile
+	if err := n.updateModeratorStats(); err != nil {
+		log.Error(err)
+	}
 	return nil
 }
 
@@ -654,6 +831,7 @@ func (n *OpenBazaarNode) SignDisputeResolution(contract *pb.RicardianContract) (
 		return contract, err
 	}
 	s.SignatureBytes = guidSig
+	s.Successions = n.identitySuccessions()
 	contract.Signatures = append(contract.Signatures, s)
 	return contract, nil
 }
@@ -801,26 +979,13 @@ func (n *OpenBazaarNode) ValidateCaseContract(contract *pb.RicardianContract) []
 			return validationErrors
 		}
 		parentFP := []byte{0x00, 0x00, 0x00, 0x00}
-		mECKey, err := n.Wallet.MasterPublicKey().ECPubKey()
-		if err != nil {
-			validationErrors = append(validationErrors, "Error validating bitcoin address and redeem script")
-			return validationErrors
-		}
-		hdKey := hd.NewExtendedKey(
-			n.Wallet.Params().HDPublicKeyID[:],
-			mECKey.SerializeCompressed(),
-			chaincode,
-			parentFP,
-			0,
-			0,
-			false)
-		moderatorKey, err := hdKey.Child(0)
+		moderatorKeys, err := n.getModeratorEscrowKeys(contract.BuyerOrder.Payment, chaincode)
 		if err != nil {
 			validationErrors = append(validationErrors, "Error validating bitcoin address and redeem script")
 			return validationErrors
 		}
 
-		hdKey = hd.NewExtendedKey(
+		hdKey := hd.NewExtendedKey(
 			n.Wallet.Params().HDPublicKeyID[:],
 			contract.BuyerOrder.BuyerID.Pubkeys.Bitcoin,
 			chaincode,
@@ -847,7 +1012,7 @@ func (n *OpenBazaarNode) ValidateCaseContract(contract *pb.RicardianContract) []
 			validationErrors = append(validationErrors, "Error validating bitcoin address and redeem script")
 			return validationErrors
 		}
-		addr, redeemScript, err := n.Wallet.GenerateMultisigScript([]hd.ExtendedKey{*buyerKey, *vendorKey, *moderatorKey}, 2)
+		addr, redeemScript, err := n.generateEscrowScript(contract.BuyerOrder.Payment, buyerKey, vendorKey, moderatorKeys)
 
 		if contract.BuyerOrder.Payment.Address != addr.EncodeAddress() {
 			validationErrors = append(validationErrors, "The calculated bitcoin address doesn't match the address in the order")
@@ -866,7 +1031,8 @@ func (n *OpenBazaarNode) ValidateDisputeResolution(contract *pb.RicardianContrac
 	if err != nil {
 		return err
 	}
-	if contract.DisputeResolution.Payout == nil || len(contract.DisputeResolution.Payout.Sigs) == 0 {
+	// A backup moderator's payout is signed by the buyer and vendor when it is released
+	if contract.DisputeResolution.Payout == nil || (len(contract.DisputeResolution.Payout.Sigs) == 0 && !decidedByBackup(contract)) {
 		return errors.New("DisputeResolution contains invalid payout")
 	}
 	checkWeOwnAddress := func(scriptPubKey string) error {
@@ -884,9 +1050,9 @@ func (n *OpenBazaarNode) ValidateDisputeResolution(contract *pb.RicardianContrac
 		return nil
 	}
 
-	if contract.VendorListings[0].VendorID.PeerID == n.IpfsNode.Identity.Pretty() && contract.DisputeResolution.Payout.VendorOutput != nil {
+	if n.isOwnID(contract.VendorListings[0].VendorID.PeerID) && contract.DisputeResolution.Payout.VendorOutput != nil {
 		return checkWeOwnAddress(contract.DisputeResolution.Payout.VendorOutput.Script)
-	} else if contract.BuyerOrder.BuyerID.PeerID == n.IpfsNode.Identity.Pretty() && contract.DisputeResolution.Payout.BuyerOutput != nil {
+	} else if n.isOwnID(contract.BuyerOrder.BuyerID.PeerID) && contract.DisputeResolution.Payout.BuyerOutput != nil {
 		return checkWeOwnAddress(contract.DisputeResolution.Payout.BuyerOutput.Script)
 	}
 	return nil
@@ -894,7 +1060,7 @@ func (n *OpenBazaarNode) ValidateDisputeResolution(contract *pb.RicardianContrac
 
 func (n *OpenBazaarNode) verifySignatureOnDisputeResolution(contract *pb.RicardianContract) error {
 
-	moderatorID, err := peer.IDB58Decode(contract.BuyerOrder.Payment.Moderator)
+	moderatorID, err := peer.IDB58Decode(disputeModerator(contract.BuyerOrder, contract.Dispute))
 	if err != nil {
 		return err
 	}
@@ -932,12 +1098,61 @@ func (n *OpenBazaarNode) verifySignatureOnDisputeResolution(contract *pb.Ricardi
 }
 
 func (n *OpenBazaarNode) ReleaseFunds(contract *pb.RicardianContract, records []*spvwallet.TransactionRecord) error {
+	if decidedByBackup(contract) {
+		return n.releaseBackupPayout(contract)
+	}
+	inputs, outputs, err := buildPayoutTransaction(contract.DisputeResolution.Payout)
+	if err != nil {
+		return err
+	}
+	signingKey, err := n.getMultisigSigningKey(contract)
+	if err != nil {
+		return err
+	}
+
+	// Create signatures
+	redeemScriptBytes, err := hex.DecodeString(contract.BuyerOrder.Payment.RedeemScript)
+	if err != nil {
+		return err
+	}
+	mySigs, err := n.Wallet.CreateMultisigSignature(inputs, outputs, signingKey, redeemScriptBytes, 0)
+	if err != nil {
+		return err
+	}
+
+	// A panel needs the signatures of a majority of its moderators
+	if hasModeratorPanel(contract.BuyerOrder.Payment) {
+		panelSigs, err := collectPanelSignatures(contract)
+		if err != nil {
+			return err
+		}
+		return n.multisignPayout(inputs, outputs, append([][]spvwallet.Signature{mySigs}, panelSigs...), redeemScriptBytes, panelSpend)
+	}
+
+	var moderatorSigs []spvwallet.Signature
+	for _, sig := range contract.DisputeResolution.Payout.Sigs {
+		s := spvwallet.Signature{
+			Signature:  sig.Signature,
+			InputIndex: sig.InputIndex,
+		}
+		moderatorSigs = append(moderatorSigs, s)
+	}
+
+	err = n.Wallet.Multisign(inputs, outputs, mySigs, moderatorSigs, redeemScriptBytes, 0)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+// Build the inputs and outputs of a dispute payout transaction
+func buildPayoutTransaction(payout *pb.DisputeResolution_Payout) ([]spvwallet.TransactionInput, []spvwallet.TransactionOutput, error) {
 	// Create inputs
 	var inputs []spvwallet.TransactionInput
-	for _, o := range contract.DisputeResolution.Payout.Inputs {
+	for _, o := range payout.Inputs {
 		decodedHash, err := hex.DecodeString(o.Hash)
 		if err != nil {
-			return err
+			return nil, nil, err
 		}
 		input := spvwallet.TransactionInput{
 			OutpointHash:  decodedHash,
@@ -947,58 +1162,39 @@ func (n *OpenBazaarNode) ReleaseFunds(contract *pb.RicardianContract, records []
 	}
 
 	if len(inputs) == 0 {
-		return errors.New("Transaction has no inputs")
+		return nil, nil, errors.New("Transaction has no inputs")
 	}
 
 	// Create outputs
 	var outputs []spvwallet.TransactionOutput
-	if contract.DisputeResolution.Payout.BuyerOutput != nil {
-		decodedScript, err := hex.DecodeString(contract.DisputeResolution.Payout.BuyerOutput.Script)
-		if err != nil {
-			return err
-		}
-		output := spvwallet.TransactionOutput{
-			ScriptPubKey: decodedScript,
-			Value:        int64(contract.DisputeResolution.Payout.BuyerOutput.Amount),
-		}
-		outputs = append(outputs, output)
-	}
-	if contract.DisputeResolution.Payout.VendorOutput != nil {
-		decodedScript, err := hex.DecodeString(contract.DisputeResolution.Payout.VendorOutput.Script)
-		if err != nil {
-			return err
-		}
-		output := spvwallet.TransactionOutput{
-			ScriptPubKey: decodedScript,
-			Value:        int64(contract.DisputeResolution.Payout.VendorOutput.Amount),
+	for _, o := range append([]*pb.DisputeResolution_Payout_Output{payout.BuyerOutput, payout.VendorOutput, payout.ModeratorOutput}, payout.PanelOutputs...) {
+		if o == nil {
+			continue
 		}
-		outputs = append(outputs, output)
-	}
-	if contract.DisputeResolution.Payout.ModeratorOutput != nil {
-		decodedScript, err := hex.DecodeString(contract.DisputeResolution.Payout.ModeratorOutput.Script)
+		decodedScript, err := hex.DecodeString(o.Script)
 		if err != nil {
-			return err
+			return nil, nil, err
 		}
 		output := spvwallet.TransactionOutput{
 			ScriptPubKey: decodedScript,
-			Value:        int64(contract.DisputeResolution.Payout.ModeratorOutput.Amount),
+			Value:        int64(o.Amount),
 		}
 		outputs = append(outputs, output)
 	}
+	return inputs, outputs, nil
+}
 
-	// Create signing key
+// Derive our key for the order's multisig address
+func (n *OpenBazaarNode) getMultisigSigningKey(contract *pb.RicardianContract) (*hd.ExtendedKey, error) {
 	parentFP := []byte{0x00, 0x00, 0x00, 0x00}
 	chaincodeBytes, err := hex.DecodeString(contract.BuyerOrder.Payment.Chaincode)
 	if err != nil {
-		return err
+		return nil, err
 	}
 	mPrivKey := n.Wallet.MasterPrivateKey()
-	if err != nil {
-		return err
-	}
 	mECKey, err := mPrivKey.ECPrivKey()
 	if err != nil {
-		return err
+		return nil, err
 	}
 	hdKey := hd.NewExtendedKey(
 		n.Wallet.Params().HDPrivateKeyID[:],
@@ -1009,33 +1205,5 @@ func (n *OpenBazaarNode) ReleaseFunds(contract *pb.RicardianContract, records []
 		0,
 		true)
 
-	signingKey, err := hdKey.Child(0)
-	if err != nil {
-		return err
-	}
-
-	// Create signatures
-	redeemScriptBytes, err := hex.DecodeString(contract.BuyerOrder.Payment.RedeemScript)
-	if err != nil {
-		return err
-	}
-	mySigs, err := n.Wallet.CreateMultisigSignature(inputs, outputs, signingKey, redeemScriptBytes, 0)
-	if err != nil {
-		return err
-	}
-
-	var moderatorSigs []spvwallet.Signature
-	for _, sig := range contract.DisputeResolution.Payout.Sigs {
-		s := spvwallet.Signature{
-			Signature:  sig.Signature,
-			InputIndex: sig.InputIndex,
-		}
-		moderatorSigs = append(moderatorSigs, s)
-	}
-
-	err = n.Wallet.Multisign(inputs, outputs, mySigs, moderatorSigs, redeemScriptBytes, 0)
-	if err != nil {
-		return err
-	}
-	return nil
+	return hdKey.Child(0)
 }
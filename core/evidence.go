@@ -0,0 +1,361 @@
+package core
+
+import (
+	"bytes"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"image"
+	"image/jpeg"
+	"io/ioutil"
+	"os"
+
+	libp2p "gx/ipfs/QmPGxZ1DP2w45WcogpW1h43BvseXbfke9N91qotpoQcUeS/go-libp2p-crypto"
+	peer "gx/ipfs/QmWUswjn261LSyVxWAEpMVtPdy8zmKBJJfBpG3Qdpa8ZsE/go-libp2p-peer"
+
+	"github.com/OpenBazaar/openbazaar-go/ipfs"
+	"github.com/OpenBazaar/openbazaar-go/net"
+	"github.com/OpenBazaar/openbazaar-go/pb"
+	"github.com/golang/protobuf/proto"
+)
+
+const (
+	// Maximum number of files which can be attached to a single dispute message
+	MaxDisputeAttachments = 10
+
+	// Maximum size of an attached file in bytes
+	MaxDisputeAttachmentSize = 10 << 20
+)
+
+type DisputeAttachmentData struct {
+	Filename string `json:"filename"`
+	Data     string `json:"data"` // Base64 encoded file
+}
+
+// Encrypt each file with a new key, add it and a thumbnail for images to IPFS, and
+// encrypt the key to the moderators, the counterparty and ourselves
+func (n *OpenBazaarNode) addDisputeAttachments(contract *pb.RicardianContract, moderators []string, files []DisputeAttachmentData) ([]*pb.DisputeAttachment, error) {
+	if len(files) > MaxDisputeAttachments {
+		return nil, fmt.Errorf("Number of attachments is greater than the max of %d", MaxDisputeAttachments)
+	}
+	if len(files) == 0 {
+		return nil, nil
+	}
+
+	// Collect the key of each party which can read the attachments
+	var counterparty *pb.ID
+	if n.isOwnID(contract.BuyerOrder.BuyerID.PeerID) {
+		counterparty = contract.VendorListings[0].VendorID
+	} else {
+		counterparty = contract.BuyerOrder.BuyerID
+	}
+	counterkey, err := libp2p.UnmarshalPublicKey(counterparty.Pubkeys.Identity)
+	if err != nil {
+		return nil, err
+	}
+	var moderatorIDs []peer.ID
+	for _, moderator := range moderators {
+		moderatorID, err := peer.IDB58Decode(moderator)
+		if err != nil {
+			return nil, err
+		}
+		moderatorIDs = append(moderatorIDs, moderatorID)
+	}
+	counterpartyID, err := peer.IDB58Decode(counterparty.PeerID)
+	if err != nil {
+		return nil, err
+	}
+
+	var attachments []*pb.DisputeAttachment
+	for _, f := range files {
+		if f.Filename == "" {
+			return nil, errors.New("Attachment must have a filename")
+		}
+		if len(f.Filename) > FilenameMaxCharacters {
+			return nil, fmt.Errorf("Attachment filename is longer than the max of %d characters", FilenameMaxCharacters)
+		}
+		data, err := base64.StdEncoding.DecodeString(f.Data)
+		if err != nil {
+			return nil, err
+		}
+		if len(data) > MaxDisputeAttachmentSize {
+			return nil, fmt.Errorf("Attachment %s is larger than the max of %d bytes", f.Filename, MaxDisputeAttachmentSize)
+		}
+		key := make([]byte, net.AESKeyBytes)
+		if _, err := rand.Read(key); err != nil {
+			return nil, err
+		}
+		attachment := &pb.DisputeAttachment{
+			PeerID:   n.IpfsNode.Identity.Pretty(),
+			Filename: f.Filename,
+		}
+		attachment.Hash, err = n.addEncryptedFile(key, data)
+		if err != nil {
+			return nil, err
+		}
+		if img, _, err := image.Decode(bytes.NewReader(data)); err == nil {
+			cfg := image.Config{Width: img.Bounds().Dx(), Height: img.Bounds().Dy()}
+			thumb := new(bytes.Buffer)
+			if err := jpeg.Encode(thumb, resizeImage(img, &cfg, 228, 228), nil); err != nil {
+				return nil, err
+			}
+			attachment.Thumbnail, err = n.addEncryptedFile(key, thumb.Bytes())
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		for _, moderatorID := range moderatorIDs {
+			moderatorKey, err := n.EncryptMessage(moderatorID, nil, key)
+			if err != nil {
+				return nil, err
+			}
+			attachment.Keys = append(attachment.Keys, &pb.DisputeAttachment_Key{PeerID: moderatorID.Pretty(), Key: moderatorKey})
+		}
+		counterpartyKey, err := n.EncryptMessage(counterpartyID, &counterkey, key)
+		if err != nil {
+			return nil, err
+		}
+		ourKey, err := net.Encrypt(n.IpfsNode.PrivateKey.GetPublic(), key)
+		if err != nil {
+			return nil, err
+		}
+		attachment.Keys = append(attachment.Keys,
+			&pb.DisputeAttachment_Key{PeerID: counterpartyID.Pretty(), Key: counterpartyKey},
+			&pb.DisputeAttachment_Key{PeerID: n.IpfsNode.Identity.Pretty(), Key: ourKey},
+		)
+		attachments = append(attachments, attachment)
+	}
+	return attachments, nil
+}
+
+// Encrypt the data and add it to IPFS. Attachments are kept out of the root directory
+// so they are never published with the rest of our content.
+func (n *OpenBazaarNode) addEncryptedFile(key, data []byte) (string, error) {
+	ciphertext, err := encryptAttachment(key, data)
+	if err != nil {
+		return "", err
+	}
+	f, err := ioutil.TempFile("", "attachment")
+	if err != nil {
+		return "", err
+	}
+	defer os.Remove(f.Name())
+	_, err = f.Write(ciphertext)
+	f.Close()
+	if err != nil {
+		return "", err
+	}
+	return ipfs.AddFile(n.Context, f.Name())
+}
+
+// Fetch and decrypt an attached file or its thumbnail
+func (n *OpenBazaarNode) GetDisputeAttachment(attachment *pb.DisputeAttachment, thumbnail bool) ([]byte, error) {
+	hash := attachment.Hash
+	if thumbnail {
+		if attachment.Thumbnail == "" {
+			return nil, errors.New("Attachment does not have a thumbnail")
+		}
+		hash = attachment.Thumbnail
+	}
+	// Prefer the key encrypted to our current identity over one encrypted to an
+	// identity we had before our key was rotated
+	var encryptedKey []byte
+	for _, k := range attachment.Keys {
+		if n.isOwnID(k.PeerID) {
+			encryptedKey = k.Key
+			if k.PeerID == n.IpfsNode.Identity.Pretty() {
+				break
+			}
+		}
+	}
+	if encryptedKey == nil {
+		return nil, errors.New("Attachment was not encrypted to us")
+	}
+	key, err := net.Decrypt(n.IpfsNode.PrivateKey, encryptedKey)
+	if err != nil {
+		return nil, err
+	}
+	ciphertext, err := ipfs.Cat(n.Context, hash)
+	if err != nil {
+		return nil, err
+	}
+	return decryptAttachment(key, ciphertext)
+}
+
+func encryptAttachment(key, plaintext []byte) ([]byte, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	gcm, err := cipher.NewGCM(block)
+	if err != nil {
+		return nil, err
+	}
+	nonce := make([]byte, gcm.NonceSize())
+	if _, err := rand.Read(nonce); err != nil {
+		return nil, err
+	}
+	return gcm.Seal(nonce, nonce, plaintext, nil), nil
+}
+
+func decryptAttachment(key, ciphertext []byte) ([]byte, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	gcm, err := cipher.NewGCM(block)
+	if err != nil {
+		return nil, err
+	}
+	if len(ciphertext) < gcm.NonceSize() {
+		return nil, net.ErrShortCiphertext
+	}
+	return gcm.Open(nil, ciphertext[:gcm.NonceSize()], ciphertext[gcm.NonceSize():], nil)
+}
+
+// Attach more evidence to an open dispute. The attachments are sent to the moderators
+// and the counterparty in a signed dispute update.
+func (n *OpenBazaarNode) AddDisputeEvidence(orderID string, files []DisputeAttachmentData) error {
+	if len(files) == 0 {
+		return errors.New("No attachments were provided")
+	}
+	contract, state, _, _, _, err := n.Datastore.Purchases().GetByOrderId(orderID)
+	if err != nil {
+		contract, state, _, _, _, err = n.Datastore.Sales().GetByOrderId(orderID)
+		if err != nil {
+			return errors.New("Order not found")
+		}
+	}
+	if state != pb.OrderState_DISPUTED {
+		return errors.New("A dispute for this order is not open")
+	}
+	update := new(pb.DisputeUpdate)
+	update.OrderId = orderID
+	moderators := disputePanel(contract.BuyerOrder, contract.Dispute)
+	update.Attachments, err = n.addDisputeAttachments(contract, moderators, files)
+	if err != nil {
+		return err
+	}
+	ser, err := proto.Marshal(update)
+	if err != nil {
+		return err
+	}
+	update.Signature, err = n.IpfsNode.PrivateKey.Sign(ser)
+	if err != nil {
+		return err
+	}
+	if err := n.Datastore.Cases().PutAttachments(orderID, update.Attachments); err != nil {
+		return err
+	}
+	counterparty := contract.BuyerOrder.BuyerID.PeerID
+	if n.isOwnID(counterparty) {
+		counterparty = contract.VendorListings[0].VendorID.PeerID
+	}
+	for _, p := range append(moderators, counterparty) {
+		if err := n.SendDisputeUpdate(p, update); err != nil {
+			log.Errorf("Error sending dispute evidence to %s: %s", p, err.Error())
+		}
+	}
+	return nil
+}
+
+// Return the evidence attached to a dispute we moderate or are a party to. For our
+// own orders this is the evidence opening the dispute followed by any sent later.
+func (n *OpenBazaarNode) GetDisputeAttachments(orderID string) ([]*pb.DisputeAttachment, error) {
+	var attachments []*pb.DisputeAttachment
+	contract, _, _, _, _, err := n.Datastore.Purchases().GetByOrderId(orderID)
+	if err != nil {
+		contract, _, _, _, _, err = n.Datastore.Sales().GetByOrderId(orderID)
+	}
+	if err == nil && contract.Dispute != nil {
+		attachments = append(attachments, contract.Dispute.Attachments...)
+	}
+	added, err := n.Datastore.Cases().GetAttachments(orderID)
+	if err != nil {
+		return nil, err
+	}
+	return append(attachments, added...), nil
+}
+
+// Save evidence sent to us as the moderator of a case or as the counterparty to the
+// dispute after checking it was signed by the buyer or vendor who sent it
+func (n *OpenBazaarNode) ProcessDisputeEvidence(peerID string, update *pb.DisputeUpdate) error {
+	var contract *pb.RicardianContract
+	buyerContract, vendorContract, _, _, _, _, state, err := n.Datastore.Cases().GetPayoutDetails(update.OrderId)
+	if err == nil {
+		contract = buyerContract
+		if contract == nil {
+			contract = vendorContract
+		}
+		if contract == nil {
+			return errors.New("Case does not contain a contract")
+		}
+	} else {
+		contract, state, _, _, _, err = n.Datastore.Purchases().GetByOrderId(update.OrderId)
+		if err != nil {
+			contract, state, _, _, _, err = n.Datastore.Sales().GetByOrderId(update.OrderId)
+			if err != nil {
+				return ErrCaseNotFound
+			}
+		}
+		if n.isOwnID(peerID) {
+			return errors.New("Evidence was sent by ourselves")
+		}
+	}
+	if state != pb.OrderState_DISPUTED {
+		return errors.New("A dispute for this order is not open")
+	}
+	var pubkey []byte
+	if peerID == contract.BuyerOrder.BuyerID.PeerID {
+		pubkey = contract.BuyerOrder.BuyerID.Pubkeys.Identity
+	} else if peerID == contract.VendorListings[0].VendorID.PeerID {
+		pubkey = contract.VendorListings[0].VendorID.Pubkeys.Identity
+	} else {
+		return errors.New("Peer ID doesn't match either buyer or vendor")
+	}
+	if err := verifyDisputeUpdate(update, pubkey); err != nil {
+		return err
+	}
+	if err := validateDisputeAttachments(update.Attachments, peerID); err != nil {
+		return err
+	}
+	return n.Datastore.Cases().PutAttachments(update.OrderId, update.Attachments)
+}
+
+// Checks the signature on a dispute update was made by the given key
+func verifyDisputeUpdate(update *pb.DisputeUpdate, pubkeyBytes []byte) error {
+	pubkey, err := libp2p.UnmarshalPublicKey(pubkeyBytes)
+	if err != nil {
+		return err
+	}
+	unsigned := *update
+	unsigned.Signature = nil
+	ser, err := proto.Marshal(&unsigned)
+	if err != nil {
+		return err
+	}
+	valid, err := pubkey.Verify(ser, update.Signature)
+	if err != nil || !valid {
+		return errors.New("Signature on dispute update failed to verify")
+	}
+	return nil
+}
+
+func validateDisputeAttachments(attachments []*pb.DisputeAttachment, peerID string) error {
+	if len(attachments) > MaxDisputeAttachments {
+		return fmt.Errorf("Number of attachments is greater than the max of %d", MaxDisputeAttachments)
+	}
+	for _, a := range attachments {
+		if a.PeerID != peerID {
+			return errors.New("Attachment was not added by the sender")
+		}
+		if a.Hash == "" {
+			return errors.New("Attachment is missing a hash")
+		}
+	}
+	return nil
+}
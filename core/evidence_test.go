@@ -0,0 +1,76 @@
+package core
+
+import (
+	"bytes"
+	crypto "gx/ipfs/QmPGxZ1DP2w45WcogpW1h43BvseXbfke9N91qotpoQcUeS/go-libp2p-crypto"
+	"testing"
+
+	"github.com/OpenBazaar/openbazaar-go/pb"
+	"github.com/golang/protobuf/proto"
+)
+
+func TestEncryptAttachment(t *testing.T) {
+	key := bytes.Repeat([]byte{0x01}, 32)
+	plaintext := []byte("photo of a broken item")
+	ciphertext, err := encryptAttachment(key, plaintext)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if bytes.Contains(ciphertext, plaintext) {
+		t.Error("Ciphertext contains the plaintext")
+	}
+	decrypted, err := decryptAttachment(key, ciphertext)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(decrypted, plaintext) {
+		t.Error("Decrypted attachment does not match the original")
+	}
+	if _, err := decryptAttachment(bytes.Repeat([]byte{0x02}, 32), ciphertext); err == nil {
+		t.Error("Expected decryption with the wrong key to fail")
+	}
+}
+
+func TestVerifyDisputeUpdate(t *testing.T) {
+	priv, pub, err := crypto.GenerateKeyPair(crypto.Ed25519, 256)
+	if err != nil {
+		t.Fatal(err)
+	}
+	pubBytes, err := pub.Bytes()
+	if err != nil {
+		t.Fatal(err)
+	}
+	update := &pb.DisputeUpdate{
+		OrderId:     "QmOrder",
+		Attachments: []*pb.DisputeAttachment{{PeerID: "QmBuyer", Filename: "broken.jpg", Hash: "QmBroken"}},
+	}
+	ser, err := proto.Marshal(update)
+	if err != nil {
+		t.Fatal(err)
+	}
+	update.Signature, err = priv.Sign(ser)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := verifyDisputeUpdate(update, pubBytes); err != nil {
+		t.Error(err)
+	}
+	update.Attachments[0].Hash = "QmSwapped"
+	if err := verifyDisputeUpdate(update, pubBytes); err == nil {
+		t.Error("Expected a modified update to fail verification")
+	}
+}
+
+func TestValidateDisputeAttachments(t *testing.T) {
+	attachments := []*pb.DisputeAttachment{{PeerID: "QmBuyer", Filename: "broken.jpg", Hash: "QmBroken"}}
+	if err := validateDisputeAttachments(attachments, "QmBuyer"); err != nil {
+		t.Error(err)
+	}
+	if err := validateDisputeAttachments(attachments, "QmVendor"); err == nil {
+		t.Error("Expected an attachment from another peer to fail validation")
+	}
+	attachments[0].Hash = ""
+	if err := validateDisputeAttachments(attachments, "QmBuyer"); err == nil {
+		t.Error("Expected an attachment without a hash to fail validation")
+	}
+}
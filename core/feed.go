@@ -0,0 +1,171 @@
+package core
+
+import (
+	"database/sql"
+	"encoding/json"
+	"path"
+	"time"
+
+	"github.com/OpenBazaar/jsonpb"
+	"github.com/OpenBazaar/openbazaar-go/ipfs"
+	"github.com/OpenBazaar/openbazaar-go/pb"
+	"github.com/OpenBazaar/openbazaar-go/repo"
+	ipnspath "github.com/ipfs/go-ipfs/path"
+)
+
+// How often the stores we follow are crawled for new activity
+const FeedCrawlPeriod = time.Hour
+
+// The types of event recorded in the feed
+const (
+	FeedNewListing    = "newListing"
+	FeedPriceChange   = "priceChange"
+	FeedBackInStock   = "backInStock"
+	FeedProfileUpdate = "profileUpdate"
+	FeedNewPost       = "newPost"
+)
+
+// Crawl the stores we follow for new activity. Intended to be run in its own goroutine.
+func (n *OpenBazaarNode) StartFeedCrawler() {
+	n.CrawlFollowedStores()
+	tick := time.NewTicker(FeedCrawlPeriod)
+	defer tick.Stop()
+	for range tick.C {
+		n.CrawlFollowedStores()
+	}
+}
+
+// Compare the listing index and profile of each peer we follow against what they were
+// at the last crawl, record an event for each change and push it to the websocket
+func (n *OpenBazaarNode) CrawlFollowedStores() {
+	following, err := n.Datastore.Following().Get("", -1)
+	if err != nil {
+		log.Error(err)
+		return
+	}
+	for _, peerID := range following {
+		if err := n.crawlStore(peerID); err != nil {
+			log.Debugf("Couldn't crawl %s for the feed: %s", peerID, err.Error())
+		}
+	}
+}
+
+func (n *OpenBazaarNode) crawlStore(peerID string) error {
+	profile, err := n.FetchProfile(peerID)
+	if err != nil {
+		return err
+	}
+	indexBytes, err := ipfs.ResolveThenCat(n.Context, ipnspath.FromString(path.Join(peerID, "listings", "index.json")))
+	if err != nil {
+		// The peer's root resolved to fetch their profile so a store without any
+		// listings has no index
+		indexBytes = []byte("[]")
+	}
+	var index []listingData
+	if err := json.Unmarshal(indexBytes, &index); err != nil {
+		return err
+	}
+	m := jsonpb.Marshaler{
+		EnumsAsInts:  false,
+		EmitDefaults: false,
+		Indent:       "",
+		OrigName:     false,
+	}
+	profileJson, err := m.MarshalToString(&profile)
+	if err != nil {
+		return err
+	}
+
+	oldIndexBytes, oldProfileBytes, err := n.Datastore.Feed().GetSnapshot(peerID)
+	if err != nil && err != sql.ErrNoRows {
+		return err
+	}
+	// The first crawl of a peer only records what they have so following a store
+	// doesn't fill the feed with everything in it
+	if err == nil {
+		var oldIndex []listingData
+		if err := json.Unmarshal(oldIndexBytes, &oldIndex); err != nil {
+			return err
+		}
+		oldProfile := new(pb.Profile)
+		if err := jsonpb.UnmarshalString(string(oldProfileBytes), oldProfile); err != nil {
+			return err
+		}
+		for _, event := range diffFeedSnapshot(peerID, oldIndex, index, oldProfile, &profile) {
+			if err := n.addFeedEvent(event); err != nil {
+				return err
+			}
+		}
+	}
+	return n.Datastore.Feed().PutSnapshot(peerID, indexBytes, []byte(profileJson))
+}
+
+// Record an event in the feed and push it to the websocket
+func (n *OpenBazaarNode) addFeedEvent(event repo.FeedEvent) error {
+	var err error
+	event.ID, err = n.Datastore.Feed().Put(event)
+	if err != nil {
+		return err
+	}
+	ser, err := json.MarshalIndent(struct {
+		FeedEvent repo.FeedEvent `json:"feedEvent"`
+	}{event}, "", "    ")
+	if err != nil {
+		return err
+	}
+	n.Broadcast <- ser
+	return nil
+}
+
+// The events between two crawls of a peer's listing index and profile
+func diffFeedSnapshot(peerID string, oldIndex, index []listingData, oldProfile, profile *pb.Profile) []repo.FeedEvent {
+	var events []repo.FeedEvent
+	now := time.Now()
+	previous := make(map[string]listingData)
+	for _, ld := range oldIndex {
+		previous[ld.Slug] = ld
+	}
+	for _, ld := range index {
+		event := repo.FeedEvent{
+			PeerId:       peerID,
+			Slug:         ld.Slug,
+			Title:        ld.Title,
+			Thumbnail:    ld.Thumbnail.Small,
+			CurrencyCode: ld.Price.CurrencyCode,
+			Price:        ld.Price.Amount,
+			Timestamp:    now,
+		}
+		old, ok := previous[ld.Slug]
+		if !ok {
+			event.Type = FeedNewListing
+			events = append(events, event)
+			continue
+		}
+		if old.Price != ld.Price {
+			event.Type = FeedPriceChange
+			event.OldPrice = old.Price.Amount
+			if old.Price.CurrencyCode != ld.Price.CurrencyCode {
+				event.OldPrice = 0
+			}
+			events = append(events, event)
+		}
+		if old.OutOfStock && !ld.OutOfStock {
+			event.Type = FeedBackInStock
+			event.OldPrice = 0
+			events = append(events, event)
+		}
+	}
+	if profileEdited(oldProfile, profile) {
+		event := repo.FeedEvent{
+			Type:      FeedProfileUpdate,
+			PeerId:    peerID,
+			Title:     profile.Name,
+			Timestamp: now,
+		}
+		if profile.AvatarHashes != nil {
+			event.Thumbnail = profile.AvatarHashes.Small
+		}
+		events = append(events, event)
+	}
+	return events
+}
@@ -0,0 +1,46 @@
+package core
+
+import (
+	"testing"
+
+	"github.com/OpenBazaar/openbazaar-go/pb"
+)
+
+func TestDiffFeedSnapshot(t *testing.T) {
+	oldIndex := []listingData{
+		{Slug: "tshirt", Title: "T-shirt", Price: price{"USD", 1000}},
+		{Slug: "hoodie", Title: "Hoodie", Price: price{"USD", 3000}, OutOfStock: true},
+		{Slug: "mug", Title: "Mug", Price: price{"USD", 500}},
+	}
+	index := []listingData{
+		{Slug: "tshirt", Title: "T-shirt", Price: price{"USD", 800}},
+		{Slug: "hoodie", Title: "Hoodie", Price: price{"USD", 3000}},
+		{Slug: "mug", Title: "Mug", Price: price{"USD", 500}},
+		{Slug: "hat", Title: "Hat", Price: price{"USD", 1500}},
+	}
+	profile := &pb.Profile{Name: "Store", Stats: &pb.Profile_Stats{FollowerCount: 3}}
+	events := diffFeedSnapshot(reportedStore, oldIndex, index, &pb.Profile{Name: "Store"}, profile)
+	if len(events) != 3 {
+		t.Fatalf("Expected 3 events, got %d", len(events))
+	}
+	if events[0].Type != FeedPriceChange || events[0].Slug != "tshirt" || events[0].OldPrice != 1000 || events[0].Price != 800 {
+		t.Error("Expected a price change for the t-shirt")
+	}
+	if events[1].Type != FeedBackInStock || events[1].Slug != "hoodie" {
+		t.Error("Expected the hoodie to be back in stock")
+	}
+	if events[2].Type != FeedNewListing || events[2].Slug != "hat" {
+		t.Error("Expected a new listing for the hat")
+	}
+	for _, e := range events {
+		if e.PeerId != reportedStore {
+			t.Error("Event has the wrong peer ID")
+		}
+	}
+
+	profile.About = "New arrivals every week"
+	events = diffFeedSnapshot(reportedStore, index, index, &pb.Profile{Name: "Store"}, profile)
+	if len(events) != 1 || events[0].Type != FeedProfileUpdate {
+		t.Error("Expected a profile update")
+	}
+}
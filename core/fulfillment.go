@@ -166,6 +166,7 @@ func (n *OpenBazaarNode) SignOrderFulfillment(contract *pb.RicardianContract) (*
 		return contract, err
 	}
 	s.SignatureBytes = guidSig
+	s.Successions = n.identitySuccessions()
 	contract.Signatures = append(contract.Signatures, s)
 	return contract, nil
 }
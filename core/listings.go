@@ -10,6 +10,7 @@ import (
 	"net/url"
 	"os"
 	"path"
+	"reflect"
 	"strconv"
 	"strings"
 	"time"
@@ -41,6 +42,7 @@ const (
 	AboutMaxCharacters       = 10000
 	URLMaxCharacters         = 2000
 	MaxCountryCodes          = 255
+	MaxBillingInterval       = 365
 )
 
 type price struct {
@@ -52,20 +54,32 @@ type thumbnail struct {
 	Small  string `json:"small"`
 	Medium string `json:"medium"`
 }
+type zone struct {
+	Country     string   `json:"country"`
+	States      []string `json:"states,omitempty"`
+	PostalCodes []string `json:"postalCodes,omitempty"`
+	Exclude     bool     `json:"exclude,omitempty"`
+}
 type listingData struct {
-	Hash          string    `json:"hash"`
-	Slug          string    `json:"slug"`
-	Title         string    `json:"title"`
-	Categories    []string  `json:"categories"`
-	ContractType  string    `json:"contractType"`
-	Description   string    `json:"description"`
-	Thumbnail     thumbnail `json:"thumbnail"`
-	Price         price     `json:"price"`
-	ShipsTo       []string  `json:"shipsTo"`
-	FreeShipping  []string  `json:"freeShipping"`
-	Language      string    `json:"language"`
-	AverageRating float32   `json:"averageRating"`
-	RatingCount   uint32    `json:"ratingCount"`
+	Hash             string    `json:"hash"`
+	Slug             string    `json:"slug"`
+	Title            string    `json:"title"`
+	Categories       []string  `json:"categories"`
+	ContractType     string    `json:"contractType"`
+	Description      string    `json:"description"`
+	Thumbnail        thumbnail `json:"thumbnail"`
+	Price            price     `json:"price"`
+	ShipsTo          []string  `json:"shipsTo"`
+	FreeShipping     []string  `json:"freeShipping"`
+	ShipsToZones     []zone    `json:"shipsToZones,omitempty"`
+	Language         string    `json:"language"`
+	BillingInterval  uint32    `json:"billingInterval,omitempty"`
+	Preorder         bool      `json:"preorder,omitempty"`
+	MaxBackorder     uint32    `json:"maxBackorder,omitempty"`
+	ExpectedShipDate string    `json:"expectedShipDate,omitempty"`
+	AverageRating    float32   `json:"averageRating"`
+	RatingCount      uint32    `json:"ratingCount"`
+	OutOfStock       bool      `json:"outOfStock,omitempty"`
 }
 
 func (n *OpenBazaarNode) GenerateSlug(title string) (string, error) {
@@ -100,6 +114,11 @@ func (n *OpenBazaarNode) SignListing(listing *pb.Listing) (*pb.RicardianContract
 
 	c := new(pb.RicardianContract)
 
+	// Render the shipping options from the shipping profile if one is used
+	if err := n.applyShippingProfile(listing); err != nil {
+		return c, err
+	}
+
 	// Check the listing data is correct for continuing
 	if err := validateListing(listing); err != nil {
 		return c, err
@@ -189,6 +208,14 @@ func (n *OpenBazaarNode) SignListing(listing *pb.Listing) (*pb.RicardianContract
 /* Sets the inventory for the listing in the database. Does some basic validation
    to make sure the inventory uses the correct variants. */
 func (n *OpenBazaarNode) SetListingInventory(listing *pb.Listing) error {
+	// Bundles draw their inventory from the component skus
+	if len(listing.Item.Bundle) > 0 {
+		if err := n.validateBundle(listing); err != nil {
+			return err
+		}
+		return n.setBundleInventory(listing)
+	}
+
 	// Grab current inventory
 	currentInv, err := n.Datastore.Inventory().Get(listing.Slug)
 	if err != nil {
@@ -200,6 +227,10 @@ func (n *OpenBazaarNode) SetListingInventory(listing *pb.Listing) error {
 		if err != nil {
 			return err
 		}
+		_, err = n.FillBackorders(listing.Slug, i)
+		if err != nil {
+			return err
+		}
 		_, ok := currentInv[i]
 		if ok {
 			delete(currentInv, i)
@@ -223,7 +254,14 @@ func (n *OpenBazaarNode) SetListingInventory(listing *pb.Listing) error {
 			return err
 		}
 	}
-	return nil
+	// Update any bundles using this listing. The caller updates this listing's entry
+	// in the index.
+	bundles, err := n.UpdateBundleInventory(listing.Slug)
+	if err != nil {
+		return err
+	}
+	_, err = n.UpdateStockInListingIndex(bundles...)
+	return err
 }
 
 func (n *OpenBazaarNode) UpdateListingIndex(contract *pb.RicardianContract) error {
@@ -262,7 +300,21 @@ func (n *OpenBazaarNode) extractListingData(contract *pb.RicardianContract) (lis
 
 	shipsTo := []string{}
 	freeShipping := []string{}
+	var shipsToZones []zone
 	for _, shippingOption := range contract.VendorListings[0].ShippingOptions {
+		for _, z := range shippingOption.Zones {
+			summary := zone{z.Country.String(), z.States, z.PostalCodes, z.Exclude}
+			duplicate := false
+			for _, existing := range shipsToZones {
+				if reflect.DeepEqual(existing, summary) {
+					duplicate = true
+					break
+				}
+			}
+			if !duplicate {
+				shipsToZones = append(shipsToZones, summary)
+			}
+		}
 		for _, region := range shippingOption.Regions {
 			if !contains(shipsTo, region.String()) {
 				shipsTo = append(shipsTo, region.String())
@@ -276,21 +328,75 @@ func (n *OpenBazaarNode) extractListingData(contract *pb.RicardianContract) (lis
 	}
 
 	ld := listingData{
-		Hash:         listingHash,
-		Slug:         contract.VendorListings[0].Slug,
-		Title:        contract.VendorListings[0].Item.Title,
-		Categories:   contract.VendorListings[0].Item.Categories,
-		ContractType: contract.VendorListings[0].Metadata.ContractType.String(),
-		Description:  contract.VendorListings[0].Item.Description[:descriptionLength],
-		Thumbnail:    thumbnail{contract.VendorListings[0].Item.Images[0].Tiny, contract.VendorListings[0].Item.Images[0].Small, contract.VendorListings[0].Item.Images[0].Medium},
-		Price:        price{contract.VendorListings[0].Metadata.PricingCurrency, contract.VendorListings[0].Item.Price},
-		ShipsTo:      shipsTo,
-		FreeShipping: freeShipping,
-		Language:     contract.VendorListings[0].Metadata.Language,
+		Hash:             listingHash,
+		Slug:             contract.VendorListings[0].Slug,
+		Title:            contract.VendorListings[0].Item.Title,
+		Categories:       contract.VendorListings[0].Item.Categories,
+		ContractType:     contract.VendorListings[0].Metadata.ContractType.String(),
+		Description:      contract.VendorListings[0].Item.Description[:descriptionLength],
+		Thumbnail:        thumbnail{contract.VendorListings[0].Item.Images[0].Tiny, contract.VendorListings[0].Item.Images[0].Small, contract.VendorListings[0].Item.Images[0].Medium},
+		Price:            price{contract.VendorListings[0].Metadata.PricingCurrency, contract.VendorListings[0].Item.Price},
+		ShipsTo:          shipsTo,
+		FreeShipping:     freeShipping,
+		ShipsToZones:     shipsToZones,
+		Language:         contract.VendorListings[0].Metadata.Language,
+		BillingInterval:  contract.VendorListings[0].Metadata.BillingInterval,
+		Preorder:         contract.VendorListings[0].Metadata.Preorder,
+		MaxBackorder:     contract.VendorListings[0].Metadata.MaxBackorder,
+		ExpectedShipDate: formatShipDate(contract.VendorListings[0].Metadata.ExpectedShipDate),
+		OutOfStock:       n.listingOutOfStock(contract.VendorListings[0].Slug),
 	}
 	return ld, nil
 }
 
+// A listing is out of stock when it tracks inventory and none of its variants have
+// any left. A negative count means the variant's stock isn't limited.
+func (n *OpenBazaarNode) listingOutOfStock(slug string) bool {
+	inventory, err := n.Datastore.Inventory().Get(slug)
+	if err != nil || len(inventory) == 0 {
+		return false
+	}
+	for _, count := range inventory {
+		if count != 0 {
+			return false
+		}
+	}
+	return true
+}
+
+// Update whether listings are out of stock in the index.json file after their
+// inventory changed. Returns whether the index changed.
+func (n *OpenBazaarNode) UpdateStockInListingIndex(slugs ...string) (bool, error) {
+	changed := false
+	for _, slug := range slugs {
+		c, err := n.updateStockInListingIndex(slug)
+		if err != nil {
+			return changed, err
+		}
+		changed = changed || c
+	}
+	return changed, nil
+}
+
+func (n *OpenBazaarNode) updateStockInListingIndex(slug string) (bool, error) {
+	index, err := n.getListingIndex()
+	if err != nil {
+		return false, err
+	}
+	for _, ld := range index {
+		if ld.Slug != slug {
+			continue
+		}
+		outOfStock := n.listingOutOfStock(slug)
+		if ld.OutOfStock == outOfStock {
+			return false, nil
+		}
+		ld.OutOfStock = outOfStock
+		return true, n.updateListingOnDisk(index, ld, true)
+	}
+	return false, nil
+}
+
 func (n *OpenBazaarNode) getListingIndex() ([]listingData, error) {
 	indexPath := path.Join(n.RepoPath, "root", "listings", "index.json")
 
@@ -687,6 +793,15 @@ func validateListing(listing *pb.Listing) (err error) {
 	if len(listing.Metadata.Language) > WordMaxCharacters {
 		return fmt.Errorf("Language is longer than the max of %d characters", WordMaxCharacters)
 	}
+	if listing.Metadata.BillingInterval > MaxBillingInterval {
+		return fmt.Errorf("Billing interval is longer than the max of %d days", MaxBillingInterval)
+	}
+	if listing.Metadata.BillingInterval > 0 && listing.Metadata.Format == pb.Listing_Metadata_AUCTION {
+		return errors.New("Auction listings cannot be sold as subscriptions")
+	}
+	if listing.Metadata.Preorder && listing.Metadata.ExpectedShipDate == nil {
+		return errors.New("Pre-order listings must have an expected ship date")
+	}
 
 	// Item
 	if listing.Item.Title == "" {
@@ -719,31 +834,8 @@ func validateListing(listing *pb.Listing) (err error) {
 		return fmt.Errorf("Number of listing images is greater than the max of %d", MaxListItems)
 	}
 	for _, img := range listing.Item.Images {
-		_, err := mh.FromB58String(img.Tiny)
-		if err != nil {
-			return errors.New("Tiny image hashes must be multihashes")
-		}
-		_, err = mh.FromB58String(img.Small)
-		if err != nil {
-			return errors.New("Small image hashes must be multihashes")
-		}
-		_, err = mh.FromB58String(img.Medium)
-		if err != nil {
-			return errors.New("Medium image hashes must be multihashes")
-		}
-		_, err = mh.FromB58String(img.Large)
-		if err != nil {
-			return errors.New("Large image hashes must be multihashes")
-		}
-		_, err = mh.FromB58String(img.Original)
-		if err != nil {
-			return errors.New("Original image hashes must be multihashes")
-		}
-		if img.Filename == "" {
-			return errors.New("Image file names must not be nil")
-		}
-		if len(img.Filename) > FilenameMaxCharacters {
-			return fmt.Errorf("Image filename length must be less than the max of %d", FilenameMaxCharacters)
+		if err := validateImage(img); err != nil {
+			return err
 		}
 	}
 	if len(listing.Item.Categories) > MaxCategories {
@@ -801,6 +893,20 @@ func validateListing(listing *pb.Listing) (err error) {
 		if len(sku.ProductID) > WordMaxCharacters {
 			return fmt.Errorf("Product ID length must be less than the max of %d", WordMaxCharacters)
 		}
+		if len(sku.TitleSuffix) > SentenceMaxCharacters {
+			return fmt.Errorf("Sku title suffix length must be less than the max of %d", SentenceMaxCharacters)
+		}
+		if sku.Grams < 0 {
+			return errors.New("Sku weight must not be negative")
+		}
+		if len(sku.Images) > MaxListItems {
+			return fmt.Errorf("Number of sku images is greater than the max of %d", MaxListItems)
+		}
+		for _, img := range sku.Images {
+			if err := validateImage(img); err != nil {
+				return err
+			}
+		}
 		formatted, err := json.Marshal(sku.VariantCombo)
 		if err != nil {
 			return err
@@ -822,6 +928,28 @@ func validateListing(listing *pb.Listing) (err error) {
 
 	}
 
+	// Bundle
+	if len(listing.Item.Bundle) > MaxListItems {
+		return fmt.Errorf("Number of bundle items is greater than the max of %d", MaxListItems)
+	}
+	if len(listing.Item.Bundle) > 0 && (len(listing.Item.Options) > 0 || len(listing.Item.Skus) > 0) {
+		return errors.New("Bundles cannot have their own options or skus")
+	}
+	for _, component := range listing.Item.Bundle {
+		if component.Slug == "" {
+			return errors.New("Bundle item slug must not be empty")
+		}
+		if len(component.Slug) > SentenceMaxCharacters {
+			return fmt.Errorf("Bundle item slug is longer than the max of %d", SentenceMaxCharacters)
+		}
+		if component.Slug == listing.Slug {
+			return errors.New("Bundles cannot contain themselves")
+		}
+		if component.Quantity == 0 {
+			return errors.New("Bundle item quantity must be greater than zero")
+		}
+	}
+
 	// ShippingOptions
 	if listing.Metadata.ContractType == pb.Listing_Metadata_PHYSICAL_GOOD && len(listing.ShippingOptions) == 0 {
 		return errors.New("Must be at least one shipping option for a physical good")
@@ -829,79 +957,17 @@ func validateListing(listing *pb.Listing) (err error) {
 	if len(listing.ShippingOptions) > MaxListItems {
 		return fmt.Errorf("Number of shipping options is greater than the max of %d", MaxListItems)
 	}
-	var shippingTitles []string
+	if err := validateShippingOptions(listing.ShippingOptions); err != nil {
+		return err
+	}
 	for _, shippingOption := range listing.ShippingOptions {
-		if shippingOption.Name == "" {
-			return errors.New("Shipping option title name must not be empty")
-		}
-		if len(shippingOption.Name) > WordMaxCharacters {
-			return fmt.Errorf("Shipping option service length must be less than the max of %d", WordMaxCharacters)
-		}
-		for _, t := range shippingTitles {
-			if t == shippingOption.Name {
-				return errors.New("Shipping option titles must be unique")
-			}
-		}
-		shippingTitles = append(shippingTitles, shippingOption.Name)
-		if shippingOption.Type > pb.Listing_ShippingOption_FIXED_PRICE {
-			return errors.New("Unkown shipping option type")
-		}
-		if len(shippingOption.Regions) == 0 {
-			return errors.New("Shipping options must specify at least one region")
-		}
-		if len(shippingOption.Regions) > MaxCountryCodes {
-			return fmt.Errorf("Number of shipping regions is greater than the max of %d", MaxCountryCodes)
-		}
-		if shippingOption.ShippingRules != nil {
-			if len(shippingOption.ShippingRules.Rules) == 0 {
-				return errors.New("At least on rule must be specified if ShippingRules is selected")
-			}
-			if len(shippingOption.ShippingRules.Rules) > MaxListItems {
-				return fmt.Errorf("Number of shipping rules is greater than the max of %d", MaxListItems)
-			}
-			if shippingOption.ShippingRules.RuleType > pb.Listing_ShippingOption_ShippingRules_COMBINED_SHIPPING_SUBTRACT {
-				return errors.New("Unknown shipping rule")
-			}
-			if shippingOption.ShippingRules.RuleType == pb.Listing_ShippingOption_ShippingRules_FLAT_FEE_WEIGHT_RANGE && listing.Item.Grams == 0 {
-				return errors.New("Item weight must be specified when using FLAT_FEE_WEIGHT_RANGE shipping rule")
-			}
-			if (shippingOption.ShippingRules.RuleType == pb.Listing_ShippingOption_ShippingRules_COMBINED_SHIPPING_ADD || shippingOption.ShippingRules.RuleType == pb.Listing_ShippingOption_ShippingRules_COMBINED_SHIPPING_SUBTRACT) && len(shippingOption.ShippingRules.Rules) > 1 {
-				return errors.New("Selected shipping rule type can only have a maximum of one rule")
-			}
-			for _, rule := range shippingOption.ShippingRules.Rules {
-				if (shippingOption.ShippingRules.RuleType == pb.Listing_ShippingOption_ShippingRules_FLAT_FEE_QUANTITY_RANGE || shippingOption.ShippingRules.RuleType == pb.Listing_ShippingOption_ShippingRules_FLAT_FEE_WEIGHT_RANGE) && rule.MaxRange <= rule.MinRange {
-					return errors.New("Shipping rule max range cannot be less than or equal to the min range")
-				}
-			}
-		}
-		if len(shippingOption.Services) == 0 && shippingOption.Type != pb.Listing_ShippingOption_LOCAL_PICKUP {
-			return errors.New("At least one service must be specified for a shipping option when not local pickup")
-		}
-		if len(shippingOption.Services) > MaxListItems {
-			return fmt.Errorf("Number of shipping services is greater than the max of %d", MaxListItems)
-		}
-		var serviceTitles []string
-		for _, option := range shippingOption.Services {
-			if option.Name == "" {
-				return errors.New("Shipping option service name must not be empty")
-			}
-			if len(option.Name) > WordMaxCharacters {
-				return fmt.Errorf("Shipping option service length must be less than the max of %d", WordMaxCharacters)
-			}
-			for _, t := range serviceTitles {
-				if t == option.Name {
-					return errors.New("Shipping option services names must be unique")
-				}
-			}
-			serviceTitles = append(serviceTitles, option.Name)
-			if option.EstimatedDelivery == "" {
-				return errors.New("Shipping option estimated delivery must not be empty")
-			}
-			if len(option.EstimatedDelivery) > SentenceMaxCharacters {
-				return fmt.Errorf("Shipping option estimated delivery length must be less than the max of %d", SentenceMaxCharacters)
-			}
+		if shippingOption.ShippingRules != nil && shippingOption.ShippingRules.RuleType == pb.Listing_ShippingOption_ShippingRules_FLAT_FEE_WEIGHT_RANGE && !hasWeight(listing) {
+			return errors.New("Item weight must be specified when using FLAT_FEE_WEIGHT_RANGE shipping rule")
 		}
 	}
+	if len(listing.ShippingProfile) > WordMaxCharacters {
+		return fmt.Errorf("Shipping profile name is longer than the max of %d characters", WordMaxCharacters)
+	}
 
 	// Taxes
 	if len(listing.Taxes) > MaxListItems {
@@ -971,6 +1037,162 @@ func validateListing(listing *pb.Listing) (err error) {
 	return nil
 }
 
+// Returns true if a weight can be resolved for every sku of the listing
+func hasWeight(listing *pb.Listing) bool {
+	if listing.Item.Grams > 0 {
+		return true
+	}
+	if len(listing.Item.Skus) == 0 {
+		return false
+	}
+	for _, sku := range listing.Item.Skus {
+		if sku.Grams <= 0 {
+			return false
+		}
+	}
+	return true
+}
+
+// Checks the image hashes and filename are valid. Used for item and sku images.
+func validateImage(img *pb.Listing_Item_Image) error {
+	_, err := mh.FromB58String(img.Tiny)
+	if err != nil {
+		return errors.New("Tiny image hashes must be multihashes")
+	}
+	_, err = mh.FromB58String(img.Small)
+	if err != nil {
+		return errors.New("Small image hashes must be multihashes")
+	}
+	_, err = mh.FromB58String(img.Medium)
+	if err != nil {
+		return errors.New("Medium image hashes must be multihashes")
+	}
+	_, err = mh.FromB58String(img.Large)
+	if err != nil {
+		return errors.New("Large image hashes must be multihashes")
+	}
+	_, err = mh.FromB58String(img.Original)
+	if err != nil {
+		return errors.New("Original image hashes must be multihashes")
+	}
+	if img.Filename == "" {
+		return errors.New("Image file names must not be nil")
+	}
+	if len(img.Filename) > FilenameMaxCharacters {
+		return fmt.Errorf("Image filename length must be less than the max of %d", FilenameMaxCharacters)
+	}
+	return nil
+}
+
+// Checks the shipping options are well formed. Used for both listings and shipping profiles.
+func validateShippingOptions(shippingOptions []*pb.Listing_ShippingOption) error {
+	var shippingTitles []string
+	for _, shippingOption := range shippingOptions {
+		if shippingOption.Name == "" {
+			return errors.New("Shipping option title name must not be empty")
+		}
+		if len(shippingOption.Name) > WordMaxCharacters {
+			return fmt.Errorf("Shipping option service length must be less than the max of %d", WordMaxCharacters)
+		}
+		for _, t := range shippingTitles {
+			if t == shippingOption.Name {
+				return errors.New("Shipping option titles must be unique")
+			}
+		}
+		shippingTitles = append(shippingTitles, shippingOption.Name)
+		if shippingOption.Type > pb.Listing_ShippingOption_FIXED_PRICE {
+			return errors.New("Unkown shipping option type")
+		}
+		if len(shippingOption.Regions) == 0 {
+			return errors.New("Shipping options must specify at least one region")
+		}
+		if len(shippingOption.Regions) > MaxCountryCodes {
+			return fmt.Errorf("Number of shipping regions is greater than the max of %d", MaxCountryCodes)
+		}
+		if shippingOption.ShippingRules != nil {
+			if len(shippingOption.ShippingRules.Rules) == 0 {
+				return errors.New("At least on rule must be specified if ShippingRules is selected")
+			}
+			if len(shippingOption.ShippingRules.Rules) > MaxListItems {
+				return fmt.Errorf("Number of shipping rules is greater than the max of %d", MaxListItems)
+			}
+			if shippingOption.ShippingRules.RuleType > pb.Listing_ShippingOption_ShippingRules_COMBINED_SHIPPING_SUBTRACT {
+				return errors.New("Unknown shipping rule")
+			}
+			if (shippingOption.ShippingRules.RuleType == pb.Listing_ShippingOption_ShippingRules_COMBINED_SHIPPING_ADD || shippingOption.ShippingRules.RuleType == pb.Listing_ShippingOption_ShippingRules_COMBINED_SHIPPING_SUBTRACT) && len(shippingOption.ShippingRules.Rules) > 1 {
+				return errors.New("Selected shipping rule type can only have a maximum of one rule")
+			}
+			for _, rule := range shippingOption.ShippingRules.Rules {
+				if (shippingOption.ShippingRules.RuleType == pb.Listing_ShippingOption_ShippingRules_FLAT_FEE_QUANTITY_RANGE || shippingOption.ShippingRules.RuleType == pb.Listing_ShippingOption_ShippingRules_FLAT_FEE_WEIGHT_RANGE) && rule.MaxRange <= rule.MinRange {
+					return errors.New("Shipping rule max range cannot be less than or equal to the min range")
+				}
+			}
+		}
+		if len(shippingOption.Zones) > MaxListItems {
+			return fmt.Errorf("Number of shipping zones is greater than the max of %d", MaxListItems)
+		}
+		for _, zone := range shippingOption.Zones {
+			if len(zone.States) == 0 && len(zone.PostalCodes) == 0 {
+				return errors.New("Shipping zones must specify at least one state or postal code")
+			}
+			if len(zone.States) > MaxCountryCodes {
+				return fmt.Errorf("Number of zone states is greater than the max of %d", MaxCountryCodes)
+			}
+			if len(zone.PostalCodes) > MaxCountryCodes {
+				return fmt.Errorf("Number of zone postal codes is greater than the max of %d", MaxCountryCodes)
+			}
+			inRegions := false
+			for _, region := range shippingOption.Regions {
+				if region == zone.Country || region == pb.CountryCode_ALL {
+					inRegions = true
+					break
+				}
+			}
+			if !inRegions {
+				return errors.New("Shipping zone country must be one of the shipping option regions")
+			}
+			for _, state := range zone.States {
+				if state == "" || len(state) > WordMaxCharacters {
+					return fmt.Errorf("Zone states must not be empty and no longer than the max of %d characters", WordMaxCharacters)
+				}
+			}
+			for _, postalCode := range zone.PostalCodes {
+				if postalCode == "" || len(postalCode) > WordMaxCharacters {
+					return fmt.Errorf("Zone postal codes must not be empty and no longer than the max of %d characters", WordMaxCharacters)
+				}
+			}
+		}
+		if len(shippingOption.Services) == 0 && shippingOption.Type != pb.Listing_ShippingOption_LOCAL_PICKUP {
+			return errors.New("At least one service must be specified for a shipping option when not local pickup")
+		}
+		if len(shippingOption.Services) > MaxListItems {
+			return fmt.Errorf("Number of shipping services is greater than the max of %d", MaxListItems)
+		}
+		var serviceTitles []string
+		for _, option := range shippingOption.Services {
+			if option.Name == "" {
+				return errors.New("Shipping option service name must not be empty")
+			}
+			if len(option.Name) > WordMaxCharacters {
+				return fmt.Errorf("Shipping option service length must be less than the max of %d", WordMaxCharacters)
+			}
+			for _, t := range serviceTitles {
+				if t == option.Name {
+					return errors.New("Shipping option services names must be unique")
+				}
+			}
+			serviceTitles = append(serviceTitles, option.Name)
+			if option.EstimatedDelivery == "" {
+				return errors.New("Shipping option estimated delivery must not be empty")
+			}
+			if len(option.EstimatedDelivery) > SentenceMaxCharacters {
+				return fmt.Errorf("Shipping option estimated delivery length must be less than the max of %d", SentenceMaxCharacters)
+			}
+		}
+	}
+	return nil
+}
+
 func verifySignaturesOnListing(contract *pb.RicardianContract) error {
 	for _, listing := range contract.VendorListings {
 		// Verify identity signature on listing
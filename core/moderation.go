@@ -6,6 +6,7 @@ import (
 	"github.com/OpenBazaar/jsonpb"
 	"github.com/OpenBazaar/openbazaar-go/ipfs"
 	"github.com/OpenBazaar/openbazaar-go/pb"
+	"github.com/OpenBazaar/spvwallet"
 	"golang.org/x/net/context"
 	ma "gx/ipfs/QmSWLfmj5frN9xVLMMN846dMDriy5wN5jeghUm7aTW3DAG/go-multiaddr"
 	multihash "gx/ipfs/QmbZ6Cee2uHjG7hf19qLHppgKDRtaG4CVtMzdmK9VCVqLu/go-multihash"
@@ -50,6 +51,11 @@ func (n *OpenBazaarNode) SetSelfAsModerator(moderator *pb.Moderator) error {
 			return errors.New("Fixed fee must be set when using a fixed fee type")
 		}
 
+		// Publish an address for our share of the fee when sitting on a panel
+		if moderator.FeeAddress == "" {
+			moderator.FeeAddress = n.Wallet.CurrentAddress(spvwallet.EXTERNAL).EncodeAddress()
+		}
+
 		// Update profile
 		profile, err := n.GetProfile()
 		if err != nil {
@@ -57,6 +63,10 @@ func (n *OpenBazaarNode) SetSelfAsModerator(moderator *pb.Moderator) error {
 		}
 		profile.Moderator = true
 		profile.ModeratorInfo = moderator
+		profile.ModeratorInfo.Stats, err = n.GetModeratorStats()
+		if err != nil {
+			return err
+		}
 		err = n.UpdateProfile(&profile)
 		if err != nil {
 			return err
@@ -0,0 +1,257 @@
+package core
+
+import (
+	"errors"
+	"fmt"
+	libp2p "gx/ipfs/QmPGxZ1DP2w45WcogpW1h43BvseXbfke9N91qotpoQcUeS/go-libp2p-crypto"
+	peer "gx/ipfs/QmWUswjn261LSyVxWAEpMVtPdy8zmKBJJfBpG3Qdpa8ZsE/go-libp2p-peer"
+	"io/ioutil"
+	"os"
+	"path"
+	"sort"
+	"time"
+
+	"github.com/OpenBazaar/jsonpb"
+	"github.com/OpenBazaar/openbazaar-go/pb"
+	"github.com/golang/protobuf/proto"
+	"github.com/golang/protobuf/ptypes"
+)
+
+var ErrInvalidEarningsPeriod = errors.New("Period must be one of day, week, month or year")
+
+// Moderator fees earned from the cases closed in one period
+type ModeratorEarnings struct {
+	Period string `json:"period"`
+	Cases  int    `json:"cases"`
+	Fees   uint64 `json:"fees"` // Satoshis
+}
+
+type closedCase struct {
+	opened     time.Time
+	resolution *pb.DisputeResolution
+}
+
+type durationSlice []time.Duration
+
+func (d durationSlice) Len() int           { return len(d) }
+func (d durationSlice) Less(i, j int) bool { return d[i] < d[j] }
+func (d durationSlice) Swap(i, j int)      { d[i], d[j] = d[j], d[i] }
+
+// Sorts the most recent period first
+type earningsByPeriod []ModeratorEarnings
+
+func (e earningsByPeriod) Len() int           { return len(e) }
+func (e earningsByPeriod) Less(i, j int) bool { return e[i].Period > e[j].Period }
+func (e earningsByPeriod) Swap(i, j int)      { e[i], e[j] = e[j], e[i] }
+
+// Compute statistics from the cases we have closed as a moderator and sign them so
+// they can be published in our profile
+func (n *OpenBazaarNode) GetModeratorStats() (*pb.Moderator_Stats, error) {
+	cases, err := n.getClosedCases()
+	if err != nil {
+		return nil, err
+	}
+	stats := computeModeratorStats(cases)
+	if err := signModeratorStats(stats, n.IpfsNode.PrivateKey); err != nil {
+		return nil, err
+	}
+	return stats, nil
+}
+
+// Return the moderator fees we have earned grouped by the period the case was closed
+// in, most recent first, along with the total
+func (n *OpenBazaarNode) GetModeratorEarnings(period string) ([]ModeratorEarnings, uint64, error) {
+	cases, err := n.getClosedCases()
+	if err != nil {
+		return nil, 0, err
+	}
+	return computeModeratorEarnings(cases, period)
+}
+
+// Refresh the stats in the moderator section of our profile. The caller is
+// responsible for republishing the profile.
+func (n *OpenBazaarNode) updateModeratorStats() error {
+	profilePath := path.Join(n.RepoPath, "root", "profile")
+	if _, err := os.Stat(profilePath); os.IsNotExist(err) {
+		return nil
+	}
+	file, err := ioutil.ReadFile(profilePath)
+	if err != nil {
+		return err
+	}
+	profile := new(pb.Profile)
+	err = jsonpb.UnmarshalString(string(file), profile)
+	if err != nil {
+		return err
+	}
+	if !profile.Moderator || profile.ModeratorInfo == nil {
+		return nil
+	}
+	profile.ModeratorInfo.Stats, err = n.GetModeratorStats()
+	if err != nil {
+		return err
+	}
+	return n.UpdateProfile(profile)
+}
+
+func (n *OpenBazaarNode) getClosedCases() ([]closedCase, error) {
+	cases, err := n.Datastore.Cases().GetAll("", -1)
+	if err != nil {
+		return nil, err
+	}
+	var closed []closedCase
+	for _, c := range cases {
+		if c.State != pb.OrderState_RESOLVED.String() {
+			continue
+		}
+		_, _, _, _, _, _, opened, _, _, resolution, err := n.Datastore.Cases().GetCaseMetadata(c.CaseId)
+		if err != nil || resolution == nil || resolution.Timestamp == nil {
+			continue
+		}
+		closed = append(closed, closedCase{opened, resolution})
+	}
+	return closed, nil
+}
+
+func computeModeratorStats(cases []closedCase) *pb.Moderator_Stats {
+	stats := new(pb.Moderator_Stats)
+	var durations durationSlice
+	var buyerPercentages float32
+	var paidOut int
+	for _, c := range cases {
+		stats.CasesHandled++
+		if decided, err := ptypes.Timestamp(c.resolution.Timestamp); err == nil && decided.After(c.opened) {
+			durations = append(durations, decided.Sub(c.opened))
+		}
+		if c.resolution.Payout == nil {
+			continue
+		}
+		var buyerAmount, vendorAmount uint64
+		if c.resolution.Payout.BuyerOutput != nil {
+			buyerAmount = c.resolution.Payout.BuyerOutput.Amount
+		}
+		if c.resolution.Payout.VendorOutput != nil {
+			vendorAmount = c.resolution.Payout.VendorOutput.Amount
+		}
+		switch {
+		case buyerAmount+vendorAmount == 0:
+			continue
+		case vendorAmount == 0:
+			stats.BuyerFavored++
+		case buyerAmount == 0:
+			stats.VendorFavored++
+		default:
+			stats.Split++
+		}
+		buyerPercentages += float32(buyerAmount) / float32(buyerAmount+vendorAmount) * 100
+		paidOut++
+	}
+	if paidOut > 0 {
+		stats.AverageBuyerPercentage = buyerPercentages / float32(paidOut)
+	}
+	if len(durations) > 0 {
+		sort.Sort(durations)
+		median := durations[len(durations)/2]
+		if len(durations)%2 == 0 {
+			median = (durations[len(durations)/2-1] + median) / 2
+		}
+		stats.MedianResolutionTime = uint64(median.Seconds())
+	}
+	return stats
+}
+
+func computeModeratorEarnings(cases []closedCase, period string) ([]ModeratorEarnings, uint64, error) {
+	if _, err := earningsPeriod(time.Now(), period); err != nil {
+		return nil, 0, err
+	}
+	byPeriod := make(map[string]*ModeratorEarnings)
+	var total uint64
+	for _, c := range cases {
+		decided, err := ptypes.Timestamp(c.resolution.Timestamp)
+		if err != nil {
+			continue
+		}
+		key, err := earningsPeriod(decided, period)
+		if err != nil {
+			return nil, 0, err
+		}
+		e, ok := byPeriod[key]
+		if !ok {
+			e = &ModeratorEarnings{Period: key}
+			byPeriod[key] = e
+		}
+		e.Cases++
+		if c.resolution.Payout != nil && c.resolution.Payout.ModeratorOutput != nil {
+			e.Fees += c.resolution.Payout.ModeratorOutput.Amount
+			total += c.resolution.Payout.ModeratorOutput.Amount
+		}
+	}
+	var earnings []ModeratorEarnings
+	for _, e := range byPeriod {
+		earnings = append(earnings, *e)
+	}
+	sort.Sort(earningsByPeriod(earnings))
+	return earnings, total, nil
+}
+
+// Return the name of the period containing t, formatted so periods sort by time
+func earningsPeriod(t time.Time, period string) (string, error) {
+	t = t.UTC()
+	switch period {
+	case "day":
+		return t.Format("2006-01-02"), nil
+	case "week":
+		year, week := t.ISOWeek()
+		return fmt.Sprintf("%d-W%02d", year, week), nil
+	case "month":
+		return t.Format("2006-01"), nil
+	case "year":
+		return t.Format("2006"), nil
+	}
+	return "", ErrInvalidEarningsPeriod
+}
+
+func signModeratorStats(stats *pb.Moderator_Stats, privKey libp2p.PrivKey) error {
+	ts, err := ptypes.TimestampProto(time.Now())
+	if err != nil {
+		return err
+	}
+	stats.Timestamp = ts
+	stats.Pubkey, err = privKey.GetPublic().Bytes()
+	if err != nil {
+		return err
+	}
+	stats.Signature = nil
+	ser, err := proto.Marshal(stats)
+	if err != nil {
+		return err
+	}
+	stats.Signature, err = privKey.Sign(ser)
+	return err
+}
+
+// Checks the stats in a moderator's profile were signed by that moderator
+func VerifyModeratorStats(stats *pb.Moderator_Stats, peerID string) error {
+	pubkey, err := libp2p.UnmarshalPublicKey(stats.Pubkey)
+	if err != nil {
+		return err
+	}
+	pid, err := peer.IDFromPublicKey(pubkey)
+	if err != nil {
+		return err
+	}
+	if pid.Pretty() != peerID {
+		return errors.New("Public key in moderator stats does not match the moderator's peer ID")
+	}
+	unsigned := *stats
+	unsigned.Signature = nil
+	ser, err := proto.Marshal(&unsigned)
+	if err != nil {
+		return err
+	}
+	valid, err := pubkey.Verify(ser, stats.Signature)
+	if err != nil || !valid {
+		return errors.New("Signature on moderator stats failed to verify")
+	}
+	return nil
+}
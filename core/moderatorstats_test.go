@@ -0,0 +1,126 @@
+package core
+
+import (
+	"testing"
+	"time"
+
+	libp2p "gx/ipfs/QmPGxZ1DP2w45WcogpW1h43BvseXbfke9N91qotpoQcUeS/go-libp2p-crypto"
+	peer "gx/ipfs/QmWUswjn261LSyVxWAEpMVtPdy8zmKBJJfBpG3Qdpa8ZsE/go-libp2p-peer"
+
+	"github.com/OpenBazaar/openbazaar-go/pb"
+	"github.com/golang/protobuf/ptypes"
+)
+
+func newClosedCase(opened time.Time, resolution time.Duration, buyer, vendor, moderator uint64) closedCase {
+	ts, _ := ptypes.TimestampProto(opened.Add(resolution))
+	return closedCase{
+		opened: opened,
+		resolution: &pb.DisputeResolution{
+			Timestamp: ts,
+			Payout: &pb.DisputeResolution_Payout{
+				BuyerOutput:     &pb.DisputeResolution_Payout_Output{Amount: buyer},
+				VendorOutput:    &pb.DisputeResolution_Payout_Output{Amount: vendor},
+				ModeratorOutput: &pb.DisputeResolution_Payout_Output{Amount: moderator},
+			},
+		},
+	}
+}
+
+func newClosedCases() []closedCase {
+	return []closedCase{
+		newClosedCase(time.Date(2017, 5, 30, 12, 0, 0, 0, time.UTC), time.Hour*10, 90000, 0, 1000),
+		newClosedCase(time.Date(2017, 6, 1, 12, 0, 0, 0, time.UTC), time.Hour*20, 0, 90000, 2000),
+		newClosedCase(time.Date(2017, 6, 10, 12, 0, 0, 0, time.UTC), time.Hour*40, 45000, 45000, 3000),
+		newClosedCase(time.Date(2017, 6, 20, 12, 0, 0, 0, time.UTC), time.Hour*30, 90000, 0, 4000),
+	}
+}
+
+func TestComputeModeratorStats(t *testing.T) {
+	stats := computeModeratorStats(newClosedCases())
+	if stats.CasesHandled != 4 {
+		t.Error("Returned incorrect number of cases handled")
+	}
+	if stats.MedianResolutionTime != uint64((time.Hour * 25).Seconds()) {
+		t.Error("Returned incorrect median resolution time")
+	}
+	if stats.BuyerFavored != 2 || stats.VendorFavored != 1 || stats.Split != 1 {
+		t.Error("Returned incorrect split counts")
+	}
+	if stats.AverageBuyerPercentage != 62.5 {
+		t.Error("Returned incorrect average buyer percentage")
+	}
+
+	empty := computeModeratorStats(nil)
+	if empty.CasesHandled != 0 || empty.MedianResolutionTime != 0 || empty.AverageBuyerPercentage != 0 {
+		t.Error("Expected empty stats when no cases were closed")
+	}
+}
+
+func TestComputeModeratorEarnings(t *testing.T) {
+	earnings, total, err := computeModeratorEarnings(newClosedCases(), "month")
+	if err != nil {
+		t.Error(err)
+	}
+	if total != 10000 {
+		t.Error("Returned incorrect total fees")
+	}
+	if len(earnings) != 2 {
+		t.Fatal("Returned incorrect number of periods")
+	}
+	if earnings[0].Period != "2017-06" || earnings[0].Cases != 3 || earnings[0].Fees != 9000 {
+		t.Error("Returned incorrect earnings for the most recent period")
+	}
+	if earnings[1].Period != "2017-05" || earnings[1].Cases != 1 || earnings[1].Fees != 1000 {
+		t.Error("Returned incorrect earnings for the earliest period")
+	}
+	if _, _, err := computeModeratorEarnings(nil, "fortnight"); err != ErrInvalidEarningsPeriod {
+		t.Error("Expected an invalid period to fail")
+	}
+}
+
+func TestEarningsPeriod(t *testing.T) {
+	ts := time.Date(2017, 1, 1, 12, 0, 0, 0, time.UTC)
+	periods := map[string]string{
+		"day":   "2017-01-01",
+		"week":  "2016-W52",
+		"month": "2017-01",
+		"year":  "2017",
+	}
+	for period, expected := range periods {
+		key, err := earningsPeriod(ts, period)
+		if err != nil {
+			t.Error(err)
+		}
+		if key != expected {
+			t.Errorf("Returned incorrect %s period %s", period, key)
+		}
+	}
+	if _, err := earningsPeriod(ts, "fortnight"); err != ErrInvalidEarningsPeriod {
+		t.Error("Expected an invalid period to fail")
+	}
+}
+
+func TestSignModeratorStats(t *testing.T) {
+	privKey, pubKey, err := libp2p.GenerateKeyPair(libp2p.Ed25519, 256)
+	if err != nil {
+		t.Fatal(err)
+	}
+	pid, err := peer.IDFromPublicKey(pubKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	stats := computeModeratorStats(newClosedCases())
+	if err := signModeratorStats(stats, privKey); err != nil {
+		t.Fatal(err)
+	}
+	if err := VerifyModeratorStats(stats, pid.Pretty()); err != nil {
+		t.Error(err)
+	}
+	if err := VerifyModeratorStats(stats, "QmStranger"); err == nil {
+		t.Error("Expected stats signed by another peer to fail verification")
+	}
+	stats.CasesHandled++
+	if err := VerifyModeratorStats(stats, pid.Pretty()); err == nil {
+		t.Error("Expected modified stats to fail verification")
+	}
+}
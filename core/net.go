@@ -20,6 +20,11 @@ const (
 )
 
 func (n *OpenBazaarNode) sendMessage(peerId string, k *libp2p.PubKey, message pb.Message) error {
+	// Peers who rotated their identity key are reached at their successor
+	if successorId, _, err := n.Datastore.Successions().Get(peerId); err == nil {
+		peerId = successorId
+		k = nil
+	}
 	p, err := peer.IDB58Decode(peerId)
 	if err != nil {
 		return err
@@ -136,6 +141,10 @@ func (n *OpenBazaarNode) Unfollow(peerId string) error {
 	if err != nil {
 		return err
 	}
+	err = n.Datastore.Feed().DeletePeer(peerId)
+	if err != nil {
+		return err
+	}
 	err = n.UpdateFollow()
 	if err != nil {
 		return err
@@ -204,6 +213,24 @@ func (n *OpenBazaarNode) SendCancel(peerId, orderId string) error {
 	return n.sendMessage(peerId, kp, m)
 }
 
+func (n *OpenBazaarNode) SendSubscriptionUpdate(peerId, subscriptionId string, status pb.Subscription_Status) error {
+	var msgType pb.Message_MessageType
+	switch status {
+	case pb.Subscription_ACTIVE:
+		msgType = pb.Message_SUBSCRIPTION_RESUME
+	case pb.Subscription_PAUSED:
+		msgType = pb.Message_SUBSCRIPTION_PAUSE
+	case pb.Subscription_CANCELED:
+		msgType = pb.Message_SUBSCRIPTION_CANCEL
+	}
+	a := &any.Any{Value: []byte(subscriptionId)}
+	m := pb.Message{
+		MessageType: msgType,
+		Payload:     a,
+	}
+	return n.sendMessage(peerId, nil, m)
+}
+
 func (n *OpenBazaarNode) SendReject(peerId string, rejectMessage *pb.OrderReject) error {
 	a, err := ptypes.MarshalAny(rejectMessage)
 	if err != nil {
@@ -333,6 +360,90 @@ func (n *OpenBazaarNode) SendChat(peerId string, chatMessage *pb.Chat) error {
 	return nil
 }
 
+func (n *OpenBazaarNode) SendCaseChatMessage(peerId string, chatMessage *pb.CaseChat) error {
+	a, err := ptypes.MarshalAny(chatMessage)
+	if err != nil {
+		return err
+	}
+	m := pb.Message{
+		MessageType: pb.Message_CASE_CHAT,
+		Payload:     a,
+	}
+	return n.sendMessage(peerId, nil, m)
+}
+
+func (n *OpenBazaarNode) SendPayoutProposal(peerId string, proposal *pb.PayoutProposal) error {
+	a, err := ptypes.MarshalAny(proposal)
+	if err != nil {
+		return err
+	}
+	m := pb.Message{
+		MessageType: pb.Message_PAYOUT_PROPOSAL,
+		Payload:     a,
+	}
+	return n.sendMessage(peerId, nil, m)
+}
+
+func (n *OpenBazaarNode) SendReportMessage(peerId string, report *pb.Report) error {
+	a, err := ptypes.MarshalAny(report)
+	if err != nil {
+		return err
+	}
+	m := pb.Message{
+		MessageType: pb.Message_REPORT,
+		Payload:     a,
+	}
+	return n.sendMessage(peerId, nil, m)
+}
+
+func (n *OpenBazaarNode) SendDisputeEndorsement(peerId string, k *libp2p.PubKey, endorsement *pb.DisputeEndorsement) error {
+	a, err := ptypes.MarshalAny(endorsement)
+	if err != nil {
+		return err
+	}
+	m := pb.Message{
+		MessageType: pb.Message_DISPUTE_ENDORSEMENT,
+		Payload:     a,
+	}
+	return n.sendMessage(peerId, k, m)
+}
+
+func (n *OpenBazaarNode) SendBuyerRating(peerId string, k *libp2p.PubKey, rating *pb.BuyerRating) error {
+	a, err := ptypes.MarshalAny(rating)
+	if err != nil {
+		return err
+	}
+	m := pb.Message{
+		MessageType: pb.Message_BUYER_RATING,
+		Payload:     a,
+	}
+	return n.sendMessage(peerId, k, m)
+}
+
+func (n *OpenBazaarNode) SendIdentitySuccession(peerId string, chain *pb.IdentityChain) error {
+	a, err := ptypes.MarshalAny(chain)
+	if err != nil {
+		return err
+	}
+	m := pb.Message{
+		MessageType: pb.Message_IDENTITY_SUCCESSION,
+		Payload:     a,
+	}
+	return n.sendMessage(peerId, nil, m)
+}
+
+func (n *OpenBazaarNode) SendPost(peerId string, post *pb.Post) error {
+	a, err := ptypes.MarshalAny(post)
+	if err != nil {
+		return err
+	}
+	m := pb.Message{
+		MessageType: pb.Message_POST,
+		Payload:     a,
+	}
+	return n.sendMessage(peerId, nil, m)
+}
+
 func (n *OpenBazaarNode) SendModeratorAdd(peerId string) error {
 	m := pb.Message{MessageType: pb.Message_MODERATOR_ADD}
 	err := n.sendMessage(peerId, nil, m)
@@ -44,18 +44,31 @@ type item struct {
 	Coupons     []string       `json:"coupons"`
 }
 
+type credit struct {
+	Voucher string `json:"voucher"` // ID of a voucher issued to us
+	Code    string `json:"code"`    // Code of a bearer voucher. It's kept out of the contract.
+	Amount  uint64 `json:"amount"`
+}
+
 type PurchaseData struct {
-	ShipTo               string  `json:"shipTo"`
-	Address              string  `json:"address"`
-	City                 string  `json:"city"`
-	State                string  `json:"state"`
-	PostalCode           string  `json:"postalCode"`
-	CountryCode          string  `json:"countryCode"`
-	AddressNotes         string  `json:"addressNotes"`
-	Moderator            string  `json:"moderator"`
-	Items                []item  `json:"items"`
-	AlternateContactInfo string  `json:"alternateContactInfo"`
-	RefundAddress        *string `json:"refundAddress"` //optional, can be left out of json
+	ShipTo               string   `json:"shipTo"`
+	Address              string   `json:"address"`
+	City                 string   `json:"city"`
+	State                string   `json:"state"`
+	PostalCode           string   `json:"postalCode"`
+	CountryCode          string   `json:"countryCode"`
+	AddressNotes         string   `json:"addressNotes"`
+	Moderator            string   `json:"moderator"`
+	BackupModerators     []string `json:"backupModerators"` //optional, can take over a dispute the moderator fails to decide in time
+	PanelModerators      []string `json:"panelModerators"`  //optional, sit on a panel with the moderator which decides a dispute by majority
+	Items                []item   `json:"items"`
+	AlternateContactInfo string   `json:"alternateContactInfo"`
+	RefundAddress        *string  `json:"refundAddress"` //optional, can be left out of json
+	Credit               *credit  `json:"credit"`        //optional, store credit to apply from a voucher
+
+	// Set when the order is placed on behalf of a subscription
+	SubscriptionID string `json:"-"`
+	SpendingCap    uint64 `json:"-"`
 }
 
 func (n *OpenBazaarNode) Purchase(data *PurchaseData) (orderId string, paymentAddress string, paymentAmount uint64, vendorOnline bool, err error) {
@@ -110,6 +123,7 @@ func (n *OpenBazaarNode) Purchase(data *PurchaseData) (orderId string, paymentAd
 	}
 	order.Timestamp = ts
 	order.AlternateContactInfo = data.AlternateContactInfo
+	order.SubscriptionID = data.SubscriptionID
 
 	var ratingKeys [][]byte
 	for range data.Items {
@@ -228,25 +242,66 @@ func (n *OpenBazaarNode) Purchase(data *PurchaseData) (orderId string, paymentAd
 		order.Items = append(order.Items, i)
 	}
 
+	// Let the vendor rate us once the order is done
+	if len(order.RatingKeys) > 0 && len(contract.VendorListings) > 0 {
+		order.RatingTicket, err = n.newBuyerRatingTicket(order.RatingKeys[0], contract.VendorListings[0].VendorID.PeerID)
+		if err != nil {
+			return "", "", 0, false, err
+		}
+	}
+
 	contract.BuyerOrder = order
 
-	// Add payment data and send to vendor
-	if data.Moderator != "" { // Moderated payment
-		payment := new(pb.Order_Payment)
-		payment.Method = pb.Order_Payment_MODERATED
-		payment.Moderator = data.Moderator
-		ipnsPath := ipfspath.FromString(data.Moderator + "/profile")
-		profileBytes, err := ipfs.ResolveThenCat(n.Context, ipnsPath)
+	// Apply any store credit. Orders fully covered by credit skip the on-chain payment.
+	if data.Credit != nil {
+		if (data.Credit.Voucher == "") == (data.Credit.Code == "") {
+			return "", "", 0, false, errors.New("Store credit must have either a voucher ID or a bearer code")
+		}
+		order.Credit = &pb.Order_Credit{
+			Voucher: data.Credit.Voucher,
+			Amount:  data.Credit.Amount,
+		}
+		if data.Credit.Code != "" {
+			order.Credit.CodeProof, err = VoucherCodeProof(data.Credit.Code, order.BuyerID.PeerID)
+			if err != nil {
+				return "", "", 0, false, err
+			}
+		}
+		total, err := n.CalculateOrderTotal(contract)
 		if err != nil {
 			return "", "", 0, false, err
 		}
-		profile := new(pb.Profile)
-		err = jsonpb.UnmarshalString(string(profileBytes), profile)
+		if total == 0 {
+			return n.purchaseWithCredit(contract)
+		}
+	}
+
+	// Subscription orders must stay within the buyer's spending cap
+	if data.SpendingCap > 0 {
+		total, err := n.CalculateOrderTotal(contract)
 		if err != nil {
 			return "", "", 0, false, err
 		}
-		moderatorKeyBytes, err := hex.DecodeString(profile.BitcoinPubkey)
-		if err != nil {
+		if total > data.SpendingCap {
+			return "", "", 0, false, ErrSpendingCapExceeded
+		}
+	}
+
+	// Add payment data and send to vendor
+	if data.Moderator != "" { // Moderated payment
+		payment := new(pb.Order_Payment)
+		payment.Method = pb.Order_Payment_MODERATED
+		payment.Moderator = data.Moderator
+		payment.BackupModerators = data.BackupModerators
+		payment.PanelModerators = data.PanelModerators
+		updateDeadline, decisionDeadline := n.getDisputeDeadlines()
+		payment.DisputeUpdateDeadline = uint32(updateDeadline / time.Hour)
+		payment.DisputeDecisionDeadline = uint32(decisionDeadline / time.Hour)
+		var availableMods []string
+		for _, listing := range contract.VendorListings {
+			availableMods = append(availableMods, listing.Moderators...)
+		}
+		if err := validateModeratorPanel(payment, availableMods); err != nil {
 			return "", "", 0, false, err
 		}
 		total, err := n.CalculateOrderTotal(contract)
@@ -256,7 +311,7 @@ func (n *OpenBazaarNode) Purchase(data *PurchaseData) (orderId string, paymentAd
 		payment.Amount = total
 
 		/* Generate a payment address using the first child key derived from the buyers's,
-		   vendors's and moderator's masterPubKey and a random chaincode. */
+		   vendors's and moderators' masterPubKey and a random chaincode. */
 		chaincode := make([]byte, 32)
 		_, err = rand.Read(chaincode)
 		if err != nil {
@@ -289,21 +344,12 @@ func (n *OpenBazaarNode) Purchase(data *PurchaseData) (orderId string, paymentAd
 		if err != nil {
 			return "", "", 0, false, err
 		}
-		hdKey = hd.NewExtendedKey(
-			n.Wallet.Params().HDPublicKeyID[:],
-			moderatorKeyBytes,
-			chaincode,
-			parentFP,
-			0,
-			0,
-			false)
-
-		moderatorKey, err := hdKey.Child(0)
+		moderatorKeys, err := n.getModeratorEscrowKeys(payment, chaincode)
 		if err != nil {
 			return "", "", 0, false, err
 		}
 
-		addr, redeemScript, err := n.Wallet.GenerateMultisigScript([]hd.ExtendedKey{*buyerKey, *vendorKey, *moderatorKey}, 2)
+		addr, redeemScript, err := n.generateEscrowScript(payment, buyerKey, vendorKey, moderatorKeys)
 		if err != nil {
 			return "", "", 0, false, err
 		}
@@ -529,6 +575,49 @@ func (n *OpenBazaarNode) Purchase(data *PurchaseData) (orderId string, paymentAd
 	}
 }
 
+// Send an order which is fully covered by store credit. The vendor must be online to
+// debit the voucher so there is no offline fallback and no payment address.
+func (n *OpenBazaarNode) purchaseWithCredit(contract *pb.RicardianContract) (orderId string, paymentAddress string, paymentAmount uint64, vendorOnline bool, err error) {
+	payment := new(pb.Order_Payment)
+	payment.Method = pb.Order_Payment_ADDRESS_REQUEST
+	contract.BuyerOrder.Payment = payment
+	contract, err = n.SignOrder(contract)
+	if err != nil {
+		return "", "", 0, false, err
+	}
+	resp, err := n.SendOrder(contract.VendorListings[0].VendorID.PeerID, contract)
+	if err != nil {
+		return "", "", 0, false, errors.New("Vendor must be online to redeem store credit")
+	}
+	if resp.MessageType == pb.Message_ERROR {
+		return "", "", 0, false, fmt.Errorf("Vendor rejected order, reason: %s", string(resp.Payload.Value))
+	}
+	if resp.MessageType != pb.Message_ORDER_CONFIRMATION {
+		return "", "", 0, false, errors.New("Vendor responded to the order with an incorrect message type")
+	}
+	rc := new(pb.RicardianContract)
+	err = proto.Unmarshal(resp.Payload.Value, rc)
+	if err != nil {
+		return "", "", 0, false, errors.New("Error parsing the vendor's response")
+	}
+	contract.VendorOrderConfirmation = rc.VendorOrderConfirmation
+	for _, sig := range rc.Signatures {
+		if sig.Section == pb.Signature_ORDER_CONFIRMATION {
+			contract.Signatures = append(contract.Signatures, sig)
+		}
+	}
+	err = n.ValidateOrderConfirmation(contract, false)
+	if err != nil {
+		return "", "", 0, false, err
+	}
+	orderId, err = n.CalcOrderId(contract.BuyerOrder)
+	if err != nil {
+		return "", "", 0, false, err
+	}
+	n.Datastore.Purchases().Put(orderId, *contract, pb.OrderState_FUNDED, true)
+	return orderId, "", 0, true, nil
+}
+
 func (n *OpenBazaarNode) CancelOfflineOrder(contract *pb.RicardianContract, records []*spvwallet.TransactionRecord) error {
 	orderId, err := n.CalcOrderId(contract.BuyerOrder)
 	if err != nil {
@@ -711,15 +800,8 @@ func (n *OpenBazaarNode) CalculateOrderTotal(contract *pb.RicardianContract) (ui
 				}
 
 				// Check that this option ships to us
-				shipsToMe := false
-				for _, country := range option.Regions {
-					if country == contract.BuyerOrder.Shipping.Country || country == pb.CountryCode_ALL {
-						shipsToMe = true
-						break
-					}
-				}
-				if !shipsToMe {
-					return 0, errors.New("Listing does ship to selected country")
+				if err := validateShippingAddress(option, contract.BuyerOrder.Shipping); err != nil {
+					return 0, err
 				}
 
 				// Check service exists
@@ -771,7 +853,11 @@ func (n *OpenBazaarNode) CalculateOrderTotal(contract *pb.RicardianContract) (ui
 								itemShipping += rulePrice
 							}
 						case pb.Listing_ShippingOption_ShippingRules_FLAT_FEE_WEIGHT_RANGE:
-							weight := listing.Item.Grams * float32(item.Quantity)
+							selectedSku, err := GetSelectedSku(listing, item.Options)
+							if err != nil {
+								return 0, err
+							}
+							weight := GetSkuGrams(listing, selectedSku) * float32(item.Quantity)
 							if uint32(weight) >= rule.MinRange && uint32(weight) <= rule.MaxRange {
 								itemShipping -= shippingPrice
 								rulePrice, err := n.getPriceInSatoshi(listing.Metadata.PricingCurrency, rule.Price)
@@ -842,6 +928,14 @@ func (n *OpenBazaarNode) CalculateOrderTotal(contract *pb.RicardianContract) (ui
 	}
 
 	total += shippingTotal
+
+	// Subtract any store credit
+	if contract.BuyerOrder.Credit != nil {
+		if contract.BuyerOrder.Credit.Amount > total {
+			return 0, errors.New("Store credit exceeds the order total")
+		}
+		total -= contract.BuyerOrder.Credit.Amount
+	}
 	return total, nil
 }
 
@@ -921,6 +1015,9 @@ func (n *OpenBazaarNode) ValidateOrder(contract *pb.RicardianContract) error {
 	if contract.BuyerOrder.Timestamp == nil {
 		return errors.New("Order is missing a timestamp")
 	}
+	if err := n.validateCredit(contract.BuyerOrder); err != nil {
+		return err
+	}
 	if contract.BuyerOrder.Payment.Method == pb.Order_Payment_MODERATED {
 		_, err := mh.FromB58String(contract.BuyerOrder.Payment.Moderator)
 		if err != nil {
@@ -940,6 +1037,35 @@ func (n *OpenBazaarNode) ValidateOrder(contract *pb.RicardianContract) error {
 		if !validMod {
 			return errors.New("Invalid moderator")
 		}
+		if len(contract.BuyerOrder.Payment.BackupModerators) > MaxBackupModerators {
+			return fmt.Errorf("Number of backup moderators is greater than the max of %d", MaxBackupModerators)
+		}
+		for i, backup := range contract.BuyerOrder.Payment.BackupModerators {
+			if backup == contract.BuyerOrder.Payment.Moderator {
+				return errors.New("Backup moderator is the same as the moderator")
+			}
+			for _, other := range contract.BuyerOrder.Payment.BackupModerators[:i] {
+				if backup == other {
+					return errors.New("Duplicate backup moderator")
+				}
+			}
+			validBackup := false
+			for _, mod := range availableMods {
+				if mod == backup {
+					validBackup = true
+					break
+				}
+			}
+			if !validBackup {
+				return errors.New("Invalid backup moderator")
+			}
+		}
+		if err := validateModeratorPanel(contract.BuyerOrder.Payment, availableMods); err != nil {
+			return err
+		}
+		if err := validateDisputeDeadlines(contract.BuyerOrder.Payment); err != nil {
+			return err
+		}
 	}
 
 	// Validate that the hash of the items in the contract match claimed hash in the order
@@ -995,9 +1121,11 @@ collectListings:
 
 	// Validate the selected variants
 	type inventory struct {
-		Slug    string
-		Variant int
-		Count   int
+		Slug         string
+		Variant      int
+		Count        int
+		Preorder     bool
+		MaxBackorder int
 	}
 	var inventoryList []inventory
 	for _, item := range contract.BuyerOrder.Items {
@@ -1009,7 +1137,12 @@ collectListings:
 		for _, uopt := range item.Options {
 			userOptions = append(userOptions, uopt)
 		}
-		inv := inventory{Slug: listingMap[item.ListingHash].Slug}
+		metadata := listingMap[item.ListingHash].Metadata
+		inv := inventory{
+			Slug:         listingMap[item.ListingHash].Slug,
+			Preorder:     metadata.Preorder,
+			MaxBackorder: int(metadata.MaxBackorder),
+		}
 		selectedVariant, err := GetSelectedSku(listingMap[item.ListingHash], item.Options)
 		if err != nil {
 			return err
@@ -1040,7 +1173,13 @@ collectListings:
 		if len(listingOptions) > 0 {
 			return errors.New("Not all options were selected")
 		}
-		// Create inventory paths to check later
+		// Create inventory paths to check later. Bundles are checked against their components.
+		if bundle := listingMap[item.ListingHash].Item.Bundle; len(bundle) > 0 {
+			for _, component := range bundle {
+				inventoryList = append(inventoryList, inventory{component.Slug, int(component.Variant), int(item.Quantity * component.Quantity), inv.Preorder, inv.MaxBackorder})
+			}
+			continue
+		}
 		inv.Count = int(item.Quantity)
 		inventoryList = append(inventoryList, inv)
 	}
@@ -1065,15 +1204,8 @@ collectListings:
 				}
 
 				// Check that this option ships to buyer
-				shipsToMe := false
-				for _, country := range option.Regions {
-					if country == contract.BuyerOrder.Shipping.Country || country == pb.CountryCode_ALL {
-						shipsToMe = true
-						break
-					}
-				}
-				if !shipsToMe {
-					return errors.New("Listing does ship to selected country")
+				if err := validateShippingAddress(option, contract.BuyerOrder.Shipping); err != nil {
+					return err
 				}
 
 				// Check service exists
@@ -1096,8 +1228,25 @@ collectListings:
 		if err != nil {
 			return errors.New("Vendor has no inventory for the selected variant.")
 		}
+		// Pre-orders are accepted regardless of stock
+		if inv.Preorder {
+			continue
+		}
 		if amt >= 0 && amt < inv.Count {
-			return fmt.Errorf("Not enough inventory for item %s:%d, only %d in stock", inv.Slug, inv.Variant, amt)
+			if inv.MaxBackorder == 0 {
+				return fmt.Errorf("Not enough inventory for item %s:%d, only %d in stock", inv.Slug, inv.Variant, amt)
+			}
+			backorders, err := n.Datastore.Inventory().GetBackorders(inv.Slug, inv.Variant)
+			if err != nil {
+				return err
+			}
+			available := inv.MaxBackorder - backorders
+			if available < 0 {
+				available = 0
+			}
+			if amt+available < inv.Count {
+				return fmt.Errorf("Not enough inventory for item %s:%d, only %d in stock and %d available to backorder", inv.Slug, inv.Variant, amt, available)
+			}
 		}
 	}
 
@@ -1127,6 +1276,11 @@ collectListings:
 		}
 	}
 
+	// Validate subscription orders
+	if err := n.validateSubscriptionOrder(contract); err != nil {
+		return err
+	}
+
 	// Validate the buyers's signature on the order
 	err := verifySignaturesOnOrder(contract)
 	if err != nil {
@@ -1182,21 +1336,6 @@ func (n *OpenBazaarNode) ValidateDirectPaymentAddress(order *pb.Order) error {
 }
 
 func (n *OpenBazaarNode) ValidateModeratedPaymentAddress(order *pb.Order) error {
-	ipnsPath := ipfspath.FromString(order.Payment.Moderator + "/profile")
-	profileBytes, err := ipfs.ResolveThenCat(n.Context, ipnsPath)
-	if err != nil {
-		return err
-	}
-	profile := new(pb.Profile)
-	err = jsonpb.UnmarshalString(string(profileBytes), profile)
-	if err != nil {
-		return err
-	}
-	moderatorBytes, err := hex.DecodeString(profile.BitcoinPubkey)
-	if err != nil {
-		return err
-	}
-
 	chaincode, err := hex.DecodeString(order.Payment.Chaincode)
 	if err != nil {
 		return err
@@ -1232,20 +1371,11 @@ func (n *OpenBazaarNode) ValidateModeratedPaymentAddress(order *pb.Order) error
 	if err != nil {
 		return err
 	}
-	hdKey = hd.NewExtendedKey(
-		n.Wallet.Params().HDPublicKeyID[:],
-		moderatorBytes,
-		chaincode,
-		parentFP,
-		0,
-		0,
-		false)
-
-	ModeratorKey, err := hdKey.Child(0)
+	moderatorKeys, err := n.getModeratorEscrowKeys(order.Payment, chaincode)
 	if err != nil {
 		return err
 	}
-	addr, redeemScript, err := n.Wallet.GenerateMultisigScript([]hd.ExtendedKey{*buyerKey, *vendorKey, *ModeratorKey}, 2)
+	addr, redeemScript, err := n.generateEscrowScript(order.Payment, buyerKey, vendorKey, moderatorKeys)
 	if order.Payment.Address != addr.EncodeAddress() {
 		return errors.New("Invalid payment address")
 	}
@@ -1255,6 +1385,52 @@ func (n *OpenBazaarNode) ValidateModeratedPaymentAddress(order *pb.Order) error
 	return nil
 }
 
+// Derive the escrow keys of the order's moderator followed by any panel moderators
+// from their profiles and the order's chaincode. If we are one of the moderators our
+// key is derived from our own wallet. Backup moderators have no key in the escrow.
+func (n *OpenBazaarNode) getModeratorEscrowKeys(payment *pb.Order_Payment, chaincode []byte) ([]hd.ExtendedKey, error) {
+	var keys []hd.ExtendedKey
+	for _, moderator := range append([]string{payment.Moderator}, payment.PanelModerators...) {
+		var moderatorBytes []byte
+		if n.isOwnID(moderator) {
+			mECKey, err := n.Wallet.MasterPublicKey().ECPubKey()
+			if err != nil {
+				return nil, err
+			}
+			moderatorBytes = mECKey.SerializeCompressed()
+		} else {
+			ipnsPath := ipfspath.FromString(moderator + "/profile")
+			profileBytes, err := ipfs.ResolveThenCat(n.Context, ipnsPath)
+			if err != nil {
+				return nil, err
+			}
+			profile := new(pb.Profile)
+			err = jsonpb.UnmarshalString(string(profileBytes), profile)
+			if err != nil {
+				return nil, err
+			}
+			moderatorBytes, err = hex.DecodeString(profile.BitcoinPubkey)
+			if err != nil {
+				return nil, err
+			}
+		}
+		hdKey := hd.NewExtendedKey(
+			n.Wallet.Params().HDPublicKeyID[:],
+			moderatorBytes,
+			chaincode,
+			[]byte{0x00, 0x00, 0x00, 0x00},
+			0,
+			0,
+			false)
+		moderatorKey, err := hdKey.Child(0)
+		if err != nil {
+			return nil, err
+		}
+		keys = append(keys, *moderatorKey)
+	}
+	return keys, nil
+}
+
 func (n *OpenBazaarNode) SignOrder(contract *pb.RicardianContract) (*pb.RicardianContract, error) {
 	serializedOrder, err := proto.Marshal(contract.BuyerOrder)
 	if err != nil {
@@ -1313,6 +1489,11 @@ func validateVersionNumber(rc *pb.RicardianContract) error {
 }
 
 func (n *OpenBazaarNode) ValidatePaymentAmount(requestedAmount, paymentAmount uint64) bool {
+	// The requested amount already excludes any store credit. An order fully covered
+	// by credit must not request an on-chain payment.
+	if requestedAmount == 0 {
+		return paymentAmount == 0
+	}
 	settings, _ := n.Datastore.Settings().Get()
 	bufferPercent := float32(0)
 	if settings.MisPaymentBuffer != nil {
@@ -1385,3 +1566,115 @@ func SameSku(selectedVariants []int, sku *pb.Listing_Item_Sku) bool {
 	}
 	return true
 }
+
+// Returns the weight of the selected sku, falling back to the item weight if the sku has none
+func GetSkuGrams(listing *pb.Listing, selectedSku int) float32 {
+	if selectedSku >= 0 && selectedSku < len(listing.Item.Skus) && listing.Item.Skus[selectedSku].Grams > 0 {
+		return listing.Item.Skus[selectedSku].Grams
+	}
+	return listing.Item.Grams
+}
+
+// Resolves the sku selected for an order item along with its title, weight and images
+func GetSelectedVariant(listing *pb.Listing, item *pb.Order_Item) (*pb.SelectedVariant, error) {
+	selectedSku, err := GetSelectedSku(listing, item.Options)
+	if err != nil {
+		return nil, err
+	}
+	variant := &pb.SelectedVariant{
+		ListingHash: item.ListingHash,
+		SkuIndex:    uint32(selectedSku),
+		Title:       listing.Item.Title,
+		Grams:       GetSkuGrams(listing, selectedSku),
+		Images:      listing.Item.Images,
+	}
+	if selectedSku < len(listing.Item.Skus) {
+		sku := listing.Item.Skus[selectedSku]
+		variant.ProductID = sku.ProductID
+		if sku.TitleSuffix != "" {
+			variant.Title = listing.Item.Title + " " + sku.TitleSuffix
+		}
+		if len(sku.Images) > 0 {
+			variant.Images = sku.Images
+		}
+	}
+	return variant, nil
+}
+
+// Checks the shipping option ships to the given address. The country must be in the
+// option's regions and the address must fall inside any zones which restrict that
+// country and outside any zones which exclude part of it.
+func validateShippingAddress(option *pb.Listing_ShippingOption, shipping *pb.Order_Shipping) error {
+	if shipping == nil {
+		return errors.New("Missing shipping address")
+	}
+	shipsToMe := false
+	for _, country := range option.Regions {
+		if country == shipping.Country || country == pb.CountryCode_ALL {
+			shipsToMe = true
+			break
+		}
+	}
+	if !shipsToMe {
+		return errors.New("Listing does ship to selected country")
+	}
+
+	restricted := false
+	inZone := false
+	for _, zone := range option.Zones {
+		if zone.Country != shipping.Country && zone.Country != pb.CountryCode_ALL {
+			continue
+		}
+		matches := zoneContainsAddress(zone, shipping)
+		if zone.Exclude && matches {
+			return errors.New("Listing does not ship to the selected state or postal code")
+		}
+		if !zone.Exclude {
+			restricted = true
+			if matches {
+				inZone = true
+			}
+		}
+	}
+	if restricted && !inZone {
+		return errors.New("Listing does not ship to the selected state or postal code")
+	}
+	return nil
+}
+
+// Returns true if the address is in one of the zone's states and starts with one of its postal code prefixes
+func zoneContainsAddress(zone *pb.Listing_ShippingOption_Zone, shipping *pb.Order_Shipping) bool {
+	if len(zone.States) > 0 {
+		stateMatch := false
+		for _, state := range zone.States {
+			if strings.EqualFold(strings.TrimSpace(state), strings.TrimSpace(shipping.State)) {
+				stateMatch = true
+				break
+			}
+		}
+		if !stateMatch {
+			return false
+		}
+	}
+	if len(zone.PostalCodes) > 0 {
+		postalCode := normalizePostalCode(shipping.PostalCode)
+		postalMatch := false
+		for _, prefix := range zone.PostalCodes {
+			p := normalizePostalCode(prefix)
+			if p != "" && strings.HasPrefix(postalCode, p) {
+				postalMatch = true
+				break
+			}
+		}
+		if !postalMatch {
+			return false
+		}
+	}
+	return true
+}
+
+func normalizePostalCode(code string) string {
+	code = strings.ToUpper(code)
+	code = strings.Replace(code, " ", "", -1)
+	return strings.Replace(code, "-", "", -1)
+}
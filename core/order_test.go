@@ -0,0 +1,100 @@
+package core
+
+import (
+	"testing"
+
+	"github.com/OpenBazaar/openbazaar-go/pb"
+)
+
+func TestValidateShippingAddress(t *testing.T) {
+	option := &pb.Listing_ShippingOption{
+		Name:    "Domestic",
+		Regions: []pb.CountryCode{pb.CountryCode_UNITED_STATES, pb.CountryCode_CANADA},
+		Zones: []*pb.Listing_ShippingOption_Zone{
+			{Country: pb.CountryCode_UNITED_STATES, States: []string{"AK", "HI"}, Exclude: true},
+			{Country: pb.CountryCode_CANADA, PostalCodes: []string{"M5V", "K1A"}},
+		},
+	}
+	tests := []struct {
+		shipping *pb.Order_Shipping
+		valid    bool
+	}{
+		{&pb.Order_Shipping{Country: pb.CountryCode_UNITED_STATES, State: "NY", PostalCode: "10001"}, true},
+		{&pb.Order_Shipping{Country: pb.CountryCode_UNITED_STATES, State: "ak", PostalCode: "99501"}, false},
+		{&pb.Order_Shipping{Country: pb.CountryCode_UNITED_STATES, State: " HI ", PostalCode: "96801"}, false},
+		{&pb.Order_Shipping{Country: pb.CountryCode_CANADA, State: "ON", PostalCode: "m5v 2t6"}, true},
+		{&pb.Order_Shipping{Country: pb.CountryCode_CANADA, State: "ON", PostalCode: "K1A-0B1"}, true},
+		{&pb.Order_Shipping{Country: pb.CountryCode_CANADA, State: "BC", PostalCode: "V6B 1A1"}, false},
+		{&pb.Order_Shipping{Country: pb.CountryCode_MEXICO, State: "CDMX", PostalCode: "01000"}, false},
+		{nil, false},
+	}
+	for i, test := range tests {
+		err := validateShippingAddress(option, test.shipping)
+		if test.valid && err != nil {
+			t.Errorf("Test %d: expected address to be valid, got %s", i, err)
+		}
+		if !test.valid && err == nil {
+			t.Errorf("Test %d: expected address to be invalid", i)
+		}
+	}
+}
+
+func TestValidateShippingAddressZoneCombination(t *testing.T) {
+	// A zone with both states and postal codes requires the address to match both
+	option := &pb.Listing_ShippingOption{
+		Name:    "Remote",
+		Regions: []pb.CountryCode{pb.CountryCode_ALL},
+		Zones: []*pb.Listing_ShippingOption_Zone{
+			{Country: pb.CountryCode_UNITED_KINGDOM, States: []string{"Scotland"}, PostalCodes: []string{"HS", "ZE"}},
+		},
+	}
+	if err := validateShippingAddress(option, &pb.Order_Shipping{Country: pb.CountryCode_UNITED_KINGDOM, State: "Scotland", PostalCode: "ZE1 0AA"}); err != nil {
+		t.Error(err)
+	}
+	if err := validateShippingAddress(option, &pb.Order_Shipping{Country: pb.CountryCode_UNITED_KINGDOM, State: "Scotland", PostalCode: "EH1 1AA"}); err == nil {
+		t.Error("Expected address outside of postal code prefixes to be invalid")
+	}
+	if err := validateShippingAddress(option, &pb.Order_Shipping{Country: pb.CountryCode_GERMANY, State: "Berlin", PostalCode: "10115"}); err != nil {
+		t.Error("Zones for other countries should not restrict the address")
+	}
+}
+
+func TestGetSelectedVariant(t *testing.T) {
+	listing := &pb.Listing{
+		Item: &pb.Listing_Item{
+			Title:  "Shirt",
+			Grams:  100,
+			Images: []*pb.Listing_Item_Image{{Filename: "shirt.jpg"}},
+			Options: []*pb.Listing_Item_Option{
+				{Name: "Color", Variants: []string{"Red", "Blue"}},
+			},
+			Skus: []*pb.Listing_Item_Sku{
+				{VariantCombo: []uint32{0}, ProductID: "red", TitleSuffix: "(Red)", Grams: 150, Images: []*pb.Listing_Item_Image{{Filename: "red.jpg"}}},
+				{VariantCombo: []uint32{1}, ProductID: "blue"},
+			},
+		},
+	}
+	red := &pb.Order_Item{ListingHash: "Qm", Options: []*pb.Order_Item_Option{{Name: "Color", Value: "Red"}}}
+	variant, err := GetSelectedVariant(listing, red)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if variant.SkuIndex != 0 || variant.ProductID != "red" || variant.Title != "Shirt (Red)" || variant.Grams != 150 {
+		t.Error("Resolved incorrect variant for red sku")
+	}
+	if len(variant.Images) != 1 || variant.Images[0].Filename != "red.jpg" {
+		t.Error("Failed to use sku images")
+	}
+
+	blue := &pb.Order_Item{ListingHash: "Qm", Options: []*pb.Order_Item_Option{{Name: "Color", Value: "Blue"}}}
+	variant, err = GetSelectedVariant(listing, blue)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if variant.SkuIndex != 1 || variant.Title != "Shirt" || variant.Grams != 100 {
+		t.Error("Failed to fall back to item title and weight")
+	}
+	if len(variant.Images) != 1 || variant.Images[0].Filename != "shirt.jpg" {
+		t.Error("Failed to fall back to item images")
+	}
+}
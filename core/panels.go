@@ -0,0 +1,581 @@
+package core
+
+import (
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"time"
+
+	libp2p "gx/ipfs/QmPGxZ1DP2w45WcogpW1h43BvseXbfke9N91qotpoQcUeS/go-libp2p-crypto"
+	peer "gx/ipfs/QmWUswjn261LSyVxWAEpMVtPdy8zmKBJJfBpG3Qdpa8ZsE/go-libp2p-peer"
+	mh "gx/ipfs/QmbZ6Cee2uHjG7hf19qLHppgKDRtaG4CVtMzdmK9VCVqLu/go-multihash"
+
+	"github.com/OpenBazaar/openbazaar-go/api/notifications"
+	"github.com/OpenBazaar/openbazaar-go/pb"
+	"github.com/OpenBazaar/spvwallet"
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcd/txscript"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/btcsuite/btcutil"
+	hd "github.com/btcsuite/btcutil/hdkeychain"
+	"github.com/btcsuite/btcutil/txsort"
+	"github.com/golang/protobuf/proto"
+	"github.com/golang/protobuf/ptypes"
+)
+
+// Maximum number of moderators which can sit on a panel with the order's moderator
+const MaxPanelModerators = 4
+
+var ErrPanelMajority = errors.New("The dispute resolution has not been endorsed by a majority of the moderator panel")
+
+// Return the moderators deciding a dispute, led by the order's moderator. A dispute
+// escalated to a backup moderator is decided by the backup alone.
+func disputePanel(order *pb.Order, dispute *pb.Dispute) []string {
+	if dispute != nil && dispute.Moderator != "" {
+		return []string{dispute.Moderator}
+	}
+	return append([]string{order.Payment.Moderator}, order.Payment.PanelModerators...)
+}
+
+func hasModeratorPanel(payment *pb.Order_Payment) bool {
+	return payment != nil && len(payment.PanelModerators) > 0
+}
+
+func isPanelModerator(payment *pb.Order_Payment, moderator string) bool {
+	for _, m := range payment.PanelModerators {
+		if m == moderator {
+			return true
+		}
+	}
+	return false
+}
+
+// Whether we sit on an order's moderator panel under our current or an earlier identity
+func (n *OpenBazaarNode) isOwnPanelModerator(payment *pb.Order_Payment) bool {
+	for _, m := range payment.PanelModerators {
+		if n.isOwnID(m) {
+			return true
+		}
+	}
+	return false
+}
+
+// Number of moderators which must agree on a dispute resolution
+func panelMajority(payment *pb.Order_Payment) int {
+	return (len(payment.PanelModerators)+1)/2 + 1
+}
+
+// Selects how a spend from the escrow is signed
+type escrowBranch int
+
+const (
+	// Any two of the buyer, vendor and moderator of an order without a panel
+	multisigSpend escrowBranch = iota
+	// The buyer and vendor of an order with a panel
+	cooperativeSpend
+	// The buyer or vendor and a majority of the panel
+	panelSpend
+)
+
+// Generate the escrow address and redeem script for an order. Orders with a single
+// moderator use the usual 2 of 3 multisig. A panel escrow can be spent by the buyer and
+// vendor together or by a majority of the panel along with the buyer or vendor.
+func (n *OpenBazaarNode) generateEscrowScript(payment *pb.Order_Payment, buyerKey, vendorKey *hd.ExtendedKey, moderatorKeys []hd.ExtendedKey) (btcutil.Address, []byte, error) {
+	if !hasModeratorPanel(payment) {
+		return n.Wallet.GenerateMultisigScript(append([]hd.ExtendedKey{*buyerKey, *vendorKey}, moderatorKeys...), 2)
+	}
+	var pubkeys [][]byte
+	for _, key := range append([]hd.ExtendedKey{*buyerKey, *vendorKey}, moderatorKeys...) {
+		ecKey, err := key.ECPubKey()
+		if err != nil {
+			return nil, nil, err
+		}
+		pubkeys = append(pubkeys, ecKey.SerializeCompressed())
+	}
+	redeemScript, err := panelEscrowScript(pubkeys[0], pubkeys[1], pubkeys[2:], panelMajority(payment))
+	if err != nil {
+		return nil, nil, err
+	}
+	addr, err := btcutil.NewAddressScriptHash(redeemScript, n.Wallet.Params())
+	if err != nil {
+		return nil, nil, err
+	}
+	return addr, redeemScript, nil
+}
+
+// Build the redeem script of a panel escrow:
+//
+//	OP_IF
+//	  2 <buyer> <vendor> 2 OP_CHECKMULTISIG
+//	OP_ELSE
+//	  1 <buyer> <vendor> 2 OP_CHECKMULTISIGVERIFY
+//	  <majority> <moderators...> <panel size> OP_CHECKMULTISIG
+//	OP_ENDIF
+func panelEscrowScript(buyer, vendor []byte, moderators [][]byte, majority int) ([]byte, error) {
+	builder := txscript.NewScriptBuilder()
+	builder.AddOp(txscript.OP_IF)
+	builder.AddInt64(2).AddData(buyer).AddData(vendor).AddInt64(2)
+	builder.AddOp(txscript.OP_CHECKMULTISIG)
+	builder.AddOp(txscript.OP_ELSE)
+	builder.AddInt64(1).AddData(buyer).AddData(vendor).AddInt64(2)
+	builder.AddOp(txscript.OP_CHECKMULTISIGVERIFY)
+	builder.AddInt64(int64(majority))
+	for _, moderator := range moderators {
+		builder.AddData(moderator)
+	}
+	builder.AddInt64(int64(len(moderators)))
+	builder.AddOp(txscript.OP_CHECKMULTISIG)
+	builder.AddOp(txscript.OP_ENDIF)
+	return builder.Script()
+}
+
+// Combine the buyer's and vendor's signatures on a spend from the escrow and broadcast
+// it. The wallet can't build a spend from a panel escrow so it is built here, with the
+// fee subtracted from the outputs the same way the wallet does.
+func (n *OpenBazaarNode) MultisignEscrow(payment *pb.Order_Payment, ins []spvwallet.TransactionInput, outs []spvwallet.TransactionOutput, buyerSigs, vendorSigs []spvwallet.Signature, redeemScript []byte, feePerByte uint64) error {
+	if !hasModeratorPanel(payment) {
+		return n.Wallet.Multisign(ins, outs, buyerSigs, vendorSigs, redeemScript, feePerByte)
+	}
+	var txOuts []*wire.TxOut
+	for _, out := range outs {
+		txOuts = append(txOuts, wire.NewTxOut(out.Value, out.ScriptPubKey))
+	}
+	fee := spvwallet.EstimateSerializeSize(len(ins), txOuts, false) * int(feePerByte)
+	feePerOutput := int64(fee / len(outs))
+	var outputs []spvwallet.TransactionOutput
+	for _, out := range outs {
+		outputs = append(outputs, spvwallet.TransactionOutput{
+			ScriptPubKey: out.ScriptPubKey,
+			Value:        out.Value - feePerOutput,
+		})
+	}
+	return n.multisignPayout(ins, outputs, [][]spvwallet.Signature{buyerSigs, vendorSigs}, redeemScript, cooperativeSpend)
+}
+
+func validateModeratorPanel(payment *pb.Order_Payment, availableMods []string) error {
+	if len(payment.PanelModerators) == 0 {
+		return nil
+	}
+	if len(payment.PanelModerators) > MaxPanelModerators {
+		return fmt.Errorf("Number of panel moderators is greater than the max of %d", MaxPanelModerators)
+	}
+	if len(payment.BackupModerators) > 0 {
+		return errors.New("Backup moderators cannot be used with a moderator panel")
+	}
+	for i, moderator := range payment.PanelModerators {
+		if _, err := mh.FromB58String(moderator); err != nil {
+			return errors.New("Invalid panel moderator")
+		}
+		if moderator == payment.Moderator {
+			return errors.New("Panel moderator is the same as the moderator")
+		}
+		for _, other := range payment.PanelModerators[:i] {
+			if moderator == other {
+				return errors.New("Duplicate panel moderator")
+			}
+		}
+		valid := false
+		for _, mod := range availableMods {
+			if mod == moderator {
+				valid = true
+				break
+			}
+		}
+		if !valid {
+			return errors.New("Invalid panel moderator")
+		}
+	}
+	return nil
+}
+
+// Split the moderator fee evenly between the members of a panel. The lead moderator
+// gets any remainder.
+func splitPanelFee(fee uint64, members int) []uint64 {
+	shares := make([]uint64, members)
+	for i := range shares {
+		shares[i] = fee / uint64(members)
+	}
+	shares[0] += fee % uint64(members)
+	return shares
+}
+
+// Look up the address each panel moderator published to receive their share of the fee
+func (n *OpenBazaarNode) getPanelFeeAddresses(panelModerators []string) ([]btcutil.Address, error) {
+	var addrs []btcutil.Address
+	for _, moderator := range panelModerators {
+		var feeAddress string
+		if n.isOwnID(moderator) {
+			feeAddress = n.Wallet.CurrentAddress(spvwallet.EXTERNAL).EncodeAddress()
+		} else {
+			profile, err := n.FetchProfile(moderator)
+			if err != nil {
+				return nil, err
+			}
+			if profile.ModeratorInfo != nil {
+				feeAddress = profile.ModeratorInfo.FeeAddress
+			}
+		}
+		if feeAddress == "" {
+			return nil, fmt.Errorf("Panel moderator %s has not published a fee address", moderator)
+		}
+		addr, err := btcutil.DecodeAddress(feeAddress, n.Wallet.Params())
+		if err != nil {
+			return nil, err
+		}
+		addrs = append(addrs, addr)
+	}
+	return addrs, nil
+}
+
+// Save the resolution the lead moderator of a panel we sit on decided so that we can
+// endorse it
+func (n *OpenBazaarNode) ProcessPanelResolution(peerID string, rc *pb.RicardianContract) error {
+	if rc.DisputeResolution == nil {
+		return errors.New("Dispute resolution message is nil")
+	}
+	orderId := rc.DisputeResolution.OrderId
+	buyerContract, vendorContract, _, _, _, _, state, err := n.Datastore.Cases().GetPayoutDetails(orderId)
+	if err != nil {
+		return ErrCaseNotFound
+	}
+	if state != pb.OrderState_DISPUTED {
+		return errors.New("A dispute for this order is not open")
+	}
+	contract := buyerContract
+	if contract == nil {
+		contract = vendorContract
+	}
+	if contract == nil {
+		return errors.New("Case does not contain a contract")
+	}
+	if !n.isOwnPanelModerator(contract.BuyerOrder.Payment) {
+		return errors.New("We are not a member of the moderator panel for this case")
+	}
+	if !n.IsSamePeer(contract.BuyerOrder.Payment.Moderator, peerID) {
+		return errors.New("Dispute resolution was not sent by the lead moderator")
+	}
+
+	// Check the lead moderator signed the resolution
+	decided := &pb.RicardianContract{
+		VendorListings:    contract.VendorListings,
+		BuyerOrder:        contract.BuyerOrder,
+		DisputeResolution: rc.DisputeResolution,
+	}
+	for _, sig := range rc.Signatures {
+		if sig.Section == pb.Signature_DISPUTE_RESOLUTION {
+			decided.Signatures = append(decided.Signatures, sig)
+		}
+	}
+	if err := n.verifySignatureOnDisputeResolution(decided); err != nil {
+		return err
+	}
+	if err := n.Datastore.Cases().MarkAsDecided(orderId, rc.DisputeResolution); err != nil {
+		return err
+	}
+
+	notif := notifications.PanelResolutionNotification{orderId}
+	n.Broadcast <- notif
+	n.Datastore.Notifications().Put(notif, time.Now())
+	return nil
+}
+
+// Sign the payout decided by the lead moderator of our panel and send the signatures
+// to the buyer and vendor
+func (n *OpenBazaarNode) EndorseDisputeResolution(orderId string) error {
+	buyerContract, vendorContract, _, _, state, _, _, _, _, resolution, err := n.Datastore.Cases().GetCaseMetadata(orderId)
+	if err != nil {
+		return ErrCaseNotFound
+	}
+	if state != pb.OrderState_DECIDED || resolution == nil || resolution.Payout == nil {
+		return errors.New("No resolution for this case is waiting to be endorsed")
+	}
+	contract := buyerContract
+	if contract == nil {
+		contract = vendorContract
+	}
+	if contract == nil {
+		return errors.New("Case does not contain a contract")
+	}
+	payment := contract.BuyerOrder.Payment
+	index := -1
+	for i, moderator := range payment.PanelModerators {
+		if n.isOwnID(moderator) {
+			index = i
+		}
+	}
+	if index < 0 {
+		return errors.New("We are not a member of the moderator panel for this case")
+	}
+
+	// Make sure the lead moderator shared the fee with us
+	if resolution.Payout.ModeratorOutput != nil {
+		if index >= len(resolution.Payout.PanelOutputs) {
+			return errors.New("Resolution does not pay our share of the moderator fee")
+		}
+		script, err := hex.DecodeString(resolution.Payout.PanelOutputs[index].Script)
+		if err != nil {
+			return err
+		}
+		_, addrs, _, err := txscript.ExtractPkScriptAddrs(script, n.Wallet.Params())
+		if err != nil {
+			return err
+		}
+		if len(addrs) == 0 || !n.Wallet.HasKey(addrs[0]) {
+			return errors.New("Resolution sends our share of the moderator fee to an address we don't control")
+		}
+	}
+
+	// Sign the payout
+	inputs, outputs, err := buildPayoutTransaction(resolution.Payout)
+	if err != nil {
+		return err
+	}
+	signingKey, err := n.getMultisigSigningKey(contract)
+	if err != nil {
+		return err
+	}
+	redeemScriptBytes, err := hex.DecodeString(payment.RedeemScript)
+	if err != nil {
+		return err
+	}
+	sigs, err := n.Wallet.CreateMultisigSignature(inputs, outputs, signingKey, redeemScriptBytes, 0)
+	if err != nil {
+		return err
+	}
+	endorsement := new(pb.DisputeEndorsement)
+	endorsement.OrderId = orderId
+	endorsement.Moderator = n.IpfsNode.Identity.Pretty()
+	for _, sig := range sigs {
+		endorsement.Sigs = append(endorsement.Sigs, &pb.BitcoinSignature{InputIndex: sig.InputIndex, Signature: sig.Signature})
+	}
+	if err := signDisputeEndorsement(endorsement, n.IpfsNode.PrivateKey); err != nil {
+		return err
+	}
+
+	// Send to the buyer and vendor
+	buyerKey, err := libp2p.UnmarshalPublicKey(contract.BuyerOrder.BuyerID.Pubkeys.Identity)
+	if err != nil {
+		return err
+	}
+	vendorKey, err := libp2p.UnmarshalPublicKey(contract.VendorListings[0].VendorID.Pubkeys.Identity)
+	if err != nil {
+		return err
+	}
+	if err := n.SendDisputeEndorsement(contract.BuyerOrder.BuyerID.PeerID, &buyerKey, endorsement); err != nil {
+		return err
+	}
+	if err := n.SendDisputeEndorsement(contract.VendorListings[0].VendorID.PeerID, &vendorKey, endorsement); err != nil {
+		return err
+	}
+	return n.Datastore.Cases().MarkAsClosed(orderId, resolution)
+}
+
+// Save an endorsement of the resolution of our dispute by a panel moderator
+func (n *OpenBazaarNode) ProcessDisputeEndorsement(peerID string, endorsement *pb.DisputeEndorsement) error {
+	if endorsement.Moderator != peerID {
+		return errors.New("Dispute endorsement was not sent by the endorsing moderator")
+	}
+	if err := VerifyDisputeEndorsement(endorsement); err != nil {
+		return err
+	}
+	isPurchase := false
+	contract, state, _, _, _, err := n.Datastore.Sales().GetByOrderId(endorsement.OrderId)
+	if err != nil {
+		contract, state, _, _, _, err = n.Datastore.Purchases().GetByOrderId(endorsement.OrderId)
+		if err != nil {
+			return errors.New("Order not found")
+		}
+		isPurchase = true
+	}
+	if !isPanelModerator(contract.BuyerOrder.Payment, endorsement.Moderator) {
+		return errors.New("Endorsing moderator is not on the panel for this order")
+	}
+	if state != pb.OrderState_DECIDED || contract.DisputeResolution == nil {
+		return errors.New("No dispute resolution has been received for this order")
+	}
+
+	// Replace an earlier endorsement by the same moderator
+	var endorsements []*pb.DisputeEndorsement
+	for _, e := range contract.DisputeEndorsements {
+		if e.Moderator != endorsement.Moderator {
+			endorsements = append(endorsements, e)
+		}
+	}
+	contract.DisputeEndorsements = append(endorsements, endorsement)
+	if isPurchase {
+		err = n.Datastore.Purchases().Put(endorsement.OrderId, *contract, state, false)
+	} else {
+		err = n.Datastore.Sales().Put(endorsement.OrderId, *contract, state, false)
+	}
+	if err != nil {
+		return err
+	}
+
+	notif := notifications.DisputeEndorsementNotification{endorsement.OrderId, endorsement.Moderator}
+	n.Broadcast <- notif
+	n.Datastore.Notifications().Put(notif, time.Now())
+	return nil
+}
+
+// Collect the payout signatures of a majority of the panel in the order of their keys
+// in the redeem script
+func collectPanelSignatures(contract *pb.RicardianContract) ([][]spvwallet.Signature, error) {
+	payment := contract.BuyerOrder.Payment
+	majority := panelMajority(payment)
+	var panelSigs [][]spvwallet.Signature
+	for _, moderator := range disputePanel(contract.BuyerOrder, contract.Dispute) {
+		var sigs []*pb.BitcoinSignature
+		if moderator == payment.Moderator {
+			sigs = contract.DisputeResolution.Payout.Sigs
+		} else {
+			for _, e := range contract.DisputeEndorsements {
+				if e.Moderator == moderator {
+					sigs = e.Sigs
+				}
+			}
+		}
+		if len(sigs) == 0 {
+			continue
+		}
+		panelSigs = append(panelSigs, toWalletSignatures(sigs))
+		if len(panelSigs) == majority {
+			return panelSigs, nil
+		}
+	}
+	return nil, ErrPanelMajority
+}
+
+// Combine the signatures of each signer, in the order of their keys in the redeem
+// script, and broadcast the payout. A panel spend takes the buyer's or vendor's
+// signatures followed by the panel's. Wallets do not agree on the transaction version
+// they sign so the versions are tried in turn until the signatures verify.
+func (n *OpenBazaarNode) multisignPayout(inputs []spvwallet.TransactionInput, outputs []spvwallet.TransactionOutput, sigs [][]spvwallet.Signature, redeemScript []byte, branch escrowBranch) error {
+	addr, err := btcutil.NewAddressScriptHash(redeemScript, n.Wallet.Params())
+	if err != nil {
+		return err
+	}
+	scriptPubKey, err := txscript.PayToAddrScript(addr)
+	if err != nil {
+		return err
+	}
+	for _, version := range []int32{wire.TxVersion, 0} {
+		tx, err := buildMultisigTransaction(version, inputs, outputs, sigs, redeemScript, branch)
+		if err != nil {
+			return err
+		}
+		if verifyMultisigTransaction(tx, scriptPubKey) == nil {
+			return n.Wallet.Broadcast(tx)
+		}
+	}
+	return errors.New("Payout signatures failed to verify")
+}
+
+func buildMultisigTransaction(version int32, inputs []spvwallet.TransactionInput, outputs []spvwallet.TransactionOutput, sigs [][]spvwallet.Signature, redeemScript []byte, branch escrowBranch) (*wire.MsgTx, error) {
+	tx := wire.NewMsgTx(version)
+	for _, in := range inputs {
+		ch, err := chainhash.NewHashFromStr(hex.EncodeToString(in.OutpointHash))
+		if err != nil {
+			return nil, err
+		}
+		tx.TxIn = append(tx.TxIn, wire.NewTxIn(wire.NewOutPoint(ch, in.OutpointIndex), []byte{}))
+	}
+	for _, out := range outputs {
+		tx.TxOut = append(tx.TxOut, wire.NewTxOut(out.Value, out.ScriptPubKey))
+	}
+
+	// BIP 69 sorting
+	txsort.InPlaceSort(tx)
+
+	for i, input := range tx.TxIn {
+		builder := txscript.NewScriptBuilder()
+		builder.AddOp(txscript.OP_0)
+		switch branch {
+		case panelSpend:
+			addInputSignatures(builder, sigs[1:], i)
+			builder.AddOp(txscript.OP_0)
+			addInputSignatures(builder, sigs[:1], i)
+			builder.AddOp(txscript.OP_FALSE)
+		case cooperativeSpend:
+			addInputSignatures(builder, sigs, i)
+			builder.AddOp(txscript.OP_TRUE)
+		default:
+			addInputSignatures(builder, sigs, i)
+		}
+		builder.AddData(redeemScript)
+		scriptSig, err := builder.Script()
+		if err != nil {
+			return nil, err
+		}
+		input.SignatureScript = scriptSig
+	}
+	return tx, nil
+}
+
+// Add each signer's signature for an input
+func addInputSignatures(builder *txscript.ScriptBuilder, sigs [][]spvwallet.Signature, input int) {
+	for _, signerSigs := range sigs {
+		for _, sig := range signerSigs {
+			if int(sig.InputIndex) == input {
+				builder.AddData(sig.Signature)
+			}
+		}
+	}
+}
+
+func verifyMultisigTransaction(tx *wire.MsgTx, scriptPubKey []byte) error {
+	for i := range tx.TxIn {
+		vm, err := txscript.NewEngine(scriptPubKey, tx, i, txscript.StandardVerifyFlags, nil)
+		if err != nil {
+			return err
+		}
+		if err := vm.Execute(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func signDisputeEndorsement(endorsement *pb.DisputeEndorsement, privKey libp2p.PrivKey) error {
+	ts, err := ptypes.TimestampProto(time.Now())
+	if err != nil {
+		return err
+	}
+	endorsement.Timestamp = ts
+	endorsement.Pubkey, err = privKey.GetPublic().Bytes()
+	if err != nil {
+		return err
+	}
+	endorsement.Signature = nil
+	ser, err := proto.Marshal(endorsement)
+	if err != nil {
+		return err
+	}
+	endorsement.Signature, err = privKey.Sign(ser)
+	return err
+}
+
+// Checks an endorsement was signed by the moderator it names
+func VerifyDisputeEndorsement(endorsement *pb.DisputeEndorsement) error {
+	pubkey, err := libp2p.UnmarshalPublicKey(endorsement.Pubkey)
+	if err != nil {
+		return err
+	}
+	pid, err := peer.IDFromPublicKey(pubkey)
+	if err != nil {
+		return err
+	}
+	if pid.Pretty() != endorsement.Moderator {
+		return errors.New("Public key in dispute endorsement does not match the moderator's peer ID")
+	}
+	unsigned := *endorsement
+	unsigned.Signature = nil
+	ser, err := proto.Marshal(&unsigned)
+	if err != nil {
+		return err
+	}
+	valid, err := pubkey.Verify(ser, endorsement.Signature)
+	if err != nil || !valid {
+		return errors.New("Signature on dispute endorsement failed to verify")
+	}
+	return nil
+}
@@ -0,0 +1,136 @@
+package core
+
+import (
+	"testing"
+
+	"github.com/OpenBazaar/openbazaar-go/pb"
+	"github.com/OpenBazaar/spvwallet"
+	"github.com/btcsuite/btcd/btcec"
+	"github.com/btcsuite/btcd/chaincfg"
+	"github.com/btcsuite/btcd/txscript"
+	"github.com/btcsuite/btcutil"
+)
+
+func newPanelPayment() *pb.Order_Payment {
+	return &pb.Order_Payment{
+		Moderator:       "QmQdi7EaJUmuRUtSaCPkijw5cptFfNcX2EPvMyQwR117Y2",
+		PanelModerators: []string{"QmecpJrN9RJ7smyYByQdZUy5mF6aapgCfKLKRmDtycv9aG", "QmfQkD8pBSBCBxWEwFSu4XaDVSWK6bjnNuaWZjMyQbyDub"},
+	}
+}
+
+func TestPanelMajority(t *testing.T) {
+	payment := newPanelPayment()
+	if panelMajority(payment) != 2 {
+		t.Error("Returned incorrect majority for a panel of three")
+	}
+	payment.PanelModerators = append(payment.PanelModerators, "QmPanel3")
+	if panelMajority(payment) != 3 {
+		t.Error("Returned incorrect majority for a panel of four")
+	}
+}
+
+func TestPanelEscrowScript(t *testing.T) {
+	var keys []*btcec.PrivateKey
+	var pubkeys [][]byte
+	for i := 0; i < 5; i++ {
+		key, err := btcec.NewPrivateKey(btcec.S256())
+		if err != nil {
+			t.Fatal(err)
+		}
+		keys = append(keys, key)
+		pubkeys = append(pubkeys, key.PubKey().SerializeCompressed())
+	}
+	buyer, vendor, moderators := keys[0], keys[1], keys[2:]
+	redeemScript, err := panelEscrowScript(pubkeys[0], pubkeys[1], pubkeys[2:], 2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	addr, err := btcutil.NewAddressScriptHash(redeemScript, &chaincfg.MainNetParams)
+	if err != nil {
+		t.Fatal(err)
+	}
+	scriptPubKey, err := txscript.PayToAddrScript(addr)
+	if err != nil {
+		t.Fatal(err)
+	}
+	inputs := []spvwallet.TransactionInput{{OutpointHash: make([]byte, 32), OutpointIndex: 0}}
+	outputs := []spvwallet.TransactionOutput{{ScriptPubKey: scriptPubKey, Value: 10000}}
+	unsigned, err := buildMultisigTransaction(1, inputs, outputs, nil, redeemScript, cooperativeSpend)
+	if err != nil {
+		t.Fatal(err)
+	}
+	sign := func(key *btcec.PrivateKey) []spvwallet.Signature {
+		sig, err := txscript.RawTxInSignature(unsigned, 0, redeemScript, txscript.SigHashAll, key)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return []spvwallet.Signature{{InputIndex: 0, Signature: sig}}
+	}
+	verify := func(sigs [][]spvwallet.Signature, branch escrowBranch) error {
+		tx, err := buildMultisigTransaction(1, inputs, outputs, sigs, redeemScript, branch)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return verifyMultisigTransaction(tx, scriptPubKey)
+	}
+
+	if err := verify([][]spvwallet.Signature{sign(buyer), sign(vendor)}, cooperativeSpend); err != nil {
+		t.Error("Expected the buyer and vendor to be able to spend from a panel escrow:", err)
+	}
+	if err := verify([][]spvwallet.Signature{sign(vendor), sign(moderators[0]), sign(moderators[2])}, panelSpend); err != nil {
+		t.Error("Expected the vendor and a majority of the panel to be able to spend from a panel escrow:", err)
+	}
+	if err := verify([][]spvwallet.Signature{sign(buyer), sign(moderators[1])}, panelSpend); err == nil {
+		t.Error("Expected a minority of the panel to be unable to spend from a panel escrow")
+	}
+	if err := verify([][]spvwallet.Signature{sign(buyer), sign(moderators[0])}, cooperativeSpend); err == nil {
+		t.Error("Expected the buyer and a single moderator to be unable to spend from a panel escrow")
+	}
+}
+
+func TestValidateModeratorPanel(t *testing.T) {
+	payment := newPanelPayment()
+	availableMods := append([]string{payment.Moderator}, payment.PanelModerators...)
+	if err := validateModeratorPanel(payment, availableMods); err != nil {
+		t.Error(err)
+	}
+	if err := validateModeratorPanel(payment, availableMods[:2]); err == nil {
+		t.Error("Expected a panel moderator not offered by the listing to be rejected")
+	}
+	payment.BackupModerators = []string{"QmecpJrN9RJ7smyYByQdZUy5mF6aapgCfKLKRmDtycv9aG"}
+	if err := validateModeratorPanel(payment, availableMods); err == nil {
+		t.Error("Expected backup moderators to be rejected with a panel")
+	}
+	payment = newPanelPayment()
+	payment.PanelModerators = append(payment.PanelModerators, payment.Moderator)
+	if err := validateModeratorPanel(payment, availableMods); err == nil {
+		t.Error("Expected the moderator to be rejected as a panel moderator")
+	}
+	payment = newPanelPayment()
+	payment.PanelModerators = append(payment.PanelModerators, payment.PanelModerators[0])
+	if err := validateModeratorPanel(payment, availableMods); err == nil {
+		t.Error("Expected a duplicate panel moderator to be rejected")
+	}
+}
+
+func TestSplitPanelFee(t *testing.T) {
+	shares := splitPanelFee(1000, 3)
+	if len(shares) != 3 {
+		t.Error("Returned incorrect number of fee shares")
+	}
+	if shares[0] != 334 || shares[1] != 333 || shares[2] != 333 {
+		t.Error("Expected the fee to be split evenly with the remainder to the lead moderator")
+	}
+}
+
+func TestDisputePanel(t *testing.T) {
+	order := &pb.Order{Payment: newPanelPayment()}
+	panel := disputePanel(order, &pb.Dispute{})
+	if len(panel) != 3 || panel[0] != order.Payment.Moderator {
+		t.Error("Expected the panel to be led by the order's moderator")
+	}
+	panel = disputePanel(order, &pb.Dispute{Moderator: "QmBackup"})
+	if len(panel) != 1 || panel[0] != "QmBackup" {
+		t.Error("Expected an escalated dispute to be decided by the backup moderator alone")
+	}
+}
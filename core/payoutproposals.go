@@ -0,0 +1,359 @@
+package core
+
+import (
+	"encoding/hex"
+	"errors"
+	"time"
+
+	"github.com/OpenBazaar/openbazaar-go/api/notifications"
+	"github.com/OpenBazaar/openbazaar-go/pb"
+	"github.com/OpenBazaar/spvwallet"
+	"github.com/btcsuite/btcd/txscript"
+	"github.com/btcsuite/btcutil"
+	"github.com/golang/protobuf/ptypes"
+)
+
+var ErrNoPayoutProposal = errors.New("No payout proposal for this order")
+
+var ErrOrderNotFound = errors.New("Order not found")
+
+// Propose a different split of the disputed funds to the other party. The moderator's
+// output is kept as decided so the proposal can be signed by the buyer and vendor alone.
+func (n *OpenBazaarNode) ProposeDisputePayout(orderId string, buyerPercentage, vendorPercentage float32) error {
+	if buyerPercentage+vendorPercentage != 100 {
+		return errors.New("Payout percentages must sum to 100")
+	}
+	if buyerPercentage < 0 || vendorPercentage < 0 {
+		return errors.New("Payout percentages must not be negative")
+	}
+	contract, state, isPurchase, err := n.getDisputedOrder(orderId)
+	if err != nil {
+		return err
+	}
+	if err := canProposePayout(contract, state); err != nil {
+		return err
+	}
+	resolution := contract.DisputeResolution.Payout
+
+	// Find an output script for each party
+	buyerScript, err := n.getPayoutScript(resolution.BuyerOutput, isPurchase, contract.BuyerOrder.RefundAddress)
+	if err != nil {
+		return err
+	}
+	var vendorAddress string
+	if len(contract.VendorOrderFulfillment) > 0 && contract.VendorOrderFulfillment[0].Payout != nil {
+		vendorAddress = contract.VendorOrderFulfillment[0].Payout.PayoutAddress
+	}
+	vendorScript, err := n.getPayoutScript(resolution.VendorOutput, !isPurchase, vendorAddress)
+	if err != nil {
+		return err
+	}
+
+	// Split what the moderator awarded to the buyer and vendor between them
+	var pool uint64
+	if resolution.BuyerOutput != nil {
+		pool += resolution.BuyerOutput.Amount
+	}
+	if resolution.VendorOutput != nil {
+		pool += resolution.VendorOutput.Amount
+	}
+	buyerAmount := uint64(float64(pool) * float64(buyerPercentage) / 100)
+	vendorAmount := pool - buyerAmount
+
+	payout := new(pb.DisputeResolution_Payout)
+	payout.Inputs = resolution.Inputs
+	payout.ModeratorOutput = resolution.ModeratorOutput
+	if buyerAmount > 0 {
+		if buyerScript == "" {
+			return errors.New("Buyer payout address is unknown")
+		}
+		payout.BuyerOutput = &pb.DisputeResolution_Payout_Output{Script: buyerScript, Amount: buyerAmount}
+	}
+	if vendorAmount > 0 {
+		if vendorScript == "" {
+			return errors.New("Vendor payout address is unknown")
+		}
+		payout.VendorOutput = &pb.DisputeResolution_Payout_Output{Script: vendorScript, Amount: vendorAmount}
+	}
+
+	return n.sendPayoutProposal(orderId, contract, state, isPurchase, payout)
+}
+
+// Sign our half of a payout and send it to the other party as a proposal
+func (n *OpenBazaarNode) sendPayoutProposal(orderId string, contract *pb.RicardianContract, state pb.OrderState, isPurchase bool, payout *pb.DisputeResolution_Payout) error {
+	var err error
+	payout.Sigs, err = n.signPayout(contract, payout)
+	if err != nil {
+		return err
+	}
+
+	proposal := new(pb.PayoutProposal)
+	proposal.Timestamp, err = ptypes.TimestampProto(time.Now())
+	if err != nil {
+		return err
+	}
+	proposal.OrderId = orderId
+	proposal.ProposedBy = n.IpfsNode.Identity.Pretty()
+	proposal.Payout = payout
+
+	counterparty := contract.BuyerOrder.BuyerID.PeerID
+	if isPurchase {
+		counterparty = contract.VendorListings[0].VendorID.PeerID
+	}
+	if err := n.SendPayoutProposal(counterparty, proposal); err != nil {
+		return err
+	}
+	contract.PayoutProposal = proposal
+	return n.putDisputedOrder(orderId, contract, state, isPurchase)
+}
+
+// Save a payout proposal sent to us by the other party to a decided dispute
+func (n *OpenBazaarNode) ProcessPayoutProposal(peerID string, proposal *pb.PayoutProposal) error {
+	contract, state, isPurchase, err := n.getDisputedOrder(proposal.OrderId)
+	if err != nil {
+		return err
+	}
+	counterparty := contract.BuyerOrder.BuyerID.PeerID
+	if isPurchase {
+		counterparty = contract.VendorListings[0].VendorID.PeerID
+	}
+	if err := applyPayoutProposal(contract, state, counterparty, peerID, proposal); err != nil {
+		return err
+	}
+	if err := n.putDisputedOrder(proposal.OrderId, contract, state, isPurchase); err != nil {
+		return err
+	}
+	notif := notifications.PayoutProposalNotification{
+		OrderId:    proposal.OrderId,
+		ProposedBy: peerID,
+	}
+	n.Broadcast <- notif
+	n.Datastore.Notifications().Put(notif, time.Now())
+	return nil
+}
+
+// Accept the other party's payout proposal by adding our signatures and broadcasting
+// the transaction in place of the moderator's payout
+func (n *OpenBazaarNode) AcceptPayoutProposal(orderId string) error {
+	contract, state, isPurchase, err := n.getDisputedOrder(orderId)
+	if err != nil {
+		return err
+	}
+	proposal, err := acceptablePayoutProposal(contract, state, n.isOwnID)
+	if err != nil {
+		return err
+	}
+	ourOutput := proposal.Payout.VendorOutput
+	if isPurchase {
+		ourOutput = proposal.Payout.BuyerOutput
+	}
+	if ourOutput != nil {
+		if err := n.checkWeOwnScript(ourOutput.Script); err != nil {
+			return err
+		}
+	}
+
+	inputs, outputs, err := buildPayoutTransaction(proposal.Payout)
+	if err != nil {
+		return err
+	}
+	signingKey, err := n.getMultisigSigningKey(contract)
+	if err != nil {
+		return err
+	}
+	redeemScriptBytes, err := hex.DecodeString(contract.BuyerOrder.Payment.RedeemScript)
+	if err != nil {
+		return err
+	}
+	mySigs, err := n.Wallet.CreateMultisigSignature(inputs, outputs, signingKey, redeemScriptBytes, 0)
+	if err != nil {
+		return err
+	}
+	theirSigs := toWalletSignatures(proposal.Payout.Sigs)
+	// Signatures must be in the same order as the keys in the redeem script
+	payment := contract.BuyerOrder.Payment
+	if isPurchase {
+		return n.MultisignEscrow(payment, inputs, outputs, mySigs, theirSigs, redeemScriptBytes, 0)
+	}
+	return n.MultisignEscrow(payment, inputs, outputs, theirSigs, mySigs, redeemScriptBytes, 0)
+}
+
+// Record a proposal sent by the other party on our copy of the order. It replaces any
+// earlier proposal from either side so a counter-proposal is simply a new proposal.
+func applyPayoutProposal(contract *pb.RicardianContract, state pb.OrderState, counterparty, peerID string, proposal *pb.PayoutProposal) error {
+	if err := canProposePayout(contract, state); err != nil {
+		return err
+	}
+	if peerID != counterparty || proposal.ProposedBy != peerID {
+		return errors.New("Payout proposal was not sent by the other party to the order")
+	}
+	if err := validatePayoutProposal(contract.DisputeResolution.Payout, proposal.Payout); err != nil {
+		return err
+	}
+	contract.PayoutProposal = proposal
+	return nil
+}
+
+// Return the latest proposal if it can be accepted. Only the other party's proposal
+// can be accepted, so a proposal we've countered waits for them to accept ours.
+func acceptablePayoutProposal(contract *pb.RicardianContract, state pb.OrderState, isOwnID func(string) bool) (*pb.PayoutProposal, error) {
+	if err := canProposePayout(contract, state); err != nil {
+		return nil, err
+	}
+	proposal := contract.PayoutProposal
+	if proposal == nil || isOwnID(proposal.ProposedBy) {
+		return nil, ErrNoPayoutProposal
+	}
+	if err := validatePayoutProposal(contract.DisputeResolution.Payout, proposal.Payout); err != nil {
+		return nil, err
+	}
+	return proposal, nil
+}
+
+// Payouts can only be renegotiated once the moderator has decided the dispute and
+// before either payout has been broadcast
+func canProposePayout(contract *pb.RicardianContract, state pb.OrderState) error {
+	if state != pb.OrderState_DECIDED {
+		return errors.New("Order must be in DECIDED state to propose a payout")
+	}
+	if contract.DisputeResolution == nil || contract.DisputeResolution.Payout == nil {
+		return errors.New("Dispute resolution does not contain a payout")
+	}
+	return nil
+}
+
+// Checks a proposal spends the same inputs as the moderator's payout, pays out the
+// same total and pays the moderator at least as much to the same script
+func validatePayoutProposal(resolution, proposal *pb.DisputeResolution_Payout) error {
+	if proposal == nil || len(proposal.Sigs) == 0 {
+		return errors.New("Payout proposal is not signed")
+	}
+	if len(proposal.Inputs) != len(resolution.Inputs) {
+		return errors.New("Payout proposal spends different inputs")
+	}
+	for i, in := range proposal.Inputs {
+		if in.Hash != resolution.Inputs[i].Hash || in.Index != resolution.Inputs[i].Index {
+			return errors.New("Payout proposal spends different inputs")
+		}
+	}
+	if resolution.ModeratorOutput != nil {
+		if proposal.ModeratorOutput == nil || proposal.ModeratorOutput.Script != resolution.ModeratorOutput.Script {
+			return errors.New("Payout proposal does not pay the moderator")
+		}
+		if proposal.ModeratorOutput.Amount < resolution.ModeratorOutput.Amount {
+			return errors.New("Payout proposal reduces the moderator fee")
+		}
+	}
+	if payoutTotal(proposal) != payoutTotal(resolution) {
+		return errors.New("Payout proposal does not pay out the same total as the moderator's payout")
+	}
+	return nil
+}
+
+func payoutTotal(payout *pb.DisputeResolution_Payout) uint64 {
+	var total uint64
+	for _, o := range []*pb.DisputeResolution_Payout_Output{payout.BuyerOutput, payout.VendorOutput, payout.ModeratorOutput} {
+		if o != nil {
+			total += o.Amount
+		}
+	}
+	return total
+}
+
+// Return the script to pay a party. The moderator's output is used if there is one,
+// otherwise a new address of ours or the party's address from the contract.
+func (n *OpenBazaarNode) getPayoutScript(output *pb.DisputeResolution_Payout_Output, ours bool, address string) (string, error) {
+	if output != nil {
+		return output.Script, nil
+	}
+	var addr btcutil.Address
+	if ours {
+		addr = n.Wallet.CurrentAddress(spvwallet.EXTERNAL)
+	} else if address != "" {
+		var err error
+		addr, err = btcutil.DecodeAddress(address, n.Wallet.Params())
+		if err != nil {
+			return "", err
+		}
+	} else {
+		return "", nil
+	}
+	script, err := txscript.PayToAddrScript(addr)
+	if err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(script), nil
+}
+
+func (n *OpenBazaarNode) signPayout(contract *pb.RicardianContract, payout *pb.DisputeResolution_Payout) ([]*pb.BitcoinSignature, error) {
+	inputs, outputs, err := buildPayoutTransaction(payout)
+	if err != nil {
+		return nil, err
+	}
+	signingKey, err := n.getMultisigSigningKey(contract)
+	if err != nil {
+		return nil, err
+	}
+	redeemScriptBytes, err := hex.DecodeString(contract.BuyerOrder.Payment.RedeemScript)
+	if err != nil {
+		return nil, err
+	}
+	sigs, err := n.Wallet.CreateMultisigSignature(inputs, outputs, signingKey, redeemScriptBytes, 0)
+	if err != nil {
+		return nil, err
+	}
+	var bitcoinSigs []*pb.BitcoinSignature
+	for _, sig := range sigs {
+		s := new(pb.BitcoinSignature)
+		s.InputIndex = sig.InputIndex
+		s.Signature = sig.Signature
+		bitcoinSigs = append(bitcoinSigs, s)
+	}
+	return bitcoinSigs, nil
+}
+
+func (n *OpenBazaarNode) checkWeOwnScript(scriptPubKey string) error {
+	scriptBytes, err := hex.DecodeString(scriptPubKey)
+	if err != nil {
+		return err
+	}
+	_, addrs, _, err := txscript.ExtractPkScriptAddrs(scriptBytes, n.Wallet.Params())
+	if err != nil {
+		return err
+	}
+	if len(addrs) == 0 || !n.Wallet.HasKey(addrs[0]) {
+		return errors.New("Payout proposal sends coins to an address we don't control")
+	}
+	return nil
+}
+
+// Load one of our orders which has been disputed. isPurchase is true if we're the buyer.
+func (n *OpenBazaarNode) getDisputedOrder(orderId string) (*pb.RicardianContract, pb.OrderState, bool, error) {
+	contract, state, _, _, _, err := n.Datastore.Purchases().GetByOrderId(orderId)
+	if err == nil {
+		return contract, state, true, nil
+	}
+	contract, state, _, _, _, err = n.Datastore.Sales().GetByOrderId(orderId)
+	if err != nil {
+		return nil, state, false, ErrOrderNotFound
+	}
+	return contract, state, false, nil
+}
+
+func (n *OpenBazaarNode) putDisputedOrder(orderId string, contract *pb.RicardianContract, state pb.OrderState, isPurchase bool) error {
+	if isPurchase {
+		return n.Datastore.Purchases().Put(orderId, *contract, state, false)
+	}
+	return n.Datastore.Sales().Put(orderId, *contract, state, false)
+}
+
+func toWalletSignatures(sigs []*pb.BitcoinSignature) []spvwallet.Signature {
+	var ret []spvwallet.Signature
+	for _, sig := range sigs {
+		ret = append(ret, spvwallet.Signature{
+			Signature:  sig.Signature,
+			InputIndex: sig.InputIndex,
+		})
+	}
+	return ret
+}
@@ -0,0 +1,197 @@
+package core
+
+import (
+	"testing"
+
+	"github.com/OpenBazaar/openbazaar-go/pb"
+	"github.com/golang/protobuf/proto"
+)
+
+func TestCanProposePayout(t *testing.T) {
+	contract := &pb.RicardianContract{
+		DisputeResolution: &pb.DisputeResolution{
+			Payout: &pb.DisputeResolution_Payout{
+				Inputs:          []*pb.Outpoint{{Hash: "a3f1", Index: 0}},
+				BuyerOutput:     &pb.DisputeResolution_Payout_Output{Script: "buyer", Amount: 90000},
+				ModeratorOutput: &pb.DisputeResolution_Payout_Output{Script: "moderator", Amount: 10000},
+			},
+		},
+	}
+	for state := range pb.OrderState_name {
+		err := canProposePayout(contract, pb.OrderState(state))
+		if pb.OrderState(state) == pb.OrderState_DECIDED && err != nil {
+			t.Error(err)
+		} else if pb.OrderState(state) != pb.OrderState_DECIDED && err == nil {
+			t.Errorf("Expected a payout proposal in state %s to fail", pb.OrderState(state))
+		}
+	}
+	if err := canProposePayout(&pb.RicardianContract{}, pb.OrderState_DECIDED); err == nil {
+		t.Error("Expected a payout proposal without a dispute resolution to fail")
+	}
+	if err := canProposePayout(&pb.RicardianContract{DisputeResolution: &pb.DisputeResolution{}}, pb.OrderState_DECIDED); err == nil {
+		t.Error("Expected a payout proposal without a moderator payout to fail")
+	}
+	contract.BuyerOrder = &pb.Order{Payment: newPanelPayment()}
+	if err := canProposePayout(contract, pb.OrderState_DECIDED); err != nil {
+		t.Error("Expected the buyer and vendor of a panel escrow to be able to propose a payout")
+	}
+}
+
+func TestValidatePayoutProposal(t *testing.T) {
+	resolution := &pb.DisputeResolution_Payout{
+		Inputs: []*pb.Outpoint{
+			{Hash: "a3f1", Index: 0},
+			{Hash: "b7c2", Index: 1},
+		},
+		BuyerOutput:     &pb.DisputeResolution_Payout_Output{Script: "buyer", Amount: 70000},
+		VendorOutput:    &pb.DisputeResolution_Payout_Output{Script: "vendor", Amount: 20000},
+		ModeratorOutput: &pb.DisputeResolution_Payout_Output{Script: "moderator", Amount: 10000},
+	}
+	proposal := &pb.DisputeResolution_Payout{
+		Inputs: []*pb.Outpoint{
+			{Hash: "a3f1", Index: 0},
+			{Hash: "b7c2", Index: 1},
+		},
+		BuyerOutput:     &pb.DisputeResolution_Payout_Output{Script: "buyer", Amount: 45000},
+		VendorOutput:    &pb.DisputeResolution_Payout_Output{Script: "vendor", Amount: 45000},
+		ModeratorOutput: &pb.DisputeResolution_Payout_Output{Script: "moderator", Amount: 10000},
+		Sigs:            []*pb.BitcoinSignature{{InputIndex: 0, Signature: []byte("sig")}},
+	}
+	if err := validatePayoutProposal(resolution, proposal); err != nil {
+		t.Error(err)
+	}
+
+	unsigned := proto.Clone(proposal).(*pb.DisputeResolution_Payout)
+	unsigned.Sigs = nil
+	if err := validatePayoutProposal(resolution, unsigned); err == nil {
+		t.Error("Expected an unsigned proposal to fail validation")
+	}
+
+	fewerInputs := proto.Clone(proposal).(*pb.DisputeResolution_Payout)
+	fewerInputs.Inputs = fewerInputs.Inputs[:1]
+	if err := validatePayoutProposal(resolution, fewerInputs); err == nil {
+		t.Error("Expected a proposal spending fewer inputs to fail validation")
+	}
+
+	otherInputs := proto.Clone(proposal).(*pb.DisputeResolution_Payout)
+	otherInputs.Inputs[1].Hash = "c9d4"
+	if err := validatePayoutProposal(resolution, otherInputs); err == nil {
+		t.Error("Expected a proposal spending different inputs to fail validation")
+	}
+
+	reducedFee := proto.Clone(proposal).(*pb.DisputeResolution_Payout)
+	reducedFee.ModeratorOutput.Amount = 5000
+	reducedFee.VendorOutput.Amount = 50000
+	if err := validatePayoutProposal(resolution, reducedFee); err == nil {
+		t.Error("Expected a proposal reducing the moderator fee to fail validation")
+	}
+
+	noFee := proto.Clone(proposal).(*pb.DisputeResolution_Payout)
+	noFee.ModeratorOutput = nil
+	noFee.VendorOutput.Amount = 55000
+	if err := validatePayoutProposal(resolution, noFee); err == nil {
+		t.Error("Expected a proposal without a moderator output to fail validation")
+	}
+
+	otherScript := proto.Clone(proposal).(*pb.DisputeResolution_Payout)
+	otherScript.ModeratorOutput.Script = "vendor"
+	if err := validatePayoutProposal(resolution, otherScript); err == nil {
+		t.Error("Expected a proposal paying the moderator fee elsewhere to fail validation")
+	}
+
+	otherTotal := proto.Clone(proposal).(*pb.DisputeResolution_Payout)
+	otherTotal.BuyerOutput.Amount = 50000
+	if err := validatePayoutProposal(resolution, otherTotal); err == nil {
+		t.Error("Expected a proposal paying out a different total to fail validation")
+	}
+
+	increasedFee := proto.Clone(proposal).(*pb.DisputeResolution_Payout)
+	increasedFee.ModeratorOutput.Amount = 12000
+	increasedFee.VendorOutput.Amount = 43000
+	if err := validatePayoutProposal(resolution, increasedFee); err != nil {
+		t.Error("Expected a proposal increasing the moderator fee to pass validation")
+	}
+}
+
+func TestPayoutProposalTransitions(t *testing.T) {
+	buyer := "QmYwAPJzv5CZsnA625s3Xf2nemtYgPpHdWEz79ojWnPbdG"
+	vendor := "QmeGRbXbqC8ZqKfN6ZRhXgFP4QWL1jTZzc8uVb3W1HNCFf"
+	resolution := &pb.DisputeResolution_Payout{
+		Inputs:          []*pb.Outpoint{{Hash: "a3f1", Index: 0}},
+		BuyerOutput:     &pb.DisputeResolution_Payout_Output{Script: "buyer", Amount: 70000},
+		VendorOutput:    &pb.DisputeResolution_Payout_Output{Script: "vendor", Amount: 20000},
+		ModeratorOutput: &pb.DisputeResolution_Payout_Output{Script: "moderator", Amount: 10000},
+	}
+	// Each party keeps its own copy of the decided order
+	buyerCopy := &pb.RicardianContract{DisputeResolution: &pb.DisputeResolution{Payout: resolution}}
+	vendorCopy := &pb.RicardianContract{DisputeResolution: &pb.DisputeResolution{Payout: resolution}}
+	isBuyer := func(id string) bool { return id == buyer }
+	isVendor := func(id string) bool { return id == vendor }
+
+	// Nothing to accept until a proposal arrives
+	if _, err := acceptablePayoutProposal(buyerCopy, pb.OrderState_DECIDED, isBuyer); err != ErrNoPayoutProposal {
+		t.Error("Expected no payout proposal to accept")
+	}
+
+	// Propose: the vendor proposes an even split
+	proposal := &pb.PayoutProposal{
+		OrderId:    "QmOrder",
+		ProposedBy: vendor,
+		Payout: &pb.DisputeResolution_Payout{
+			Inputs:          []*pb.Outpoint{{Hash: "a3f1", Index: 0}},
+			BuyerOutput:     &pb.DisputeResolution_Payout_Output{Script: "buyer", Amount: 45000},
+			VendorOutput:    &pb.DisputeResolution_Payout_Output{Script: "vendor", Amount: 45000},
+			ModeratorOutput: &pb.DisputeResolution_Payout_Output{Script: "moderator", Amount: 10000},
+			Sigs:            []*pb.BitcoinSignature{{InputIndex: 0, Signature: []byte("vendor sig")}},
+		},
+	}
+	vendorCopy.PayoutProposal = proposal
+	if err := applyPayoutProposal(buyerCopy, pb.OrderState_DECIDED, vendor, buyer, proposal); err == nil {
+		t.Error("Expected a proposal sent by someone other than the counterparty to be rejected")
+	}
+	if err := applyPayoutProposal(buyerCopy, pb.OrderState_DECIDED, vendor, vendor, proposal); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := acceptablePayoutProposal(vendorCopy, pb.OrderState_DECIDED, isVendor); err != ErrNoPayoutProposal {
+		t.Error("Expected the vendor not to be able to accept their own proposal")
+	}
+	if p, err := acceptablePayoutProposal(buyerCopy, pb.OrderState_DECIDED, isBuyer); err != nil || p != proposal {
+		t.Error("Expected the buyer to be able to accept the vendor's proposal")
+	}
+
+	// Counter: the buyer replaces it with a proposal of their own
+	counter := &pb.PayoutProposal{
+		OrderId:    "QmOrder",
+		ProposedBy: buyer,
+		Payout: &pb.DisputeResolution_Payout{
+			Inputs:          []*pb.Outpoint{{Hash: "a3f1", Index: 0}},
+			BuyerOutput:     &pb.DisputeResolution_Payout_Output{Script: "buyer", Amount: 60000},
+			VendorOutput:    &pb.DisputeResolution_Payout_Output{Script: "vendor", Amount: 30000},
+			ModeratorOutput: &pb.DisputeResolution_Payout_Output{Script: "moderator", Amount: 10000},
+			Sigs:            []*pb.BitcoinSignature{{InputIndex: 0, Signature: []byte("buyer sig")}},
+		},
+	}
+	buyerCopy.PayoutProposal = counter
+	if err := applyPayoutProposal(vendorCopy, pb.OrderState_DECIDED, buyer, buyer, counter); err != nil {
+		t.Fatal(err)
+	}
+	if vendorCopy.PayoutProposal != counter {
+		t.Error("Expected the counter-proposal to replace the vendor's proposal")
+	}
+	if _, err := acceptablePayoutProposal(buyerCopy, pb.OrderState_DECIDED, isBuyer); err != ErrNoPayoutProposal {
+		t.Error("Expected the buyer not to be able to accept the vendor's proposal once countered")
+	}
+
+	// Accept: the vendor accepts the counter-proposal
+	if p, err := acceptablePayoutProposal(vendorCopy, pb.OrderState_DECIDED, isVendor); err != nil || p != counter {
+		t.Error("Expected the vendor to be able to accept the buyer's counter-proposal")
+	}
+
+	// Once the payout is broadcast the order is resolved and proposals are closed
+	if _, err := acceptablePayoutProposal(vendorCopy, pb.OrderState_RESOLVED, isVendor); err == nil {
+		t.Error("Expected a proposal on a resolved order not to be acceptable")
+	}
+	if err := applyPayoutProposal(buyerCopy, pb.OrderState_RESOLVED, vendor, vendor, proposal); err == nil {
+		t.Error("Expected a proposal on a resolved order to be rejected")
+	}
+}
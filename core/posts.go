@@ -0,0 +1,424 @@
+package core
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net/url"
+	"os"
+	"path"
+	"strconv"
+	"strings"
+	"time"
+
+	libp2p "gx/ipfs/QmPGxZ1DP2w45WcogpW1h43BvseXbfke9N91qotpoQcUeS/go-libp2p-crypto"
+	peer "gx/ipfs/QmWUswjn261LSyVxWAEpMVtPdy8zmKBJJfBpG3Qdpa8ZsE/go-libp2p-peer"
+
+	"github.com/OpenBazaar/jsonpb"
+	"github.com/OpenBazaar/openbazaar-go/ipfs"
+	"github.com/OpenBazaar/openbazaar-go/pb"
+	"github.com/OpenBazaar/openbazaar-go/repo"
+	"github.com/golang/protobuf/proto"
+	"github.com/golang/protobuf/ptypes"
+	ipnspath "github.com/ipfs/go-ipfs/path"
+	"github.com/kennygrant/sanitize"
+)
+
+var ErrPostNotFound = errors.New("Post not found")
+
+// How often we check for expired posts to delete
+const PostExpiryCheckPeriod = time.Hour
+
+// An entry in the index of a store's posts
+type postData struct {
+	Hash      string     `json:"hash"`
+	Slug      string     `json:"slug"`
+	Title     string     `json:"title"`
+	Thumbnail thumbnail  `json:"thumbnail"`
+	Timestamp time.Time  `json:"timestamp"`
+	Expiry    *time.Time `json:"expiry,omitempty"`
+}
+
+func (p postData) expired() bool {
+	return p.Expiry != nil && p.Expiry.Before(time.Now())
+}
+
+func (n *OpenBazaarNode) postPath(slug string) string {
+	return path.Join(n.RepoPath, "root", "posts", slug+".json")
+}
+
+// Generate a slug for a new post from its title which no other post of ours uses
+func (n *OpenBazaarNode) GeneratePostSlug(title string) (string, error) {
+	l := TitleMaxCharacters
+	if len(title) < TitleMaxCharacters {
+		l = len(title)
+	}
+	slugBase := url.QueryEscape(sanitize.Path(strings.ToLower(title[:l])))
+	if len(slugBase) > SentenceMaxCharacters {
+		slugBase = slugBase[:SentenceMaxCharacters]
+	}
+	slugToTry := slugBase
+	for counter := 1; ; counter++ {
+		_, err := os.Stat(n.postPath(slugToTry))
+		if os.IsNotExist(err) {
+			return slugToTry, nil
+		} else if err != nil {
+			return "", err
+		}
+		slugToTry = slugBase + strconv.Itoa(counter)
+	}
+}
+
+// Sign a new or edited post, save it to our posts directory and add it to the index
+func (n *OpenBazaarNode) SavePost(post *pb.Post) error {
+	var err error
+	post.Timestamp, err = ptypes.TimestampProto(time.Now())
+	if err != nil {
+		return err
+	}
+	if err := validatePost(post); err != nil {
+		return err
+	}
+	post.VendorID = n.IpfsNode.Identity.Pretty()
+	if err := signPost(post, n.IpfsNode.PrivateKey); err != nil {
+		return err
+	}
+	m := jsonpb.Marshaler{
+		EnumsAsInts:  false,
+		EmitDefaults: false,
+		Indent:       "    ",
+		OrigName:     false,
+	}
+	out, err := m.MarshalToString(post)
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(path.Join(n.RepoPath, "root", "posts"), os.ModePerm); err != nil {
+		return err
+	}
+	postPath := n.postPath(post.Slug)
+	if err := ioutil.WriteFile(postPath, []byte(out), os.FileMode(0644)); err != nil {
+		return err
+	}
+	hash, err := ipfs.GetHash(n.Context, postPath)
+	if err != nil {
+		return err
+	}
+	pd := postData{
+		Hash:  hash,
+		Slug:  post.Slug,
+		Title: post.Title,
+	}
+	if len(post.Images) > 0 {
+		pd.Thumbnail = thumbnail{post.Images[0].Tiny, post.Images[0].Small, post.Images[0].Medium}
+	}
+	pd.Timestamp, err = ptypes.Timestamp(post.Timestamp)
+	if err != nil {
+		return err
+	}
+	if post.Expiry != nil {
+		expiry, err := ptypes.Timestamp(post.Expiry)
+		if err != nil {
+			return err
+		}
+		pd.Expiry = &expiry
+	}
+
+	index, err := n.getPostIndex()
+	if err != nil {
+		return err
+	}
+	var updated []postData
+	for _, d := range index {
+		if d.Slug != post.Slug {
+			updated = append(updated, d)
+		}
+	}
+	return n.writePostIndex(append(updated, pd))
+}
+
+// Return one of our posts
+func (n *OpenBazaarNode) GetPost(slug string) (*pb.Post, error) {
+	b, err := ioutil.ReadFile(n.postPath(slug))
+	if os.IsNotExist(err) {
+		return nil, ErrPostNotFound
+	} else if err != nil {
+		return nil, err
+	}
+	post := new(pb.Post)
+	if err := jsonpb.UnmarshalString(string(b), post); err != nil {
+		return nil, err
+	}
+	return post, nil
+}
+
+// Fetch a post from another peer and check they signed it. Expired posts aren't returned.
+func (n *OpenBazaarNode) FetchPost(peerID, slug string) (*pb.Post, error) {
+	b, err := ipfs.ResolveThenCat(n.Context, ipnspath.FromString(path.Join(peerID, "posts", slug+".json")))
+	if err != nil {
+		return nil, ErrPostNotFound
+	}
+	post := new(pb.Post)
+	if err := jsonpb.UnmarshalString(string(b), post); err != nil {
+		return nil, err
+	}
+	if err := VerifyPost(post, peerID); err != nil {
+		return nil, err
+	}
+	if postExpired(post) {
+		return nil, ErrPostNotFound
+	}
+	return post, nil
+}
+
+// Return the index of a peer's posts, leaving out any which have expired
+func (n *OpenBazaarNode) GetPosts(peerID string) ([]byte, error) {
+	var index []postData
+	var err error
+	if peerID == n.IpfsNode.Identity.Pretty() {
+		index, err = n.getPostIndex()
+		if err != nil {
+			return nil, err
+		}
+	} else {
+		b, err := ipfs.ResolveThenCat(n.Context, ipnspath.FromString(path.Join(peerID, "posts", "index.json")))
+		if err != nil {
+			return nil, err
+		}
+		if err := json.Unmarshal(b, &index); err != nil {
+			return nil, err
+		}
+	}
+	current := []postData{}
+	for _, d := range index {
+		if !d.expired() {
+			current = append(current, d)
+		}
+	}
+	return json.MarshalIndent(current, "", "    ")
+}
+
+// Delete one of our posts and remove it from the index
+func (n *OpenBazaarNode) DeletePost(slug string) error {
+	if err := os.Remove(n.postPath(slug)); os.IsNotExist(err) {
+		return ErrPostNotFound
+	} else if err != nil {
+		return err
+	}
+	index, err := n.getPostIndex()
+	if err != nil {
+		return err
+	}
+	var updated []postData
+	for _, d := range index {
+		if d.Slug != slug {
+			updated = append(updated, d)
+		}
+	}
+	return n.writePostIndex(updated)
+}
+
+// Delete our posts as they expire, republishing when any are deleted. Intended to be
+// run in its own goroutine.
+func (n *OpenBazaarNode) StartPostExpiry() {
+	n.deleteExpiredPostsAndSeed()
+	tick := time.NewTicker(PostExpiryCheckPeriod)
+	defer tick.Stop()
+	for range tick.C {
+		n.deleteExpiredPostsAndSeed()
+	}
+}
+
+func (n *OpenBazaarNode) deleteExpiredPostsAndSeed() {
+	deleted, err := n.DeleteExpiredPosts()
+	if err != nil {
+		log.Error(err)
+	}
+	if deleted {
+		if err := n.SeedNode(); err != nil {
+			log.Error(err)
+		}
+	}
+}
+
+// Delete our posts which have expired. Returns whether any were deleted.
+func (n *OpenBazaarNode) DeleteExpiredPosts() (bool, error) {
+	index, err := n.getPostIndex()
+	if err != nil {
+		return false, err
+	}
+	deleted := false
+	for _, d := range index {
+		if !d.expired() {
+			continue
+		}
+		if err := n.DeletePost(d.Slug); err != nil && err != ErrPostNotFound {
+			return deleted, err
+		}
+		deleted = true
+	}
+	return deleted, nil
+}
+
+func (n *OpenBazaarNode) getPostIndex() ([]postData, error) {
+	var index []postData
+	b, err := ioutil.ReadFile(path.Join(n.RepoPath, "root", "posts", "index.json"))
+	if os.IsNotExist(err) {
+		return index, nil
+	} else if err != nil {
+		return nil, err
+	}
+	if err := json.Unmarshal(b, &index); err != nil {
+		return nil, err
+	}
+	return index, nil
+}
+
+func (n *OpenBazaarNode) writePostIndex(index []postData) error {
+	if index == nil {
+		index = []postData{}
+	}
+	j, err := json.MarshalIndent(index, "", "    ")
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(path.Join(n.RepoPath, "root", "posts"), os.ModePerm); err != nil {
+		return err
+	}
+	return ioutil.WriteFile(path.Join(n.RepoPath, "root", "posts", "index.json"), j, os.FileMode(0644))
+}
+
+// Push a new post to the peers following us
+func (n *OpenBazaarNode) SendPostToFollowers(post *pb.Post) {
+	followers, err := n.Datastore.Followers().Get("", -1)
+	if err != nil {
+		log.Error(err)
+		return
+	}
+	for _, follower := range followers {
+		go func(peerID string) {
+			if err := n.SendPost(peerID, post); err != nil {
+				log.Errorf("Error sending post to %s: %s", peerID, err.Error())
+			}
+		}(follower)
+	}
+}
+
+// Add a post pushed to us by a store we follow to the feed
+func (n *OpenBazaarNode) ProcessPost(peerID string, post *pb.Post) error {
+	if !n.Datastore.Following().IsFollowing(peerID) {
+		return errors.New("Not following the peer who sent the post")
+	}
+	if err := VerifyPost(post, peerID); err != nil {
+		return err
+	}
+	if postExpired(post) {
+		return nil
+	}
+	event := repo.FeedEvent{
+		Type:      FeedNewPost,
+		PeerId:    peerID,
+		Slug:      post.Slug,
+		Title:     post.Title,
+		Timestamp: time.Now(),
+	}
+	if len(post.Images) > 0 {
+		event.Thumbnail = post.Images[0].Small
+	}
+	return n.addFeedEvent(event)
+}
+
+func postExpired(post *pb.Post) bool {
+	if post.Expiry == nil {
+		return false
+	}
+	expiry, err := ptypes.Timestamp(post.Expiry)
+	return err == nil && expiry.Before(time.Now())
+}
+
+func validatePost(post *pb.Post) error {
+	if post.Title == "" {
+		return errors.New("Post must have a title")
+	}
+	if len(post.Title) > TitleMaxCharacters {
+		return fmt.Errorf("Title is longer than the max of %d", TitleMaxCharacters)
+	}
+	if post.Slug == "" {
+		return errors.New("Slug must not be empty")
+	}
+	if len(post.Slug) > SentenceMaxCharacters {
+		return fmt.Errorf("Slug is longer than the max of %d", SentenceMaxCharacters)
+	}
+	if strings.ContainsAny(post.Slug, " /") {
+		return errors.New("Slugs cannot contain spaces or slashes")
+	}
+	if len(post.LongForm) > DescriptionMaxCharacters {
+		return fmt.Errorf("Post is longer than the max of %d", DescriptionMaxCharacters)
+	}
+	if len(post.Images) > MaxListItems {
+		return fmt.Errorf("Number of post images is greater than the max of %d", MaxListItems)
+	}
+	for _, img := range post.Images {
+		if err := validateImage(img); err != nil {
+			return err
+		}
+	}
+	if post.Expiry != nil {
+		expiry, err := ptypes.Timestamp(post.Expiry)
+		if err != nil {
+			return err
+		}
+		if expiry.Before(time.Now()) {
+			return errors.New("Expiry must be in the future")
+		}
+	}
+	return nil
+}
+
+func signPost(post *pb.Post, privKey libp2p.PrivKey) error {
+	var err error
+	post.Pubkey, err = privKey.GetPublic().Bytes()
+	if err != nil {
+		return err
+	}
+	post.Signature = nil
+	ser, err := proto.Marshal(post)
+	if err != nil {
+		return err
+	}
+	post.Signature, err = privKey.Sign(ser)
+	return err
+}
+
+// Checks a post was signed by the store it came from
+func VerifyPost(post *pb.Post, peerID string) error {
+	if post.VendorID != peerID {
+		return errors.New("Post was not published by this peer")
+	}
+	if len(post.Signature) == 0 {
+		return errors.New("Post is not signed")
+	}
+	pubkey, err := libp2p.UnmarshalPublicKey(post.Pubkey)
+	if err != nil {
+		return err
+	}
+	pid, err := peer.IDFromPublicKey(pubkey)
+	if err != nil {
+		return err
+	}
+	if pid.Pretty() != peerID {
+		return errors.New("Post was signed by a different peer")
+	}
+	unsigned := *post
+	unsigned.Signature = nil
+	ser, err := proto.Marshal(&unsigned)
+	if err != nil {
+		return err
+	}
+	valid, err := pubkey.Verify(ser, post.Signature)
+	if err != nil || !valid {
+		return errors.New("Signature on post failed to verify")
+	}
+	return nil
+}
@@ -0,0 +1,71 @@
+package core
+
+import (
+	"testing"
+	"time"
+
+	libp2p "gx/ipfs/QmPGxZ1DP2w45WcogpW1h43BvseXbfke9N91qotpoQcUeS/go-libp2p-crypto"
+	peer "gx/ipfs/QmWUswjn261LSyVxWAEpMVtPdy8zmKBJJfBpG3Qdpa8ZsE/go-libp2p-peer"
+
+	"github.com/OpenBazaar/openbazaar-go/pb"
+	"github.com/golang/protobuf/ptypes"
+)
+
+func TestVerifyPost(t *testing.T) {
+	priv, pub, err := libp2p.GenerateKeyPair(libp2p.Ed25519, 256)
+	if err != nil {
+		t.Fatal(err)
+	}
+	pid, err := peer.IDFromPublicKey(pub)
+	if err != nil {
+		t.Fatal(err)
+	}
+	post := &pb.Post{Slug: "spring-sale", VendorID: pid.Pretty(), Title: "Spring sale"}
+	if err := VerifyPost(post, pid.Pretty()); err == nil {
+		t.Error("Expected an unsigned post to fail verification")
+	}
+	if err := signPost(post, priv); err != nil {
+		t.Fatal(err)
+	}
+	if err := VerifyPost(post, pid.Pretty()); err != nil {
+		t.Error(err)
+	}
+	if err := VerifyPost(post, reportedStore); err == nil {
+		t.Error("Expected a post from another peer to fail verification")
+	}
+	post.Title = "Everything free"
+	if err := VerifyPost(post, pid.Pretty()); err == nil {
+		t.Error("Expected an edited post to fail verification")
+	}
+}
+
+func TestValidatePost(t *testing.T) {
+	post := &pb.Post{Slug: "spring-sale", Title: "Spring sale"}
+	if err := validatePost(post); err != nil {
+		t.Error(err)
+	}
+	post.Slug = "../listings/index"
+	if err := validatePost(post); err == nil {
+		t.Error("Expected a slug with slashes to be invalid")
+	}
+	post.Slug = "spring-sale"
+	post.Images = []*pb.Listing_Item_Image{{Filename: "sale.jpg", Tiny: "notahash"}}
+	if err := validatePost(post); err == nil {
+		t.Error("Expected an image without valid hashes to be invalid")
+	}
+	post.Images = nil
+	post.Expiry, _ = ptypes.TimestampProto(time.Now().Add(-time.Hour))
+	if err := validatePost(post); err == nil {
+		t.Error("Expected a post which has already expired to be invalid")
+	}
+	if !postExpired(post) {
+		t.Error("Expected the post to have expired")
+	}
+	post.Expiry, _ = ptypes.TimestampProto(time.Now().Add(time.Hour))
+	if err := validatePost(post); err != nil {
+		t.Error(err)
+	}
+	if postExpired(post) {
+		t.Error("Expected the post not to have expired")
+	}
+}
@@ -9,6 +9,7 @@ import (
 	"github.com/OpenBazaar/jsonpb"
 	"github.com/OpenBazaar/openbazaar-go/ipfs"
 	"github.com/OpenBazaar/openbazaar-go/pb"
+	"github.com/golang/protobuf/proto"
 	"github.com/golang/protobuf/ptypes"
 	"github.com/imdario/mergo"
 	ipnspath "github.com/ipfs/go-ipfs/path"
@@ -21,6 +22,8 @@ import (
 
 var ErrorProfileNotFound error = errors.New("Profie not found")
 
+var ErrorProfileUnsigned error = errors.New("Profile is not signed")
+
 func (n *OpenBazaarNode) GetProfile() (pb.Profile, error) {
 	var profile pb.Profile
 	f, err := os.Open(path.Join(n.RepoPath, "root", "profile"))
@@ -50,6 +53,16 @@ func (n *OpenBazaarNode) FetchProfile(peerId string) (pb.Profile, error) {
 		return pb.Profile{}, err
 	}
 	*/
+	// Profiles saved before profiles were signed are still shown but marked unverified
+	switch err := VerifyProfile(&pro, peerId); err {
+	case nil:
+		pro.Revision.Verified = true
+	case ErrorProfileUnsigned:
+		pro.Revision = &pb.Profile_Revision{}
+	default:
+		return pb.Profile{}, err
+	}
+	n.SetSocialProofStatus(&pro, peerId)
 	return pro, nil
 }
 
@@ -66,13 +79,38 @@ func (n *OpenBazaarNode) UpdateProfile(profile *pb.Profile) error {
 	*/
 
 	profile.BitcoinPubkey = hex.EncodeToString(mPubkey.SerializeCompressed())
+	if profile.ContactInfo != nil {
+		for _, account := range profile.ContactInfo.Social {
+			account.Verified = false
+		}
+	}
+
+	// Edits start a new revision which is kept in the profile history. The revision
+	// and signature are only added to the saved copy.
+	previous, err := n.GetProfile()
+	newRevision := err != nil || profileEdited(&previous, profile)
+	saved := proto.Clone(profile).(*pb.Profile)
+	saved.Revision = &pb.Profile_Revision{Number: previous.Revision.GetNumber()}
+	if newRevision {
+		saved.Revision.Number++
+		saved.LastModified, err = ptypes.TimestampProto(time.Now())
+		if err != nil {
+			return err
+		}
+	} else {
+		saved.LastModified = previous.LastModified
+	}
+	if err := signProfile(saved, n.IpfsNode.PrivateKey); err != nil {
+		return err
+	}
+
 	m := jsonpb.Marshaler{
 		EnumsAsInts:  false,
 		EmitDefaults: true,
 		Indent:       "    ",
 		OrigName:     false,
 	}
-	out, err := m.MarshalToString(profile)
+	out, err := m.MarshalToString(saved)
 	if err != nil {
 		return err
 	}
@@ -85,9 +123,27 @@ func (n *OpenBazaarNode) UpdateProfile(profile *pb.Profile) error {
 	if _, err := f.WriteString(out); err != nil {
 		return err
 	}
+	if newRevision {
+		return n.archiveProfileRevision(out, saved.Revision.Number)
+	}
 	return nil
 }
 
+// Sign our profile if it was saved before profiles were signed or under an earlier
+// identity key
+func (n *OpenBazaarNode) SignProfile() error {
+	profile, err := n.GetProfile()
+	if err == ErrorProfileNotFound {
+		return nil
+	} else if err != nil {
+		return err
+	}
+	if VerifyProfile(&profile, n.IpfsNode.Identity.Pretty()) == nil {
+		return nil
+	}
+	return n.UpdateProfile(&profile)
+}
+
 func (n *OpenBazaarNode) PatchProfile(patch map[string]interface{}) error {
 	profilePath := path.Join(n.RepoPath, "root", "profile")
 
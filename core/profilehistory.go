@@ -0,0 +1,189 @@
+package core
+
+import (
+	"encoding/json"
+	"errors"
+	"io/ioutil"
+	"os"
+	"path"
+	"strconv"
+
+	libp2p "gx/ipfs/QmPGxZ1DP2w45WcogpW1h43BvseXbfke9N91qotpoQcUeS/go-libp2p-crypto"
+	peer "gx/ipfs/QmWUswjn261LSyVxWAEpMVtPdy8zmKBJJfBpG3Qdpa8ZsE/go-libp2p-peer"
+
+	"github.com/OpenBazaar/jsonpb"
+	"github.com/OpenBazaar/openbazaar-go/ipfs"
+	"github.com/OpenBazaar/openbazaar-go/pb"
+	"github.com/golang/protobuf/proto"
+	ipnspath "github.com/ipfs/go-ipfs/path"
+)
+
+// An entry in the index of our profile's revisions
+type profileRevisionShort struct {
+	Revision uint32 `json:"revision"`
+	Hash     string `json:"hash"`
+}
+
+// Return every revision of a peer's profile which verifies, newest first
+func (n *OpenBazaarNode) GetProfileHistory(peerId string) ([]*pb.Profile, error) {
+	index, err := n.fetchProfileHistoryIndex(peerId)
+	if err != nil {
+		return nil, err
+	}
+	var history []*pb.Profile
+	for i := len(index) - 1; i >= 0; i-- {
+		entry := index[i]
+		var b []byte
+		if peerId == n.IpfsNode.Identity.Pretty() {
+			b, err = ioutil.ReadFile(n.profileRevisionPath(entry.Revision))
+		} else {
+			b, err = ipfs.Cat(n.Context, entry.Hash)
+		}
+		if err != nil {
+			log.Warningf("Skipping revision %d of the profile of %s: %s", entry.Revision, peerId, err.Error())
+			continue
+		}
+		profile := new(pb.Profile)
+		if err := jsonpb.UnmarshalString(string(b), profile); err != nil {
+			log.Warningf("Skipping revision %d of the profile of %s: %s", entry.Revision, peerId, err.Error())
+			continue
+		}
+		if err := VerifyProfile(profile, peerId); err != nil {
+			log.Warningf("Skipping revision %d of the profile of %s: %s", entry.Revision, peerId, err.Error())
+			continue
+		}
+		if profile.Revision.Number != entry.Revision {
+			log.Warningf("Skipping revision %d of the profile of %s: revision does not match the index", entry.Revision, peerId)
+			continue
+		}
+		history = append(history, profile)
+	}
+	return history, nil
+}
+
+// Read our own profile history index from disk or resolve another peer's
+func (n *OpenBazaarNode) fetchProfileHistoryIndex(peerId string) ([]profileRevisionShort, error) {
+	var index []profileRevisionShort
+	var b []byte
+	var err error
+	if peerId == n.IpfsNode.Identity.Pretty() {
+		b, err = ioutil.ReadFile(path.Join(n.RepoPath, "root", "profilehistory", "index.json"))
+		if os.IsNotExist(err) {
+			return index, nil
+		}
+	} else {
+		b, err = ipfs.ResolveThenCat(n.Context, ipnspath.FromString(path.Join(peerId, "profilehistory", "index.json")))
+	}
+	if err != nil {
+		return nil, err
+	}
+	if err := json.Unmarshal(b, &index); err != nil {
+		return nil, err
+	}
+	return index, nil
+}
+
+func (n *OpenBazaarNode) profileRevisionPath(revision uint32) string {
+	return path.Join(n.RepoPath, "root", "profilehistory", "profile_"+strconv.FormatUint(uint64(revision), 10))
+}
+
+// Keep a copy of a new revision of our profile and add it to the history index
+func (n *OpenBazaarNode) archiveProfileRevision(profileJson string, revision uint32) error {
+	if err := os.MkdirAll(path.Join(n.RepoPath, "root", "profilehistory"), os.ModePerm); err != nil {
+		return err
+	}
+	revisionPath := n.profileRevisionPath(revision)
+	if err := ioutil.WriteFile(revisionPath, []byte(profileJson), os.FileMode(0644)); err != nil {
+		return err
+	}
+	hash, err := ipfs.GetHash(n.Context, revisionPath)
+	if err != nil {
+		return err
+	}
+	index, err := n.fetchProfileHistoryIndex(n.IpfsNode.Identity.Pretty())
+	if err != nil {
+		return err
+	}
+	index = append(index, profileRevisionShort{revision, hash})
+	j, err := json.MarshalIndent(index, "", "    ")
+	if err != nil {
+		return err
+	}
+	return ioutil.WriteFile(path.Join(n.RepoPath, "root", "profilehistory", "index.json"), j, os.FileMode(0644))
+}
+
+// Whether the content of a profile differs from the previous revision. The fields the
+// node keeps up to date itself don't count as edits.
+func profileEdited(previous, profile *pb.Profile) bool {
+	clearGenerated := func(p *pb.Profile) *pb.Profile {
+		c := proto.Clone(p).(*pb.Profile)
+		c.PeerID = ""
+		c.Stats = nil
+		c.BitcoinPubkey = ""
+		c.LastModified = nil
+		c.Revision = nil
+		if c.ModeratorInfo != nil {
+			c.ModeratorInfo.Stats = nil
+		}
+		// Set from our own checks of the account proofs, never by the profile's owner
+		if c.ContactInfo != nil {
+			for _, account := range c.ContactInfo.Social {
+				account.Verified = false
+			}
+		}
+		return c
+	}
+	return !proto.Equal(clearGenerated(previous), clearGenerated(profile))
+}
+
+func signProfile(profile *pb.Profile, privKey libp2p.PrivKey) error {
+	if profile.Revision == nil {
+		profile.Revision = new(pb.Profile_Revision)
+	}
+	var err error
+	profile.Revision.Pubkey, err = privKey.GetPublic().Bytes()
+	if err != nil {
+		return err
+	}
+	profile.Revision.Signature = nil
+	profile.Revision.Verified = false
+	ser, err := proto.Marshal(profile)
+	if err != nil {
+		return err
+	}
+	profile.Revision.Signature, err = privKey.Sign(ser)
+	return err
+}
+
+// Checks a profile was signed by the peer it was fetched from. Returns
+// ErrorProfileUnsigned if it wasn't signed at all.
+func VerifyProfile(profile *pb.Profile, peerId string) error {
+	if profile.Revision == nil || len(profile.Revision.Signature) == 0 {
+		return ErrorProfileUnsigned
+	}
+	pubkey, err := libp2p.UnmarshalPublicKey(profile.Revision.Pubkey)
+	if err != nil {
+		return err
+	}
+	pid, err := peer.IDFromPublicKey(pubkey)
+	if err != nil {
+		return err
+	}
+	if pid.Pretty() != peerId {
+		return errors.New("Profile was signed by a different peer")
+	}
+	revision := *profile.Revision
+	revision.Signature = nil
+	revision.Verified = false
+	unsigned := *profile
+	unsigned.Revision = &revision
+	ser, err := proto.Marshal(&unsigned)
+	if err != nil {
+		return err
+	}
+	valid, err := pubkey.Verify(ser, profile.Revision.Signature)
+	if err != nil || !valid {
+		return errors.New("Signature on profile failed to verify")
+	}
+	return nil
+}
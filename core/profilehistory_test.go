@@ -0,0 +1,81 @@
+package core
+
+import (
+	"testing"
+
+	libp2p "gx/ipfs/QmPGxZ1DP2w45WcogpW1h43BvseXbfke9N91qotpoQcUeS/go-libp2p-crypto"
+	peer "gx/ipfs/QmWUswjn261LSyVxWAEpMVtPdy8zmKBJJfBpG3Qdpa8ZsE/go-libp2p-peer"
+
+	"github.com/OpenBazaar/openbazaar-go/pb"
+)
+
+func TestVerifyProfile(t *testing.T) {
+	priv, pub, err := libp2p.GenerateKeyPair(libp2p.Ed25519, 256)
+	if err != nil {
+		t.Fatal(err)
+	}
+	pid, err := peer.IDFromPublicKey(pub)
+	if err != nil {
+		t.Fatal(err)
+	}
+	profile := &pb.Profile{Handle: "satoshi", Name: "Satoshi Nakamoto", Revision: &pb.Profile_Revision{Number: 2}}
+	if err := VerifyProfile(profile, pid.Pretty()); err != ErrorProfileUnsigned {
+		t.Error("Expected an unsigned profile to be reported as unsigned")
+	}
+	if err := signProfile(profile, priv); err != nil {
+		t.Fatal(err)
+	}
+	if err := VerifyProfile(profile, pid.Pretty()); err != nil {
+		t.Error(err)
+	}
+	profile.Revision.Verified = true
+	if err := VerifyProfile(profile, pid.Pretty()); err != nil {
+		t.Error("Expected the verified flag not to be covered by the signature")
+	}
+	if err := VerifyProfile(profile, reportedStore); err == nil {
+		t.Error("Expected a profile fetched from another peer to fail verification")
+	}
+	profile.Name = "Craig Wright"
+	if err := VerifyProfile(profile, pid.Pretty()); err == nil {
+		t.Error("Expected an edited profile to fail verification")
+	}
+}
+
+func TestProfileEdited(t *testing.T) {
+	previous := &pb.Profile{Handle: "satoshi", Name: "Satoshi Nakamoto", Revision: &pb.Profile_Revision{Number: 1}}
+	profile := &pb.Profile{
+		Handle:   "satoshi",
+		Name:     "Satoshi Nakamoto",
+		Stats:    &pb.Profile_Stats{FollowerCount: 5},
+		Revision: &pb.Profile_Revision{Number: 3},
+	}
+	if profileEdited(previous, profile) {
+		t.Error("Expected a change to the stats not to count as an edit")
+	}
+	profile.Name = "Craig Wright"
+	if !profileEdited(previous, profile) {
+		t.Error("Expected a change to the name to count as an edit")
+	}
+	previous = &pb.Profile{
+		Handle: "satoshi",
+		ContactInfo: &pb.Profile_Contact{
+			Social: []*pb.Profile_SocialAccount{{Type: "twitter", Username: "satoshi", Proof: "https://twitter.com/satoshi/status/1"}},
+		},
+	}
+	profile = &pb.Profile{
+		Handle: "satoshi",
+		ContactInfo: &pb.Profile_Contact{
+			Social: []*pb.Profile_SocialAccount{{Type: "twitter", Username: "satoshi", Proof: "https://twitter.com/satoshi/status/1", Verified: true}},
+		},
+	}
+	if profileEdited(previous, profile) {
+		t.Error("Expected a social proof being verified not to count as an edit")
+	}
+	profile.ContactInfo.Social[0].Proof = "https://twitter.com/satoshi/status/2"
+	if !profileEdited(previous, profile) {
+		t.Error("Expected a change to a social proof to count as an edit")
+	}
+	if !profile.ContactInfo.Social[0].Verified {
+		t.Error("Expected the profile's verified flags to be left alone")
+	}
+}
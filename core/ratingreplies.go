@@ -0,0 +1,224 @@
+package core
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path"
+	"strconv"
+	"time"
+
+	libp2p "gx/ipfs/QmPGxZ1DP2w45WcogpW1h43BvseXbfke9N91qotpoQcUeS/go-libp2p-crypto"
+	peer "gx/ipfs/QmWUswjn261LSyVxWAEpMVtPdy8zmKBJJfBpG3Qdpa8ZsE/go-libp2p-peer"
+
+	"github.com/OpenBazaar/jsonpb"
+	"github.com/OpenBazaar/openbazaar-go/ipfs"
+	"github.com/OpenBazaar/openbazaar-go/pb"
+	"github.com/golang/protobuf/proto"
+	"github.com/golang/protobuf/ptypes"
+)
+
+const ReplyMaxCharacters = 3000
+
+var ErrRatingNotFound = errors.New("Rating not found")
+
+// Checks the text of a reply to a rating
+func ValidateRatingReply(text string) error {
+	if text == "" {
+		return errors.New("Reply is empty")
+	}
+	if len(text) > ReplyMaxCharacters {
+		return fmt.Errorf("Reply is longer than the max of %d characters", ReplyMaxCharacters)
+	}
+	return nil
+}
+
+// Publicly reply to one of our ratings. Replying again publishes a new revision of the
+// reply. Earlier revisions stay published and are listed in the ratings index. The
+// caller is responsible for republishing.
+func (n *OpenBazaarNode) ReplyToRating(ratingHash, text string) (*pb.RatingReply, error) {
+	if err := ValidateRatingReply(text); err != nil {
+		return nil, err
+	}
+	index, err := n.fetchRatingIndex(n.IpfsNode.Identity.Pretty())
+	if err != nil {
+		return nil, err
+	}
+	i := -1
+	for j, entry := range index {
+		if entry.Hash == ratingHash {
+			i = j
+			break
+		}
+	}
+	if i < 0 {
+		return nil, ErrRatingNotFound
+	}
+
+	reply := &pb.RatingReply{
+		RatingHash: ratingHash,
+		VendorID:   n.IpfsNode.Identity.Pretty(),
+		Reply:      text,
+		Version:    1,
+	}
+	if index[i].Reply != "" {
+		previous, err := n.readRatingReply(ratingHash)
+		if err != nil {
+			return nil, err
+		}
+		// Keep the replaced revision published under its own name
+		if err := n.archiveRatingReply(previous); err != nil {
+			return nil, err
+		}
+		reply.Version = previous.Version + 1
+		reply.Previous = index[i].Reply
+		index[i].ReplyRevisions = append(index[i].ReplyRevisions, index[i].Reply)
+	}
+	if err := signRatingReply(reply, n.IpfsNode.PrivateKey); err != nil {
+		return nil, err
+	}
+
+	m := jsonpb.Marshaler{
+		EnumsAsInts:  false,
+		EmitDefaults: false,
+		Indent:       "    ",
+		OrigName:     false,
+	}
+	out, err := m.MarshalToString(reply)
+	if err != nil {
+		return nil, err
+	}
+	replyPath := n.ratingReplyPath(ratingHash)
+	if err := ioutil.WriteFile(replyPath, []byte(out), os.FileMode(0644)); err != nil {
+		return nil, err
+	}
+	index[i].Reply, err = ipfs.GetHash(n.Context, replyPath)
+	if err != nil {
+		return nil, err
+	}
+	j, err := json.MarshalIndent(index, "", "    ")
+	if err != nil {
+		return nil, err
+	}
+	if err := ioutil.WriteFile(path.Join(n.RepoPath, "root", "ratings", "index.json"), j, os.FileMode(0644)); err != nil {
+		return nil, err
+	}
+	return reply, nil
+}
+
+// Replies are stored next to the ratings, named after the rating they reply to
+func (n *OpenBazaarNode) ratingReplyPath(ratingHash string) string {
+	return path.Join(n.RepoPath, "root", "ratings", "reply_"+ratingHash)
+}
+
+// Copy a revision of a reply which is being replaced to a file named after its version
+func (n *OpenBazaarNode) archiveRatingReply(reply *pb.RatingReply) error {
+	b, err := ioutil.ReadFile(n.ratingReplyPath(reply.RatingHash))
+	if err != nil {
+		return err
+	}
+	revisionPath := n.ratingReplyPath(reply.RatingHash) + "_" + strconv.FormatUint(uint64(reply.Version), 10)
+	return ioutil.WriteFile(revisionPath, b, os.FileMode(0644))
+}
+
+func (n *OpenBazaarNode) readRatingReply(ratingHash string) (*pb.RatingReply, error) {
+	b, err := ioutil.ReadFile(n.ratingReplyPath(ratingHash))
+	if err != nil {
+		return nil, err
+	}
+	reply := new(pb.RatingReply)
+	if err := jsonpb.UnmarshalString(string(b), reply); err != nil {
+		return nil, err
+	}
+	return reply, nil
+}
+
+// Read our own reply from disk or cat another peer's and check the vendor signed it
+func (n *OpenBazaarNode) fetchRatingReply(entry ratingShort, peerId string) (*pb.RatingReply, error) {
+	if peerId == n.IpfsNode.Identity.Pretty() {
+		return n.readRatingReply(entry.Hash)
+	}
+	b, err := ipfs.Cat(n.Context, entry.Reply)
+	if err != nil {
+		return nil, err
+	}
+	reply := new(pb.RatingReply)
+	if err := jsonpb.UnmarshalString(string(b), reply); err != nil {
+		return nil, err
+	}
+	if err := VerifyRatingReply(reply, peerId, entry.Hash); err != nil {
+		return nil, err
+	}
+	if err := checkReplyRevisions(reply, entry.ReplyRevisions); err != nil {
+		return nil, err
+	}
+	return reply, nil
+}
+
+// Checks a reply links to the last of the earlier revisions listed in the index
+func checkReplyRevisions(reply *pb.RatingReply, revisions []string) error {
+	var previous string
+	if len(revisions) > 0 {
+		previous = revisions[len(revisions)-1]
+	}
+	if reply.Previous != previous {
+		return errors.New("Reply does not replace its last revision")
+	}
+	if reply.Version != uint32(len(revisions)+1) {
+		return errors.New("Reply version does not match its revisions")
+	}
+	return nil
+}
+
+func signRatingReply(reply *pb.RatingReply, privKey libp2p.PrivKey) error {
+	ts, err := ptypes.TimestampProto(time.Now())
+	if err != nil {
+		return err
+	}
+	reply.Timestamp = ts
+	reply.Pubkey, err = privKey.GetPublic().Bytes()
+	if err != nil {
+		return err
+	}
+	reply.Signature = nil
+	ser, err := proto.Marshal(reply)
+	if err != nil {
+		return err
+	}
+	reply.Signature, err = privKey.Sign(ser)
+	return err
+}
+
+// Checks a reply was signed by the vendor and is for the rating it was listed with
+func VerifyRatingReply(reply *pb.RatingReply, peerID, ratingHash string) error {
+	if reply.VendorID != peerID {
+		return errors.New("Reply was not written by this vendor")
+	}
+	if reply.RatingHash != ratingHash {
+		return errors.New("Reply is for a different rating")
+	}
+	pubkey, err := libp2p.UnmarshalPublicKey(reply.Pubkey)
+	if err != nil {
+		return err
+	}
+	pid, err := peer.IDFromPublicKey(pubkey)
+	if err != nil {
+		return err
+	}
+	if pid.Pretty() != peerID {
+		return errors.New("Public key in reply does not match the vendor's peer ID")
+	}
+	unsigned := *reply
+	unsigned.Signature = nil
+	ser, err := proto.Marshal(&unsigned)
+	if err != nil {
+		return err
+	}
+	valid, err := pubkey.Verify(ser, reply.Signature)
+	if err != nil || !valid {
+		return errors.New("Signature on reply failed to verify")
+	}
+	return nil
+}
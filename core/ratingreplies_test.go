@@ -0,0 +1,80 @@
+package core
+
+import (
+	"strings"
+	"testing"
+
+	libp2p "gx/ipfs/QmPGxZ1DP2w45WcogpW1h43BvseXbfke9N91qotpoQcUeS/go-libp2p-crypto"
+	peer "gx/ipfs/QmWUswjn261LSyVxWAEpMVtPdy8zmKBJJfBpG3Qdpa8ZsE/go-libp2p-peer"
+
+	"github.com/OpenBazaar/openbazaar-go/pb"
+)
+
+const repliedRating = "QmYwAPJzv5CZsnA625s3Xf2nemtYgPpHdWEz79ojWnPbdG"
+
+func TestValidateRatingReply(t *testing.T) {
+	if err := ValidateRatingReply("Sorry it arrived late"); err != nil {
+		t.Error(err)
+	}
+	if err := ValidateRatingReply(""); err == nil {
+		t.Error("Expected an empty reply to fail validation")
+	}
+	if err := ValidateRatingReply(strings.Repeat("a", ReplyMaxCharacters+1)); err == nil {
+		t.Error("Expected a reply over the max length to fail validation")
+	}
+}
+
+func TestSignRatingReply(t *testing.T) {
+	priv, pub, err := libp2p.GenerateKeyPair(libp2p.Ed25519, 256)
+	if err != nil {
+		t.Fatal(err)
+	}
+	pid, err := peer.IDFromPublicKey(pub)
+	if err != nil {
+		t.Fatal(err)
+	}
+	reply := &pb.RatingReply{
+		RatingHash: repliedRating,
+		VendorID:   pid.Pretty(),
+		Reply:      "Sorry it arrived late, we have changed couriers.",
+		Version:    2,
+	}
+	if err := signRatingReply(reply, priv); err != nil {
+		t.Fatal(err)
+	}
+	if err := VerifyRatingReply(reply, pid.Pretty(), repliedRating); err != nil {
+		t.Error(err)
+	}
+	if err := VerifyRatingReply(reply, reportedStore, repliedRating); err == nil {
+		t.Error("Expected a reply fetched from another vendor to fail verification")
+	}
+	if err := VerifyRatingReply(reply, pid.Pretty(), "QmOtherRating"); err == nil {
+		t.Error("Expected a reply listed with another rating to fail verification")
+	}
+	reply.Version = 1
+	if err := VerifyRatingReply(reply, pid.Pretty(), repliedRating); err == nil {
+		t.Error("Expected a modified reply to fail verification")
+	}
+}
+
+func TestCheckReplyRevisions(t *testing.T) {
+	reply := &pb.RatingReply{RatingHash: repliedRating, Version: 1}
+	if err := checkReplyRevisions(reply, nil); err != nil {
+		t.Error(err)
+	}
+	revisions := []string{"QmFirstRevision", "QmSecondRevision"}
+	reply.Version = 3
+	reply.Previous = "QmSecondRevision"
+	if err := checkReplyRevisions(reply, revisions); err != nil {
+		t.Error(err)
+	}
+	reply.Previous = "QmFirstRevision"
+	if err := checkReplyRevisions(reply, revisions); err == nil {
+		t.Error("Expected a reply which doesn't replace the last revision to fail")
+	}
+	reply.Previous = "QmSecondRevision"
+	reply.Version = 2
+	if err := checkReplyRevisions(reply, revisions); err == nil {
+		t.Error("Expected a reply with the wrong version to fail")
+	}
+}
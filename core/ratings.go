@@ -0,0 +1,298 @@
+package core
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/json"
+	"errors"
+	"io/ioutil"
+	"os"
+	"path"
+
+	libp2p "gx/ipfs/QmPGxZ1DP2w45WcogpW1h43BvseXbfke9N91qotpoQcUeS/go-libp2p-crypto"
+	peer "gx/ipfs/QmWUswjn261LSyVxWAEpMVtPdy8zmKBJJfBpG3Qdpa8ZsE/go-libp2p-peer"
+
+	"github.com/OpenBazaar/jsonpb"
+	"github.com/OpenBazaar/openbazaar-go/ipfs"
+	"github.com/OpenBazaar/openbazaar-go/pb"
+	"github.com/btcsuite/btcd/btcec"
+	"github.com/golang/protobuf/proto"
+	ipnspath "github.com/ipfs/go-ipfs/path"
+)
+
+// Average score in each rating dimension
+type RatingAverages struct {
+	Overall         float32 `json:"overall"`
+	Quality         float32 `json:"quality"`
+	Description     float32 `json:"description"`
+	DeliverySpeed   float32 `json:"deliverySpeed"`
+	CustomerService float32 `json:"customerService"`
+}
+
+// A rating which verified along with the vendor's reply, if any, and the hashes of the
+// reply's earlier revisions
+type VerifiedRating struct {
+	Hash           string
+	Rating         *pb.OrderCompletion_Rating
+	Reply          *pb.RatingReply
+	ReplyRevisions []string
+}
+
+// The verified ratings of a store or one of its listings along with their aggregates
+type RatingsSummary struct {
+	PeerId    string           `json:"peerId"`
+	Slug      string           `json:"slug,omitempty"`
+	Count     int              `json:"count"`
+	Average   RatingAverages   `json:"average"`
+	Histogram [RatingMax]int   `json:"histogram"` // Number of overall ratings of 1 to 5 stars
+	Invalid   int              `json:"invalid"`   // Ratings left out as they failed to verify
+	Ratings   []VerifiedRating `json:"-"`
+}
+
+// Fetch the ratings a peer has published, optionally only those for one listing,
+// and aggregate the ones which verify. Verified ratings are cached by hash so they
+// are only fetched and checked once. As the index is not signed, a rating listed more
+// than once or under another hash with the same rating key is only counted once.
+func (n *OpenBazaarNode) GetRatings(peerId, slug string) (*RatingsSummary, error) {
+	index, err := n.fetchRatingIndex(peerId)
+	if err != nil {
+		return nil, err
+	}
+	var ratings []*pb.OrderCompletion_Rating
+	var verified []VerifiedRating
+	invalid := 0
+	seenHashes := make(map[string]bool)
+	seenKeys := make(map[string]bool)
+	for _, entry := range index {
+		if slug != "" && entry.Slug != slug {
+			continue
+		}
+		if seenHashes[entry.Hash] {
+			continue
+		}
+		seenHashes[entry.Hash] = true
+		rating, err := n.Datastore.Ratings().Get(entry.Hash)
+		if err == nil {
+			err = checkRatingOwner(rating, n.ratingOwner(rating, peerId), entry.Slug)
+		} else {
+			rating, err = n.fetchRating(entry, peerId)
+		}
+		if err != nil {
+			log.Warningf("Skipping rating %s from %s: %s", entry.Hash, peerId, err.Error())
+			invalid++
+			continue
+		}
+		if seenKeys[string(rating.RatingData.RatingKey)] {
+			log.Warningf("Skipping rating %s from %s: rating key was already used", entry.Hash, peerId)
+			invalid++
+			continue
+		}
+		seenKeys[string(rating.RatingData.RatingKey)] = true
+		ratings = append(ratings, rating)
+
+		// A bad reply doesn't invalidate the rating
+		vr := VerifiedRating{Hash: entry.Hash, Rating: rating}
+		if entry.Reply != "" {
+			vr.Reply, err = n.fetchRatingReply(entry, peerId)
+			if err != nil {
+				log.Warningf("Skipping reply to rating %s from %s: %s", entry.Hash, peerId, err.Error())
+			} else {
+				vr.ReplyRevisions = entry.ReplyRevisions
+			}
+		}
+		verified = append(verified, vr)
+	}
+	summary := summarizeRatings(ratings)
+	summary.Ratings = verified
+	summary.PeerId = peerId
+	summary.Slug = slug
+	summary.Invalid = invalid
+	return summary, nil
+}
+
+// Read our own ratings index from disk or resolve another peer's
+func (n *OpenBazaarNode) fetchRatingIndex(peerId string) ([]ratingShort, error) {
+	var index []ratingShort
+	var b []byte
+	var err error
+	if peerId == n.IpfsNode.Identity.Pretty() {
+		b, err = ioutil.ReadFile(path.Join(n.RepoPath, "root", "ratings", "index.json"))
+		if os.IsNotExist(err) {
+			return index, nil
+		}
+	} else {
+		b, err = ipfs.ResolveThenCat(n.Context, ipnspath.FromString(path.Join(peerId, "ratings", "index.json")))
+	}
+	if err != nil {
+		return nil, err
+	}
+	if err := json.Unmarshal(b, &index); err != nil {
+		return nil, err
+	}
+	return index, nil
+}
+
+// Cat a rating, verify it and add it to the cache
+func (n *OpenBazaarNode) fetchRating(entry ratingShort, peerId string) (*pb.OrderCompletion_Rating, error) {
+	b, err := ipfs.Cat(n.Context, entry.Hash)
+	if err != nil {
+		return nil, err
+	}
+	rating := new(pb.OrderCompletion_Rating)
+	if err := jsonpb.UnmarshalString(string(b), rating); err != nil {
+		return nil, err
+	}
+	owner := n.ratingOwner(rating, peerId)
+	if err := VerifyRating(rating, owner); err != nil {
+		return nil, err
+	}
+	if err := checkRatingOwner(rating, owner, entry.Slug); err != nil {
+		return nil, err
+	}
+	if err := n.Datastore.Ratings().Put(entry.Hash, peerId, rating); err != nil {
+		return nil, err
+	}
+	return rating, nil
+}
+
+// The ID a rating in a peer's index should have been left for. Ratings left before the
+// peer rotated its identity key name its predecessor ID.
+func (n *OpenBazaarNode) ratingOwner(rating *pb.OrderCompletion_Rating, peerId string) string {
+	rd := rating.RatingData
+	if rd == nil || rd.VendorID == nil || rd.VendorID.PeerID == peerId {
+		return peerId
+	}
+	if peerId == n.IpfsNode.Identity.Pretty() {
+		if n.isOwnID(rd.VendorID.PeerID) {
+			return rd.VendorID.PeerID
+		}
+	} else if n.IsSamePeer(rd.VendorID.PeerID, peerId) {
+		return rd.VendorID.PeerID
+	}
+	return peerId
+}
+
+// Check a rating listed in a peer's index was left for that peer and listing. The
+// index is not signed so it could list ratings belonging to someone else.
+func checkRatingOwner(rating *pb.OrderCompletion_Rating, peerId, slug string) error {
+	if rating.RatingData.VendorID.PeerID != peerId {
+		return errors.New("Rating was left for a different vendor")
+	}
+	if rating.RatingData.VendorSig.Metadata.ListingSlug != slug {
+		return errors.New("Rating was left for a different listing")
+	}
+	return nil
+}
+
+// Verify the signature chain on a rating. The vendor signed the buyer's rating key
+// for the listing, the buyer signed the rating with that key and, if the buyer won
+// a dispute, the moderator signed the rating with their escrow key.
+func VerifyRating(rating *pb.OrderCompletion_Rating, peerId string) error {
+	rd := rating.RatingData
+	if rd == nil || rd.VendorID == nil || rd.VendorID.Pubkeys == nil || rd.VendorSig == nil || rd.VendorSig.Metadata == nil {
+		return errors.New("Rating is missing required fields")
+	}
+
+	// Vendor's signature on the rating key
+	if rd.VendorID.PeerID != peerId {
+		return errors.New("Rating was left for a different vendor")
+	}
+	vendorKey, err := libp2p.UnmarshalPublicKey(rd.VendorID.Pubkeys.Identity)
+	if err != nil {
+		return err
+	}
+	pid, err := peer.IDFromPublicKey(vendorKey)
+	if err != nil {
+		return err
+	}
+	if pid.Pretty() != peerId {
+		return errors.New("Public key in rating does not match the vendor's peer ID")
+	}
+	if !bytes.Equal(rd.VendorSig.Metadata.RatingKey, rd.RatingKey) {
+		return errors.New("Rating key was not signed by the vendor")
+	}
+	ser, err := proto.Marshal(rd.VendorSig.Metadata)
+	if err != nil {
+		return err
+	}
+	valid, err := vendorKey.Verify(ser, rd.VendorSig.Signature)
+	if err != nil || !valid {
+		return errors.New("Invalid vendor signature on rating")
+	}
+
+	// Buyer's signature on the rating
+	if err := verifyECDSASignature(rd.RatingKey, rating.Signature, rd); err != nil {
+		return errors.New("Invalid rating signature on rating")
+	}
+
+	// Moderator's signature on the rating, which covers everything but itself
+	if len(rd.ModeratorSig) > 0 {
+		if len(rd.VendorSig.Metadata.ModeratorKey) == 0 {
+			return errors.New("Rating has a moderator signature but the order was not moderated")
+		}
+		unsigned := *rd
+		unsigned.ModeratorSig = nil
+		if err := verifyECDSASignature(rd.VendorSig.Metadata.ModeratorKey, rd.ModeratorSig, &unsigned); err != nil {
+			return errors.New("Invalid moderator signature on rating")
+		}
+	}
+
+	return validateRatingRanges(rd)
+}
+
+func verifyECDSASignature(key, sig []byte, msg proto.Message) error {
+	pubkey, err := btcec.ParsePubKey(key, btcec.S256())
+	if err != nil {
+		return err
+	}
+	signature, err := btcec.ParseSignature(sig, btcec.S256())
+	if err != nil {
+		return err
+	}
+	ser, err := proto.Marshal(msg)
+	if err != nil {
+		return err
+	}
+	hashed := sha256.Sum256(ser)
+	if !signature.Verify(hashed[:], pubkey) {
+		return errors.New("Signature failed to verify")
+	}
+	return nil
+}
+
+func validateRatingRanges(rd *pb.OrderCompletion_Rating_RatingData) error {
+	for _, score := range []uint32{rd.Overall, rd.Quality, rd.Description, rd.DeliverySpeed, rd.CustomerService} {
+		if score < RatingMin || score > RatingMax {
+			return errors.New("Rating not within valid range")
+		}
+	}
+	return nil
+}
+
+func summarizeRatings(ratings []*pb.OrderCompletion_Rating) *RatingsSummary {
+	summary := &RatingsSummary{
+		Count: len(ratings),
+	}
+	if len(ratings) == 0 {
+		return summary
+	}
+	var total [5]uint32
+	for _, rating := range ratings {
+		rd := rating.RatingData
+		total[0] += rd.Overall
+		total[1] += rd.Quality
+		total[2] += rd.Description
+		total[3] += rd.DeliverySpeed
+		total[4] += rd.CustomerService
+		summary.Histogram[rd.Overall-RatingMin]++
+	}
+	count := float32(len(ratings))
+	summary.Average = RatingAverages{
+		Overall:         float32(total[0]) / count,
+		Quality:         float32(total[1]) / count,
+		Description:     float32(total[2]) / count,
+		DeliverySpeed:   float32(total[3]) / count,
+		CustomerService: float32(total[4]) / count,
+	}
+	return summary
+}
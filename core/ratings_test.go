@@ -0,0 +1,285 @@
+package core
+
+import (
+	"crypto/sha256"
+	"encoding/json"
+	"errors"
+	"io/ioutil"
+	"os"
+	"path"
+	"testing"
+
+	libp2p "gx/ipfs/QmPGxZ1DP2w45WcogpW1h43BvseXbfke9N91qotpoQcUeS/go-libp2p-crypto"
+	peer "gx/ipfs/QmWUswjn261LSyVxWAEpMVtPdy8zmKBJJfBpG3Qdpa8ZsE/go-libp2p-peer"
+
+	"github.com/OpenBazaar/openbazaar-go/pb"
+	"github.com/OpenBazaar/openbazaar-go/repo"
+	"github.com/btcsuite/btcd/btcec"
+	"github.com/golang/protobuf/proto"
+	"github.com/ipfs/go-ipfs/core"
+)
+
+// A datastore holding only cached ratings
+type ratingsDatastore struct {
+	repo.Datastore
+	cache ratingCache
+}
+
+func (d *ratingsDatastore) Ratings() repo.Ratings {
+	return d.cache
+}
+
+type ratingCache map[string]*pb.OrderCompletion_Rating
+
+func (c ratingCache) Put(hash, peerID string, rating *pb.OrderCompletion_Rating) error {
+	c[hash] = rating
+	return nil
+}
+
+func (c ratingCache) Get(hash string) (*pb.OrderCompletion_Rating, error) {
+	rating, ok := c[hash]
+	if !ok {
+		return nil, errors.New("Rating not cached")
+	}
+	return rating, nil
+}
+
+func signECDSA(t *testing.T, key *btcec.PrivateKey, msg proto.Message) []byte {
+	ser, err := proto.Marshal(msg)
+	if err != nil {
+		t.Fatal(err)
+	}
+	hashed := sha256.Sum256(ser)
+	sig, err := key.Sign(hashed[:])
+	if err != nil {
+		t.Fatal(err)
+	}
+	return sig.Serialize()
+}
+
+// Build a rating signed by a new vendor and buyer, returning it with the vendor's peer ID
+func newSignedRating(t *testing.T) (*pb.OrderCompletion_Rating, string) {
+	vendorPriv, _, err := libp2p.GenerateKeyPair(libp2p.Ed25519, 256)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return newSignedRatingForVendor(t, vendorPriv)
+}
+
+// Build a rating signed by the vendor and a new buyer
+func newSignedRatingForVendor(t *testing.T, vendorPriv libp2p.PrivKey) (*pb.OrderCompletion_Rating, string) {
+	vendorPub := vendorPriv.GetPublic()
+	pid, err := peer.IDFromPublicKey(vendorPub)
+	if err != nil {
+		t.Fatal(err)
+	}
+	vendorPubBytes, err := vendorPub.Bytes()
+	if err != nil {
+		t.Fatal(err)
+	}
+	ratingKey, err := btcec.NewPrivateKey(btcec.S256())
+	if err != nil {
+		t.Fatal(err)
+	}
+	metadata := &pb.RatingSignature_TransactionMetadata{
+		ListingSlug: "ron-swanson-tshirt",
+		RatingKey:   ratingKey.PubKey().SerializeCompressed(),
+	}
+	ser, err := proto.Marshal(metadata)
+	if err != nil {
+		t.Fatal(err)
+	}
+	vendorSig, err := vendorPriv.Sign(ser)
+	if err != nil {
+		t.Fatal(err)
+	}
+	rating := &pb.OrderCompletion_Rating{
+		RatingData: &pb.OrderCompletion_Rating_RatingData{
+			RatingKey:       metadata.RatingKey,
+			VendorID:        &pb.ID{PeerID: pid.Pretty(), Pubkeys: &pb.ID_Pubkeys{Identity: vendorPubBytes}},
+			VendorSig:       &pb.RatingSignature{Metadata: metadata, Signature: vendorSig},
+			Overall:         4,
+			Quality:         5,
+			Description:     4,
+			DeliverySpeed:   3,
+			CustomerService: 5,
+			Review:          "Great shirt",
+		},
+	}
+	rating.Signature = signECDSA(t, ratingKey, rating.RatingData)
+	return rating, pid.Pretty()
+}
+
+func TestVerifyRating(t *testing.T) {
+	rating, vendor := newSignedRating(t)
+	if err := VerifyRating(rating, vendor); err != nil {
+		t.Error(err)
+	}
+	if err := VerifyRating(rating, reportedStore); err == nil {
+		t.Error("Expected a rating listed by another peer to fail verification")
+	}
+	rating.RatingData.Overall = 5
+	if err := VerifyRating(rating, vendor); err == nil {
+		t.Error("Expected a modified rating to fail verification")
+	}
+
+	rating, vendor = newSignedRating(t)
+	rating.RatingData.VendorSig.Metadata.ListingSlug = "another-listing"
+	if err := VerifyRating(rating, vendor); err == nil {
+		t.Error("Expected a rating with modified vendor metadata to fail verification")
+	}
+}
+
+func TestVerifyRatingModeratorSignature(t *testing.T) {
+	rating, vendor := newSignedRating(t)
+	rating.RatingData.ModeratorSig = []byte("sig")
+	if err := VerifyRating(rating, vendor); err == nil {
+		t.Error("Expected a moderator signature on an unmoderated order to fail verification")
+	}
+
+	moderatorKey, err := btcec.NewPrivateKey(btcec.S256())
+	if err != nil {
+		t.Fatal(err)
+	}
+	rating, vendor = newSignedRating(t)
+	rating.RatingData.VendorSig.Metadata.ModeratorKey = moderatorKey.PubKey().SerializeCompressed()
+	if err := VerifyRating(rating, vendor); err == nil {
+		t.Error("Expected a rating with modified vendor metadata to fail verification")
+	}
+}
+
+func TestSummarizeRatings(t *testing.T) {
+	newRating := func(overall, quality uint32) *pb.OrderCompletion_Rating {
+		return &pb.OrderCompletion_Rating{
+			RatingData: &pb.OrderCompletion_Rating_RatingData{
+				Overall:         overall,
+				Quality:         quality,
+				Description:     3,
+				DeliverySpeed:   4,
+				CustomerService: 5,
+			},
+		}
+	}
+	summary := summarizeRatings([]*pb.OrderCompletion_Rating{newRating(5, 4), newRating(3, 2), newRating(5, 3)})
+	if summary.Count != 3 {
+		t.Error("Returned incorrect rating count")
+	}
+	if summary.Average.Overall != float32(13)/3 || summary.Average.Quality != 3 || summary.Average.Description != 3 ||
+		summary.Average.DeliverySpeed != 4 || summary.Average.CustomerService != 5 {
+		t.Error("Returned incorrect rating averages")
+	}
+	if summary.Histogram != [RatingMax]int{0, 0, 1, 0, 2} {
+		t.Error("Returned incorrect rating histogram")
+	}
+	if summarizeRatings(nil).Count != 0 {
+		t.Error("Expected no ratings to summarize to zero")
+	}
+}
+
+func TestGetRatingsSkipsDuplicates(t *testing.T) {
+	vendorPriv, _, err := libp2p.GenerateKeyPair(libp2p.Ed25519, 256)
+	if err != nil {
+		t.Fatal(err)
+	}
+	pid, err := peer.IDFromPrivateKey(vendorPriv)
+	if err != nil {
+		t.Fatal(err)
+	}
+	rating, _ := newSignedRatingForVendor(t, vendorPriv)
+	other, _ := newSignedRatingForVendor(t, vendorPriv)
+	repoPath, err := ioutil.TempDir("", "ratings")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(repoPath)
+	if err := os.MkdirAll(path.Join(repoPath, "root", "ratings"), os.ModePerm); err != nil {
+		t.Fatal(err)
+	}
+	// The index lists one rating twice and a copy of it under another hash
+	index, err := json.Marshal([]ratingShort{
+		{Hash: "QmRating", Slug: "ron-swanson-tshirt"},
+		{Hash: "QmRating", Slug: "ron-swanson-tshirt"},
+		{Hash: "QmRatingCopy", Slug: "ron-swanson-tshirt"},
+		{Hash: "QmOtherRating", Slug: "ron-swanson-tshirt"},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := ioutil.WriteFile(path.Join(repoPath, "root", "ratings", "index.json"), index, os.ModePerm); err != nil {
+		t.Fatal(err)
+	}
+	n := &OpenBazaarNode{
+		IpfsNode: &core.IpfsNode{Identity: pid},
+		RepoPath: repoPath,
+		Datastore: &ratingsDatastore{cache: ratingCache{
+			"QmRating":      rating,
+			"QmRatingCopy":  proto.Clone(rating).(*pb.OrderCompletion_Rating),
+			"QmOtherRating": other,
+		}},
+	}
+
+	summary, err := n.GetRatings(pid.Pretty(), "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if summary.Count != 2 || len(summary.Ratings) != 2 {
+		t.Errorf("Expected 2 ratings, got %d", summary.Count)
+	}
+	if summary.Invalid != 1 {
+		t.Errorf("Expected the copy of the rating to be left out as invalid, got %d invalid", summary.Invalid)
+	}
+}
+
+func TestGetRatingsAfterIdentityRotation(t *testing.T) {
+	predecessorPriv, _, err := libp2p.GenerateKeyPair(libp2p.Ed25519, 256)
+	if err != nil {
+		t.Fatal(err)
+	}
+	successorPriv, _, err := libp2p.GenerateKeyPair(libp2p.Ed25519, 256)
+	if err != nil {
+		t.Fatal(err)
+	}
+	successor, err := peer.IDFromPrivateKey(successorPriv)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// The rating was left before the vendor rotated its identity key
+	rating, predecessor := newSignedRatingForVendor(t, predecessorPriv)
+	repoPath, err := ioutil.TempDir("", "ratings")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(repoPath)
+	if err := os.MkdirAll(path.Join(repoPath, "root", "ratings"), os.ModePerm); err != nil {
+		t.Fatal(err)
+	}
+	index, err := json.Marshal([]ratingShort{{Hash: "QmRating", Slug: "ron-swanson-tshirt"}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := ioutil.WriteFile(path.Join(repoPath, "root", "ratings", "index.json"), index, os.ModePerm); err != nil {
+		t.Fatal(err)
+	}
+	chain := &pb.IdentityChain{Successions: []*pb.IdentitySuccession{{PredecessorID: predecessor, SuccessorID: successor.Pretty()}}}
+	if err := writeIdentityChain(repoPath, chain); err != nil {
+		t.Fatal(err)
+	}
+	// The rating was verified against our predecessor ID and cached when it was first fetched
+	n := &OpenBazaarNode{
+		IpfsNode:  &core.IpfsNode{Identity: successor},
+		RepoPath:  repoPath,
+		Datastore: &ratingsDatastore{cache: ratingCache{"QmRating": rating}},
+	}
+	if err := VerifyRating(rating, n.ratingOwner(rating, successor.Pretty())); err != nil {
+		t.Fatal(err)
+	}
+	for i := 0; i < 2; i++ {
+		summary, err := n.GetRatings(successor.Pretty(), "")
+		if err != nil {
+			t.Fatal(err)
+		}
+		if summary.Count != 1 || summary.Invalid != 0 {
+			t.Errorf("Query %d: expected the rating left for our predecessor ID to be counted", i+1)
+		}
+	}
+}
@@ -101,15 +101,22 @@ func (n *OpenBazaarNode) RefundOrder(contract *pb.RicardianContract, records []*
 				outValue += r.Value
 			}
 		}
-		refundAddr, err := btcutil.DecodeAddress(contract.BuyerOrder.RefundAddress, n.Wallet.Params())
-		if err != nil {
-			return err
-		}
-		_, err = n.Wallet.Spend(outValue, refundAddr, spvwallet.NORMAL)
-		if err != nil {
-			return err
+		// Orders fully covered by store credit have nothing to refund on chain
+		if outValue > 0 {
+			refundAddr, err := btcutil.DecodeAddress(contract.BuyerOrder.RefundAddress, n.Wallet.Params())
+			if err != nil {
+				return err
+			}
+			_, err = n.Wallet.Spend(outValue, refundAddr, spvwallet.NORMAL)
+			if err != nil {
+				return err
+			}
 		}
 	}
+	// Return any store credit applied to the order to the voucher
+	if err := n.RestoreCredit(contract.BuyerOrder); err != nil {
+		log.Error(err)
+	}
 	contract.Refund = refundMsg
 	contract, err = n.SignRefund(contract)
 	if err != nil {
@@ -135,6 +142,7 @@ func (n *OpenBazaarNode) SignRefund(contract *pb.RicardianContract) (*pb.Ricardi
 		return contract, err
 	}
 	s.SignatureBytes = guidSig
+	s.Successions = n.identitySuccessions()
 	contract.Signatures = append(contract.Signatures, s)
 	return contract, nil
 }
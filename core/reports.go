@@ -0,0 +1,420 @@
+package core
+
+import (
+	"crypto/sha256"
+	"errors"
+	"fmt"
+	libp2p "gx/ipfs/QmPGxZ1DP2w45WcogpW1h43BvseXbfke9N91qotpoQcUeS/go-libp2p-crypto"
+	peer "gx/ipfs/QmWUswjn261LSyVxWAEpMVtPdy8zmKBJJfBpG3Qdpa8ZsE/go-libp2p-peer"
+	mh "gx/ipfs/QmbZ6Cee2uHjG7hf19qLHppgKDRtaG4CVtMzdmK9VCVqLu/go-multihash"
+	"io/ioutil"
+	"os"
+	"path"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/OpenBazaar/jsonpb"
+	"github.com/OpenBazaar/openbazaar-go/api/notifications"
+	"github.com/OpenBazaar/openbazaar-go/ipfs"
+	"github.com/OpenBazaar/openbazaar-go/pb"
+	"github.com/golang/protobuf/proto"
+	"github.com/golang/protobuf/ptypes"
+	ipnspath "github.com/ipfs/go-ipfs/path"
+)
+
+// How often the blocklists of the moderators we subscribe to are fetched
+const BlocklistUpdatePeriod = time.Hour * 6
+
+var ErrReportsNotAccepted = errors.New("Moderator does not accept reports")
+
+var ErrBlocklistEntryNotFound = errors.New("Blocklist entry not found")
+
+// The last blocklist fetched from each moderator, used while their blocklist can't be
+// fetched
+var (
+	lastBlocklists   = make(map[string]*pb.Blocklist)
+	lastBlocklistsMu sync.Mutex
+)
+
+// Sign an abuse report for a listing or store and send it to each of the given
+// moderators that accepts reports. Returns the report and the moderators it was
+// sent to.
+func (n *OpenBazaarNode) SendReport(moderators []string, listingHash, peerID string, reason pb.Report_Reason, evidence string) (*pb.Report, []string, error) {
+	report := &pb.Report{
+		ReporterID:  n.IpfsNode.Identity.Pretty(),
+		ListingHash: listingHash,
+		PeerID:      peerID,
+		Reason:      reason,
+		Evidence:    evidence,
+	}
+	if err := validateReport(report); err != nil {
+		return nil, nil, err
+	}
+	if len(moderators) == 0 {
+		return nil, nil, errors.New("At least one moderator must be given")
+	}
+
+	ts, err := ptypes.TimestampProto(time.Now())
+	if err != nil {
+		return nil, nil, err
+	}
+	report.Timestamp = ts
+	report.Pubkey, err = n.IpfsNode.PrivateKey.GetPublic().Bytes()
+	if err != nil {
+		return nil, nil, err
+	}
+	h := sha256.Sum256([]byte(report.ReporterID + listingHash + peerID + ptypes.TimestampString(ts)))
+	encoded, err := mh.Encode(h[:], mh.SHA2_256)
+	if err != nil {
+		return nil, nil, err
+	}
+	reportId, err := mh.Cast(encoded)
+	if err != nil {
+		return nil, nil, err
+	}
+	report.ReportId = reportId.B58String()
+	ser, err := proto.Marshal(report)
+	if err != nil {
+		return nil, nil, err
+	}
+	report.Signature, err = n.IpfsNode.PrivateKey.Sign(ser)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var sentTo []string
+	for _, moderator := range moderators {
+		profile, err := n.FetchProfile(moderator)
+		if err != nil || !profile.Moderator || profile.ModeratorInfo == nil || !profile.ModeratorInfo.AcceptsReports {
+			continue
+		}
+		if err := n.SendReportMessage(moderator, report); err != nil {
+			log.Errorf("Error sending report to %s: %s", moderator, err.Error())
+			continue
+		}
+		sentTo = append(sentTo, moderator)
+	}
+	if len(sentTo) == 0 {
+		return nil, nil, ErrReportsNotAccepted
+	}
+	return report, sentTo, nil
+}
+
+// Save a report sent to our report inbox
+func (n *OpenBazaarNode) HandleReport(peerId string, report *pb.Report) error {
+	if report.ReporterID != peerId {
+		return errors.New("Report was not sent by its reporter")
+	}
+	if err := validateReport(report); err != nil {
+		return err
+	}
+	if err := VerifyReport(report); err != nil {
+		return err
+	}
+	profile, err := n.GetProfile()
+	if err != nil || !profile.Moderator || profile.ModeratorInfo == nil || !profile.ModeratorInfo.AcceptsReports {
+		return ErrReportsNotAccepted
+	}
+	if err := n.Datastore.Reports().Put(report); err != nil {
+		return err
+	}
+	notif := notifications.ReportNotification{
+		ReportId:    report.ReportId,
+		ReporterId:  report.ReporterID,
+		ListingHash: report.ListingHash,
+		PeerId:      report.PeerID,
+		Reason:      reasonString(report.Reason),
+	}
+	n.Broadcast <- notif
+	n.Datastore.Notifications().Put(notif, time.Now())
+	return nil
+}
+
+// Checks the report was signed by the peer it claims to be from
+func VerifyReport(report *pb.Report) error {
+	if report.Timestamp == nil {
+		return errors.New("Invalid timestamp")
+	}
+	pubkey, err := libp2p.UnmarshalPublicKey(report.Pubkey)
+	if err != nil {
+		return err
+	}
+	pid, err := peer.IDFromPublicKey(pubkey)
+	if err != nil {
+		return err
+	}
+	if pid.Pretty() != report.ReporterID {
+		return errors.New("Public key in report does not match reported peer ID")
+	}
+	unsigned := *report
+	unsigned.Signature = nil
+	ser, err := proto.Marshal(&unsigned)
+	if err != nil {
+		return err
+	}
+	valid, err := pubkey.Verify(ser, report.Signature)
+	if err != nil || !valid {
+		return errors.New("Signature on report failed to verify")
+	}
+	return nil
+}
+
+// Return our blocklist. An empty blocklist is returned if we have not published one.
+func (n *OpenBazaarNode) GetBlocklist() (*pb.Blocklist, error) {
+	blocklistPath := path.Join(n.RepoPath, "root", "blocklist")
+	file, err := ioutil.ReadFile(blocklistPath)
+	if os.IsNotExist(err) {
+		return &pb.Blocklist{ModeratorID: n.IpfsNode.Identity.Pretty()}, nil
+	} else if err != nil {
+		return nil, err
+	}
+	blocklist := new(pb.Blocklist)
+	if err := jsonpb.UnmarshalString(string(file), blocklist); err != nil {
+		return nil, err
+	}
+	return blocklist, nil
+}
+
+// Flag a listing or store in our blocklist. An existing entry for the same listing
+// or store is replaced. The caller is responsible for republishing.
+func (n *OpenBazaarNode) AddBlocklistEntry(listingHash, peerID string, reason pb.Report_Reason) (*pb.Blocklist, error) {
+	if err := ValidateReportTarget(listingHash, peerID); err != nil {
+		return nil, err
+	}
+	profile, err := n.GetProfile()
+	if err != nil || !profile.Moderator {
+		return nil, errors.New("Only moderators can publish a blocklist")
+	}
+	blocklist, err := n.GetBlocklist()
+	if err != nil {
+		return nil, err
+	}
+	ts, err := ptypes.TimestampProto(time.Now())
+	if err != nil {
+		return nil, err
+	}
+	entry := &pb.Blocklist_Entry{
+		ListingHash: listingHash,
+		PeerID:      peerID,
+		Reason:      reason,
+		Added:       ts,
+	}
+	entries := []*pb.Blocklist_Entry{entry}
+	for _, e := range blocklist.Entries {
+		if e.ListingHash != listingHash || e.PeerID != peerID {
+			entries = append(entries, e)
+		}
+	}
+	blocklist.Entries = entries
+	if err := n.saveBlocklist(blocklist); err != nil {
+		return nil, err
+	}
+	return blocklist, nil
+}
+
+// Remove the entries for a listing hash or store from our blocklist. The caller is
+// responsible for republishing.
+func (n *OpenBazaarNode) RemoveBlocklistEntry(target string) error {
+	blocklist, err := n.GetBlocklist()
+	if err != nil {
+		return err
+	}
+	var entries []*pb.Blocklist_Entry
+	for _, e := range blocklist.Entries {
+		if e.ListingHash == target || (e.ListingHash == "" && e.PeerID == target) {
+			continue
+		}
+		entries = append(entries, e)
+	}
+	if len(entries) == len(blocklist.Entries) {
+		return ErrBlocklistEntryNotFound
+	}
+	blocklist.Entries = entries
+	return n.saveBlocklist(blocklist)
+}
+
+// Fetch a moderator's blocklist and check it was signed by the moderator
+func (n *OpenBazaarNode) FetchBlocklist(peerId string) (*pb.Blocklist, error) {
+	b, err := ipfs.ResolveThenCat(n.Context, ipnspath.FromString(path.Join(peerId, "blocklist")))
+	if err != nil {
+		return nil, err
+	}
+	blocklist := new(pb.Blocklist)
+	if err := jsonpb.UnmarshalString(string(b), blocklist); err != nil {
+		return nil, err
+	}
+	if err := VerifyBlocklist(blocklist, peerId); err != nil {
+		return nil, err
+	}
+	return blocklist, nil
+}
+
+// Fetch the blocklists of the moderators in our settings and feed the flagged stores
+// and listings into the ban manager
+func (n *OpenBazaarNode) UpdateBlocklists() {
+	settings, err := n.Datastore.Settings().Get()
+	if err != nil || settings.BlocklistSubscriptions == nil {
+		n.BanManager.SetBlocklist(nil, nil)
+		return
+	}
+	var peerIds []peer.ID
+	var listingHashes []string
+	for _, moderator := range *settings.BlocklistSubscriptions {
+		blocklist, err := n.FetchBlocklist(moderator)
+		if err != nil {
+			log.Errorf("Error fetching blocklist from %s: %s", moderator, err.Error())
+		}
+		blocklist = lastGoodBlocklist(moderator, blocklist, err)
+		if blocklist == nil {
+			continue
+		}
+		for _, e := range blocklist.Entries {
+			if e.ListingHash != "" {
+				listingHashes = append(listingHashes, e.ListingHash)
+				continue
+			}
+			pid, err := peer.IDB58Decode(e.PeerID)
+			if err != nil {
+				continue
+			}
+			peerIds = append(peerIds, pid)
+		}
+	}
+	n.BanManager.SetBlocklist(peerIds, listingHashes)
+}
+
+// Remember a blocklist which was fetched or return the last one fetched from the
+// moderator if fetching failed
+func lastGoodBlocklist(moderator string, blocklist *pb.Blocklist, err error) *pb.Blocklist {
+	lastBlocklistsMu.Lock()
+	defer lastBlocklistsMu.Unlock()
+	if err != nil {
+		return lastBlocklists[moderator]
+	}
+	lastBlocklists[moderator] = blocklist
+	return blocklist
+}
+
+// Keep the blocklists we subscribe to up to date. Intended to be run in its own goroutine.
+func (n *OpenBazaarNode) StartBlocklistUpdates() {
+	n.UpdateBlocklists()
+	tick := time.NewTicker(BlocklistUpdatePeriod)
+	defer tick.Stop()
+	for range tick.C {
+		n.UpdateBlocklists()
+	}
+}
+
+// Checks the blocklist was signed by the moderator who published it
+func VerifyBlocklist(blocklist *pb.Blocklist, peerID string) error {
+	if blocklist.ModeratorID != peerID {
+		return errors.New("Blocklist was not published by this moderator")
+	}
+	pubkey, err := libp2p.UnmarshalPublicKey(blocklist.Pubkey)
+	if err != nil {
+		return err
+	}
+	pid, err := peer.IDFromPublicKey(pubkey)
+	if err != nil {
+		return err
+	}
+	if pid.Pretty() != peerID {
+		return errors.New("Public key in blocklist does not match the moderator's peer ID")
+	}
+	unsigned := *blocklist
+	unsigned.Signature = nil
+	ser, err := proto.Marshal(&unsigned)
+	if err != nil {
+		return err
+	}
+	valid, err := pubkey.Verify(ser, blocklist.Signature)
+	if err != nil || !valid {
+		return errors.New("Signature on blocklist failed to verify")
+	}
+	return nil
+}
+
+func (n *OpenBazaarNode) saveBlocklist(blocklist *pb.Blocklist) error {
+	blocklist.ModeratorID = n.IpfsNode.Identity.Pretty()
+	if err := signBlocklist(blocklist, n.IpfsNode.PrivateKey); err != nil {
+		return err
+	}
+	m := jsonpb.Marshaler{
+		EnumsAsInts:  false,
+		EmitDefaults: true,
+		Indent:       "    ",
+		OrigName:     false,
+	}
+	out, err := m.MarshalToString(blocklist)
+	if err != nil {
+		return err
+	}
+	return ioutil.WriteFile(path.Join(n.RepoPath, "root", "blocklist"), []byte(out), os.ModePerm)
+}
+
+func signBlocklist(blocklist *pb.Blocklist, privKey libp2p.PrivKey) error {
+	ts, err := ptypes.TimestampProto(time.Now())
+	if err != nil {
+		return err
+	}
+	blocklist.Timestamp = ts
+	blocklist.Pubkey, err = privKey.GetPublic().Bytes()
+	if err != nil {
+		return err
+	}
+	blocklist.Signature = nil
+	ser, err := proto.Marshal(blocklist)
+	if err != nil {
+		return err
+	}
+	blocklist.Signature, err = privKey.Sign(ser)
+	return err
+}
+
+func validateReport(report *pb.Report) error {
+	if err := ValidateReportTarget(report.ListingHash, report.PeerID); err != nil {
+		return err
+	}
+	if _, ok := pb.Report_Reason_name[int32(report.Reason)]; !ok {
+		return errors.New("Invalid report reason")
+	}
+	if len(report.Evidence) > PolicyMaxCharacters {
+		return fmt.Errorf("Evidence is longer than the max of %d characters", PolicyMaxCharacters)
+	}
+	return nil
+}
+
+// A report or blocklist entry must name a listing hash or a store, or both
+func ValidateReportTarget(listingHash, peerID string) error {
+	if listingHash == "" && peerID == "" {
+		return errors.New("A listing hash or peer ID must be given")
+	}
+	if listingHash != "" {
+		if _, err := mh.FromB58String(listingHash); err != nil {
+			return errors.New("Invalid listing hash")
+		}
+	}
+	if peerID != "" {
+		if _, err := peer.IDB58Decode(peerID); err != nil {
+			return errors.New("Invalid peer ID")
+		}
+	}
+	return nil
+}
+
+// Parse a report reason such as "prohibited_item". An empty reason is OTHER.
+func ParseReportReason(reason string) (pb.Report_Reason, error) {
+	if reason == "" {
+		return pb.Report_OTHER, nil
+	}
+	r, ok := pb.Report_Reason_value[strings.ToUpper(reason)]
+	if !ok {
+		return pb.Report_OTHER, errors.New("Invalid report reason")
+	}
+	return pb.Report_Reason(r), nil
+}
+
+func reasonString(reason pb.Report_Reason) string {
+	return strings.Replace(strings.ToLower(reason.String()), "_", " ", -1)
+}
@@ -0,0 +1,177 @@
+package core
+
+import (
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	libp2p "gx/ipfs/QmPGxZ1DP2w45WcogpW1h43BvseXbfke9N91qotpoQcUeS/go-libp2p-crypto"
+	peer "gx/ipfs/QmWUswjn261LSyVxWAEpMVtPdy8zmKBJJfBpG3Qdpa8ZsE/go-libp2p-peer"
+
+	"github.com/OpenBazaar/openbazaar-go/pb"
+	"github.com/golang/protobuf/proto"
+	"github.com/golang/protobuf/ptypes"
+)
+
+const (
+	reportedListing = "QmYwAPJzv5CZsnA625s3Xf2nemtYgPpHdWEz79ojWnPbdG"
+	reportedStore   = "QmecpJrN9RJ7smyYByQdZUy5mF6aapgCfKLKRmDtycv9aG"
+)
+
+func TestValidateReportTarget(t *testing.T) {
+	if err := ValidateReportTarget(reportedListing, ""); err != nil {
+		t.Error(err)
+	}
+	if err := ValidateReportTarget("", reportedStore); err != nil {
+		t.Error(err)
+	}
+	if err := ValidateReportTarget(reportedListing, reportedStore); err != nil {
+		t.Error(err)
+	}
+	if err := ValidateReportTarget("", ""); err == nil {
+		t.Error("Expected a report without a listing or store to fail validation")
+	}
+	if err := ValidateReportTarget("not a hash", ""); err == nil {
+		t.Error("Expected an invalid listing hash to fail validation")
+	}
+	if err := ValidateReportTarget("", "not a peer"); err == nil {
+		t.Error("Expected an invalid peer ID to fail validation")
+	}
+}
+
+func TestValidateReport(t *testing.T) {
+	report := &pb.Report{ListingHash: reportedListing, Reason: pb.Report_SCAM}
+	if err := validateReport(report); err != nil {
+		t.Error(err)
+	}
+	report.Reason = pb.Report_Reason(99)
+	if err := validateReport(report); err == nil {
+		t.Error("Expected an unknown reason to fail validation")
+	}
+	report.Reason = pb.Report_SCAM
+	report.Evidence = strings.Repeat("a", PolicyMaxCharacters+1)
+	if err := validateReport(report); err == nil {
+		t.Error("Expected long evidence to fail validation")
+	}
+}
+
+func TestParseReportReason(t *testing.T) {
+	reasons := map[string]pb.Report_Reason{
+		"":                pb.Report_OTHER,
+		"scam":            pb.Report_SCAM,
+		"prohibited_item": pb.Report_PROHIBITED_ITEM,
+		"COUNTERFEIT":     pb.Report_COUNTERFEIT,
+	}
+	for s, expected := range reasons {
+		reason, err := ParseReportReason(s)
+		if err != nil {
+			t.Error(err)
+		}
+		if reason != expected {
+			t.Errorf("Returned incorrect reason for %q", s)
+		}
+	}
+	if _, err := ParseReportReason("rude"); err == nil {
+		t.Error("Expected an unknown reason to fail")
+	}
+	if reasonString(pb.Report_PROHIBITED_ITEM) != "prohibited item" {
+		t.Error("Returned incorrect reason string")
+	}
+}
+
+func TestVerifyReport(t *testing.T) {
+	priv, pub, err := libp2p.GenerateKeyPair(libp2p.Ed25519, 256)
+	if err != nil {
+		t.Fatal(err)
+	}
+	pid, err := peer.IDFromPublicKey(pub)
+	if err != nil {
+		t.Fatal(err)
+	}
+	pubkey, err := pub.Bytes()
+	if err != nil {
+		t.Fatal(err)
+	}
+	ts, err := ptypes.TimestampProto(time.Now())
+	if err != nil {
+		t.Fatal(err)
+	}
+	report := &pb.Report{
+		ReportId:    "QmReport",
+		ReporterID:  pid.Pretty(),
+		ListingHash: reportedListing,
+		Reason:      pb.Report_COUNTERFEIT,
+		Evidence:    "Photos show a replica",
+		Timestamp:   ts,
+		Pubkey:      pubkey,
+	}
+	ser, err := proto.Marshal(report)
+	if err != nil {
+		t.Fatal(err)
+	}
+	report.Signature, err = priv.Sign(ser)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := VerifyReport(report); err != nil {
+		t.Error(err)
+	}
+	report.ReporterID = reportedStore
+	if err := VerifyReport(report); err == nil {
+		t.Error("Expected a report claiming another reporter to fail verification")
+	}
+	report.ReporterID = pid.Pretty()
+	report.Evidence = "Changed"
+	if err := VerifyReport(report); err == nil {
+		t.Error("Expected a modified report to fail verification")
+	}
+}
+
+func TestSignBlocklist(t *testing.T) {
+	priv, pub, err := libp2p.GenerateKeyPair(libp2p.Ed25519, 256)
+	if err != nil {
+		t.Fatal(err)
+	}
+	pid, err := peer.IDFromPublicKey(pub)
+	if err != nil {
+		t.Fatal(err)
+	}
+	blocklist := &pb.Blocklist{
+		ModeratorID: pid.Pretty(),
+		Entries: []*pb.Blocklist_Entry{
+			{ListingHash: reportedListing, PeerID: reportedStore, Reason: pb.Report_PROHIBITED_ITEM},
+			{PeerID: reportedStore, Reason: pb.Report_SCAM},
+		},
+	}
+	if err := signBlocklist(blocklist, priv); err != nil {
+		t.Fatal(err)
+	}
+	if err := VerifyBlocklist(blocklist, pid.Pretty()); err != nil {
+		t.Error(err)
+	}
+	if err := VerifyBlocklist(blocklist, reportedStore); err == nil {
+		t.Error("Expected a blocklist fetched from another peer to fail verification")
+	}
+	blocklist.Entries = blocklist.Entries[:1]
+	if err := VerifyBlocklist(blocklist, pid.Pretty()); err == nil {
+		t.Error("Expected a modified blocklist to fail verification")
+	}
+}
+
+func TestLastGoodBlocklist(t *testing.T) {
+	moderator := "QmModeratorWithBlocklist"
+	if lastGoodBlocklist(moderator, nil, errors.New("not found")) != nil {
+		t.Error("Expected no blocklist before one was fetched")
+	}
+	fetched := &pb.Blocklist{ModeratorID: moderator, Entries: []*pb.Blocklist_Entry{{PeerID: reportedStore}}}
+	if lastGoodBlocklist(moderator, fetched, nil) != fetched {
+		t.Error("Expected the fetched blocklist to be returned")
+	}
+	if lastGoodBlocklist(moderator, nil, errors.New("timeout")) != fetched {
+		t.Error("Expected the last fetched blocklist to be kept when fetching fails")
+	}
+	if lastGoodBlocklist("QmOtherModerator", nil, errors.New("timeout")) != nil {
+		t.Error("Expected another moderator's blocklist not to be returned")
+	}
+}
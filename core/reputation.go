@@ -0,0 +1,168 @@
+package core
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	libp2p "gx/ipfs/QmPGxZ1DP2w45WcogpW1h43BvseXbfke9N91qotpoQcUeS/go-libp2p-crypto"
+	peer "gx/ipfs/QmWUswjn261LSyVxWAEpMVtPdy8zmKBJJfBpG3Qdpa8ZsE/go-libp2p-peer"
+
+	"github.com/OpenBazaar/jsonpb"
+	"github.com/OpenBazaar/openbazaar-go/ipfs"
+	"github.com/OpenBazaar/openbazaar-go/pb"
+	"github.com/golang/protobuf/proto"
+	"github.com/golang/protobuf/ptypes"
+)
+
+// Bundle all of our ratings which verify along with the ID of the order each was left
+// for, signed with our identity key
+func (n *OpenBazaarNode) ExportReputation() (*pb.ReputationBundle, error) {
+	peerId := n.IpfsNode.Identity.Pretty()
+	index, err := n.fetchRatingIndex(peerId)
+	if err != nil {
+		return nil, err
+	}
+	orderIds, err := n.ratingOrderIds()
+	if err != nil {
+		return nil, err
+	}
+	bundle := &pb.ReputationBundle{PeerID: peerId}
+	for _, entry := range index {
+		b, err := ipfs.Cat(n.Context, entry.Hash)
+		if err != nil {
+			return nil, err
+		}
+		rating := new(pb.OrderCompletion_Rating)
+		if err := jsonpb.UnmarshalString(string(b), rating); err != nil {
+			return nil, err
+		}
+		if err := VerifyRating(rating, peerId); err != nil {
+			log.Warningf("Leaving rating %s out of reputation export: %s", entry.Hash, err.Error())
+			continue
+		}
+		bundle.Ratings = append(bundle.Ratings, &pb.ReputationBundle_Rating{
+			RatingHash: entry.Hash,
+			OrderId:    orderIds[string(rating.RatingData.RatingKey)],
+			Rating:     rating,
+		})
+	}
+	if err := signReputationBundle(bundle, n.IpfsNode.PrivateKey); err != nil {
+		return nil, err
+	}
+	return bundle, nil
+}
+
+// Map the rating keys of our completed sales to their order IDs
+func (n *OpenBazaarNode) ratingOrderIds() (map[string]string, error) {
+	sales, err := n.Datastore.Sales().GetAll("", -1)
+	if err != nil {
+		return nil, err
+	}
+	orderIds := make(map[string]string)
+	for _, sale := range sales {
+		contract, _, _, _, _, err := n.Datastore.Sales().GetByOrderId(sale.OrderId)
+		if err != nil || contract.BuyerOrderCompletion == nil {
+			continue
+		}
+		for _, key := range contract.BuyerOrder.RatingKeys {
+			orderIds[string(key)] = sale.OrderId
+		}
+	}
+	return orderIds, nil
+}
+
+// Merge the ratings from a bundle of our own ratings into our ratings index, returning
+// how many were not already in it. The bundle may come from one of our identities
+// before our key was rotated. The whole bundle is rejected if any signature fails to
+// verify. The caller is responsible for republishing.
+func (n *OpenBazaarNode) ImportReputation(bundle *pb.ReputationBundle) (int, error) {
+	if !n.isOwnID(bundle.PeerID) {
+		return 0, errors.New("Reputation bundle is for a different store")
+	}
+	if err := VerifyReputationBundle(bundle); err != nil {
+		return 0, err
+	}
+	index, err := n.fetchRatingIndex(n.IpfsNode.Identity.Pretty())
+	if err != nil {
+		return 0, err
+	}
+	existing := make(map[string]bool)
+	for _, entry := range index {
+		existing[entry.Hash] = true
+	}
+	imported := 0
+	for _, r := range bundle.Ratings {
+		ratingPath, err := n.saveRating(r.Rating)
+		if err != nil {
+			return imported, err
+		}
+		ratingHash, err := ipfs.GetHash(n.Context, ratingPath)
+		if err != nil {
+			return imported, err
+		}
+		if existing[ratingHash] {
+			continue
+		}
+		if err := n.updateRatingIndex(r.Rating, ratingPath); err != nil {
+			return imported, err
+		}
+		existing[ratingHash] = true
+		imported++
+	}
+	return imported, nil
+}
+
+func signReputationBundle(bundle *pb.ReputationBundle, privKey libp2p.PrivKey) error {
+	ts, err := ptypes.TimestampProto(time.Now())
+	if err != nil {
+		return err
+	}
+	bundle.Timestamp = ts
+	bundle.Pubkey, err = privKey.GetPublic().Bytes()
+	if err != nil {
+		return err
+	}
+	bundle.Signature = nil
+	ser, err := proto.Marshal(bundle)
+	if err != nil {
+		return err
+	}
+	bundle.Signature, err = privKey.Sign(ser)
+	return err
+}
+
+// Checks a reputation bundle was signed by the store it claims to be from and that
+// the signature chain on every rating in it verifies
+func VerifyReputationBundle(bundle *pb.ReputationBundle) error {
+	pubkey, err := libp2p.UnmarshalPublicKey(bundle.Pubkey)
+	if err != nil {
+		return err
+	}
+	pid, err := peer.IDFromPublicKey(pubkey)
+	if err != nil {
+		return err
+	}
+	if pid.Pretty() != bundle.PeerID {
+		return errors.New("Public key in reputation bundle does not match the store's peer ID")
+	}
+	unsigned := *bundle
+	unsigned.Signature = nil
+	ser, err := proto.Marshal(&unsigned)
+	if err != nil {
+		return err
+	}
+	valid, err := pubkey.Verify(ser, bundle.Signature)
+	if err != nil || !valid {
+		return errors.New("Signature on reputation bundle failed to verify")
+	}
+	for i, r := range bundle.Ratings {
+		if r.Rating == nil {
+			return fmt.Errorf("Rating %d in reputation bundle is empty", i)
+		}
+		if err := VerifyRating(r.Rating, bundle.PeerID); err != nil {
+			return fmt.Errorf("Rating %d in reputation bundle failed to verify: %s", i, err.Error())
+		}
+	}
+	return nil
+}
@@ -0,0 +1,66 @@
+package core
+
+import (
+	"testing"
+
+	libp2p "gx/ipfs/QmPGxZ1DP2w45WcogpW1h43BvseXbfke9N91qotpoQcUeS/go-libp2p-crypto"
+
+	"github.com/OpenBazaar/openbazaar-go/pb"
+)
+
+func newSignedReputationBundle(t *testing.T) *pb.ReputationBundle {
+	vendorPriv, _, err := libp2p.GenerateKeyPair(libp2p.Ed25519, 256)
+	if err != nil {
+		t.Fatal(err)
+	}
+	bundle := new(pb.ReputationBundle)
+	for i := 0; i < 2; i++ {
+		rating, vendor := newSignedRatingForVendor(t, vendorPriv)
+		bundle.PeerID = vendor
+		bundle.Ratings = append(bundle.Ratings, &pb.ReputationBundle_Rating{OrderId: "QmOrder", Rating: rating})
+	}
+	if err := signReputationBundle(bundle, vendorPriv); err != nil {
+		t.Fatal(err)
+	}
+	return bundle
+}
+
+func TestVerifyReputationBundle(t *testing.T) {
+	bundle := newSignedReputationBundle(t)
+	if err := VerifyReputationBundle(bundle); err != nil {
+		t.Error(err)
+	}
+	bundle.Ratings = bundle.Ratings[1:]
+	if err := VerifyReputationBundle(bundle); err == nil {
+		t.Error("Expected a bundle with a rating removed to fail verification")
+	}
+
+	bundle = newSignedReputationBundle(t)
+	bundle.PeerID = reportedStore
+	if err := VerifyReputationBundle(bundle); err == nil {
+		t.Error("Expected a bundle claiming another store to fail verification")
+	}
+
+	// A rating left for another store, re-signed into the bundle
+	vendorPriv, _, err := libp2p.GenerateKeyPair(libp2p.Ed25519, 256)
+	if err != nil {
+		t.Fatal(err)
+	}
+	bundle = new(pb.ReputationBundle)
+	rating, vendor := newSignedRatingForVendor(t, vendorPriv)
+	bundle.PeerID = vendor
+	other, _ := newSignedRating(t)
+	bundle.Ratings = []*pb.ReputationBundle_Rating{{Rating: rating}, {Rating: other}}
+	if err := signReputationBundle(bundle, vendorPriv); err != nil {
+		t.Fatal(err)
+	}
+	if err := VerifyReputationBundle(bundle); err == nil {
+		t.Error("Expected a bundle with another store's rating to fail verification")
+	}
+
+	bundle = newSignedReputationBundle(t)
+	bundle.Ratings[0].Rating.RatingData.Overall = 1
+	if err := VerifyReputationBundle(bundle); err == nil {
+		t.Error("Expected a bundle with a modified rating to fail verification")
+	}
+}
@@ -0,0 +1,54 @@
+package core
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/OpenBazaar/openbazaar-go/pb"
+)
+
+var ErrResolutionTemplateNotFound = errors.New("Resolution template not found")
+
+// Checks the resolution template is well formed before it's saved to the database
+func ValidateResolutionTemplate(template *pb.ResolutionTemplate) error {
+	if template.Name == "" {
+		return errors.New("Resolution template name must not be empty")
+	}
+	if len(template.Name) > SentenceMaxCharacters {
+		return fmt.Errorf("Resolution template name is longer than the max of %d characters", SentenceMaxCharacters)
+	}
+	// The name is the last element of the template's API path
+	if strings.Contains(template.Name, "/") {
+		return errors.New("Resolution template name must not contain a slash")
+	}
+	if template.BuyerPercentage < 0 || template.VendorPercentage < 0 {
+		return errors.New("Payout percentages must not be negative")
+	}
+	if template.BuyerPercentage+template.VendorPercentage != 100 {
+		return errors.New("Payout percentages must sum to 100")
+	}
+	if template.Resolution == "" {
+		return errors.New("Resolution template must contain a resolution")
+	}
+	if len(template.Resolution) > PolicyMaxCharacters {
+		return fmt.Errorf("Resolution is longer than the max of %d characters", PolicyMaxCharacters)
+	}
+	return nil
+}
+
+// Return the percentages and resolution to close a dispute with. If a template is named
+// its percentages are used, along with its resolution unless one is given.
+func (n *OpenBazaarNode) ApplyResolutionTemplate(name string, buyerPercentage, vendorPercentage float32, resolution string) (float32, float32, string, error) {
+	if name == "" {
+		return buyerPercentage, vendorPercentage, resolution, nil
+	}
+	template, err := n.Datastore.ResolutionTemplates().Get(name)
+	if err != nil {
+		return 0, 0, "", ErrResolutionTemplateNotFound
+	}
+	if resolution == "" {
+		resolution = template.Resolution
+	}
+	return template.BuyerPercentage, template.VendorPercentage, resolution, nil
+}
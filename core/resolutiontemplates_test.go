@@ -0,0 +1,58 @@
+package core
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/OpenBazaar/openbazaar-go/pb"
+	"github.com/golang/protobuf/proto"
+)
+
+func TestValidateResolutionTemplate(t *testing.T) {
+	template := &pb.ResolutionTemplate{
+		Name:             "Partial damage",
+		BuyerPercentage:  50,
+		VendorPercentage: 50,
+		Resolution:       "The item arrived partially damaged so the payment is split evenly.",
+	}
+	if err := ValidateResolutionTemplate(template); err != nil {
+		t.Error(err)
+	}
+
+	noName := proto.Clone(template).(*pb.ResolutionTemplate)
+	noName.Name = ""
+	if err := ValidateResolutionTemplate(noName); err == nil {
+		t.Error("Expected a template without a name to fail validation")
+	}
+
+	longName := proto.Clone(template).(*pb.ResolutionTemplate)
+	longName.Name = strings.Repeat("a", SentenceMaxCharacters+1)
+	if err := ValidateResolutionTemplate(longName); err == nil {
+		t.Error("Expected a template with a long name to fail validation")
+	}
+
+	badSum := proto.Clone(template).(*pb.ResolutionTemplate)
+	badSum.VendorPercentage = 40
+	if err := ValidateResolutionTemplate(badSum); err == nil {
+		t.Error("Expected percentages not summing to 100 to fail validation")
+	}
+
+	negative := proto.Clone(template).(*pb.ResolutionTemplate)
+	negative.BuyerPercentage = 110
+	negative.VendorPercentage = -10
+	if err := ValidateResolutionTemplate(negative); err == nil {
+		t.Error("Expected a negative percentage to fail validation")
+	}
+
+	slash := proto.Clone(template).(*pb.ResolutionTemplate)
+	slash.Name = "Damaged/lost"
+	if err := ValidateResolutionTemplate(slash); err == nil {
+		t.Error("Expected a template with a slash in its name to fail validation")
+	}
+
+	noResolution := proto.Clone(template).(*pb.ResolutionTemplate)
+	noResolution.Resolution = ""
+	if err := ValidateResolutionTemplate(noResolution); err == nil {
+		t.Error("Expected a template without a resolution to fail validation")
+	}
+}
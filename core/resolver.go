@@ -0,0 +1,217 @@
+package core
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	peer "gx/ipfs/QmWUswjn261LSyVxWAEpMVtPdy8zmKBJJfBpG3Qdpa8ZsE/go-libp2p-peer"
+
+	bstk "github.com/OpenBazaar/go-blockstackclient"
+)
+
+// How long a resolved handle is used before resolving it again
+const ResolverCacheTTL = time.Minute * 10
+
+// The file in the data directory mapping handles we've named ourselves to peer IDs
+const PetnamesFile = "petnames.json"
+
+// The prefix of the TXT record a domain uses to name its OpenBazaar peer
+const dnsTXTPrefix = "openbazaar="
+
+// Returned by a resolver which has no entry for a handle
+var ErrHandleNotFound = errors.New("Handle not found")
+
+// Resolves an @handle to a peer ID
+type NameResolver interface {
+	Resolve(handle string) (string, error)
+}
+
+// A source of handles which the resolver chain can report misses for by name
+type NamedResolver interface {
+	NameResolver
+	Name() string
+}
+
+// Returned when none of the resolvers in a chain could resolve a handle. It lists
+// why each one failed so a miss can be told apart from a resolver being unavailable.
+type ResolutionError struct {
+	Handle string
+	Errors map[string]error
+	order  []string
+}
+
+func (e *ResolutionError) Error() string {
+	var reasons []string
+	for _, name := range e.order {
+		reasons = append(reasons, name+": "+e.Errors[name].Error())
+	}
+	return fmt.Sprintf("Could not resolve %s (%s)", e.Handle, strings.Join(reasons, "; "))
+}
+
+// Tries each resolver in turn and caches what they resolve
+type ResolverChain struct {
+	resolvers []NamedResolver
+	ttl       time.Duration
+	cache     map[string]cachedResolution
+	lock      sync.Mutex
+}
+
+type cachedResolution struct {
+	peerID string
+	expiry time.Time
+}
+
+func NewResolverChain(ttl time.Duration, resolvers ...NamedResolver) *ResolverChain {
+	return &ResolverChain{
+		resolvers: resolvers,
+		ttl:       ttl,
+		cache:     make(map[string]cachedResolution),
+	}
+}
+
+func (c *ResolverChain) Resolve(handle string) (string, error) {
+	formatted := formatHandle(handle)
+	if formatted == "" {
+		return "", errors.New("Handle is empty")
+	}
+	c.lock.Lock()
+	cached, ok := c.cache[formatted]
+	if ok && time.Now().Before(cached.expiry) {
+		c.lock.Unlock()
+		return cached.peerID, nil
+	}
+	delete(c.cache, formatted)
+	c.lock.Unlock()
+
+	rerr := &ResolutionError{Handle: "@" + formatted, Errors: make(map[string]error)}
+	for _, r := range c.resolvers {
+		peerID, err := r.Resolve(formatted)
+		if err == nil {
+			if _, err = peer.IDB58Decode(peerID); err != nil {
+				err = fmt.Errorf("Resolved to an invalid peer ID %s", peerID)
+			}
+		}
+		if err != nil {
+			rerr.Errors[r.Name()] = err
+			rerr.order = append(rerr.order, r.Name())
+			continue
+		}
+		c.lock.Lock()
+		c.cache[formatted] = cachedResolution{peerID, time.Now().Add(c.ttl)}
+		c.lock.Unlock()
+		return peerID, nil
+	}
+	if len(c.resolvers) == 0 {
+		return "", fmt.Errorf("Could not resolve %s (no resolvers are configured)", rerr.Handle)
+	}
+	return "", rerr
+}
+
+// Resolve a peer ID or @handle to a peer ID
+func (n *OpenBazaarNode) ResolvePeerID(id string) (string, error) {
+	if !strings.HasPrefix(id, "@") {
+		return id, nil
+	}
+	return n.Resolver.Resolve(id)
+}
+
+// Looks up handles in the Blockstack resolver API
+type BlockstackResolver struct {
+	Client *bstk.BlockstackClient
+}
+
+func (r *BlockstackResolver) Name() string { return "blockstack" }
+
+func (r *BlockstackResolver) Resolve(handle string) (string, error) {
+	return r.Client.Resolve(handle)
+}
+
+// Resolves handles which are domain names, such as @example.com, from a TXT record on
+// the domain of the form openbazaar=<peerID>. DNS lookups don't go through Tor so this
+// resolver shouldn't be used when the node is Tor only.
+type DNSResolver struct {
+	LookupTXT func(name string) ([]string, error)
+}
+
+func NewDNSResolver() *DNSResolver {
+	return &DNSResolver{LookupTXT: net.LookupTXT}
+}
+
+func (r *DNSResolver) Name() string { return "dns" }
+
+func (r *DNSResolver) Resolve(handle string) (string, error) {
+	domain := formatHandle(handle)
+	if !strings.Contains(domain, ".") {
+		return "", errors.New("Handle is not a domain name")
+	}
+	records, err := r.LookupTXT(domain)
+	if err != nil {
+		if dnsErr, ok := err.(*net.DNSError); ok && dnsErr.Err == "no such host" {
+			return "", ErrHandleNotFound
+		}
+		return "", err
+	}
+	for _, record := range records {
+		if strings.HasPrefix(record, dnsTXTPrefix) {
+			return strings.TrimSpace(strings.TrimPrefix(record, dnsTXTPrefix)), nil
+		}
+	}
+	return "", ErrHandleNotFound
+}
+
+// Resolves handles from a JSON object of handles to peer IDs which the user curates
+// themselves. The file is read again whenever it changes.
+type PetnameResolver struct {
+	path     string
+	petnames map[string]string
+	modified time.Time
+	lock     sync.Mutex
+}
+
+func NewPetnameResolver(path string) *PetnameResolver {
+	return &PetnameResolver{path: path}
+}
+
+func (r *PetnameResolver) Name() string { return "petnames" }
+
+func (r *PetnameResolver) Resolve(handle string) (string, error) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	fi, err := os.Stat(r.path)
+	if os.IsNotExist(err) {
+		return "", ErrHandleNotFound
+	} else if err != nil {
+		return "", err
+	}
+	if r.petnames == nil || !fi.ModTime().Equal(r.modified) {
+		b, err := ioutil.ReadFile(r.path)
+		if err != nil {
+			return "", err
+		}
+		petnames := make(map[string]string)
+		if err := json.Unmarshal(b, &petnames); err != nil {
+			return "", fmt.Errorf("Invalid %s: %s", PetnamesFile, err.Error())
+		}
+		r.petnames = make(map[string]string)
+		for name, peerID := range petnames {
+			r.petnames[formatHandle(name)] = peerID
+		}
+		r.modified = fi.ModTime()
+	}
+	peerID, ok := r.petnames[formatHandle(handle)]
+	if !ok {
+		return "", ErrHandleNotFound
+	}
+	return peerID, nil
+}
+
+func formatHandle(handle string) string {
+	return strings.ToLower(strings.TrimPrefix(strings.TrimSpace(handle), "@"))
+}
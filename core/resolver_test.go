@@ -0,0 +1,137 @@
+package core
+
+import (
+	"errors"
+	"io/ioutil"
+	"os"
+	"path"
+	"testing"
+	"time"
+)
+
+type mockResolver struct {
+	name    string
+	handles map[string]string
+	calls   int
+}
+
+func (r *mockResolver) Name() string { return r.name }
+
+func (r *mockResolver) Resolve(handle string) (string, error) {
+	r.calls++
+	peerID, ok := r.handles[handle]
+	if !ok {
+		return "", ErrHandleNotFound
+	}
+	return peerID, nil
+}
+
+func TestResolverChain(t *testing.T) {
+	first := &mockResolver{name: "first", handles: map[string]string{"satoshi": reportedStore, "broken": "notapeer"}}
+	second := &mockResolver{name: "second", handles: map[string]string{"broken": reportedStore}}
+	chain := NewResolverChain(time.Hour, first, second)
+
+	peerID, err := chain.Resolve("@Satoshi")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if peerID != reportedStore {
+		t.Error("Resolved the wrong peer ID")
+	}
+	if _, err := chain.Resolve("@satoshi"); err != nil {
+		t.Fatal(err)
+	}
+	if first.calls != 1 || second.calls != 0 {
+		t.Error("Expected the second lookup to be served from the cache")
+	}
+
+	// An invalid peer ID falls through to the next resolver
+	peerID, err = chain.Resolve("@broken")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if peerID != reportedStore {
+		t.Error("Expected the second resolver to resolve the handle")
+	}
+
+	_, err = chain.Resolve("@nobody")
+	rerr, ok := err.(*ResolutionError)
+	if !ok {
+		t.Fatal("Expected a resolution error")
+	}
+	if rerr.Errors["first"] != ErrHandleNotFound || rerr.Errors["second"] != ErrHandleNotFound {
+		t.Error("Expected the resolution error to list each resolver's miss")
+	}
+	if rerr.Error() != "Could not resolve @nobody (first: Handle not found; second: Handle not found)" {
+		t.Errorf("Unexpected error message: %s", rerr.Error())
+	}
+}
+
+func TestResolverChainCacheExpiry(t *testing.T) {
+	r := &mockResolver{name: "mock", handles: map[string]string{"satoshi": reportedStore}}
+	chain := NewResolverChain(time.Millisecond, r)
+	if _, err := chain.Resolve("@satoshi"); err != nil {
+		t.Fatal(err)
+	}
+	time.Sleep(time.Millisecond * 5)
+	if _, err := chain.Resolve("@satoshi"); err != nil {
+		t.Fatal(err)
+	}
+	if r.calls != 2 {
+		t.Error("Expected an expired handle to be resolved again")
+	}
+}
+
+func TestPetnameResolver(t *testing.T) {
+	dir, err := ioutil.TempDir("", "petnames")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	petnames := path.Join(dir, PetnamesFile)
+	r := NewPetnameResolver(petnames)
+	if _, err := r.Resolve("@satoshi"); err != ErrHandleNotFound {
+		t.Error("Expected a miss without a petnames file")
+	}
+	if err := ioutil.WriteFile(petnames, []byte(`{"@Satoshi": "`+reportedStore+`"}`), os.FileMode(0644)); err != nil {
+		t.Fatal(err)
+	}
+	peerID, err := r.Resolve("@satoshi")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if peerID != reportedStore {
+		t.Error("Resolved the wrong peer ID")
+	}
+	if _, err := r.Resolve("@nobody"); err != ErrHandleNotFound {
+		t.Error("Expected a miss for a handle which isn't in the file")
+	}
+}
+
+func TestDNSResolver(t *testing.T) {
+	r := &DNSResolver{LookupTXT: func(name string) ([]string, error) {
+		switch name {
+		case "example.com":
+			return []string{"v=spf1 -all", "openbazaar=" + reportedStore}, nil
+		case "down.example.com":
+			return nil, errors.New("Timed out")
+		}
+		return []string{"v=spf1 -all"}, nil
+	}}
+	peerID, err := r.Resolve("@Example.com")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if peerID != reportedStore {
+		t.Error("Resolved the wrong peer ID")
+	}
+	if _, err := r.Resolve("@other.com"); err != ErrHandleNotFound {
+		t.Error("Expected a miss for a domain without an openbazaar record")
+	}
+	if _, err := r.Resolve("@down.example.com"); err == nil || err == ErrHandleNotFound {
+		t.Error("Expected a lookup failure to be reported rather than a miss")
+	}
+	if _, err := r.Resolve("@satoshi"); err == nil {
+		t.Error("Expected a handle which isn't a domain to be skipped")
+	}
+}
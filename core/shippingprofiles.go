@@ -0,0 +1,132 @@
+package core
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path"
+
+	"github.com/OpenBazaar/jsonpb"
+	"github.com/OpenBazaar/openbazaar-go/pb"
+)
+
+// Checks the shipping profile is well formed before it's saved to the database
+func ValidateShippingProfile(profile *pb.ShippingProfile) error {
+	if profile.Name == "" {
+		return errors.New("Shipping profile name must not be empty")
+	}
+	if len(profile.Name) > WordMaxCharacters {
+		return fmt.Errorf("Shipping profile name is longer than the max of %d characters", WordMaxCharacters)
+	}
+	if len(profile.ShippingOptions) == 0 {
+		return errors.New("Shipping profile must contain at least one shipping option")
+	}
+	if len(profile.ShippingOptions) > MaxListItems {
+		return fmt.Errorf("Number of shipping options is greater than the max of %d", MaxListItems)
+	}
+	return validateShippingOptions(profile.ShippingOptions)
+}
+
+// If the listing references a shipping profile, replace its shipping options with
+// the options from the profile so the signed contract is self contained.
+func (n *OpenBazaarNode) applyShippingProfile(listing *pb.Listing) error {
+	if listing.ShippingProfile == "" {
+		return nil
+	}
+	profile, err := n.Datastore.ShippingProfiles().Get(listing.ShippingProfile)
+	if err != nil {
+		return fmt.Errorf("Shipping profile %s does not exist", listing.ShippingProfile)
+	}
+	listing.ShippingOptions = profile.ShippingOptions
+	return nil
+}
+
+// Return the slugs of all listings that reference the given shipping profile
+func (n *OpenBazaarNode) GetShippingProfileListings(name string) ([]string, error) {
+	index, err := n.getListingIndex()
+	if err != nil {
+		return nil, err
+	}
+	var slugs []string
+	for _, ld := range index {
+		contract, err := n.GetListingFromSlug(ld.Slug)
+		if err != nil {
+			return nil, err
+		}
+		if contract.VendorListings[0].ShippingProfile == name {
+			slugs = append(slugs, ld.Slug)
+		}
+	}
+	return slugs, nil
+}
+
+// Re-render, re-sign and re-index every listing that uses the given shipping profile.
+// A listing which fails to update doesn't stop the others; its error is returned in
+// the failed map keyed by slug. The node is seeded once after all the listings have
+// been updated. Returns the slugs of the listings which were updated.
+func (n *OpenBazaarNode) UpdateShippingProfileListings(name string) (updated []string, failed map[string]string, err error) {
+	slugs, err := n.GetShippingProfileListings(name)
+	if err != nil {
+		return nil, nil, err
+	}
+	failed = make(map[string]string)
+	for _, slug := range slugs {
+		if err := n.updateShippingProfileListing(slug); err != nil {
+			log.Errorf("Error updating listing %s for shipping profile %s: %s", slug, name, err.Error())
+			failed[slug] = err.Error()
+			continue
+		}
+		updated = append(updated, slug)
+	}
+	if len(updated) == 0 {
+		return updated, failed, nil
+	}
+	if err := n.SeedNode(); err != nil {
+		return updated, failed, err
+	}
+	return updated, failed, nil
+}
+
+func (n *OpenBazaarNode) updateShippingProfileListing(slug string) error {
+	sl, err := n.GetListingFromSlug(slug)
+	if err != nil {
+		return err
+	}
+	// The stored listing only has the hashes of its coupon codes so keep the codes
+	// SignListing would otherwise drop
+	coupons, err := n.Datastore.Coupons().Get(slug)
+	if err != nil {
+		return err
+	}
+	contract, err := n.SignListing(sl.VendorListings[0])
+	if err != nil {
+		return err
+	}
+	if err := n.Datastore.Coupons().Delete(slug); err != nil {
+		return err
+	}
+	if err := n.Datastore.Coupons().Put(coupons); err != nil {
+		return err
+	}
+	m := jsonpb.Marshaler{
+		EnumsAsInts:  false,
+		EmitDefaults: false,
+		Indent:       "    ",
+		OrigName:     false,
+	}
+	out, err := m.MarshalToString(contract)
+	if err != nil {
+		return err
+	}
+	listingPath := path.Join(n.RepoPath, "root", "listings", slug+".json")
+	f, err := os.Create(listingPath)
+	if err != nil {
+		return err
+	}
+	_, err = f.WriteString(out)
+	f.Close()
+	if err != nil {
+		return err
+	}
+	return n.UpdateListingIndex(contract)
+}
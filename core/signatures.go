@@ -37,11 +37,20 @@ func verifyMessageSignature(msg proto.Message, pk []byte, sigs []*pb.Signature,
 	if err != nil {
 		return err
 	}
-	guidPubkey, err := crypto.UnmarshalPublicKey(pk)
+	sig, err := selectSignature(sigs, sigType)
 	if err != nil {
 		return err
 	}
-	sig, err := selectSignature(sigs, sigType)
+	// A signer who rotated their identity key since the contract was made signs with
+	// the new key and attaches the chain from the identity in the contract
+	if successionIndex(sig.Successions, guid) >= 0 {
+		successor, err := VerifyIdentityChain(&pb.IdentityChain{Successions: sig.Successions}, guid)
+		if err != nil {
+			return err
+		}
+		pk, guid = successor.SuccessorPubkey, successor.SuccessorID
+	}
+	guidPubkey, err := crypto.UnmarshalPublicKey(pk)
 	if err != nil {
 		return err
 	}
@@ -0,0 +1,363 @@
+package core
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	libp2p "gx/ipfs/QmPGxZ1DP2w45WcogpW1h43BvseXbfke9N91qotpoQcUeS/go-libp2p-crypto"
+	routing "gx/ipfs/QmUc6twRJRE9MNrUGd8eo9WjHHxebGppdZfptGCASkR7fF/go-libp2p-routing"
+	peer "gx/ipfs/QmWUswjn261LSyVxWAEpMVtPdy8zmKBJJfBpG3Qdpa8ZsE/go-libp2p-peer"
+
+	"github.com/OpenBazaar/openbazaar-go/pb"
+	"golang.org/x/net/context"
+	"golang.org/x/net/proxy"
+)
+
+// How long the result of checking a social proof is used before checking it again
+const SocialProofTTL = time.Hour * 24
+
+// The most of a proof post which is read when looking for the proof
+const maxProofPostSize = 1 << 20
+
+// Checks the proof posted at a social account's proof URL links the account to a peer
+type SocialProofVerifier interface {
+	Verify(peerID string, pubkey libp2p.PubKey, account *pb.Profile_SocialAccount) error
+}
+
+// Verifiers for the social account types we know where proofs can be posted
+func NewSocialProofVerifiers(dialer proxy.Dialer) map[string]SocialProofVerifier {
+	return map[string]SocialProofVerifier{
+		"twitter": &HTTPProofVerifier{Hosts: []string{"twitter.com", "mobile.twitter.com"}, PostURL: tweetURL, PostText: tweetText, Dialer: dialer},
+		"github":  &HTTPProofVerifier{Hosts: []string{"gist.github.com", "gist.githubusercontent.com"}, PostURL: gistURL, PostText: gistText, Dialer: dialer},
+		"reddit":  &HTTPProofVerifier{Hosts: []string{"www.reddit.com", "reddit.com"}, OwnerPrefix: []string{"user", "u"}, PostURL: redditPostURL, PostText: redditPostText, Dialer: dialer},
+	}
+}
+
+var errProofAuthor = errors.New("Proof was not posted by the account")
+
+// Checks a proof over HTTPS, through Tor if a dialer is set. The proof URL must be on
+// one of the hosts and the path segment naming the page's owner must be the username.
+// The owner is the first segment, or the one after OwnerPrefix if set. As others can
+// comment on the proof page, only the post itself is fetched from the site's API and
+// it is only searched for the proof if the API names the account as its author.
+type HTTPProofVerifier struct {
+	Hosts       []string
+	OwnerPrefix []string
+
+	// Returns the API URL of the post at a proof URL
+	PostURL func(proof *url.URL) (string, error)
+
+	// Returns the text of the post in an API response if the account is its author
+	PostText func(resp []byte, account *pb.Profile_SocialAccount) (string, error)
+
+	Dialer proxy.Dialer
+}
+
+// Checks a proof URL is on one of the hosts and owned by the account
+func (v *HTTPProofVerifier) checkProofURL(u *url.URL, account *pb.Profile_SocialAccount) error {
+	if u.Scheme != "https" {
+		return errors.New("Proof URL must use https")
+	}
+	hostAllowed := false
+	for _, host := range v.Hosts {
+		if strings.ToLower(u.Host) == host {
+			hostAllowed = true
+			break
+		}
+	}
+	if !hostAllowed {
+		return fmt.Errorf("Proofs of %s accounts can't be hosted on %s", account.Type, u.Host)
+	}
+	segments := strings.Split(strings.TrimPrefix(u.Path, "/"), "/")
+	if len(v.OwnerPrefix) > 0 {
+		prefixed := false
+		for _, prefix := range v.OwnerPrefix {
+			if strings.EqualFold(segments[0], prefix) {
+				prefixed = true
+				break
+			}
+		}
+		if !prefixed {
+			return errors.New("Proof URL is not under the account's username")
+		}
+		segments = segments[1:]
+	}
+	if len(segments) == 0 || account.Username == "" || !strings.EqualFold(segments[0], account.Username) {
+		return errors.New("Proof URL is not under the account's username")
+	}
+	return nil
+}
+
+func (v *HTTPProofVerifier) Verify(peerID string, pubkey libp2p.PubKey, account *pb.Profile_SocialAccount) error {
+	u, err := url.Parse(account.Proof)
+	if err != nil {
+		return err
+	}
+	if err := v.checkProofURL(u, account); err != nil {
+		return err
+	}
+	postURL, err := v.PostURL(u)
+	if err != nil {
+		return err
+	}
+
+	// The API answers directly so a redirect could only lead to someone else's content
+	transport := &http.Transport{}
+	if v.Dialer != nil {
+		transport.Dial = v.Dialer.Dial
+	}
+	client := &http.Client{Transport: transport, Timeout: time.Minute, CheckRedirect: refuseProofRedirect}
+	resp, err := client.Get(postURL)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("Proof post returned status %d", resp.StatusCode)
+	}
+	body, err := ioutil.ReadAll(io.LimitReader(resp.Body, maxProofPostSize))
+	if err != nil {
+		return err
+	}
+	text, err := v.PostText(body, account)
+	if err != nil {
+		return err
+	}
+	return VerifySocialProofText(text, peerID, pubkey, account)
+}
+
+func refuseProofRedirect(req *http.Request, via []*http.Request) error {
+	return errors.New("Proof post redirected")
+}
+
+// A tweet's text and author from Twitter's oEmbed API
+func tweetURL(proof *url.URL) (string, error) {
+	segments := strings.Split(strings.Trim(proof.Path, "/"), "/")
+	if len(segments) < 3 || segments[1] != "status" || !isAlphanumeric(segments[2]) {
+		return "", errors.New("Proof URL is not a tweet")
+	}
+	tweet := "https://twitter.com/" + segments[0] + "/status/" + segments[2]
+	return "https://publish.twitter.com/oembed?omit_script=true&url=" + url.QueryEscape(tweet), nil
+}
+
+func tweetText(resp []byte, account *pb.Profile_SocialAccount) (string, error) {
+	var tweet struct {
+		AuthorURL string `json:"author_url"`
+		HTML      string `json:"html"`
+	}
+	if err := json.Unmarshal(resp, &tweet); err != nil {
+		return "", err
+	}
+	author, err := url.Parse(tweet.AuthorURL)
+	if err != nil || strings.ToLower(author.Host) != "twitter.com" || !strings.EqualFold(strings.Trim(author.Path, "/"), account.Username) {
+		return "", errProofAuthor
+	}
+	return tweet.HTML, nil
+}
+
+// A gist's files and owner from the GitHub API
+func gistURL(proof *url.URL) (string, error) {
+	segments := strings.Split(strings.Trim(proof.Path, "/"), "/")
+	if len(segments) < 2 || !isAlphanumeric(segments[1]) {
+		return "", errors.New("Proof URL is not a gist")
+	}
+	return "https://api.github.com/gists/" + segments[1], nil
+}
+
+func gistText(resp []byte, account *pb.Profile_SocialAccount) (string, error) {
+	var gist struct {
+		Owner struct {
+			Login string `json:"login"`
+		} `json:"owner"`
+		Files map[string]struct {
+			Content string `json:"content"`
+		} `json:"files"`
+	}
+	if err := json.Unmarshal(resp, &gist); err != nil {
+		return "", err
+	}
+	if gist.Owner.Login == "" || !strings.EqualFold(gist.Owner.Login, account.Username) {
+		return "", errProofAuthor
+	}
+	var files []string
+	for _, file := range gist.Files {
+		files = append(files, file.Content)
+	}
+	return strings.Join(files, "\n"), nil
+}
+
+// A Reddit post and its comments from the post's JSON listing. Only the post is read.
+func redditPostURL(proof *url.URL) (string, error) {
+	segments := strings.Split(strings.Trim(proof.Path, "/"), "/")
+	if len(segments) < 4 || segments[2] != "comments" || !isAlphanumeric(segments[3]) {
+		return "", errors.New("Proof URL is not a Reddit post")
+	}
+	return "https://www.reddit.com/comments/" + segments[3] + ".json", nil
+}
+
+func redditPostText(resp []byte, account *pb.Profile_SocialAccount) (string, error) {
+	var listings []struct {
+		Data struct {
+			Children []struct {
+				Data struct {
+					Author   string `json:"author"`
+					Selftext string `json:"selftext"`
+				} `json:"data"`
+			} `json:"children"`
+		} `json:"data"`
+	}
+	if err := json.Unmarshal(resp, &listings); err != nil {
+		return "", err
+	}
+	if len(listings) == 0 || len(listings[0].Data.Children) == 0 {
+		return "", errors.New("Proof post not found")
+	}
+	post := listings[0].Data.Children[0].Data
+	if post.Author == "" || !strings.EqualFold(post.Author, account.Username) {
+		return "", errProofAuthor
+	}
+	return post.Selftext, nil
+}
+
+func isAlphanumeric(s string) bool {
+	if s == "" {
+		return false
+	}
+	for _, r := range s {
+		if !(r >= 'A' && r <= 'Z' || r >= 'a' && r <= 'z' || r >= '0' && r <= '9') {
+			return false
+		}
+	}
+	return true
+}
+
+// The statement a peer signs and posts to prove they own a social account
+func SocialProofStatement(peerID, accountType, username string) string {
+	return fmt.Sprintf("Verifying my OpenBazaar ID is %s and my %s username is %s.", peerID, strings.ToLower(accountType), username)
+}
+
+// Create the text to post to prove we own a social account: the statement followed
+// by our signature on it
+func (n *OpenBazaarNode) SignSocialProof(accountType, username string) (string, error) {
+	statement := SocialProofStatement(n.IpfsNode.Identity.Pretty(), accountType, username)
+	sig, err := n.IpfsNode.PrivateKey.Sign([]byte(statement))
+	if err != nil {
+		return "", err
+	}
+	return statement + " sig:" + base64.StdEncoding.EncodeToString(sig), nil
+}
+
+// Find a signed proof statement in text, such as the text of a post fetched from a
+// social site, and check the peer's signature on it
+func VerifySocialProofText(text, peerID string, pubkey libp2p.PubKey, account *pb.Profile_SocialAccount) error {
+	statement := SocialProofStatement(peerID, account.Type, account.Username)
+	i := strings.Index(text, statement+" sig:")
+	if i < 0 {
+		return errors.New("Proof statement not found")
+	}
+	encoded := text[i+len(statement)+len(" sig:"):]
+	end := strings.IndexFunc(encoded, func(r rune) bool {
+		return !(r >= 'A' && r <= 'Z' || r >= 'a' && r <= 'z' || r >= '0' && r <= '9' || r == '+' || r == '/' || r == '=')
+	})
+	if end >= 0 {
+		encoded = encoded[:end]
+	}
+	sig, err := base64.StdEncoding.DecodeString(encoded)
+	if err != nil {
+		return err
+	}
+	pid, err := peer.IDFromPublicKey(pubkey)
+	if err != nil {
+		return err
+	}
+	if pid.Pretty() != peerID {
+		return errors.New("Public key does not match the peer ID")
+	}
+	valid, err := pubkey.Verify([]byte(statement), sig)
+	if err != nil || !valid {
+		return errors.New("Signature on proof failed to verify")
+	}
+	return nil
+}
+
+var (
+	socialProofChecks     = make(map[string]bool)
+	socialProofChecksLock sync.Mutex
+)
+
+// Mark the social accounts in a peer's profile whose proofs verified. The flags in the
+// profile itself are never trusted. Accounts which haven't been checked recently are
+// checked in the background so the result is ready the next time the profile is seen.
+func (n *OpenBazaarNode) SetSocialProofStatus(profile *pb.Profile, peerID string) {
+	if profile.ContactInfo == nil {
+		return
+	}
+	for _, account := range profile.ContactInfo.Social {
+		account.Verified = false
+		if account.Proof == "" {
+			continue
+		}
+		verified, checked, err := n.Datastore.SocialProofs().Get(peerID, account.Type, account.Username, account.Proof)
+		if err == nil {
+			account.Verified = verified
+		}
+		if err != nil || time.Since(checked) > SocialProofTTL {
+			go n.checkSocialProof(peerID, *account)
+		}
+	}
+}
+
+func (n *OpenBazaarNode) checkSocialProof(peerID string, account pb.Profile_SocialAccount) {
+	key := strings.Join([]string{peerID, account.Type, account.Username, account.Proof}, " ")
+	socialProofChecksLock.Lock()
+	if socialProofChecks[key] {
+		socialProofChecksLock.Unlock()
+		return
+	}
+	socialProofChecks[key] = true
+	socialProofChecksLock.Unlock()
+	defer func() {
+		socialProofChecksLock.Lock()
+		delete(socialProofChecks, key)
+		socialProofChecksLock.Unlock()
+	}()
+
+	err := errors.New("No verifier for this account type")
+	if verifier, ok := n.SocialProofVerifiers[strings.ToLower(account.Type)]; ok {
+		var pubkey libp2p.PubKey
+		pubkey, err = n.getPeerPubKey(peerID)
+		if err == nil {
+			err = verifier.Verify(peerID, pubkey, &account)
+		}
+	}
+	verified := err == nil
+	if !verified {
+		log.Debugf("Social proof of %s account %s for %s failed: %s", account.Type, account.Username, peerID, err.Error())
+	}
+	if err := n.Datastore.SocialProofs().Put(peerID, account.Type, account.Username, account.Proof, verified); err != nil {
+		log.Error(err)
+	}
+}
+
+// Look up a peer's identity key in the peerstore or the DHT
+func (n *OpenBazaarNode) getPeerPubKey(peerID string) (libp2p.PubKey, error) {
+	pid, err := peer.IDB58Decode(peerID)
+	if err != nil {
+		return nil, err
+	}
+	if pubkey := n.IpfsNode.Peerstore.PubKey(pid); pubkey != nil {
+		return pubkey, nil
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
+	defer cancel()
+	return routing.GetPublicKey(n.IpfsNode.Routing, ctx, []byte(pid))
+}
@@ -0,0 +1,175 @@
+package core
+
+import (
+	"encoding/base64"
+	"net/url"
+	"strings"
+	"testing"
+
+	libp2p "gx/ipfs/QmPGxZ1DP2w45WcogpW1h43BvseXbfke9N91qotpoQcUeS/go-libp2p-crypto"
+	peer "gx/ipfs/QmWUswjn261LSyVxWAEpMVtPdy8zmKBJJfBpG3Qdpa8ZsE/go-libp2p-peer"
+
+	"github.com/OpenBazaar/openbazaar-go/pb"
+)
+
+func TestVerifySocialProofText(t *testing.T) {
+	priv, pub, err := libp2p.GenerateKeyPair(libp2p.Ed25519, 256)
+	if err != nil {
+		t.Fatal(err)
+	}
+	pid, err := peer.IDFromPublicKey(pub)
+	if err != nil {
+		t.Fatal(err)
+	}
+	account := &pb.Profile_SocialAccount{Type: "Twitter", Username: "ronswanson"}
+	statement := SocialProofStatement(pid.Pretty(), account.Type, account.Username)
+	sig, err := priv.Sign([]byte(statement))
+	if err != nil {
+		t.Fatal(err)
+	}
+	proof := statement + " sig:" + base64.StdEncoding.EncodeToString(sig)
+	page := "<p class=\"tweet-text\">" + proof + "</p>"
+
+	if err := VerifySocialProofText(page, pid.Pretty(), pub, account); err != nil {
+		t.Error(err)
+	}
+	if err := VerifySocialProofText(page, pid.Pretty(), pub, &pb.Profile_SocialAccount{Type: "twitter", Username: "leslieknope"}); err == nil {
+		t.Error("Expected a proof for another account to fail verification")
+	}
+	if err := VerifySocialProofText(page, reportedStore, pub, account); err == nil {
+		t.Error("Expected a proof for another peer to fail verification")
+	}
+	tampered := strings.Replace(page, "sig:", "sig:AAAA", 1)
+	if err := VerifySocialProofText(tampered, pid.Pretty(), pub, account); err == nil {
+		t.Error("Expected a proof with a bad signature to fail verification")
+	}
+	if err := VerifySocialProofText("<p></p>", pid.Pretty(), pub, account); err == nil {
+		t.Error("Expected a page without a proof to fail verification")
+	}
+}
+
+func TestHTTPProofVerifierURL(t *testing.T) {
+	_, pub, err := libp2p.GenerateKeyPair(libp2p.Ed25519, 256)
+	if err != nil {
+		t.Fatal(err)
+	}
+	verifier := NewSocialProofVerifiers(nil)["twitter"]
+	for _, proof := range []string{
+		"http://twitter.com/ronswanson/status/1",
+		"https://example.com/ronswanson/status/1",
+		"https://twitter.com/leslieknope/status/1",
+	} {
+		account := &pb.Profile_SocialAccount{Type: "twitter", Username: "ronswanson", Proof: proof}
+		if err := verifier.Verify(reportedStore, pub, account); err == nil {
+			t.Errorf("Expected proof URL %s to be rejected", proof)
+		}
+	}
+}
+
+func TestCheckProofURL(t *testing.T) {
+	verifiers := NewSocialProofVerifiers(nil)
+	for _, c := range []struct {
+		accountType string
+		proof       string
+		valid       bool
+	}{
+		{"twitter", "https://twitter.com/ronswanson/status/1", true},
+		{"twitter", "https://mobile.twitter.com/RonSwanson/status/1", true},
+		{"twitter", "https://twitter.com/leslieknope/status/ronswanson", false},
+		{"twitter", "https://twitter.com/ronswanson2/status/1", false},
+		{"github", "https://gist.github.com/ronswanson/abc", true},
+		{"github", "https://gist.github.com/leslieknope/ronswanson", false},
+		{"reddit", "https://www.reddit.com/user/ronswanson/comments/1/proof", true},
+		{"reddit", "https://www.reddit.com/u/ronswanson/comments/1/proof", true},
+		{"reddit", "https://www.reddit.com/r/ronswanson/comments/1/proof", false},
+		{"reddit", "https://www.reddit.com/ronswanson", false},
+	} {
+		u, err := url.Parse(c.proof)
+		if err != nil {
+			t.Fatal(err)
+		}
+		account := &pb.Profile_SocialAccount{Type: c.accountType, Username: "ronswanson", Proof: c.proof}
+		err = verifiers[c.accountType].(*HTTPProofVerifier).checkProofURL(u, account)
+		if c.valid && err != nil {
+			t.Errorf("Expected proof URL %s to be accepted: %s", c.proof, err)
+		} else if !c.valid && err == nil {
+			t.Errorf("Expected proof URL %s to be rejected", c.proof)
+		}
+	}
+}
+
+func TestProofPostURL(t *testing.T) {
+	verifiers := NewSocialProofVerifiers(nil)
+	for _, c := range []struct {
+		accountType string
+		proof       string
+		post        string
+	}{
+		{"twitter", "https://twitter.com/ronswanson/status/1", "https://publish.twitter.com/oembed?omit_script=true&url=https%3A%2F%2Ftwitter.com%2Fronswanson%2Fstatus%2F1"},
+		{"twitter", "https://twitter.com/ronswanson", ""},
+		{"twitter", "https://twitter.com/ronswanson/likes/1", ""},
+		{"github", "https://gist.github.com/ronswanson/a3f1b7c2", "https://api.github.com/gists/a3f1b7c2"},
+		{"github", "https://gist.githubusercontent.com/ronswanson/a3f1b7c2/raw/proof.txt", "https://api.github.com/gists/a3f1b7c2"},
+		{"github", "https://gist.github.com/ronswanson/..%2Fusers", ""},
+		{"reddit", "https://www.reddit.com/user/ronswanson/comments/8xk2q1/proof", "https://www.reddit.com/comments/8xk2q1.json"},
+		{"reddit", "https://www.reddit.com/user/ronswanson/submitted", ""},
+	} {
+		u, err := url.Parse(c.proof)
+		if err != nil {
+			t.Fatal(err)
+		}
+		post, err := verifiers[c.accountType].(*HTTPProofVerifier).PostURL(u)
+		if c.post != "" && (err != nil || post != c.post) {
+			t.Errorf("Expected the post at %s to be fetched from %s, got %s", c.proof, c.post, post)
+		} else if c.post == "" && err == nil {
+			t.Errorf("Expected proof URL %s not to name a post", c.proof)
+		}
+	}
+}
+
+func TestProofPostText(t *testing.T) {
+	priv, pub, err := libp2p.GenerateKeyPair(libp2p.Ed25519, 256)
+	if err != nil {
+		t.Fatal(err)
+	}
+	pid, err := peer.IDFromPublicKey(pub)
+	if err != nil {
+		t.Fatal(err)
+	}
+	verifiers := NewSocialProofVerifiers(nil)
+	for _, c := range []struct {
+		accountType string
+		resp        string
+		valid       bool
+	}{
+		{"twitter", `{"author_url": "https://twitter.com/RonSwanson", "html": "<blockquote><p>%s</p></blockquote>"}`, true},
+		// A reply by a third party to the account's tweet
+		{"twitter", `{"author_url": "https://twitter.com/leslieknope", "html": "<blockquote><p>%s</p></blockquote>"}`, false},
+		{"twitter", `{"html": "<blockquote><p>%s</p></blockquote>"}`, false},
+		{"github", `{"owner": {"login": "ronswanson"}, "files": {"proof.txt": {"content": "%s"}}}`, true},
+		// A third party's gist, or a comment they left on the account's gist which the API doesn't return
+		{"github", `{"owner": {"login": "leslieknope"}, "files": {"proof.txt": {"content": "%s"}}}`, false},
+		{"github", `{"owner": {"login": "ronswanson"}, "files": {"notes.txt": {"content": "Bacon"}}, "comments": 1, "comment": "%s"}`, false},
+		{"reddit", `[{"data": {"children": [{"data": {"author": "ronswanson", "selftext": "%s"}}]}}, {"data": {"children": []}}]`, true},
+		// A third party's comment on the account's post
+		{"reddit", `[{"data": {"children": [{"data": {"author": "ronswanson", "selftext": "Bacon"}}]}}, {"data": {"children": [{"data": {"author": "leslieknope", "body": "%s"}}]}}]`, false},
+		{"reddit", `[{"data": {"children": [{"data": {"author": "leslieknope", "selftext": "%s"}}]}}]`, false},
+	} {
+		account := &pb.Profile_SocialAccount{Type: c.accountType, Username: "ronswanson"}
+		statement := SocialProofStatement(pid.Pretty(), account.Type, account.Username)
+		sig, err := priv.Sign([]byte(statement))
+		if err != nil {
+			t.Fatal(err)
+		}
+		resp := strings.Replace(c.resp, "%s", statement+" sig:"+base64.StdEncoding.EncodeToString(sig), 1)
+		text, err := verifiers[c.accountType].(*HTTPProofVerifier).PostText([]byte(resp), account)
+		if err == nil {
+			err = VerifySocialProofText(text, pid.Pretty(), pub, account)
+		}
+		if c.valid && err != nil {
+			t.Errorf("Expected %s proof %s to verify: %s", c.accountType, c.resp, err)
+		} else if !c.valid && err == nil {
+			t.Errorf("Expected %s proof %s to fail verification", c.accountType, c.resp)
+		}
+	}
+}
@@ -0,0 +1,395 @@
+package core
+
+import (
+	"encoding/json"
+	"errors"
+	"path"
+	"time"
+
+	"github.com/OpenBazaar/jsonpb"
+	"github.com/OpenBazaar/openbazaar-go/ipfs"
+	"github.com/OpenBazaar/openbazaar-go/pb"
+	"github.com/OpenBazaar/spvwallet"
+	"github.com/btcsuite/btcutil"
+	"github.com/golang/protobuf/proto"
+	"github.com/golang/protobuf/ptypes"
+	ipfspath "github.com/ipfs/go-ipfs/path"
+)
+
+var ErrSubscriptionNotFound = errors.New("Subscription not found")
+
+var ErrSpendingCapExceeded = errors.New("Order total exceeds the subscription spending cap")
+
+// How often the buyer's node checks for subscriptions which are due
+const SubscriptionBillingPeriod = time.Hour
+
+// Failed attempts in a row to pay for a subscription order before the subscription is paused
+const SubscriptionMaxFundingFailures = 3
+
+type SubscriptionData struct {
+	Purchase    PurchaseData `json:"purchase"`
+	SpendingCap uint64       `json:"spendingCap"` // Maximum satoshis per order
+}
+
+// Create a subscription to a listing which declares a billing interval. The first
+// order is placed and funded immediately, later orders every billing interval.
+func (n *OpenBazaarNode) Subscribe(data *SubscriptionData) (*pb.Subscription, error) {
+	if len(data.Purchase.Items) == 0 {
+		return nil, errors.New("Subscription hasn't selected any items")
+	}
+	if data.SpendingCap == 0 {
+		return nil, errors.New("Subscription must have a spending cap")
+	}
+	listingHash := data.Purchase.Items[0].ListingHash
+	for _, item := range data.Purchase.Items {
+		if item.ListingHash != listingHash {
+			return nil, errors.New("All items in a subscription must be from the same listing")
+		}
+	}
+	b, err := ipfs.Cat(n.Context, listingHash)
+	if err != nil {
+		return nil, err
+	}
+	rc := new(pb.RicardianContract)
+	err = jsonpb.UnmarshalString(string(b), rc)
+	if err != nil {
+		return nil, err
+	}
+	if len(rc.VendorListings) == 0 || rc.VendorListings[0].Metadata == nil || rc.VendorListings[0].VendorID == nil {
+		return nil, errors.New("Invalid listing")
+	}
+	listing := rc.VendorListings[0]
+	if listing.Metadata.BillingInterval == 0 {
+		return nil, errors.New("Listing is not available as a subscription")
+	}
+
+	template, err := json.Marshal(data.Purchase)
+	if err != nil {
+		return nil, err
+	}
+	created, err := ptypes.TimestampProto(time.Now())
+	if err != nil {
+		return nil, err
+	}
+	sub := &pb.Subscription{
+		BuyerID:         n.IpfsNode.Identity.Pretty(),
+		VendorID:        listing.VendorID.PeerID,
+		ListingSlug:     listing.Slug,
+		BillingInterval: listing.Metadata.BillingInterval,
+		SpendingCap:     data.SpendingCap,
+		Created:         created,
+		PurchaseData:    string(template),
+	}
+	ser, err := proto.Marshal(sub)
+	if err != nil {
+		return nil, err
+	}
+	multihash, err := EncodeMultihash(ser)
+	if err != nil {
+		return nil, err
+	}
+	sub.Id = multihash.B58String()
+
+	if err := n.billSubscription(sub); err != nil {
+		return nil, err
+	}
+	if err := n.Datastore.Subscriptions().Put(sub); err != nil {
+		return nil, err
+	}
+	return sub, nil
+}
+
+// Place and fund the next order for a subscription. The listing hash is looked up
+// again each cycle so the order uses the vendor's current version of the listing.
+// If the last order couldn't be paid for, paying for it is retried instead.
+func (n *OpenBazaarNode) billSubscription(sub *pb.Subscription) error {
+	if sub.UnfundedOrderID != "" {
+		return n.fundSubscriptionOrder(sub)
+	}
+	var data PurchaseData
+	if err := json.Unmarshal([]byte(sub.PurchaseData), &data); err != nil {
+		return err
+	}
+	listingHash, err := n.getListingHash(sub.VendorID, sub.ListingSlug)
+	if err != nil {
+		return err
+	}
+	for i := range data.Items {
+		data.Items[i].ListingHash = listingHash
+	}
+	data.SubscriptionID = sub.Id
+	data.SpendingCap = sub.SpendingCap
+
+	orderId, _, amount, _, err := n.Purchase(&data)
+	if err != nil {
+		return err
+	}
+	// Store credit is only applied to the first order so drop it from the template
+	if data.Credit != nil {
+		var template PurchaseData
+		if err := json.Unmarshal([]byte(sub.PurchaseData), &template); err != nil {
+			return err
+		}
+		template.Credit = nil
+		b, err := json.Marshal(template)
+		if err != nil {
+			return err
+		}
+		sub.PurchaseData = string(b)
+	}
+	sub.OrderIDs = append(sub.OrderIDs, orderId)
+
+	// Orders fully covered by store credit have nothing to pay
+	if amount == 0 {
+		return advanceSubscription(sub)
+	}
+	sub.UnfundedOrderID = orderId
+	return n.fundSubscriptionOrder(sub)
+}
+
+// Pay for the subscription's unfunded order. The billing cycle only advances once the
+// order is paid for. A failure is recorded on the subscription and retried at the next
+// billing check. After SubscriptionMaxFundingFailures in a row the subscription is paused
+// and stays paused until the buyer resumes it.
+func (n *OpenBazaarNode) fundSubscriptionOrder(sub *pb.Subscription) error {
+	contract, _, funded, _, _, err := n.Datastore.Purchases().GetByOrderId(sub.UnfundedOrderID)
+	if err != nil {
+		return err
+	}
+	if !funded {
+		paymentAddress := contract.BuyerOrder.Payment.Address
+		if contract.VendorOrderConfirmation != nil {
+			paymentAddress = contract.VendorOrderConfirmation.PaymentAddress
+		}
+		addr, err := btcutil.DecodeAddress(paymentAddress, n.Wallet.Params())
+		if err != nil {
+			return err
+		}
+		_, err = n.Wallet.Spend(int64(contract.BuyerOrder.Payment.Amount), addr, spvwallet.NORMAL)
+		if err != nil {
+			sub.FundingFailures++
+			sub.LastError = "Failed to fund order " + sub.UnfundedOrderID + ": " + err.Error()
+			log.Errorf("Subscription %s: %s", sub.Id, sub.LastError)
+			if sub.FundingFailures >= SubscriptionMaxFundingFailures {
+				sub.Status = pb.Subscription_PAUSED
+				if err := n.SendSubscriptionUpdate(sub.VendorID, sub.Id, sub.Status); err != nil {
+					log.Error(err)
+				}
+			}
+			return nil
+		}
+	}
+	sub.UnfundedOrderID = ""
+	sub.FundingFailures = 0
+	return advanceSubscription(sub)
+}
+
+// Start the next billing interval from now
+func advanceSubscription(sub *pb.Subscription) error {
+	var err error
+	sub.LastOrder, err = ptypes.TimestampProto(time.Now())
+	if err != nil {
+		return err
+	}
+	sub.LastError = ""
+	return nil
+}
+
+// Return the hash of a vendor's current listing for the given slug
+func (n *OpenBazaarNode) getListingHash(peerId, slug string) (string, error) {
+	var index []listingData
+	if n.isOwnID(peerId) {
+		var err error
+		index, err = n.getListingIndex()
+		if err != nil {
+			return "", err
+		}
+	} else {
+		b, err := ipfs.ResolveThenCat(n.Context, ipfspath.FromString(path.Join(peerId, "listings", "index.json")))
+		if err != nil {
+			return "", err
+		}
+		if err := json.Unmarshal(b, &index); err != nil {
+			return "", err
+		}
+	}
+	for _, ld := range index {
+		if ld.Slug == slug {
+			return ld.Hash, nil
+		}
+	}
+	return "", errors.New("Subscription listing no longer exists")
+}
+
+// Place orders for every active subscription we're buying which has reached the
+// end of its billing interval
+func (n *OpenBazaarNode) BillSubscriptions() {
+	subs, err := n.Datastore.Subscriptions().GetAll()
+	if err != nil {
+		log.Error(err)
+		return
+	}
+	for _, sub := range subs {
+		if !n.isOwnID(sub.BuyerID) || sub.Status != pb.Subscription_ACTIVE {
+			continue
+		}
+		if sub.LastOrder != nil {
+			lastOrder, err := ptypes.Timestamp(sub.LastOrder)
+			if err != nil {
+				continue
+			}
+			if time.Now().Before(lastOrder.Add(time.Hour * 24 * time.Duration(sub.BillingInterval))) {
+				continue
+			}
+		}
+		if err := n.billSubscription(sub); err != nil {
+			log.Errorf("Error placing order for subscription %s: %s", sub.Id, err.Error())
+			sub.LastError = err.Error()
+			if err == ErrSpendingCapExceeded {
+				// The price went up. Pause until the buyer raises the cap and resumes.
+				sub.Status = pb.Subscription_PAUSED
+				if err := n.SendSubscriptionUpdate(sub.VendorID, sub.Id, sub.Status); err != nil {
+					log.Error(err)
+				}
+			}
+		}
+		if err := n.Datastore.Subscriptions().Put(sub); err != nil {
+			log.Error(err)
+		}
+	}
+}
+
+// Bill subscriptions periodically. Intended to be run in its own goroutine once the
+// wallet has started.
+func (n *OpenBazaarNode) StartSubscriptionBilling() {
+	tick := time.NewTicker(SubscriptionBillingPeriod)
+	defer tick.Stop()
+	for range tick.C {
+		n.BillSubscriptions()
+	}
+}
+
+// Change the status of one of our subscriptions and notify the other party
+func (n *OpenBazaarNode) SetSubscriptionStatus(id string, status pb.Subscription_Status) error {
+	sub, err := n.Datastore.Subscriptions().Get(id)
+	if err != nil {
+		return ErrSubscriptionNotFound
+	}
+	if err := updateSubscriptionStatus(sub, status, n.isOwnID(sub.BuyerID)); err != nil {
+		return err
+	}
+	if err := n.Datastore.Subscriptions().Put(sub); err != nil {
+		return err
+	}
+	counterparty := sub.VendorID
+	if n.isOwnID(sub.VendorID) {
+		counterparty = sub.BuyerID
+	}
+	return n.SendSubscriptionUpdate(counterparty, sub.Id, status)
+}
+
+// Apply a status change sent to us by the other party to a subscription
+func (n *OpenBazaarNode) HandleSubscriptionUpdate(peerId, id string, status pb.Subscription_Status) error {
+	sub, err := n.Datastore.Subscriptions().Get(id)
+	if err != nil {
+		return ErrSubscriptionNotFound
+	}
+	byBuyer := n.IsSamePeer(sub.BuyerID, peerId)
+	if !byBuyer && !n.IsSamePeer(sub.VendorID, peerId) {
+		return errors.New("Peer is not a party to the subscription")
+	}
+	if err := updateSubscriptionStatus(sub, status, byBuyer); err != nil {
+		return err
+	}
+	return n.Datastore.Subscriptions().Put(sub)
+}
+
+// Either party may pause or cancel a subscription but only the buyer can resume
+// billing. Canceled subscriptions can't be changed.
+func updateSubscriptionStatus(sub *pb.Subscription, status pb.Subscription_Status, byBuyer bool) error {
+	if sub.Status == pb.Subscription_CANCELED {
+		return errors.New("Subscription has been canceled")
+	}
+	if status == pb.Subscription_ACTIVE && !byBuyer {
+		return errors.New("Only the buyer can resume a subscription")
+	}
+	// Resuming gives an unfunded order a fresh set of attempts
+	if status == pb.Subscription_ACTIVE {
+		sub.FundingFailures = 0
+	}
+	sub.Status = status
+	return nil
+}
+
+// Change the most we'll pay for each order of a subscription we're buying. A
+// subscription paused because the price went over the cap must be resumed afterwards.
+func (n *OpenBazaarNode) SetSubscriptionSpendingCap(id string, spendingCap uint64) error {
+	sub, err := n.Datastore.Subscriptions().Get(id)
+	if err != nil {
+		return ErrSubscriptionNotFound
+	}
+	if !n.isOwnID(sub.BuyerID) {
+		return errors.New("Only the buyer can change the spending cap")
+	}
+	if sub.Status == pb.Subscription_CANCELED {
+		return errors.New("Subscription has been canceled")
+	}
+	if spendingCap == 0 {
+		return errors.New("Subscription must have a spending cap")
+	}
+	sub.SpendingCap = spendingCap
+	return n.Datastore.Subscriptions().Put(sub)
+}
+
+// Checks an incoming order placed on behalf of a subscription is for a subscription
+// listing and that we haven't paused or canceled the subscription
+func (n *OpenBazaarNode) validateSubscriptionOrder(contract *pb.RicardianContract) error {
+	id := contract.BuyerOrder.SubscriptionID
+	if id == "" {
+		return nil
+	}
+	for _, listing := range contract.VendorListings {
+		if listing.Metadata == nil || listing.Metadata.BillingInterval == 0 {
+			return errors.New("Listing is not available as a subscription")
+		}
+	}
+	sub, err := n.Datastore.Subscriptions().Get(id)
+	if err != nil {
+		return nil
+	}
+	if !n.IsSamePeer(sub.BuyerID, contract.BuyerOrder.BuyerID.PeerID) {
+		return errors.New("Subscription belongs to a different buyer")
+	}
+	if sub.Status != pb.Subscription_ACTIVE {
+		return errors.New("Subscription is not active")
+	}
+	return nil
+}
+
+// Record an accepted order against the subscription it was placed for, creating
+// the vendor's copy of the subscription on its first order
+func (n *OpenBazaarNode) TrackSubscriptionOrder(contract *pb.RicardianContract) error {
+	id := contract.BuyerOrder.SubscriptionID
+	if id == "" {
+		return nil
+	}
+	orderId, err := n.CalcOrderId(contract.BuyerOrder)
+	if err != nil {
+		return err
+	}
+	sub, err := n.Datastore.Subscriptions().Get(id)
+	if err != nil {
+		listing := contract.VendorListings[0]
+		sub = &pb.Subscription{
+			Id:              id,
+			BuyerID:         contract.BuyerOrder.BuyerID.PeerID,
+			VendorID:        n.IpfsNode.Identity.Pretty(),
+			ListingSlug:     listing.Slug,
+			BillingInterval: listing.Metadata.BillingInterval,
+			Created:         contract.BuyerOrder.Timestamp,
+		}
+	}
+	sub.OrderIDs = append(sub.OrderIDs, orderId)
+	sub.LastOrder = contract.BuyerOrder.Timestamp
+	return n.Datastore.Subscriptions().Put(sub)
+}
@@ -0,0 +1,42 @@
+package core
+
+import (
+	"testing"
+
+	"github.com/OpenBazaar/openbazaar-go/pb"
+)
+
+func TestUpdateSubscriptionStatus(t *testing.T) {
+	sub := &pb.Subscription{Id: "QmSubscription"}
+	if err := updateSubscriptionStatus(sub, pb.Subscription_PAUSED, true); err != nil {
+		t.Error(err)
+	}
+	if sub.Status != pb.Subscription_PAUSED {
+		t.Error("Failed to pause subscription")
+	}
+	if err := updateSubscriptionStatus(sub, pb.Subscription_ACTIVE, false); err == nil {
+		t.Error("Expected the vendor not to be able to resume the subscription")
+	}
+	if sub.Status != pb.Subscription_PAUSED {
+		t.Error("Vendor resumed the subscription")
+	}
+	sub.FundingFailures = SubscriptionMaxFundingFailures
+	if err := updateSubscriptionStatus(sub, pb.Subscription_ACTIVE, true); err != nil {
+		t.Error(err)
+	}
+	if sub.FundingFailures != 0 {
+		t.Error("Expected resuming to reset the funding failures")
+	}
+	if err := updateSubscriptionStatus(sub, pb.Subscription_PAUSED, false); err != nil {
+		t.Error(err)
+	}
+	if err := updateSubscriptionStatus(sub, pb.Subscription_CANCELED, true); err != nil {
+		t.Error(err)
+	}
+	if err := updateSubscriptionStatus(sub, pb.Subscription_ACTIVE, true); err == nil {
+		t.Error("Expected a canceled subscription to stay canceled")
+	}
+	if sub.Status != pb.Subscription_CANCELED {
+		t.Error("Canceled subscription status was changed")
+	}
+}
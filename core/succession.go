@@ -0,0 +1,477 @@
+package core
+
+import (
+	"errors"
+	"io/ioutil"
+	"os"
+	"path"
+	"time"
+
+	libp2p "gx/ipfs/QmPGxZ1DP2w45WcogpW1h43BvseXbfke9N91qotpoQcUeS/go-libp2p-crypto"
+	peer "gx/ipfs/QmWUswjn261LSyVxWAEpMVtPdy8zmKBJJfBpG3Qdpa8ZsE/go-libp2p-peer"
+
+	"github.com/OpenBazaar/jsonpb"
+	"github.com/OpenBazaar/openbazaar-go/api/notifications"
+	"github.com/OpenBazaar/openbazaar-go/ipfs"
+	"github.com/OpenBazaar/openbazaar-go/pb"
+	"github.com/OpenBazaar/openbazaar-go/repo"
+	"github.com/golang/protobuf/proto"
+	"github.com/golang/protobuf/ptypes"
+)
+
+// Marks a rotation which the node finishes the next time it starts under the new key
+const successionPendingFile = "succession_pending"
+
+// Derive the next identity key from our mnemonic and hand our identity over to it.
+// The key for each rotation is derived from the mnemonic and the rotation's index in
+// the chain so restoring from the mnemonic with the number of rotations gives the
+// current key back. The succession is signed by both keys and added to the chain in
+// our root. The node must be restarted to run under the new key, after which
+// CompleteIdentityRotation finishes the rotation.
+func RotateIdentityKey(repoPath string, config repo.Config) (*pb.IdentityChain, error) {
+	oldKeyBytes, err := config.GetIdentityKey()
+	if err != nil {
+		return nil, err
+	}
+	oldKey, err := libp2p.UnmarshalPrivateKey(oldKeyBytes)
+	if err != nil {
+		return nil, err
+	}
+	chain, err := readIdentityChain(repoPath)
+	if err != nil {
+		return nil, err
+	}
+	mnemonic, err := config.GetMnemonic()
+	if err != nil {
+		return nil, err
+	}
+	newKeyBytes, err := repo.IdentityKeyFromMnemonic(mnemonic, uint32(len(chain.Successions)+1))
+	if err != nil {
+		return nil, err
+	}
+	newKey, err := libp2p.UnmarshalPrivateKey(newKeyBytes)
+	if err != nil {
+		return nil, err
+	}
+	if newKey.Equals(oldKey) {
+		return nil, errors.New("Identity chain does not record the rotation to the current key")
+	}
+	succession, err := newIdentitySuccession(oldKey, newKey)
+	if err != nil {
+		return nil, err
+	}
+	chain.Successions = append(chain.Successions, succession)
+
+	if err := config.SetIdentityKey(newKeyBytes); err != nil {
+		return nil, err
+	}
+	if err := writeIdentityChain(repoPath, chain); err != nil {
+		config.SetIdentityKey(oldKeyBytes)
+		return nil, err
+	}
+	if err := ioutil.WriteFile(path.Join(repoPath, successionPendingFile), []byte(succession.PredecessorID), os.FileMode(0600)); err != nil {
+		return nil, err
+	}
+	return chain, nil
+}
+
+// Rotate the identity key of the running node. The chain is published under our
+// current peer ID straight away so anyone looking us up finds the successor.
+func (n *OpenBazaarNode) RotateIdentityKey() (*pb.IdentityChain, error) {
+	chain, err := RotateIdentityKey(n.RepoPath, n.Datastore.Config())
+	if err != nil {
+		return nil, err
+	}
+	return chain, n.SeedNode()
+}
+
+// Finish a rotation of our identity key once the node is running under the new key.
+// Our listings and profile are signed again, the store is published under the new
+// peer ID and the peers we follow, who follow us or who we have open orders or cases
+// with are sent the chain.
+func (n *OpenBazaarNode) CompleteIdentityRotation() error {
+	pendingPath := path.Join(n.RepoPath, successionPendingFile)
+	if _, err := os.Stat(pendingPath); os.IsNotExist(err) {
+		return nil
+	}
+	chain, err := readIdentityChain(n.RepoPath)
+	if err != nil {
+		return err
+	}
+	peerID := n.IpfsNode.Identity.Pretty()
+	if len(chain.Successions) == 0 || chain.Successions[len(chain.Successions)-1].SuccessorID != peerID {
+		return errors.New("Identity chain does not end at our peer ID")
+	}
+	log.Noticef("Completing rotation of identity %s to %s", chain.Successions[len(chain.Successions)-1].PredecessorID, peerID)
+
+	if err := n.resignListings(); err != nil {
+		return err
+	}
+	if profile, err := n.GetProfile(); err == nil {
+		profile.PeerID = peerID
+		if err := n.UpdateProfile(&profile); err != nil {
+			return err
+		}
+	}
+	if err := n.UpdateFollow(); err != nil {
+		return err
+	}
+	if err := n.SeedNode(); err != nil {
+		return err
+	}
+	for _, p := range n.successionRecipients() {
+		if err := n.SendIdentitySuccession(p, chain); err != nil {
+			log.Errorf("Failed to send identity succession to %s: %s", p, err.Error())
+		}
+	}
+	return os.Remove(pendingPath)
+}
+
+// Sign each of our listings again so they carry our new peer ID and key
+func (n *OpenBazaarNode) resignListings() error {
+	index, err := n.getListingIndex()
+	if err != nil {
+		return err
+	}
+	hashes := make(map[string]string)
+	for _, ld := range index {
+		listingPath := path.Join(n.RepoPath, "root", "listings", ld.Slug+".json")
+		file, err := ioutil.ReadFile(listingPath)
+		if err != nil {
+			return err
+		}
+		rc := new(pb.RicardianContract)
+		if err := jsonpb.UnmarshalString(string(file), rc); err != nil {
+			return err
+		}
+		// The stored listing only has the hashes of its coupon codes so keep the codes
+		// SignListing would otherwise drop
+		coupons, err := n.Datastore.Coupons().Get(ld.Slug)
+		if err != nil {
+			return err
+		}
+		signed, err := n.SignListing(rc.VendorListings[0])
+		if err != nil {
+			return err
+		}
+		if err := n.Datastore.Coupons().Delete(ld.Slug); err != nil {
+			return err
+		}
+		if err := n.Datastore.Coupons().Put(coupons); err != nil {
+			return err
+		}
+		m := jsonpb.Marshaler{
+			EnumsAsInts:  false,
+			EmitDefaults: false,
+			Indent:       "    ",
+			OrigName:     false,
+		}
+		out, err := m.MarshalToString(signed)
+		if err != nil {
+			return err
+		}
+		if err := ioutil.WriteFile(listingPath, []byte(out), os.FileMode(0644)); err != nil {
+			return err
+		}
+		hash, err := ipfs.GetHash(n.Context, listingPath)
+		if err != nil {
+			return err
+		}
+		hashes[ld.Slug] = hash
+	}
+	return n.UpdateIndexHashes(hashes)
+}
+
+// The peers who need to learn about our successor: followers, the peers we follow and
+// the other parties to our open orders and cases
+func (n *OpenBazaarNode) successionRecipients() []string {
+	recipients := make(map[string]bool)
+	followers, err := n.Datastore.Followers().Get("", -1)
+	if err == nil {
+		for _, p := range followers {
+			recipients[p] = true
+		}
+	}
+	following, err := n.Datastore.Following().Get("", -1)
+	if err == nil {
+		for _, p := range following {
+			recipients[p] = true
+		}
+	}
+	addParties := func(contract *pb.RicardianContract, state pb.OrderState) {
+		if contract == nil || !orderOpen(state) || len(contract.VendorListings) == 0 || contract.BuyerOrder == nil {
+			return
+		}
+		recipients[contract.BuyerOrder.BuyerID.PeerID] = true
+		recipients[contract.VendorListings[0].VendorID.PeerID] = true
+		if contract.BuyerOrder.Payment != nil && contract.BuyerOrder.Payment.Moderator != "" {
+			recipients[contract.BuyerOrder.Payment.Moderator] = true
+		}
+	}
+	if purchases, err := n.Datastore.Purchases().GetAll("", -1); err == nil {
+		for _, purchase := range purchases {
+			contract, state, _, _, _, err := n.Datastore.Purchases().GetByOrderId(purchase.OrderId)
+			if err == nil {
+				addParties(contract, state)
+			}
+		}
+	}
+	if sales, err := n.Datastore.Sales().GetAll("", -1); err == nil {
+		for _, sale := range sales {
+			contract, state, _, _, _, err := n.Datastore.Sales().GetByOrderId(sale.OrderId)
+			if err == nil {
+				addParties(contract, state)
+			}
+		}
+	}
+	if cases, err := n.Datastore.Cases().GetAll("", -1); err == nil {
+		for _, c := range cases {
+			if c.State == pb.OrderState_RESOLVED.String() {
+				continue
+			}
+			if c.BuyerId != "" {
+				recipients[c.BuyerId] = true
+			}
+			if c.VendorId != "" {
+				recipients[c.VendorId] = true
+			}
+		}
+	}
+	for _, s := range n.identitySuccessions() {
+		delete(recipients, s.PredecessorID)
+	}
+	delete(recipients, n.IpfsNode.Identity.Pretty())
+	var ret []string
+	for p := range recipients {
+		ret = append(ret, p)
+	}
+	return ret
+}
+
+func orderOpen(state pb.OrderState) bool {
+	switch state {
+	case pb.OrderState_COMPLETE, pb.OrderState_RESOLVED, pb.OrderState_REFUNDED, pb.OrderState_CANCELED, pb.OrderState_REJECTED:
+		return false
+	}
+	return true
+}
+
+// Record a chain sent to us by the peer it ends at. Follows in either direction are
+// moved from the peer's old IDs to the new one and messages to the old IDs are sent
+// to the new one from now on. The caller is responsible for republishing.
+func (n *OpenBazaarNode) ProcessIdentitySuccession(peerID string, chain *pb.IdentityChain) error {
+	if len(chain.Successions) == 0 {
+		return errors.New("Identity chain is empty")
+	}
+	last, err := VerifyIdentityChain(chain, chain.Successions[0].PredecessorID)
+	if err != nil {
+		return err
+	}
+	if last.SuccessorID != peerID {
+		return errors.New("Identity chain was not sent by the peer it ends at")
+	}
+
+	// Whoever holds a predecessor's key could sign it over to someone else. Only the
+	// first chain we see for an ID counts, and later ones must extend it.
+	for _, s := range chain.Successions {
+		existing, _, err := n.Datastore.Successions().Get(s.PredecessorID)
+		if err == nil && existing != peerID && successionIndex(chain.Successions, existing) < 0 {
+			return errors.New("Identity chain conflicts with a succession we already know")
+		}
+	}
+
+	for _, s := range chain.Successions {
+		if err := n.Datastore.Successions().Put(s.PredecessorID, peerID, chain); err != nil {
+			return err
+		}
+		if n.Datastore.Following().IsFollowing(s.PredecessorID) {
+			if err := n.Datastore.Following().Delete(s.PredecessorID); err != nil {
+				return err
+			}
+			if err := n.Datastore.Following().Put(peerID); err != nil {
+				return err
+			}
+		}
+		if n.Datastore.Followers().FollowsMe(s.PredecessorID) {
+			if err := n.Datastore.Followers().Delete(s.PredecessorID); err != nil {
+				return err
+			}
+			if err := n.Datastore.Followers().Put(peerID); err != nil {
+				return err
+			}
+		}
+	}
+	if err := n.UpdateFollow(); err != nil {
+		return err
+	}
+
+	notif := notifications.IdentitySuccessionNotification{last.PredecessorID, peerID}
+	n.Broadcast <- notif
+	n.Datastore.Notifications().Put(notif, time.Now())
+	return nil
+}
+
+// Whether a message from senderID comes from the peer known as peerID, either because
+// they are the same or because senderID is peerID's verified successor
+func (n *OpenBazaarNode) IsSamePeer(peerID, senderID string) bool {
+	if peerID == senderID {
+		return true
+	}
+	successorID, _, err := n.Datastore.Successions().Get(peerID)
+	return err == nil && successorID == senderID
+}
+
+// Whether a peer ID is ours, either now or before our identity key was rotated
+func (n *OpenBazaarNode) isOwnID(peerID string) bool {
+	if peerID == n.IpfsNode.Identity.Pretty() {
+		return true
+	}
+	return successionIndex(n.identitySuccessions(), peerID) >= 0
+}
+
+// The successions to attach to our signatures on contracts so they verify against
+// the identity in contracts made before our key was rotated
+func (n *OpenBazaarNode) identitySuccessions() []*pb.IdentitySuccession {
+	chain, err := readIdentityChain(n.RepoPath)
+	if err != nil {
+		log.Error(err)
+		return nil
+	}
+	return chain.Successions
+}
+
+func readIdentityChain(repoPath string) (*pb.IdentityChain, error) {
+	chain := new(pb.IdentityChain)
+	b, err := ioutil.ReadFile(path.Join(repoPath, "root", "succession.json"))
+	if os.IsNotExist(err) {
+		return chain, nil
+	} else if err != nil {
+		return nil, err
+	}
+	if err := jsonpb.UnmarshalString(string(b), chain); err != nil {
+		return nil, err
+	}
+	return chain, nil
+}
+
+func writeIdentityChain(repoPath string, chain *pb.IdentityChain) error {
+	m := jsonpb.Marshaler{
+		EnumsAsInts:  false,
+		EmitDefaults: false,
+		Indent:       "    ",
+		OrigName:     false,
+	}
+	out, err := m.MarshalToString(chain)
+	if err != nil {
+		return err
+	}
+	return ioutil.WriteFile(path.Join(repoPath, "root", "succession.json"), []byte(out), os.FileMode(0644))
+}
+
+func newIdentitySuccession(oldKey, newKey libp2p.PrivKey) (*pb.IdentitySuccession, error) {
+	oldPubkey, err := oldKey.GetPublic().Bytes()
+	if err != nil {
+		return nil, err
+	}
+	oldID, err := peer.IDFromPrivateKey(oldKey)
+	if err != nil {
+		return nil, err
+	}
+	newPubkey, err := newKey.GetPublic().Bytes()
+	if err != nil {
+		return nil, err
+	}
+	newID, err := peer.IDFromPrivateKey(newKey)
+	if err != nil {
+		return nil, err
+	}
+	ts, err := ptypes.TimestampProto(time.Now())
+	if err != nil {
+		return nil, err
+	}
+	succession := &pb.IdentitySuccession{
+		PredecessorID:     oldID.Pretty(),
+		PredecessorPubkey: oldPubkey,
+		SuccessorID:       newID.Pretty(),
+		SuccessorPubkey:   newPubkey,
+		Timestamp:         ts,
+	}
+	ser, err := proto.Marshal(succession)
+	if err != nil {
+		return nil, err
+	}
+	succession.PredecessorSignature, err = oldKey.Sign(ser)
+	if err != nil {
+		return nil, err
+	}
+	succession.SuccessorSignature, err = newKey.Sign(ser)
+	if err != nil {
+		return nil, err
+	}
+	return succession, nil
+}
+
+// Checks every succession in a chain from peerID onwards and returns the last one,
+// which names the peer's current identity
+func VerifyIdentityChain(chain *pb.IdentityChain, peerID string) (*pb.IdentitySuccession, error) {
+	i := successionIndex(chain.Successions, peerID)
+	if i < 0 {
+		return nil, errors.New("Peer has no successor in the identity chain")
+	}
+	for ; i < len(chain.Successions); i++ {
+		s := chain.Successions[i]
+		if s.PredecessorID != peerID {
+			return nil, errors.New("Identity chain is broken")
+		}
+		if err := verifyIdentitySuccession(s); err != nil {
+			return nil, err
+		}
+		peerID = s.SuccessorID
+	}
+	return chain.Successions[len(chain.Successions)-1], nil
+}
+
+func verifyIdentitySuccession(s *pb.IdentitySuccession) error {
+	unsigned := *s
+	unsigned.PredecessorSignature = nil
+	unsigned.SuccessorSignature = nil
+	ser, err := proto.Marshal(&unsigned)
+	if err != nil {
+		return err
+	}
+	for _, k := range []struct {
+		peerID    string
+		pubkey    []byte
+		signature []byte
+	}{
+		{s.PredecessorID, s.PredecessorPubkey, s.PredecessorSignature},
+		{s.SuccessorID, s.SuccessorPubkey, s.SuccessorSignature},
+	} {
+		pubkey, err := libp2p.UnmarshalPublicKey(k.pubkey)
+		if err != nil {
+			return err
+		}
+		pid, err := peer.IDB58Decode(k.peerID)
+		if err != nil {
+			return err
+		}
+		if !pid.MatchesPublicKey(pubkey) {
+			return errors.New("Public key in identity succession does not match the peer ID")
+		}
+		valid, err := pubkey.Verify(ser, k.signature)
+		if err != nil || !valid {
+			return errors.New("Signature on identity succession failed to verify")
+		}
+	}
+	return nil
+}
+
+// Position of the succession handing over peerID, or -1 if it isn't in the chain
+func successionIndex(successions []*pb.IdentitySuccession, peerID string) int {
+	for i, s := range successions {
+		if s.PredecessorID == peerID {
+			return i
+		}
+	}
+	return -1
+}
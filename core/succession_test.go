@@ -0,0 +1,177 @@
+package core
+
+import (
+	"bytes"
+	"io/ioutil"
+	"os"
+	"path"
+	"testing"
+
+	libp2p "gx/ipfs/QmPGxZ1DP2w45WcogpW1h43BvseXbfke9N91qotpoQcUeS/go-libp2p-crypto"
+	peer "gx/ipfs/QmWUswjn261LSyVxWAEpMVtPdy8zmKBJJfBpG3Qdpa8ZsE/go-libp2p-peer"
+
+	"github.com/OpenBazaar/openbazaar-go/pb"
+	"github.com/OpenBazaar/openbazaar-go/repo"
+	"github.com/golang/protobuf/proto"
+)
+
+type mockIdentityConfig struct {
+	mnemonic    string
+	identityKey []byte
+}
+
+func (c *mockIdentityConfig) Init(mnemonic string, identityKey []byte, password string) error {
+	c.mnemonic = mnemonic
+	c.identityKey = identityKey
+	return nil
+}
+func (c *mockIdentityConfig) GetMnemonic() (string, error)    { return c.mnemonic, nil }
+func (c *mockIdentityConfig) GetIdentityKey() ([]byte, error) { return c.identityKey, nil }
+func (c *mockIdentityConfig) SetIdentityKey(key []byte) error { c.identityKey = key; return nil }
+func (c *mockIdentityConfig) IsEncrypted() bool               { return false }
+
+func newIdentityKeys(t *testing.T, count int) []libp2p.PrivKey {
+	var keys []libp2p.PrivKey
+	for i := 0; i < count; i++ {
+		priv, _, err := libp2p.GenerateKeyPair(libp2p.Ed25519, 256)
+		if err != nil {
+			t.Fatal(err)
+		}
+		keys = append(keys, priv)
+	}
+	return keys
+}
+
+func newIdentityChain(t *testing.T, keys []libp2p.PrivKey) *pb.IdentityChain {
+	chain := new(pb.IdentityChain)
+	for i := 1; i < len(keys); i++ {
+		s, err := newIdentitySuccession(keys[i-1], keys[i])
+		if err != nil {
+			t.Fatal(err)
+		}
+		chain.Successions = append(chain.Successions, s)
+	}
+	return chain
+}
+
+func identityID(t *testing.T, key libp2p.PrivKey) string {
+	pid, err := peer.IDFromPrivateKey(key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return pid.Pretty()
+}
+
+func TestVerifyIdentityChain(t *testing.T) {
+	keys := newIdentityKeys(t, 3)
+	chain := newIdentityChain(t, keys)
+	for _, k := range keys[:2] {
+		last, err := VerifyIdentityChain(chain, identityID(t, k))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if last.SuccessorID != identityID(t, keys[2]) {
+			t.Error("Identity chain ended at the wrong peer")
+		}
+	}
+	if _, err := VerifyIdentityChain(chain, identityID(t, keys[2])); err == nil {
+		t.Error("Expected the current identity to have no successor")
+	}
+
+	// A successor named without the successor's signature
+	other := newIdentityKeys(t, 1)[0]
+	forged := proto.Clone(chain).(*pb.IdentityChain)
+	forged.Successions[1].SuccessorID = identityID(t, other)
+	if _, err := VerifyIdentityChain(forged, identityID(t, keys[0])); err == nil {
+		t.Error("Expected a chain with an altered successor to fail verification")
+	}
+
+	// Links which don't follow on from each other
+	broken := newIdentityChain(t, []libp2p.PrivKey{keys[0], keys[1]})
+	broken.Successions = append(broken.Successions, newIdentityChain(t, []libp2p.PrivKey{other, keys[2]}).Successions...)
+	if _, err := VerifyIdentityChain(broken, identityID(t, keys[0])); err == nil {
+		t.Error("Expected a broken chain to fail verification")
+	}
+}
+
+func TestVerifyMessageSignatureWithSuccession(t *testing.T) {
+	keys := newIdentityKeys(t, 2)
+	oldPubkey, err := keys[0].GetPublic().Bytes()
+	if err != nil {
+		t.Fatal(err)
+	}
+	msg := &pb.OrderConfirmation{OrderID: "QmOrder"}
+	ser, err := proto.Marshal(msg)
+	if err != nil {
+		t.Fatal(err)
+	}
+	sigBytes, err := keys[1].Sign(ser)
+	if err != nil {
+		t.Fatal(err)
+	}
+	sig := &pb.Signature{Section: pb.Signature_ORDER_CONFIRMATION, SignatureBytes: sigBytes}
+	if err := verifyMessageSignature(msg, oldPubkey, []*pb.Signature{sig}, pb.Signature_ORDER_CONFIRMATION, identityID(t, keys[0])); err == nil {
+		t.Error("Expected a signature by another key to fail verification")
+	}
+	sig.Successions = newIdentityChain(t, keys).Successions
+	if err := verifyMessageSignature(msg, oldPubkey, []*pb.Signature{sig}, pb.Signature_ORDER_CONFIRMATION, identityID(t, keys[0])); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestRotateIdentityKey(t *testing.T) {
+	repoPath, err := ioutil.TempDir("", "rotation")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(repoPath)
+	if err := os.MkdirAll(path.Join(repoPath, "root"), os.ModePerm); err != nil {
+		t.Fatal(err)
+	}
+	mnemonic := "mule track design catch stairs remain produce evidence cannon opera hamster burst"
+	firstBytes, err := repo.IdentityKeyFromMnemonic(mnemonic, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	first, err := libp2p.UnmarshalPrivateKey(firstBytes)
+	if err != nil {
+		t.Fatal(err)
+	}
+	config := &mockIdentityConfig{mnemonic: mnemonic, identityKey: firstBytes}
+
+	for i := 1; i <= 2; i++ {
+		chain, err := RotateIdentityKey(repoPath, config)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(chain.Successions) != i {
+			t.Fatalf("Expected %d successions, got %d", i, len(chain.Successions))
+		}
+	}
+	current, err := libp2p.UnmarshalPrivateKey(config.identityKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	chain, err := readIdentityChain(repoPath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	last, err := VerifyIdentityChain(chain, identityID(t, first))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if last.SuccessorID != identityID(t, current) {
+		t.Error("Identity chain does not end at the stored identity key")
+	}
+	// Restoring from the mnemonic with the number of rotations gives the current key
+	restored, err := repo.IdentityKeyFromMnemonic(mnemonic, uint32(len(chain.Successions)))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(restored, config.identityKey) {
+		t.Error("Expected the current identity key to be derived from the mnemonic")
+	}
+	if _, err := os.Stat(path.Join(repoPath, successionPendingFile)); err != nil {
+		t.Error("Expected the rotation to be marked as pending")
+	}
+}
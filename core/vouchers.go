@@ -0,0 +1,262 @@
+package core
+
+import (
+	"errors"
+	"fmt"
+	crypto "gx/ipfs/QmPGxZ1DP2w45WcogpW1h43BvseXbfke9N91qotpoQcUeS/go-libp2p-crypto"
+	peer "gx/ipfs/QmWUswjn261LSyVxWAEpMVtPdy8zmKBJJfBpG3Qdpa8ZsE/go-libp2p-peer"
+	"math"
+	"time"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/OpenBazaar/openbazaar-go/pb"
+	"github.com/golang/protobuf/proto"
+	"github.com/golang/protobuf/ptypes"
+)
+
+// Bearer codes are committed to in orders by hash alone, so a code needs this many bits of
+// entropy for the hash not to be brute forced back to the code by anyone who sees the order.
+const VoucherCodeMinEntropy = 64
+
+// Create, sign and save a voucher to the store credit ledger. If buyerID is set only
+// that peer may redeem the voucher, otherwise it is redeemable with the bearer code.
+func (n *OpenBazaarNode) IssueVoucher(amount uint64, buyerID, code, memo string) (*pb.Voucher, error) {
+	if amount == 0 {
+		return nil, errors.New("Voucher amount must be greater than zero")
+	}
+	if (buyerID == "") == (code == "") {
+		return nil, errors.New("Voucher must have either a buyer ID or a bearer code")
+	}
+	if len(memo) > ShortDescriptionLength {
+		return nil, fmt.Errorf("Voucher memo is longer than the max of %d characters", ShortDescriptionLength)
+	}
+	voucher := new(pb.Voucher)
+	voucher.Amount = amount
+	voucher.Memo = memo
+	if buyerID != "" {
+		if _, err := peer.IDB58Decode(buyerID); err != nil {
+			return nil, errors.New("Invalid buyer ID")
+		}
+		voucher.BuyerID = buyerID
+	} else {
+		if voucherCodeEntropy(code) < VoucherCodeMinEntropy {
+			return nil, fmt.Errorf("Voucher code must have at least %d bits of entropy. Use a longer code mixing upper and lower case letters, digits and symbols.", VoucherCodeMinEntropy)
+		}
+		multihash, err := EncodeMultihash([]byte(code))
+		if err != nil {
+			return nil, err
+		}
+		if _, err := n.Datastore.Vouchers().GetByCodeHash(multihash.B58String()); err == nil {
+			return nil, errors.New("A voucher with this code already exists")
+		}
+		voucher.CodeHash = multihash.B58String()
+	}
+	ts, err := ptypes.TimestampProto(time.Now())
+	if err != nil {
+		return nil, err
+	}
+	voucher.Timestamp = ts
+	pubkey, err := n.IpfsNode.PrivateKey.GetPublic().Bytes()
+	if err != nil {
+		return nil, err
+	}
+	voucher.VendorID = &pb.ID{
+		PeerID:  n.IpfsNode.Identity.Pretty(),
+		Pubkeys: &pb.ID_Pubkeys{Identity: pubkey},
+	}
+
+	ser, err := proto.Marshal(voucher)
+	if err != nil {
+		return nil, err
+	}
+	multihash, err := EncodeMultihash(ser)
+	if err != nil {
+		return nil, err
+	}
+	voucher.Id = multihash.B58String()
+
+	ser, err = proto.Marshal(voucher)
+	if err != nil {
+		return nil, err
+	}
+	voucher.Signature, err = n.IpfsNode.PrivateKey.Sign(ser)
+	if err != nil {
+		return nil, err
+	}
+	if err := n.Datastore.Vouchers().Put(voucher); err != nil {
+		return nil, err
+	}
+	return voucher, nil
+}
+
+// Estimate the entropy of a code from its length and the classes of characters it uses
+func voucherCodeEntropy(code string) float64 {
+	var lower, upper, digit, other bool
+	for _, r := range code {
+		switch {
+		case unicode.IsLower(r):
+			lower = true
+		case unicode.IsUpper(r):
+			upper = true
+		case unicode.IsDigit(r):
+			digit = true
+		default:
+			other = true
+		}
+	}
+	pool := 0
+	if lower {
+		pool += 26
+	}
+	if upper {
+		pool += 26
+	}
+	if digit {
+		pool += 10
+	}
+	if other {
+		pool += 33
+	}
+	if pool == 0 {
+		return 0
+	}
+	return float64(utf8.RuneCountInString(code)) * math.Log2(float64(pool))
+}
+
+// Checks the voucher was signed by the vendor it claims to be from
+func VerifyVoucher(voucher *pb.Voucher) error {
+	if voucher.VendorID == nil || voucher.VendorID.Pubkeys == nil {
+		return errors.New("Voucher does not contain a vendor ID")
+	}
+	pubkey, err := crypto.UnmarshalPublicKey(voucher.VendorID.Pubkeys.Identity)
+	if err != nil {
+		return err
+	}
+	pid, err := peer.IDFromPublicKey(pubkey)
+	if err != nil {
+		return err
+	}
+	if pid.Pretty() != voucher.VendorID.PeerID {
+		return errors.New("Public key in voucher does not match reported vendor ID")
+	}
+	unsigned := *voucher
+	unsigned.Signature = nil
+	ser, err := proto.Marshal(&unsigned)
+	if err != nil {
+		return err
+	}
+	valid, err := pubkey.Verify(ser, voucher.Signature)
+	if err != nil || !valid {
+		return errors.New("Vendor's signature on voucher failed to verify")
+	}
+	return nil
+}
+
+// The proof a buyer puts in their order that they hold a bearer voucher's code. It
+// commits to the hash of the code and the buyer's peer ID so the code never appears in
+// the contract and the proof can't be reused in an order from another buyer.
+func VoucherCodeProof(code, buyerID string) (string, error) {
+	codeHash, err := EncodeMultihash([]byte(code))
+	if err != nil {
+		return "", err
+	}
+	return voucherCodeProof(codeHash.B58String(), buyerID)
+}
+
+func voucherCodeProof(codeHash, buyerID string) (string, error) {
+	multihash, err := EncodeMultihash([]byte(codeHash + buyerID))
+	if err != nil {
+		return "", err
+	}
+	return multihash.B58String(), nil
+}
+
+// Look up the voucher referenced by the order's credit. The reference is either the ID
+// of a voucher tied to the buyer or a proof the buyer holds the code of a bearer voucher.
+func (n *OpenBazaarNode) getCreditVoucher(order *pb.Order) (*pb.VoucherBalance, error) {
+	if order.BuyerID == nil {
+		return nil, errors.New("Order does not contain a buyer ID")
+	}
+	var vb *pb.VoucherBalance
+	if order.Credit.Voucher != "" {
+		var err error
+		vb, err = n.Datastore.Vouchers().Get(order.Credit.Voucher)
+		if err != nil {
+			return nil, errors.New("Voucher not found")
+		}
+		if vb.Voucher.BuyerID == "" || vb.Voucher.BuyerID != order.BuyerID.PeerID {
+			return nil, errors.New("Voucher cannot be redeemed by this buyer")
+		}
+	} else {
+		vouchers, err := n.Datastore.Vouchers().GetAll()
+		if err != nil {
+			return nil, err
+		}
+		for _, v := range vouchers {
+			if v.Voucher.CodeHash == "" {
+				continue
+			}
+			proof, err := voucherCodeProof(v.Voucher.CodeHash, order.BuyerID.PeerID)
+			if err != nil {
+				return nil, err
+			}
+			if proof == order.Credit.CodeProof {
+				vb = v
+				break
+			}
+		}
+		if vb == nil {
+			return nil, errors.New("Voucher not found")
+		}
+	}
+	if err := VerifyVoucher(vb.Voucher); err != nil {
+		return nil, err
+	}
+	if !n.isOwnID(vb.Voucher.VendorID.PeerID) {
+		return nil, errors.New("Voucher was not issued by this store")
+	}
+	return vb, nil
+}
+
+// Checks the store credit applied to the order is covered by the voucher balance
+func (n *OpenBazaarNode) validateCredit(order *pb.Order) error {
+	if order.Credit == nil {
+		return nil
+	}
+	if order.Credit.Amount == 0 {
+		return errors.New("Store credit amount must be greater than zero")
+	}
+	vb, err := n.getCreditVoucher(order)
+	if err != nil {
+		return err
+	}
+	if vb.Balance < order.Credit.Amount {
+		return errors.New("Store credit exceeds the voucher balance")
+	}
+	return nil
+}
+
+// Debit the store credit applied to the order from the voucher ledger
+func (n *OpenBazaarNode) RedeemCredit(order *pb.Order) error {
+	if order.Credit == nil {
+		return nil
+	}
+	vb, err := n.getCreditVoucher(order)
+	if err != nil {
+		return err
+	}
+	return n.Datastore.Vouchers().Redeem(vb.Voucher.Id, order.Credit.Amount)
+}
+
+// Return the store credit applied to a canceled or rejected order to the voucher
+func (n *OpenBazaarNode) RestoreCredit(order *pb.Order) error {
+	if order.Credit == nil {
+		return nil
+	}
+	vb, err := n.getCreditVoucher(order)
+	if err != nil {
+		return err
+	}
+	return n.Datastore.Vouchers().Restore(vb.Voucher.Id, order.Credit.Amount)
+}
@@ -0,0 +1,92 @@
+package core
+
+import (
+	crypto "gx/ipfs/QmPGxZ1DP2w45WcogpW1h43BvseXbfke9N91qotpoQcUeS/go-libp2p-crypto"
+	peer "gx/ipfs/QmWUswjn261LSyVxWAEpMVtPdy8zmKBJJfBpG3Qdpa8ZsE/go-libp2p-peer"
+	"testing"
+
+	"github.com/OpenBazaar/openbazaar-go/pb"
+	"github.com/golang/protobuf/proto"
+)
+
+func TestVerifyVoucher(t *testing.T) {
+	priv, pub, err := crypto.GenerateKeyPair(crypto.Ed25519, 256)
+	if err != nil {
+		t.Fatal(err)
+	}
+	pid, err := peer.IDFromPublicKey(pub)
+	if err != nil {
+		t.Fatal(err)
+	}
+	pubBytes, err := pub.Bytes()
+	if err != nil {
+		t.Fatal(err)
+	}
+	voucher := &pb.Voucher{
+		Id:       "QmVoucher",
+		Amount:   50000,
+		CodeHash: "QmCode",
+		VendorID: &pb.ID{PeerID: pid.Pretty(), Pubkeys: &pb.ID_Pubkeys{Identity: pubBytes}},
+	}
+	ser, err := proto.Marshal(voucher)
+	if err != nil {
+		t.Fatal(err)
+	}
+	voucher.Signature, err = priv.Sign(ser)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := VerifyVoucher(voucher); err != nil {
+		t.Error(err)
+	}
+
+	voucher.Amount = 100000
+	if err := VerifyVoucher(voucher); err == nil {
+		t.Error("Expected a modified voucher to fail verification")
+	}
+	voucher.Amount = 50000
+
+	voucher.VendorID.PeerID = "QmYwAPJzv5CZsnA625s3Xf2nemtYgPpHdWEz79ojWnPbdG"
+	if err := VerifyVoucher(voucher); err == nil {
+		t.Error("Expected a mismatched vendor ID to fail verification")
+	}
+}
+
+func TestVoucherCodeProof(t *testing.T) {
+	buyer := "QmYwAPJzv5CZsnA625s3Xf2nemtYgPpHdWEz79ojWnPbdG"
+	proof, err := VoucherCodeProof("GIFT-1234", buyer)
+	if err != nil {
+		t.Fatal(err)
+	}
+	codeHash, err := EncodeMultihash([]byte("GIFT-1234"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected, err := voucherCodeProof(codeHash.B58String(), buyer)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if proof != expected {
+		t.Error("Expected the proof to be checkable from the stored code hash")
+	}
+	other, err := VoucherCodeProof("GIFT-1234", "QmeGRbXbqC8ZqKfN6ZRhXgFP4QWL1jTZzc8uVb3W1HNCFf")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if proof == other {
+		t.Error("Expected the proof to be bound to the buyer")
+	}
+}
+
+func TestVoucherCodeEntropy(t *testing.T) {
+	for _, code := range []string{"", "GIFT-1234", "giftcard2017", "0000000000000000"} {
+		if voucherCodeEntropy(code) >= VoucherCodeMinEntropy {
+			t.Errorf("Expected code %q to be rejected as guessable", code)
+		}
+	}
+	for _, code := range []string{"GIFT-7fQ2-xK9m-Lp4w", "qz7Rk2Wm9Xv4Tb", "correcthorsebatterystaple"} {
+		if voucherCodeEntropy(code) < VoucherCodeMinEntropy {
+			t.Errorf("Expected code %q to be accepted", code)
+		}
+	}
+}
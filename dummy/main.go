@@ -259,7 +259,7 @@ func newNode(repoPath string, db *db.SQLiteDatastore) (*core.OpenBazaarNode, err
 		RepoPath:           repoPath,
 		Datastore:          db,
 		Wallet:             wallet,
-		Resolver:           bstk.NewBlockStackClient(resolverURL),
+		Resolver:           core.NewResolverChain(core.ResolverCacheTTL, &core.BlockstackResolver{Client: bstk.NewBlockStackClient(resolverURL)}),
 		ExchangeRates:      exchange.NewBitcoinPriceFetcher(),
 		MessageStorage:     selfhosted.NewSelfHostedStorage(repoPath, ctx, gatewayUrls),
 		CrosspostGateways:  gatewayUrls,
@@ -5,6 +5,7 @@ import (
 	"crypto/hmac"
 	"crypto/sha256"
 	"encoding/base64"
+	"encoding/binary"
 	"github.com/ipfs/go-ipfs/repo/config"
 	libp2p "gx/ipfs/QmPGxZ1DP2w45WcogpW1h43BvseXbfke9N91qotpoQcUeS/go-libp2p-crypto"
 	peer "gx/ipfs/QmWUswjn261LSyVxWAEpMVtPdy8zmKBJJfBpG3Qdpa8ZsE/go-libp2p-peer"
@@ -45,3 +46,23 @@ func IdentityKeyFromSeed(seed []byte, bits int) ([]byte, error) {
 	}
 	return encodedKey, nil
 }
+
+// Derive the identity key which succeeds the seed's original key after it has been
+// rotated a number of times, so a rotated identity can be restored from the seed.
+// Rotation 0 is the original key.
+func IdentityKeyFromSeedAtRotation(seed []byte, rotation uint32, bits int) ([]byte, error) {
+	if rotation == 0 {
+		return IdentityKeyFromSeed(seed, bits)
+	}
+	index := make([]byte, 4)
+	binary.BigEndian.PutUint32(index, rotation)
+	hmac := hmac.New(sha256.New, []byte("OpenBazaar identity rotation"))
+	hmac.Write(seed)
+	hmac.Write(index)
+	reader := bytes.NewReader(hmac.Sum(nil))
+	sk, _, err := libp2p.GenerateKeyPairWithReader(libp2p.Ed25519, bits, reader)
+	if err != nil {
+		return nil, err
+	}
+	return sk.Bytes()
+}
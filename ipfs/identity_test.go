@@ -45,3 +45,36 @@ func TestIdentityKeyFromSeed(t *testing.T) {
 		t.Error("Failed to extract correct private key from seed")
 	}
 }
+
+func TestIdentityKeyFromSeedAtRotation(t *testing.T) {
+	seed := bip39.NewSeed("mule track design catch stairs remain produce evidence cannon opera hamster burst", "Secret Passphrase")
+	original, err := IdentityKeyFromSeedAtRotation(seed, 0, 4096)
+	if err != nil {
+		t.Error(err)
+	}
+	keyBytes, err := hex.DecodeString(keyHex)
+	if err != nil {
+		t.Error(err)
+	}
+	if !bytes.Equal(original, keyBytes) {
+		t.Error("Expected rotation 0 to be the original key")
+	}
+	first, err := IdentityKeyFromSeedAtRotation(seed, 1, 4096)
+	if err != nil {
+		t.Error(err)
+	}
+	again, err := IdentityKeyFromSeedAtRotation(seed, 1, 4096)
+	if err != nil {
+		t.Error(err)
+	}
+	if !bytes.Equal(first, again) {
+		t.Error("Expected the same rotation to derive the same key")
+	}
+	second, err := IdentityKeyFromSeedAtRotation(seed, 2, 4096)
+	if err != nil {
+		t.Error(err)
+	}
+	if bytes.Equal(first, original) || bytes.Equal(second, original) || bytes.Equal(first, second) {
+		t.Error("Expected each rotation to derive a different key")
+	}
+}
@@ -29,10 +29,11 @@ const MAGIC string = "000000000000000000000000"
 type Purpose int
 
 const (
-	MESSAGE   Purpose = 1
-	MODERATOR Purpose = 2
-	TAG       Purpose = 3
-	CHANNEL   Purpose = 4
+	MESSAGE      Purpose = 1
+	MODERATOR    Purpose = 2
+	TAG          Purpose = 3
+	CHANNEL      Purpose = 4
+	BUYER_RATING Purpose = 5
 )
 
 /* A pointer is a custom provider inserted into the DHT which points to a location of a file.
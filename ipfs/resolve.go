@@ -3,6 +3,7 @@ package ipfs
 import (
 	"github.com/ipfs/go-ipfs/commands"
 	coreCmds "github.com/ipfs/go-ipfs/core/commands"
+	"github.com/ipfs/go-ipfs/path"
 	"time"
 )
 
@@ -25,3 +26,28 @@ func Resolve(ctx commands.Context, hash string) (string, error) {
 	returnedVal := resp.(*coreCmds.ResolvedPath)
 	return returnedVal.Path.Segments()[1], nil
 }
+
+// Resolve a path under a peer's IPNS name to the hash of the file it points to
+func ResolvePath(ctx commands.Context, ipnsPath path.Path) (string, error) {
+	hash, err := Resolve(ctx, ipnsPath.Segments()[0])
+	if err != nil {
+		return "", err
+	}
+	p := make([]string, len(ipnsPath.Segments()))
+	p[0] = hash
+	for i := 0; i < len(ipnsPath.Segments())-1; i++ {
+		p[i+1] = ipnsPath.Segments()[i+1]
+	}
+	args := []string{"resolve", "-r", "/ipfs/" + path.Join(p)}
+	req, cmd, err := NewRequestWithTimeout(ctx, args, ResolveTimeout)
+	if err != nil {
+		return "", err
+	}
+	res := commands.NewResponse(req)
+	cmd.Run(req, res)
+	if res.Error() != nil {
+		return "", res.Error()
+	}
+	returnedVal := res.Output().(*coreCmds.ResolvedPath)
+	return returnedVal.Path.Segments()[1], nil
+}
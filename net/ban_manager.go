@@ -7,6 +7,10 @@ import (
 
 type BanManager struct {
 	blockedIds map[string]bool
+
+	// Stores and listings flagged in the blocklists of moderators we subscribe to
+	blocklistIds      map[string]bool
+	blocklistListings map[string]bool
 	*sync.RWMutex
 }
 
@@ -15,7 +19,7 @@ func NewBanManager(blockedIds []peer.ID) *BanManager {
 	for _, pid := range blockedIds {
 		blockedMap[pid.Pretty()] = true
 	}
-	return &BanManager{blockedMap, new(sync.RWMutex)}
+	return &BanManager{blockedMap, make(map[string]bool), make(map[string]bool), new(sync.RWMutex)}
 }
 
 func (bm *BanManager) AddBlockedId(peerId peer.ID) {
@@ -60,5 +64,36 @@ func (bm *BanManager) GetBlockedIds() []peer.ID {
 func (bm *BanManager) IsBanned(peerId peer.ID) bool {
 	bm.RLock()
 	defer bm.RUnlock()
-	return bm.blockedIds[peerId.Pretty()]
+	return bm.blockedIds[peerId.Pretty()] || bm.blocklistIds[peerId.Pretty()]
+}
+
+// Replace the stores and listings from moderator blocklists. These are kept apart
+// from the nodes blocked in our settings.
+func (bm *BanManager) SetBlocklist(peerIds []peer.ID, listingHashes []string) {
+	bm.Lock()
+	defer bm.Unlock()
+
+	bm.blocklistIds = make(map[string]bool)
+	bm.blocklistListings = make(map[string]bool)
+
+	for _, pid := range peerIds {
+		bm.blocklistIds[pid.Pretty()] = true
+	}
+	for _, hash := range listingHashes {
+		bm.blocklistListings[hash] = true
+	}
+}
+
+// Returns true if the store was flagged by a moderator we subscribe to
+func (bm *BanManager) IsBlocklisted(peerId peer.ID) bool {
+	bm.RLock()
+	defer bm.RUnlock()
+	return bm.blocklistIds[peerId.Pretty()]
+}
+
+// Returns true if the listing was flagged by a moderator we subscribe to
+func (bm *BanManager) IsListingBlocklisted(hash string) bool {
+	bm.RLock()
+	defer bm.RUnlock()
+	return bm.blocklistListings[hash]
 }
@@ -0,0 +1,36 @@
+package net
+
+import (
+	peer "gx/ipfs/QmWUswjn261LSyVxWAEpMVtPdy8zmKBJJfBpG3Qdpa8ZsE/go-libp2p-peer"
+	"testing"
+)
+
+func TestBanManagerBlocklist(t *testing.T) {
+	blocked, _ := peer.IDB58Decode("QmecpJrN9RJ7smyYByQdZUy5mF6aapgCfKLKRmDtycv9aG")
+	flagged, _ := peer.IDB58Decode("QmamudHQGtztShX7Nc9HcczehdpGGWpFBWu2JvKWcpELxr")
+	bm := NewBanManager([]peer.ID{blocked})
+	bm.SetBlocklist([]peer.ID{flagged}, []string{"QmListing"})
+
+	if !bm.IsBanned(blocked) || !bm.IsBanned(flagged) {
+		t.Error("Expected blocked and blocklisted nodes to be banned")
+	}
+	if bm.IsBlocklisted(blocked) {
+		t.Error("Expected a node blocked in settings not to be reported as blocklisted")
+	}
+	if !bm.IsBlocklisted(flagged) {
+		t.Error("Expected the blocklisted node to be reported as blocklisted")
+	}
+	if !bm.IsListingBlocklisted("QmListing") || bm.IsListingBlocklisted("QmOther") {
+		t.Error("Returned incorrect blocklisted listings")
+	}
+
+	// Replacing the nodes blocked in settings leaves the blocklist in place
+	bm.SetBlockedIds(nil)
+	if !bm.IsBanned(flagged) {
+		t.Error("Expected the blocklisted node to remain banned")
+	}
+	bm.SetBlocklist(nil, nil)
+	if bm.IsBanned(flagged) || bm.IsListingBlocklisted("QmListing") {
+		t.Error("Failed to replace the blocklist")
+	}
+}
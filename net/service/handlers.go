@@ -61,6 +61,26 @@ func (service *OpenBazaarService) HandlerForMsgType(t pb.Message_MessageType) fu
 		return service.handleModeratorAdd
 	case pb.Message_MODERATOR_REMOVE:
 		return service.handleModeratorRemove
+	case pb.Message_SUBSCRIPTION_PAUSE:
+		return service.handleSubscriptionPause
+	case pb.Message_SUBSCRIPTION_RESUME:
+		return service.handleSubscriptionResume
+	case pb.Message_SUBSCRIPTION_CANCEL:
+		return service.handleSubscriptionCancel
+	case pb.Message_CASE_CHAT:
+		return service.handleCaseChat
+	case pb.Message_PAYOUT_PROPOSAL:
+		return service.handlePayoutProposal
+	case pb.Message_REPORT:
+		return service.handleReport
+	case pb.Message_DISPUTE_ENDORSEMENT:
+		return service.handleDisputeEndorsement
+	case pb.Message_BUYER_RATING:
+		return service.handleBuyerRating
+	case pb.Message_IDENTITY_SUCCESSION:
+		return service.handleIdentitySuccession
+	case pb.Message_POST:
+		return service.handlePost
 	default:
 		return nil
 	}
@@ -202,7 +222,8 @@ func (service *OpenBazaarService) handleOrder(peer peer.ID, pmes *pb.Message, op
 			log.Error("Calculated a different payment amount")
 			return errorResponse("Calculated a different payment amount"), nil
 		}
-		contract, err = service.node.NewOrderConfirmation(contract, true)
+		// Orders fully covered by store credit don't need a payment address
+		contract, err = service.node.NewOrderConfirmation(contract, total > 0)
 		if err != nil {
 			log.Error(err)
 			return errorResponse("Error building order confirmation"), nil
@@ -212,7 +233,18 @@ func (service *OpenBazaarService) handleOrder(peer peer.ID, pmes *pb.Message, op
 			log.Error(err)
 			return errorResponse("Error building order confirmation"), nil
 		}
-		service.node.Datastore.Sales().Put(contract.VendorOrderConfirmation.OrderID, *contract, pb.OrderState_CONFIRMED, false)
+		if err := service.node.RedeemCredit(contract.BuyerOrder); err != nil {
+			log.Error(err)
+			return errorResponse(err.Error()), nil
+		}
+		if err := service.node.TrackSubscriptionOrder(contract); err != nil {
+			log.Error(err)
+		}
+		state := pb.OrderState_CONFIRMED
+		if total == 0 {
+			state = pb.OrderState_FUNDED
+		}
+		service.node.Datastore.Sales().Put(contract.VendorOrderConfirmation.OrderID, *contract, state, false)
 		m := pb.Message{
 			MessageType: pb.Message_ORDER_CONFIRMATION,
 			Payload:     a,
@@ -240,6 +272,13 @@ func (service *OpenBazaarService) handleOrder(peer peer.ID, pmes *pb.Message, op
 			log.Error(err)
 			return errorResponse(err.Error()), err
 		}
+		if err := service.node.RedeemCredit(contract.BuyerOrder); err != nil {
+			log.Error(err)
+			return errorResponse(err.Error()), err
+		}
+		if err := service.node.TrackSubscriptionOrder(contract); err != nil {
+			log.Error(err)
+		}
 		service.node.Datastore.Sales().Put(orderId, *contract, pb.OrderState_PENDING, false)
 		return nil, nil
 	} else if contract.BuyerOrder.Payment.Method == pb.Order_Payment_MODERATED && !offline {
@@ -278,6 +317,13 @@ func (service *OpenBazaarService) handleOrder(peer peer.ID, pmes *pb.Message, op
 			log.Error(err)
 			return errorResponse("Error building order confirmation"), nil
 		}
+		if err := service.node.RedeemCredit(contract.BuyerOrder); err != nil {
+			log.Error(err)
+			return errorResponse(err.Error()), nil
+		}
+		if err := service.node.TrackSubscriptionOrder(contract); err != nil {
+			log.Error(err)
+		}
 		service.node.Datastore.Sales().Put(contract.VendorOrderConfirmation.OrderID, *contract, pb.OrderState_CONFIRMED, false)
 		m := pb.Message{
 			MessageType: pb.Message_ORDER_CONFIRMATION,
@@ -306,6 +352,13 @@ func (service *OpenBazaarService) handleOrder(peer peer.ID, pmes *pb.Message, op
 			log.Error(err)
 			return errorResponse(err.Error()), err
 		}
+		if err := service.node.RedeemCredit(contract.BuyerOrder); err != nil {
+			log.Error(err)
+			return errorResponse(err.Error()), err
+		}
+		if err := service.node.TrackSubscriptionOrder(contract); err != nil {
+			log.Error(err)
+		}
 		service.node.Datastore.Sales().Put(orderId, *contract, pb.OrderState_PENDING, false)
 		return nil, nil
 	}
@@ -368,6 +421,11 @@ func (service *OpenBazaarService) handleOrderCancel(p peer.ID, pmes *pb.Message,
 		return nil, err
 	}
 
+	// Return any store credit applied to the order
+	if err := service.node.RestoreCredit(contract.BuyerOrder); err != nil {
+		log.Error(err)
+	}
+
 	// Set message state to canceled
 	service.datastore.Sales().Put(orderId, *contract, pb.OrderState_CANCELED, false)
 
@@ -509,7 +567,7 @@ func (service *OpenBazaarService) handleReject(p peer.ID, pmes *pb.Message, opti
 			sig := spvwallet.Signature{InputIndex: s.InputIndex, Signature: s.Signature}
 			vendorSignatures = append(vendorSignatures, sig)
 		}
-		err = service.node.Wallet.Multisign(ins, []spvwallet.TransactionOutput{output}, buyerSignatures, vendorSignatures, redeemScript, contract.BuyerOrder.RefundFee)
+		err = service.node.MultisignEscrow(contract.BuyerOrder.Payment, ins, []spvwallet.TransactionOutput{output}, buyerSignatures, vendorSignatures, redeemScript, contract.BuyerOrder.RefundFee)
 		if err != nil {
 			return nil, err
 		}
@@ -611,7 +669,7 @@ func (service *OpenBazaarService) handleRefund(p peer.ID, pmes *pb.Message, opti
 			sig := spvwallet.Signature{InputIndex: s.InputIndex, Signature: s.Signature}
 			vendorSignatures = append(vendorSignatures, sig)
 		}
-		err = service.node.Wallet.Multisign(ins, []spvwallet.TransactionOutput{output}, buyerSignatures, vendorSignatures, redeemScript, contract.BuyerOrder.RefundFee)
+		err = service.node.MultisignEscrow(contract.BuyerOrder.Payment, ins, []spvwallet.TransactionOutput{output}, buyerSignatures, vendorSignatures, redeemScript, contract.BuyerOrder.RefundFee)
 		if err != nil {
 			return nil, err
 		}
@@ -742,7 +800,7 @@ func (service *OpenBazaarService) handleOrderCompletion(p peer.ID, pmes *pb.Mess
 			buyerSignatures = append(buyerSignatures, sig)
 		}
 
-		err = service.node.Wallet.Multisign(ins, []spvwallet.TransactionOutput{output}, buyerSignatures, vendorSignatures, redeemScript, contract.VendorOrderFulfillment[0].Payout.PayoutFeePerByte)
+		err = service.node.MultisignEscrow(contract.BuyerOrder.Payment, ins, []spvwallet.TransactionOutput{output}, buyerSignatures, vendorSignatures, redeemScript, contract.VendorOrderFulfillment[0].Payout.PayoutFeePerByte)
 		if err != nil {
 			return nil, err
 		}
@@ -801,6 +859,17 @@ func (service *OpenBazaarService) handleDisputeUpdate(p peer.ID, pmes *pb.Messag
 	if err != nil {
 		return nil, err
 	}
+	// Updates without a contract carry additional evidence for an open case
+	if len(update.SerializedContract) == 0 {
+		err = service.node.ProcessDisputeEvidence(p.Pretty(), update)
+		if err != nil {
+			return nil, err
+		}
+		n := notifications.DisputeUpdateNotification{update.OrderId}
+		service.broadcast <- n
+		service.datastore.Notifications().Put(n, time.Now())
+		return nil, nil
+	}
 	buyerContract, vendorContract, _, _, _, _, _, err := service.node.Datastore.Cases().GetPayoutDetails(update.OrderId)
 	if err != nil {
 		return nil, err
@@ -850,7 +919,8 @@ func (service *OpenBazaarService) handleDisputeClose(p peer.ID, pmes *pb.Message
 	if err != nil {
 		contract, _, _, _, _, err = service.datastore.Purchases().GetByOrderId(rc.DisputeResolution.OrderId)
 		if err != nil {
-			return nil, err
+			// The rest of a moderator panel receives the resolution to endorse it
+			return nil, service.node.ProcessPanelResolution(p.Pretty(), rc)
 		}
 		isPurchase = true
 	}
@@ -987,3 +1057,94 @@ func (service *OpenBazaarService) handleModeratorRemove(peer peer.ID, pmes *pb.M
 	service.datastore.Notifications().Put(n, time.Now())
 	return nil, nil
 }
+
+func (service *OpenBazaarService) handleSubscriptionPause(p peer.ID, pmes *pb.Message, options interface{}) (*pb.Message, error) {
+	log.Debugf("Received SUBSCRIPTION_PAUSE message from %s", p.Pretty())
+	return nil, service.node.HandleSubscriptionUpdate(p.Pretty(), string(pmes.Payload.Value), pb.Subscription_PAUSED)
+}
+
+func (service *OpenBazaarService) handleSubscriptionResume(p peer.ID, pmes *pb.Message, options interface{}) (*pb.Message, error) {
+	log.Debugf("Received SUBSCRIPTION_RESUME message from %s", p.Pretty())
+	return nil, service.node.HandleSubscriptionUpdate(p.Pretty(), string(pmes.Payload.Value), pb.Subscription_ACTIVE)
+}
+
+func (service *OpenBazaarService) handleSubscriptionCancel(p peer.ID, pmes *pb.Message, options interface{}) (*pb.Message, error) {
+	log.Debugf("Received SUBSCRIPTION_CANCEL message from %s", p.Pretty())
+	return nil, service.node.HandleSubscriptionUpdate(p.Pretty(), string(pmes.Payload.Value), pb.Subscription_CANCELED)
+}
+
+func (service *OpenBazaarService) handleCaseChat(p peer.ID, pmes *pb.Message, options interface{}) (*pb.Message, error) {
+	log.Debugf("Received CASE_CHAT message from %s", p.Pretty())
+	chat := new(pb.CaseChat)
+	err := ptypes.UnmarshalAny(pmes.Payload, chat)
+	if err != nil {
+		return nil, err
+	}
+	return nil, service.node.HandleCaseChat(p.Pretty(), chat)
+}
+
+func (service *OpenBazaarService) handlePayoutProposal(p peer.ID, pmes *pb.Message, options interface{}) (*pb.Message, error) {
+	log.Debugf("Received PAYOUT_PROPOSAL message from %s", p.Pretty())
+	proposal := new(pb.PayoutProposal)
+	err := ptypes.UnmarshalAny(pmes.Payload, proposal)
+	if err != nil {
+		return nil, err
+	}
+	return nil, service.node.ProcessPayoutProposal(p.Pretty(), proposal)
+}
+
+func (service *OpenBazaarService) handleReport(p peer.ID, pmes *pb.Message, options interface{}) (*pb.Message, error) {
+	log.Debugf("Received REPORT message from %s", p.Pretty())
+	report := new(pb.Report)
+	err := ptypes.UnmarshalAny(pmes.Payload, report)
+	if err != nil {
+		return nil, err
+	}
+	return nil, service.node.HandleReport(p.Pretty(), report)
+}
+
+func (service *OpenBazaarService) handleDisputeEndorsement(p peer.ID, pmes *pb.Message, options interface{}) (*pb.Message, error) {
+	log.Debugf("Received DISPUTE_ENDORSEMENT message from %s", p.Pretty())
+	endorsement := new(pb.DisputeEndorsement)
+	err := ptypes.UnmarshalAny(pmes.Payload, endorsement)
+	if err != nil {
+		return nil, err
+	}
+	return nil, service.node.ProcessDisputeEndorsement(p.Pretty(), endorsement)
+}
+
+func (service *OpenBazaarService) handleBuyerRating(p peer.ID, pmes *pb.Message, options interface{}) (*pb.Message, error) {
+	log.Debugf("Received BUYER_RATING message from %s", p.Pretty())
+	rating := new(pb.BuyerRating)
+	err := ptypes.UnmarshalAny(pmes.Payload, rating)
+	if err != nil {
+		return nil, err
+	}
+	if err := service.node.ProcessBuyerRating(p.Pretty(), rating); err != nil {
+		return nil, err
+	}
+	return nil, service.node.SeedNode()
+}
+
+func (service *OpenBazaarService) handleIdentitySuccession(p peer.ID, pmes *pb.Message, options interface{}) (*pb.Message, error) {
+	log.Debugf("Received IDENTITY_SUCCESSION message from %s", p.Pretty())
+	chain := new(pb.IdentityChain)
+	err := ptypes.UnmarshalAny(pmes.Payload, chain)
+	if err != nil {
+		return nil, err
+	}
+	if err := service.node.ProcessIdentitySuccession(p.Pretty(), chain); err != nil {
+		return nil, err
+	}
+	return nil, service.node.SeedNode()
+}
+
+func (service *OpenBazaarService) handlePost(p peer.ID, pmes *pb.Message, options interface{}) (*pb.Message, error) {
+	log.Debugf("Received POST message from %s", p.Pretty())
+	post := new(pb.Post)
+	err := ptypes.UnmarshalAny(pmes.Payload, post)
+	if err != nil {
+		return nil, err
+	}
+	return nil, service.node.ProcessPost(p.Pretty(), post)
+}
@@ -28,6 +28,7 @@ import (
 	"encoding/hex"
 	bstk "github.com/OpenBazaar/go-blockstackclient"
 	"github.com/OpenBazaar/go-onion-transport"
+	"github.com/OpenBazaar/jsonpb"
 	"github.com/OpenBazaar/openbazaar-go/api"
 	"github.com/OpenBazaar/openbazaar-go/bitcoin"
 	"github.com/OpenBazaar/openbazaar-go/bitcoin/bitcoind"
@@ -39,6 +40,7 @@ import (
 	rep "github.com/OpenBazaar/openbazaar-go/net/repointer"
 	ret "github.com/OpenBazaar/openbazaar-go/net/retriever"
 	"github.com/OpenBazaar/openbazaar-go/net/service"
+	"github.com/OpenBazaar/openbazaar-go/pb"
 	"github.com/OpenBazaar/openbazaar-go/repo"
 	"github.com/OpenBazaar/openbazaar-go/repo/db"
 	sto "github.com/OpenBazaar/openbazaar-go/storage"
@@ -91,11 +93,12 @@ var fileLogFormat = logging.MustStringFormatter(
 var encryptedDatabaseError = errors.New("could not decrypt the database")
 
 type Init struct {
-	Password string `short:"p" long:"password" description:"the encryption password if the database is to be encrypted"`
-	DataDir  string `short:"d" long:"datadir" description:"specify the data directory to be used"`
-	Mnemonic string `short:"m" long:"mnemonic" description:"speficy a mnemonic seed to use to derive the keychain"`
-	Testnet  bool   `short:"t" long:"testnet" description:"use the test network"`
-	Force    bool   `short:"f" long:"force" description:"force overwrite existing repo (dangerous!)"`
+	Password  string `short:"p" long:"password" description:"the encryption password if the database is to be encrypted"`
+	DataDir   string `short:"d" long:"datadir" description:"specify the data directory to be used"`
+	Mnemonic  string `short:"m" long:"mnemonic" description:"speficy a mnemonic seed to use to derive the keychain"`
+	Rotations uint32 `long:"rotations" description:"the number of times the identity key was rotated, to restore the current identity key from the mnemonic"`
+	Testnet   bool   `short:"t" long:"testnet" description:"use the test network"`
+	Force     bool   `short:"f" long:"force" description:"force overwrite existing repo (dangerous!)"`
 }
 type Status struct {
 	DataDir string `short:"d" long:"datadir" description:"specify the data directory to be used"`
@@ -119,6 +122,16 @@ type Start struct {
 	DisableExchangeRates bool     `long:"disableexchangerates" description:"disable the exchange rate service to prevent api queries"`
 	Storage              string   `long:"storage" description:"set the outgoing message storage option [self-hosted, dropbox] default=self-hosted"`
 }
+type VerifyReputation struct {
+	Args struct {
+		File string `positional-arg-name:"file" description:"reputation bundle exported from /ob/reputation/export"`
+	} `positional-args:"yes" required:"yes"`
+}
+type RotateIdentity struct {
+	Password string `short:"p" long:"password" description:"the encryption password if the database is encrypted"`
+	DataDir  string `short:"d" long:"datadir" description:"specify the data directory to be used"`
+	Testnet  bool   `short:"t" long:"testnet" description:"use the test network"`
+}
 type Opts struct {
 	Version bool `short:"v" long:"version" description:"Print the version number and exit"`
 }
@@ -134,6 +147,8 @@ var restartServer Restart
 var encryptDatabase EncryptDatabase
 var decryptDatabase DecryptDatabase
 var setAPICreds SetAPICreds
+var verifyReputation VerifyReputation
+var rotateIdentity RotateIdentity
 var status Status
 var opts Opts
 
@@ -190,6 +205,14 @@ func main() {
 		"decrypt your database",
 		"This command decrypts the database containing your bitcoin private keys, identity key, and contracts.\n [Warning] doing so may put your bitcoins at risk.",
 		&decryptDatabase)
+	parser.AddCommand("verifyreputation",
+		"verify a reputation bundle",
+		"Checks the store's signature on a reputation bundle and the full signature chain on every rating in it. Does not require a repo or a running server.",
+		&verifyReputation)
+	parser.AddCommand("rotateidentity",
+		"rotate your identity key",
+		"Creates a new identity key and signs a succession to it with the current key. The server must be stopped first. On the next start the store is published under the new peer ID and followers and the other parties to open orders and cases are sent the succession.",
+		&rotateIdentity)
 	if len(os.Args) > 1 && (os.Args[1] == "--version" || os.Args[1] == "-v") {
 		fmt.Println(core.VERSION)
 		return
@@ -207,6 +230,53 @@ func (x *DecryptDatabase) Execute(args []string) error {
 	return db.Decrypt()
 }
 
+func (x *VerifyReputation) Execute(args []string) error {
+	b, err := ioutil.ReadFile(x.Args.File)
+	if err != nil {
+		return err
+	}
+	bundle := new(pb.ReputationBundle)
+	if err := jsonpb.UnmarshalString(string(b), bundle); err != nil {
+		return err
+	}
+	if err := core.VerifyReputationBundle(bundle); err != nil {
+		fmt.Println("Reputation bundle is invalid:", err.Error())
+		os.Exit(1)
+	}
+	fmt.Printf("Verified %d ratings for %s\n", len(bundle.Ratings), bundle.PeerID)
+	return nil
+}
+
+func (x *RotateIdentity) Execute(args []string) error {
+	repoPath, err := getRepoPath(x.Testnet)
+	if err != nil {
+		return err
+	}
+	if x.DataDir != "" {
+		repoPath = x.DataDir
+	}
+	if !fsrepo.IsInitialized(repoPath) {
+		return errors.New("Repo is not initialized")
+	}
+	sqliteDB, err := db.Create(repoPath, x.Password, x.Testnet)
+	if err != nil {
+		return err
+	}
+	defer sqliteDB.Close()
+	if sqliteDB.Config().IsEncrypted() {
+		return encryptedDatabaseError
+	}
+	chain, err := core.RotateIdentityKey(repoPath, sqliteDB.Config())
+	if err != nil {
+		return err
+	}
+	succession := chain.Successions[len(chain.Successions)-1]
+	fmt.Printf("Rotated identity %s to %s\n", succession.PredecessorID, succession.SuccessorID)
+	fmt.Printf("To restore this identity from your mnemonic, initialize with --rotations %d.\n", len(chain.Successions))
+	fmt.Println("Start the server to publish your store under the new peer ID.")
+	return nil
+}
+
 func (x *SetAPICreds) Execute(args []string) error {
 	// Set repo path
 	repoPath, err := getRepoPath(x.Testnet)
@@ -340,30 +410,48 @@ func (x *Init) Execute(args []string) error {
 	if x.Password != "" {
 		x.Password = strings.Replace(x.Password, "'", "''", -1)
 	}
+	if x.Rotations > 0 && x.Mnemonic == "" {
+		return errors.New("Restoring a rotated identity key requires the mnemonic")
+	}
 
-	_, err = initializeRepo(repoPath, x.Password, x.Mnemonic, x.Testnet)
+	sqliteDB, err := initializeRepo(repoPath, x.Password, x.Mnemonic, x.Testnet)
 	if err == repo.ErrRepoExists && x.Force {
 		reader := bufio.NewReader(os.Stdin)
 		fmt.Print("Force overwriting the db will destroy your existing keys and history. Are you really, really sure you want to continue? (y/n): ")
 		resp, _ := reader.ReadString('\n')
 		if strings.ToLower(resp) == "y\n" || strings.ToLower(resp) == "yes\n" {
 			os.RemoveAll(repoPath)
-			_, err = initializeRepo(repoPath, x.Password, x.Mnemonic, x.Testnet)
+			sqliteDB, err = initializeRepo(repoPath, x.Password, x.Mnemonic, x.Testnet)
 			if err != nil {
 				return err
 			}
-			fmt.Printf("OpenBazaar repo initialized at %s\n", repoPath)
-			return nil
 		} else {
 			return nil
 		}
 	} else if err != nil {
 		return err
 	}
+	if err := restoreIdentityRotations(repoPath, sqliteDB, x.Rotations); err != nil {
+		return err
+	}
 	fmt.Printf("OpenBazaar repo initialized at %s\n", repoPath)
 	return nil
 }
 
+// Rotate an identity key restored from the mnemonic as many times as it was rotated
+// before. Each successor is derived from the mnemonic so this gives the current key.
+func restoreIdentityRotations(repoPath string, sqliteDB *db.SQLiteDatastore, rotations uint32) error {
+	for i := uint32(0); i < rotations; i++ {
+		if _, err := core.RotateIdentityKey(repoPath, sqliteDB.Config()); err != nil {
+			return err
+		}
+	}
+	if rotations > 0 {
+		fmt.Printf("Restored identity key after %d rotations\n", rotations)
+	}
+	return nil
+}
+
 func (x *Start) Execute(args []string) error {
 	printSplashScreen()
 	var err error
@@ -795,6 +883,17 @@ func (x *Start) Execute(args []string) error {
 		return err
 	}
 
+	// Handles are looked up in our own petnames first, then Blockstack. DNS lookups
+	// would bypass Tor so domain handles are only resolved when using the clear internet.
+	resolvers := []core.NamedResolver{
+		core.NewPetnameResolver(path.Join(repoPath, core.PetnamesFile)),
+		&core.BlockstackResolver{Client: bstk.NewBlockStackClient(resolverUrl, torDialer)},
+	}
+	if !usingTor || usingClearnet {
+		resolvers = append(resolvers, core.NewDNSResolver())
+	}
+	resolver := core.NewResolverChain(core.ResolverCacheTTL, resolvers...)
+
 	var exchangeRates bitcoin.ExchangeRates
 	if !x.DisableExchangeRates {
 		exchangeRates = exchange.NewBitcoinPriceFetcher(torDialer)
@@ -820,19 +919,20 @@ func (x *Start) Execute(args []string) error {
 
 	// OpenBazaar node setup
 	core.Node = &core.OpenBazaarNode{
-		Context:           ctx,
-		IpfsNode:          nd,
-		RootHash:          ipath.Path(e.Value).String(),
-		RepoPath:          repoPath,
-		Datastore:         sqliteDB,
-		Wallet:            wallet,
-		MessageStorage:    storage,
-		Resolver:          bstk.NewBlockStackClient(resolverUrl, torDialer),
-		ExchangeRates:     exchangeRates,
-		CrosspostGateways: gatewayUrls,
-		TorDialer:         torDialer,
-		UserAgent:         core.USERAGENT,
-		BanManager:        bm,
+		Context:              ctx,
+		IpfsNode:             nd,
+		RootHash:             ipath.Path(e.Value).String(),
+		RepoPath:             repoPath,
+		Datastore:            sqliteDB,
+		Wallet:               wallet,
+		MessageStorage:       storage,
+		Resolver:             resolver,
+		ExchangeRates:        exchangeRates,
+		CrosspostGateways:    gatewayUrls,
+		TorDialer:            torDialer,
+		SocialProofVerifiers: core.NewSocialProofVerifiers(torDialer),
+		UserAgent:            core.USERAGENT,
+		BanManager:           bm,
 	}
 
 	if len(cfg.Addresses.Gateway) <= 0 {
@@ -858,7 +958,7 @@ func (x *Start) Execute(args []string) error {
 		core.Node.PointerRepublisher = PR
 		if !x.DisableWallet {
 			MR.Wait()
-			TL := lis.NewTransactionListener(core.Node.Datastore, core.Node.Broadcast, core.Node.Wallet.Params())
+			TL := lis.NewTransactionListener(core.Node.Datastore, core.Node.Broadcast, core.Node.Wallet.Params(), core.Node.InventoryChanged)
 			WL := lis.NewWalletListener(core.Node.Datastore, core.Node.Broadcast)
 			wallet.AddTransactionListener(TL.OnTransactionReceived)
 			wallet.AddTransactionListener(WL.OnTransactionReceived)
@@ -866,9 +966,20 @@ func (x *Start) Execute(args []string) error {
 			su := bitcoin.NewStatusUpdater(wallet, core.Node.Broadcast, nd.Context())
 			go su.Start()
 			go wallet.Start()
+			go core.Node.StartSubscriptionBilling()
 		}
+		go core.Node.StartDisputeDeadlineChecks()
+		go core.Node.StartBlocklistUpdates()
+		go core.Node.StartFeedCrawler()
+		go core.Node.StartPostExpiry()
 		core.Node.UpdateFollow()
+		if err := core.Node.SignProfile(); err != nil {
+			log.Error(err)
+		}
 		core.Node.SeedNode()
+		if err := core.Node.CompleteIdentityRotation(); err != nil {
+			log.Error(err)
+		}
 	}()
 
 	// Start gateway
@@ -965,7 +1076,9 @@ func newHTTPGateway(node *core.OpenBazaarNode, authCookie http.Cookie, config re
 		corehttp.CommandsROOption(node.Context),
 		corehttp.VersionOption(),
 		corehttp.IPNSHostnameOption(),
-		corehttp.GatewayOption(node.Resolver, config.Authenticated, config.AllowedIPs, authCookie, config.Username, config.Password, cfg.Gateway.Writable, "/ipfs", "/ipns"),
+		// Handles are resolved before the gateway sees them so it needs no resolver
+		api.HandleResolutionOption(node.Resolver),
+		corehttp.GatewayOption(nil, config.Authenticated, config.AllowedIPs, authCookie, config.Username, config.Password, cfg.Gateway.Writable, "/ipfs", "/ipns"),
 	}
 
 	if len(cfg.Gateway.RootRedirect) > 0 {
@@ -21,26 +21,45 @@ It has these top-level messages:
 	TransactionRecord
 	PeerAndProfile
 	PeerAndProfileWithID
+	ShippingProfile
+	SelectedVariant
+	VoucherBalance
+	Subscription
+	ResolutionTemplate
+	BuyerReputation
 	RicardianContract
 	Listing
 	Order
 	OrderConfirmation
+	Voucher
 	OrderReject
 	RatingSignature
 	BitcoinSignature
 	OrderFulfillment
 	OrderCompletion
 	Dispute
+	DisputeAttachment
 	DisputeResolution
+	DisputeEndorsement
+	PayoutProposal
+	CaseChat
 	Outpoint
 	Refund
 	ID
 	Signature
+	RatingReply
+	BuyerRating
+	ReputationBundle
+	IdentitySuccession
+	IdentityChain
+	Post
 	Message
 	Envelope
 	Chat
 	Moderator
 	DisputeUpdate
+	Report
+	Blocklist
 	Profile
 */
 package pb
@@ -61,6 +80,30 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.ProtoPackageIsVersion2 // please upgrade the proto package
 
+type Subscription_Status int32
+
+const (
+	Subscription_ACTIVE   Subscription_Status = 0
+	Subscription_PAUSED   Subscription_Status = 1
+	Subscription_CANCELED Subscription_Status = 2
+)
+
+var Subscription_Status_name = map[int32]string{
+	0: "ACTIVE",
+	1: "PAUSED",
+	2: "CANCELED",
+}
+var Subscription_Status_value = map[string]int32{
+	"ACTIVE":   0,
+	"PAUSED":   1,
+	"CANCELED": 2,
+}
+
+func (x Subscription_Status) String() string {
+	return proto.EnumName(Subscription_Status_name, int32(x))
+}
+func (Subscription_Status) EnumDescriptor() ([]byte, []int) { return fileDescriptor0, []int{9, 0} }
+
 type Coupon struct {
 	Hash string `protobuf:"bytes,1,opt,name=hash" json:"hash,omitempty"`
 	Code string `protobuf:"bytes,2,opt,name=code" json:"code,omitempty"`
@@ -86,11 +129,13 @@ func (m *Coupon) GetCode() string {
 }
 
 type OrderRespApi struct {
-	Contract     *RicardianContract   `protobuf:"bytes,1,opt,name=contract" json:"contract,omitempty"`
-	State        OrderState           `protobuf:"varint,2,opt,name=state,enum=OrderState" json:"state,omitempty"`
-	Read         bool                 `protobuf:"varint,3,opt,name=read" json:"read,omitempty"`
-	Funded       bool                 `protobuf:"varint,4,opt,name=funded" json:"funded,omitempty"`
-	Transactions []*TransactionRecord `protobuf:"bytes,5,rep,name=transactions" json:"transactions,omitempty"`
+	Contract        *RicardianContract   `protobuf:"bytes,1,opt,name=contract" json:"contract,omitempty"`
+	State           OrderState           `protobuf:"varint,2,opt,name=state,enum=OrderState" json:"state,omitempty"`
+	Read            bool                 `protobuf:"varint,3,opt,name=read" json:"read,omitempty"`
+	Funded          bool                 `protobuf:"varint,4,opt,name=funded" json:"funded,omitempty"`
+	Transactions    []*TransactionRecord `protobuf:"bytes,5,rep,name=transactions" json:"transactions,omitempty"`
+	Variants        []*SelectedVariant   `protobuf:"bytes,6,rep,name=variants" json:"variants,omitempty"`
+	BuyerReputation *BuyerReputation     `protobuf:"bytes,7,opt,name=buyerReputation" json:"buyerReputation,omitempty"`
 }
 
 func (m *OrderRespApi) Reset()                    { *m = OrderRespApi{} }
@@ -133,6 +178,20 @@ func (m *OrderRespApi) GetTransactions() []*TransactionRecord {
 	return nil
 }
 
+func (m *OrderRespApi) GetVariants() []*SelectedVariant {
+	if m != nil {
+		return m.Variants
+	}
+	return nil
+}
+
+func (m *OrderRespApi) GetBuyerReputation() *BuyerReputation {
+	if m != nil {
+		return m.BuyerReputation
+	}
+	return nil
+}
+
 type CaseRespApi struct {
 	Timestamp                      *google_protobuf.Timestamp `protobuf:"bytes,1,opt,name=timestamp" json:"timestamp,omitempty"`
 	BuyerContract                  *RicardianContract         `protobuf:"bytes,2,opt,name=buyerContract" json:"buyerContract,omitempty"`
@@ -144,6 +203,7 @@ type CaseRespApi struct {
 	BuyerOpened                    bool                       `protobuf:"varint,8,opt,name=buyerOpened" json:"buyerOpened,omitempty"`
 	Claim                          string                     `protobuf:"bytes,9,opt,name=claim" json:"claim,omitempty"`
 	Resolution                     *DisputeResolution         `protobuf:"bytes,10,opt,name=resolution" json:"resolution,omitempty"`
+	Attachments                    []*DisputeAttachment       `protobuf:"bytes,11,rep,name=attachments" json:"attachments,omitempty"`
 }
 
 func (m *CaseRespApi) Reset()                    { *m = CaseRespApi{} }
@@ -221,6 +281,13 @@ func (m *CaseRespApi) GetResolution() *DisputeResolution {
 	return nil
 }
 
+func (m *CaseRespApi) GetAttachments() []*DisputeAttachment {
+	if m != nil {
+		return m.Attachments
+	}
+	return nil
+}
+
 type TransactionRecord struct {
 	Txid          string `protobuf:"bytes,1,opt,name=txid" json:"txid,omitempty"`
 	Value         int64  `protobuf:"varint,2,opt,name=value" json:"value,omitempty"`
@@ -309,6 +376,308 @@ func (m *PeerAndProfileWithID) GetProfile() *Profile {
 	return nil
 }
 
+type ShippingProfile struct {
+	Name            string                    `protobuf:"bytes,1,opt,name=name" json:"name,omitempty"`
+	ShippingOptions []*Listing_ShippingOption `protobuf:"bytes,2,rep,name=shippingOptions" json:"shippingOptions,omitempty"`
+}
+
+func (m *ShippingProfile) Reset()                    { *m = ShippingProfile{} }
+func (m *ShippingProfile) String() string            { return proto.CompactTextString(m) }
+func (*ShippingProfile) ProtoMessage()               {}
+func (*ShippingProfile) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{6} }
+
+func (m *ShippingProfile) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *ShippingProfile) GetShippingOptions() []*Listing_ShippingOption {
+	if m != nil {
+		return m.ShippingOptions
+	}
+	return nil
+}
+
+// The sku resolved from the options selected for an item in an order
+type SelectedVariant struct {
+	ListingHash string                `protobuf:"bytes,1,opt,name=listingHash" json:"listingHash,omitempty"`
+	SkuIndex    uint32                `protobuf:"varint,2,opt,name=skuIndex" json:"skuIndex,omitempty"`
+	ProductID   string                `protobuf:"bytes,3,opt,name=productID" json:"productID,omitempty"`
+	Title       string                `protobuf:"bytes,4,opt,name=title" json:"title,omitempty"`
+	Grams       float32               `protobuf:"fixed32,5,opt,name=grams" json:"grams,omitempty"`
+	Images      []*Listing_Item_Image `protobuf:"bytes,6,rep,name=images" json:"images,omitempty"`
+}
+
+func (m *SelectedVariant) Reset()                    { *m = SelectedVariant{} }
+func (m *SelectedVariant) String() string            { return proto.CompactTextString(m) }
+func (*SelectedVariant) ProtoMessage()               {}
+func (*SelectedVariant) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{7} }
+
+func (m *SelectedVariant) GetListingHash() string {
+	if m != nil {
+		return m.ListingHash
+	}
+	return ""
+}
+
+func (m *SelectedVariant) GetSkuIndex() uint32 {
+	if m != nil {
+		return m.SkuIndex
+	}
+	return 0
+}
+
+func (m *SelectedVariant) GetProductID() string {
+	if m != nil {
+		return m.ProductID
+	}
+	return ""
+}
+
+func (m *SelectedVariant) GetTitle() string {
+	if m != nil {
+		return m.Title
+	}
+	return ""
+}
+
+func (m *SelectedVariant) GetGrams() float32 {
+	if m != nil {
+		return m.Grams
+	}
+	return 0
+}
+
+func (m *SelectedVariant) GetImages() []*Listing_Item_Image {
+	if m != nil {
+		return m.Images
+	}
+	return nil
+}
+
+type VoucherBalance struct {
+	Voucher *Voucher `protobuf:"bytes,1,opt,name=voucher" json:"voucher,omitempty"`
+	Balance uint64   `protobuf:"varint,2,opt,name=balance" json:"balance,omitempty"`
+}
+
+func (m *VoucherBalance) Reset()                    { *m = VoucherBalance{} }
+func (m *VoucherBalance) String() string            { return proto.CompactTextString(m) }
+func (*VoucherBalance) ProtoMessage()               {}
+func (*VoucherBalance) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{8} }
+
+func (m *VoucherBalance) GetVoucher() *Voucher {
+	if m != nil {
+		return m.Voucher
+	}
+	return nil
+}
+
+func (m *VoucherBalance) GetBalance() uint64 {
+	if m != nil {
+		return m.Balance
+	}
+	return 0
+}
+
+// A recurring order. The buyer's node places a new order through the normal purchase
+// flow each billing interval. The vendor's node tracks the orders it receives.
+type Subscription struct {
+	Id              string                     `protobuf:"bytes,1,opt,name=id" json:"id,omitempty"`
+	BuyerID         string                     `protobuf:"bytes,2,opt,name=buyerID" json:"buyerID,omitempty"`
+	VendorID        string                     `protobuf:"bytes,3,opt,name=vendorID" json:"vendorID,omitempty"`
+	ListingSlug     string                     `protobuf:"bytes,4,opt,name=listingSlug" json:"listingSlug,omitempty"`
+	BillingInterval uint32                     `protobuf:"varint,5,opt,name=billingInterval" json:"billingInterval,omitempty"`
+	SpendingCap     uint64                     `protobuf:"varint,6,opt,name=spendingCap" json:"spendingCap,omitempty"`
+	Status          Subscription_Status        `protobuf:"varint,7,opt,name=status,enum=Subscription_Status" json:"status,omitempty"`
+	Created         *google_protobuf.Timestamp `protobuf:"bytes,8,opt,name=created" json:"created,omitempty"`
+	LastOrder       *google_protobuf.Timestamp `protobuf:"bytes,9,opt,name=lastOrder" json:"lastOrder,omitempty"`
+	OrderIDs        []string                   `protobuf:"bytes,10,rep,name=orderIDs" json:"orderIDs,omitempty"`
+	PurchaseData    string                     `protobuf:"bytes,11,opt,name=purchaseData" json:"purchaseData,omitempty"`
+	LastError       string                     `protobuf:"bytes,12,opt,name=lastError" json:"lastError,omitempty"`
+	UnfundedOrderID string                     `protobuf:"bytes,13,opt,name=unfundedOrderID" json:"unfundedOrderID,omitempty"`
+	FundingFailures uint32                     `protobuf:"varint,14,opt,name=fundingFailures" json:"fundingFailures,omitempty"`
+}
+
+func (m *Subscription) Reset()                    { *m = Subscription{} }
+func (m *Subscription) String() string            { return proto.CompactTextString(m) }
+func (*Subscription) ProtoMessage()               {}
+func (*Subscription) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{9} }
+
+func (m *Subscription) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *Subscription) GetBuyerID() string {
+	if m != nil {
+		return m.BuyerID
+	}
+	return ""
+}
+
+func (m *Subscription) GetVendorID() string {
+	if m != nil {
+		return m.VendorID
+	}
+	return ""
+}
+
+func (m *Subscription) GetListingSlug() string {
+	if m != nil {
+		return m.ListingSlug
+	}
+	return ""
+}
+
+func (m *Subscription) GetBillingInterval() uint32 {
+	if m != nil {
+		return m.BillingInterval
+	}
+	return 0
+}
+
+func (m *Subscription) GetSpendingCap() uint64 {
+	if m != nil {
+		return m.SpendingCap
+	}
+	return 0
+}
+
+func (m *Subscription) GetStatus() Subscription_Status {
+	if m != nil {
+		return m.Status
+	}
+	return Subscription_ACTIVE
+}
+
+func (m *Subscription) GetCreated() *google_protobuf.Timestamp {
+	if m != nil {
+		return m.Created
+	}
+	return nil
+}
+
+func (m *Subscription) GetLastOrder() *google_protobuf.Timestamp {
+	if m != nil {
+		return m.LastOrder
+	}
+	return nil
+}
+
+func (m *Subscription) GetOrderIDs() []string {
+	if m != nil {
+		return m.OrderIDs
+	}
+	return nil
+}
+
+func (m *Subscription) GetPurchaseData() string {
+	if m != nil {
+		return m.PurchaseData
+	}
+	return ""
+}
+
+func (m *Subscription) GetLastError() string {
+	if m != nil {
+		return m.LastError
+	}
+	return ""
+}
+
+func (m *Subscription) GetUnfundedOrderID() string {
+	if m != nil {
+		return m.UnfundedOrderID
+	}
+	return ""
+}
+
+func (m *Subscription) GetFundingFailures() uint32 {
+	if m != nil {
+		return m.FundingFailures
+	}
+	return 0
+}
+
+// A ruling a moderator applies to disputes again and again, such as a full refund
+// when the item was never received
+type ResolutionTemplate struct {
+	Name             string  `protobuf:"bytes,1,opt,name=name" json:"name,omitempty"`
+	BuyerPercentage  float32 `protobuf:"fixed32,2,opt,name=buyerPercentage" json:"buyerPercentage,omitempty"`
+	VendorPercentage float32 `protobuf:"fixed32,3,opt,name=vendorPercentage" json:"vendorPercentage,omitempty"`
+	Resolution       string  `protobuf:"bytes,4,opt,name=resolution" json:"resolution,omitempty"`
+}
+
+func (m *ResolutionTemplate) Reset()                    { *m = ResolutionTemplate{} }
+func (m *ResolutionTemplate) String() string            { return proto.CompactTextString(m) }
+func (*ResolutionTemplate) ProtoMessage()               {}
+func (*ResolutionTemplate) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{10} }
+
+func (m *ResolutionTemplate) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *ResolutionTemplate) GetBuyerPercentage() float32 {
+	if m != nil {
+		return m.BuyerPercentage
+	}
+	return 0
+}
+
+func (m *ResolutionTemplate) GetVendorPercentage() float32 {
+	if m != nil {
+		return m.VendorPercentage
+	}
+	return 0
+}
+
+func (m *ResolutionTemplate) GetResolution() string {
+	if m != nil {
+		return m.Resolution
+	}
+	return ""
+}
+
+// Summary of the ratings vendors have left a buyer
+type BuyerReputation struct {
+	RatingCount    uint32  `protobuf:"varint,1,opt,name=ratingCount" json:"ratingCount,omitempty"`
+	AverageRating  float32 `protobuf:"fixed32,2,opt,name=averageRating" json:"averageRating,omitempty"`
+	DisputedOrders uint32  `protobuf:"varint,3,opt,name=disputedOrders" json:"disputedOrders,omitempty"`
+}
+
+func (m *BuyerReputation) Reset()                    { *m = BuyerReputation{} }
+func (m *BuyerReputation) String() string            { return proto.CompactTextString(m) }
+func (*BuyerReputation) ProtoMessage()               {}
+func (*BuyerReputation) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{11} }
+
+func (m *BuyerReputation) GetRatingCount() uint32 {
+	if m != nil {
+		return m.RatingCount
+	}
+	return 0
+}
+
+func (m *BuyerReputation) GetAverageRating() float32 {
+	if m != nil {
+		return m.AverageRating
+	}
+	return 0
+}
+
+func (m *BuyerReputation) GetDisputedOrders() uint32 {
+	if m != nil {
+		return m.DisputedOrders
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*Coupon)(nil), "Coupon")
 	proto.RegisterType((*OrderRespApi)(nil), "OrderRespApi")
@@ -316,43 +685,85 @@ func init() {
 	proto.RegisterType((*TransactionRecord)(nil), "TransactionRecord")
 	proto.RegisterType((*PeerAndProfile)(nil), "PeerAndProfile")
 	proto.RegisterType((*PeerAndProfileWithID)(nil), "PeerAndProfileWithID")
+	proto.RegisterType((*ShippingProfile)(nil), "ShippingProfile")
+	proto.RegisterType((*SelectedVariant)(nil), "SelectedVariant")
+	proto.RegisterType((*VoucherBalance)(nil), "VoucherBalance")
+	proto.RegisterType((*Subscription)(nil), "Subscription")
+	proto.RegisterType((*ResolutionTemplate)(nil), "ResolutionTemplate")
+	proto.RegisterType((*BuyerReputation)(nil), "BuyerReputation")
+	proto.RegisterEnum("Subscription_Status", Subscription_Status_name, Subscription_Status_value)
 }
 
 func init() { proto.RegisterFile("api.proto", fileDescriptor0) }
 
 var fileDescriptor0 = []byte{
-	// 518 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x09, 0x6e, 0x88, 0x02, 0xff, 0x84, 0x53, 0xdd, 0x8a, 0x13, 0x31,
-	0x14, 0xa6, 0x9d, 0xb6, 0xdb, 0x9e, 0xfe, 0x88, 0x61, 0x91, 0x61, 0x41, 0xad, 0x83, 0x17, 0xbd,
-	0x9a, 0x95, 0x0a, 0xb2, 0x78, 0xb7, 0xb6, 0x0a, 0x0b, 0xc2, 0x2e, 0x71, 0x51, 0xf0, 0x2e, 0x9d,
-	0xa4, 0xdb, 0xc0, 0x34, 0x09, 0x49, 0x66, 0xd1, 0x07, 0xf4, 0x75, 0x7c, 0x06, 0xc9, 0xcf, 0xb4,
-	0x1d, 0xd7, 0xba, 0x77, 0x39, 0xdf, 0xf9, 0xce, 0xff, 0x17, 0x18, 0x10, 0xc5, 0x73, 0xa5, 0xa5,
-	0x95, 0x67, 0x4f, 0x0a, 0x29, 0xac, 0x26, 0x85, 0x35, 0x11, 0x18, 0x49, 0x4d, 0x99, 0xae, 0xad,
-	0xb1, 0xd2, 0x72, 0xcd, 0x4b, 0x16, 0xcd, 0x97, 0x77, 0x52, 0xde, 0x95, 0xec, 0xdc, 0x5b, 0xab,
-	0x6a, 0x7d, 0x6e, 0xf9, 0x96, 0x19, 0x4b, 0xb6, 0x2a, 0x10, 0xb2, 0x37, 0xd0, 0x5b, 0xc8, 0x4a,
-	0x49, 0x81, 0x10, 0x74, 0x36, 0xc4, 0x6c, 0xd2, 0xd6, 0xb4, 0x35, 0x1b, 0x60, 0xff, 0x76, 0x58,
-	0x21, 0x29, 0x4b, 0xdb, 0x01, 0x73, 0xef, 0xec, 0x57, 0x0b, 0x46, 0xd7, 0xae, 0x24, 0x66, 0x46,
-	0x5d, 0x2a, 0x8e, 0x72, 0xe8, 0xd7, 0x3d, 0xf9, 0xe0, 0xe1, 0x1c, 0xe5, 0x98, 0x17, 0x44, 0x53,
-	0x4e, 0xc4, 0x22, 0x7a, 0xf0, 0x8e, 0x83, 0x5e, 0x41, 0xd7, 0x58, 0x62, 0x43, 0xd6, 0xc9, 0x7c,
-	0x98, 0xfb, 0x6c, 0x5f, 0x1c, 0x84, 0x83, 0xc7, 0xd5, 0xd5, 0x8c, 0xd0, 0x34, 0x99, 0xb6, 0x66,
-	0x7d, 0xec, 0xdf, 0xe8, 0x19, 0xf4, 0xd6, 0x95, 0xa0, 0x8c, 0xa6, 0x1d, 0x8f, 0x46, 0x0b, 0xbd,
-	0x83, 0x91, 0xd5, 0x44, 0x18, 0x52, 0x58, 0x2e, 0x85, 0x49, 0xbb, 0xd3, 0xc4, 0xb7, 0x70, 0xbb,
-	0x07, 0x31, 0x2b, 0xa4, 0xa6, 0xb8, 0xc1, 0xcb, 0x7e, 0x27, 0x30, 0x5c, 0x10, 0xc3, 0xea, 0x31,
-	0x2e, 0x60, 0xb0, 0x5b, 0x4e, 0x9c, 0xe3, 0x2c, 0x0f, 0xeb, 0xcb, 0xeb, 0xf5, 0xe5, 0xb7, 0x35,
-	0x03, 0xef, 0xc9, 0xe8, 0x02, 0xc6, 0xab, 0xea, 0x27, 0xd3, 0xf5, 0xac, 0x7e, 0xb0, 0x7f, 0x6f,
-	0xa1, 0x49, 0x44, 0xef, 0x61, 0x72, 0xcf, 0x04, 0x95, 0xfb, 0xd0, 0xe4, 0x68, 0xe8, 0x5f, 0x4c,
-	0xb4, 0x84, 0xe7, 0x8d, 0x64, 0x5f, 0x49, 0xc9, 0x29, 0x71, 0xb3, 0x7d, 0xd4, 0x5a, 0x6a, 0x93,
-	0x76, 0xa6, 0xc9, 0x6c, 0x80, 0xff, 0x4f, 0x42, 0x9f, 0xe0, 0x45, 0x33, 0xef, 0x83, 0x34, 0x5d,
-	0x9f, 0xe6, 0x11, 0xd6, 0xfe, 0xa8, 0xbd, 0x47, 0x8f, 0x7a, 0x72, 0x70, 0xd4, 0x29, 0x0c, 0x7d,
-	0x7f, 0xd7, 0x8a, 0x09, 0x46, 0xd3, 0xbe, 0x77, 0x1d, 0x42, 0xe8, 0x14, 0xba, 0x45, 0x49, 0xf8,
-	0x36, 0x1d, 0x78, 0x0d, 0x06, 0x03, 0xcd, 0x01, 0x34, 0x33, 0xb2, 0xac, 0x5c, 0x0b, 0x29, 0xc4,
-	0xa5, 0x2d, 0xb9, 0x51, 0x95, 0x75, 0x17, 0x8d, 0x1e, 0x7c, 0xc0, 0xca, 0x0a, 0x78, 0xfa, 0x40,
-	0x13, 0xae, 0x29, 0xfb, 0x83, 0xd3, 0x5a, 0xf5, 0xee, 0xed, 0x4a, 0xde, 0x93, 0xb2, 0x0a, 0x02,
-	0x4d, 0x70, 0x30, 0xd0, 0x6b, 0x18, 0x17, 0x52, 0xac, 0xb9, 0xde, 0x92, 0x20, 0x34, 0x77, 0xaa,
-	0x31, 0x6e, 0x82, 0xd9, 0x67, 0x98, 0xdc, 0x30, 0xa6, 0x2f, 0x05, 0xbd, 0x09, 0x1f, 0xd1, 0xe9,
-	0x56, 0x31, 0xa6, 0xaf, 0xea, 0x1a, 0xd1, 0x42, 0x19, 0x9c, 0xc4, 0xbf, 0x1a, 0xf5, 0xd2, 0xcf,
-	0x63, 0x08, 0xae, 0x1d, 0xd9, 0x0a, 0x4e, 0x9b, 0xd9, 0xbe, 0x71, 0xbb, 0xb9, 0x5a, 0xa2, 0x09,
-	0xb4, 0x77, 0x3d, 0xb7, 0x39, 0x3d, 0xa8, 0xd1, 0x3e, 0x56, 0x23, 0x39, 0x52, 0xe3, 0x43, 0xe7,
-	0x7b, 0x5b, 0xad, 0x56, 0x3d, 0x2f, 0xf1, 0xb7, 0x7f, 0x02, 0x00, 0x00, 0xff, 0xff, 0x84, 0x04,
-	0xba, 0xea, 0x6a, 0x04, 0x00, 0x00,
+	// 1087 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x85, 0x56, 0x5d, 0x6f, 0xe3, 0x44,
+	0x14, 0x25, 0x1f, 0x4d, 0x93, 0x9b, 0x8f, 0x96, 0xa1, 0x02, 0xab, 0x82, 0x65, 0xb1, 0x10, 0xaa,
+	0x00, 0x79, 0x51, 0x41, 0x08, 0xf1, 0x96, 0x26, 0x5d, 0x11, 0xa9, 0xda, 0x56, 0x93, 0x52, 0x24,
+	0xde, 0x26, 0xf6, 0x34, 0x19, 0xe1, 0xd8, 0xd6, 0x78, 0x5c, 0x2d, 0x8f, 0xfc, 0x11, 0x9e, 0x78,
+	0xe7, 0x3f, 0xf0, 0xc0, 0xef, 0xe2, 0xce, 0x87, 0x1d, 0x3b, 0xa5, 0xdb, 0xa7, 0xcc, 0x3d, 0x73,
+	0xe6, 0xce, 0xcc, 0x99, 0x7b, 0x8f, 0x03, 0x03, 0x96, 0x89, 0x20, 0x93, 0xa9, 0x4a, 0x4f, 0x8f,
+	0xc2, 0x34, 0x51, 0x92, 0x85, 0x2a, 0x77, 0xc0, 0x28, 0x95, 0x11, 0x97, 0x65, 0x34, 0xc6, 0x9f,
+	0x7b, 0x11, 0x73, 0x17, 0x7e, 0xba, 0x4e, 0xd3, 0x75, 0xcc, 0x5f, 0x99, 0x68, 0x55, 0xdc, 0xbf,
+	0x52, 0x62, 0xcb, 0x73, 0xc5, 0xb6, 0x99, 0x25, 0xf8, 0xdf, 0x40, 0x6f, 0x96, 0x16, 0x59, 0x9a,
+	0x10, 0x02, 0xdd, 0x0d, 0xcb, 0x37, 0x5e, 0xeb, 0x65, 0xeb, 0x6c, 0x40, 0xcd, 0x58, 0x63, 0x61,
+	0x1a, 0x71, 0xaf, 0x6d, 0x31, 0x3d, 0xf6, 0xff, 0x6e, 0xc3, 0xe8, 0x5a, 0x6f, 0x49, 0x79, 0x9e,
+	0x4d, 0x33, 0x41, 0x02, 0xe8, 0x97, 0x67, 0x32, 0x8b, 0x87, 0xe7, 0x24, 0xa0, 0x22, 0x64, 0x32,
+	0x12, 0x2c, 0x99, 0xb9, 0x19, 0x5a, 0x71, 0xc8, 0x67, 0x70, 0x80, 0x27, 0x50, 0x36, 0xeb, 0xe4,
+	0x7c, 0x18, 0x98, 0x6c, 0x4b, 0x0d, 0x51, 0x3b, 0xa3, 0xf7, 0x95, 0x9c, 0x45, 0x5e, 0x07, 0x19,
+	0x7d, 0x6a, 0xc6, 0xe4, 0x43, 0xe8, 0xdd, 0x17, 0x49, 0xc4, 0x23, 0xaf, 0x6b, 0x50, 0x17, 0x91,
+	0xef, 0x61, 0x84, 0x79, 0x93, 0x1c, 0x53, 0x8b, 0x34, 0xc9, 0xbd, 0x83, 0x97, 0x1d, 0x73, 0x84,
+	0xdb, 0x1d, 0x48, 0x79, 0x88, 0x22, 0xd1, 0x06, 0x8f, 0x7c, 0x0d, 0xfd, 0x07, 0x26, 0xf1, 0x88,
+	0x2a, 0xf7, 0x7a, 0x66, 0xcd, 0x71, 0xb0, 0xe4, 0x31, 0x0f, 0x15, 0x8f, 0xee, 0xec, 0x04, 0xad,
+	0x18, 0xe4, 0x47, 0x38, 0x5a, 0x15, 0xbf, 0xeb, 0x4b, 0x67, 0x05, 0x1e, 0x11, 0x33, 0x78, 0x87,
+	0xe6, 0xae, 0xc7, 0xc1, 0x45, 0x13, 0xa7, 0xfb, 0x44, 0xff, 0xaf, 0x2e, 0x0c, 0x67, 0x2c, 0xe7,
+	0xa5, 0x60, 0x3f, 0xc0, 0xa0, 0x7a, 0x06, 0xa7, 0xd8, 0x69, 0x60, 0x1f, 0x2a, 0x28, 0x1f, 0x2a,
+	0xb8, 0x2d, 0x19, 0x74, 0x47, 0xc6, 0x95, 0x63, 0x93, 0xbc, 0x54, 0xd5, 0x48, 0xf8, 0xff, 0x7a,
+	0x37, 0x89, 0x78, 0xfe, 0xc9, 0x03, 0x4f, 0xa2, 0x74, 0xb7, 0xb4, 0xf3, 0xe4, 0xd2, 0x3d, 0x26,
+	0x99, 0xc3, 0x27, 0x8d, 0x64, 0x77, 0x2c, 0x16, 0x91, 0xb9, 0xda, 0xa5, 0x94, 0xa9, 0xcc, 0xf1,
+	0x41, 0x3a, 0x58, 0x1e, 0xef, 0x26, 0x91, 0xd7, 0xf0, 0xa2, 0x99, 0xf7, 0x51, 0x9a, 0x03, 0x93,
+	0xe6, 0x19, 0xd6, 0xae, 0x7c, 0x7a, 0xcf, 0x96, 0xcf, 0x61, 0xad, 0x7c, 0x5e, 0xc2, 0xd0, 0x9c,
+	0xef, 0x3a, 0xe3, 0x09, 0xd6, 0x50, 0xdf, 0x4c, 0xd5, 0x21, 0x72, 0x02, 0x07, 0x61, 0xcc, 0xc4,
+	0xd6, 0x1b, 0x98, 0x6a, 0xb7, 0x01, 0x39, 0x07, 0x90, 0x3c, 0x4f, 0xe3, 0xc2, 0xbc, 0x39, 0x38,
+	0xd1, 0xe6, 0x22, 0xc7, 0xe7, 0xd5, 0x2f, 0xea, 0x66, 0x68, 0x8d, 0x45, 0xbe, 0x83, 0x21, 0x53,
+	0x8a, 0x85, 0x9b, 0x2d, 0xd7, 0xd5, 0x35, 0x74, 0x15, 0xe9, 0x16, 0x4d, 0xab, 0x29, 0x5a, 0xa7,
+	0xf9, 0x21, 0xbc, 0xff, 0xa8, 0x66, 0xf5, 0x55, 0xd4, 0x5b, 0x11, 0x95, 0x5d, 0xa9, 0xc7, 0xfa,
+	0xa0, 0x0f, 0x2c, 0x2e, 0x6c, 0x03, 0x75, 0xa8, 0x0d, 0xc8, 0xe7, 0x30, 0xc6, 0x16, 0xbb, 0x17,
+	0x72, 0xcb, 0x6c, 0x23, 0xe8, 0x07, 0x1e, 0xd3, 0x26, 0xe8, 0x5f, 0xc1, 0xe4, 0x86, 0x73, 0x39,
+	0x4d, 0xa2, 0x1b, 0x6b, 0x14, 0xba, 0xaf, 0x32, 0x44, 0x16, 0xe5, 0x1e, 0x2e, 0x22, 0x3e, 0x1c,
+	0x3a, 0x2f, 0x71, 0x55, 0xd6, 0x0f, 0xdc, 0x12, 0x5a, 0x4e, 0xf8, 0x2b, 0x38, 0x69, 0x66, 0xfb,
+	0x45, 0xa8, 0xcd, 0x62, 0x4e, 0x26, 0xd0, 0xae, 0xce, 0x8c, 0xa3, 0xda, 0x1e, 0xed, 0xa7, 0xf6,
+	0xe8, 0x3c, 0xb5, 0xc7, 0x06, 0x8e, 0x96, 0x1b, 0x91, 0x65, 0x22, 0x59, 0x97, 0x47, 0x46, 0x51,
+	0x12, 0xb6, 0xe5, 0xa5, 0x28, 0x7a, 0x4c, 0xa6, 0x70, 0x94, 0x3b, 0xda, 0x75, 0x66, 0x05, 0x68,
+	0x1b, 0xdd, 0x3f, 0x0a, 0xae, 0x44, 0xae, 0x10, 0x0e, 0x96, 0x8d, 0x79, 0xba, 0xcf, 0xf7, 0xff,
+	0x6d, 0xe1, 0x56, 0x4d, 0x07, 0xd0, 0x65, 0x13, 0xdb, 0xe5, 0x3f, 0xed, 0xcc, 0xb1, 0x0e, 0x91,
+	0x53, 0xe8, 0xe7, 0xbf, 0x15, 0x0b, 0xf4, 0xa2, 0xb7, 0xe6, 0x76, 0x63, 0x5a, 0xc5, 0xe4, 0x63,
+	0x18, 0xe0, 0x35, 0xa2, 0x22, 0x54, 0x8b, 0xb9, 0xb9, 0xe1, 0x80, 0xee, 0x00, 0xfd, 0x8e, 0x4a,
+	0x28, 0xbc, 0x7b, 0xd7, 0x16, 0x9c, 0x09, 0x34, 0xba, 0x96, 0x6c, 0xab, 0xdb, 0xa1, 0x75, 0xd6,
+	0xa6, 0x36, 0x20, 0x5f, 0x41, 0x4f, 0x6c, 0xd9, 0x9a, 0x97, 0x5e, 0xf5, 0x41, 0x75, 0xab, 0x85,
+	0xe2, 0xdb, 0x60, 0xa1, 0xe7, 0xa8, 0xa3, 0xf8, 0x6f, 0x60, 0x72, 0x97, 0x16, 0xe1, 0x86, 0xcb,
+	0x0b, 0x16, 0xb3, 0x24, 0xe4, 0x5a, 0xe8, 0x07, 0x8b, 0x38, 0xc3, 0xe9, 0x07, 0x8e, 0x41, 0xcb,
+	0x09, 0xe2, 0xc1, 0xe1, 0xca, 0xd2, 0xcd, 0x3d, 0xba, 0xb4, 0x0c, 0xfd, 0x7f, 0xba, 0x30, 0x5a,
+	0x16, 0xab, 0x3c, 0x94, 0xc2, 0x48, 0xf5, 0xe8, 0x7d, 0xf5, 0x52, 0xdd, 0x49, 0x78, 0x4b, 0xfb,
+	0xc0, 0x65, 0xa8, 0xd5, 0xb1, 0xfd, 0x5c, 0x09, 0x50, 0xc5, 0x35, 0x6d, 0x97, 0x71, 0xb1, 0x76,
+	0x2a, 0xd4, 0x21, 0x72, 0x86, 0xae, 0x2b, 0xe2, 0x18, 0xc3, 0x45, 0xa2, 0xb8, 0xc4, 0x42, 0x37,
+	0xaa, 0x8c, 0xe9, 0x3e, 0xac, 0x73, 0xe5, 0xd8, 0xc6, 0x11, 0x62, 0x33, 0x96, 0x19, 0x6f, 0xe8,
+	0xd2, 0x3a, 0x84, 0x7e, 0xdf, 0xd3, 0xee, 0x50, 0xe4, 0xc6, 0x16, 0x26, 0xe7, 0x27, 0x41, 0xfd,
+	0x4a, 0xc1, 0xd2, 0xcc, 0x51, 0xc7, 0xc1, 0x16, 0x3e, 0x0c, 0xd1, 0x37, 0x94, 0xb3, 0x8a, 0x77,
+	0x3b, 0x74, 0x49, 0xd5, 0xce, 0x1e, 0xb3, 0x5c, 0x19, 0x47, 0x32, 0x36, 0xf2, 0x8c, 0xb3, 0x57,
+	0x64, 0xad, 0x93, 0xf9, 0x8e, 0x2f, 0xe6, 0x39, 0x9a, 0x8c, 0xf6, 0xc1, 0x2a, 0xc6, 0xc7, 0x1b,
+	0x65, 0x85, 0x0c, 0xf1, 0x8b, 0xcc, 0xe7, 0x4c, 0x31, 0xf4, 0x13, 0x2d, 0x54, 0x03, 0xd3, 0x95,
+	0xa6, 0x93, 0x19, 0x8f, 0xf4, 0x46, 0xb6, 0xd2, 0x2a, 0x40, 0xeb, 0x58, 0x24, 0xf6, 0x7b, 0x79,
+	0x6d, 0xb3, 0x7a, 0x63, 0xc3, 0xd9, 0x87, 0x35, 0x53, 0x03, 0xa8, 0xd9, 0x6b, 0x26, 0xe2, 0x02,
+	0x4d, 0xcd, 0x9b, 0x58, 0xc5, 0xf7, 0x60, 0x3f, 0x80, 0x9e, 0xd5, 0x8c, 0x00, 0xf4, 0xa6, 0xb3,
+	0xdb, 0xc5, 0xdd, 0xe5, 0xf1, 0x7b, 0x7a, 0x7c, 0x33, 0xfd, 0x79, 0x79, 0x39, 0x3f, 0x6e, 0x91,
+	0x11, 0xf4, 0x67, 0xd3, 0x37, 0xb3, 0xcb, 0x2b, 0x8c, 0xda, 0xfe, 0x9f, 0x2d, 0x20, 0x3b, 0xbf,
+	0xbc, 0xe5, 0xdb, 0x2c, 0x76, 0x5e, 0xfd, 0xa8, 0x97, 0xcf, 0xdc, 0xc7, 0xf6, 0x86, 0xcb, 0x10,
+	0xad, 0x11, 0x6b, 0xda, 0x94, 0x55, 0x9b, 0xee, 0xc3, 0xe4, 0x4b, 0x38, 0xb6, 0xe5, 0x54, 0xa3,
+	0x76, 0x0c, 0xf5, 0x11, 0x4e, 0x5e, 0x34, 0x9c, 0xdc, 0x56, 0x5b, 0x0d, 0xf1, 0xff, 0xc0, 0xf6,
+	0xdf, 0xfb, 0x96, 0xeb, 0xb2, 0x92, 0x4c, 0x97, 0x23, 0xfe, 0x49, 0x4a, 0xec, 0xdf, 0x9b, 0x31,
+	0xad, 0x43, 0xda, 0x76, 0xd9, 0x03, 0x97, 0xba, 0xfd, 0x0c, 0xea, 0x4e, 0xda, 0x04, 0xc9, 0x17,
+	0x30, 0x89, 0xac, 0xfb, 0x5b, 0xa5, 0x4b, 0x77, 0xde, 0x43, 0x2f, 0xba, 0xbf, 0xb6, 0xb3, 0xd5,
+	0xaa, 0x67, 0x6a, 0xe5, 0xdb, 0xff, 0x00, 0x16, 0x6d, 0x87, 0x51, 0xf7, 0x09, 0x00, 0x00,
 }
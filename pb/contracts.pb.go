@@ -118,7 +118,7 @@ func (x Listing_ShippingOption_ShippingRules_RuleType) String() string {
 	return proto.EnumName(Listing_ShippingOption_ShippingRules_RuleType_name, int32(x))
 }
 func (Listing_ShippingOption_ShippingRules_RuleType) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor1, []int{1, 2, 1, 0}
+	return fileDescriptor1, []int{1, 2, 2, 0}
 }
 
 type Order_Payment_Method int32
@@ -182,18 +182,20 @@ var Signature_Section_value = map[string]int32{
 func (x Signature_Section) String() string {
 	return proto.EnumName(Signature_Section_name, int32(x))
 }
-func (Signature_Section) EnumDescriptor() ([]byte, []int) { return fileDescriptor1, []int{14, 0} }
+func (Signature_Section) EnumDescriptor() ([]byte, []int) { return fileDescriptor1, []int{19, 0} }
 
 type RicardianContract struct {
-	VendorListings          []*Listing          `protobuf:"bytes,1,rep,name=vendorListings" json:"vendorListings,omitempty"`
-	BuyerOrder              *Order              `protobuf:"bytes,2,opt,name=buyerOrder" json:"buyerOrder,omitempty"`
-	VendorOrderConfirmation *OrderConfirmation  `protobuf:"bytes,3,opt,name=vendorOrderConfirmation" json:"vendorOrderConfirmation,omitempty"`
-	VendorOrderFulfillment  []*OrderFulfillment `protobuf:"bytes,4,rep,name=vendorOrderFulfillment" json:"vendorOrderFulfillment,omitempty"`
-	BuyerOrderCompletion    *OrderCompletion    `protobuf:"bytes,5,opt,name=buyerOrderCompletion" json:"buyerOrderCompletion,omitempty"`
-	Dispute                 *Dispute            `protobuf:"bytes,6,opt,name=dispute" json:"dispute,omitempty"`
-	DisputeResolution       *DisputeResolution  `protobuf:"bytes,7,opt,name=disputeResolution" json:"disputeResolution,omitempty"`
-	Refund                  *Refund             `protobuf:"bytes,8,opt,name=refund" json:"refund,omitempty"`
-	Signatures              []*Signature        `protobuf:"bytes,9,rep,name=signatures" json:"signatures,omitempty"`
+	VendorListings          []*Listing            `protobuf:"bytes,1,rep,name=vendorListings" json:"vendorListings,omitempty"`
+	BuyerOrder              *Order                `protobuf:"bytes,2,opt,name=buyerOrder" json:"buyerOrder,omitempty"`
+	VendorOrderConfirmation *OrderConfirmation    `protobuf:"bytes,3,opt,name=vendorOrderConfirmation" json:"vendorOrderConfirmation,omitempty"`
+	VendorOrderFulfillment  []*OrderFulfillment   `protobuf:"bytes,4,rep,name=vendorOrderFulfillment" json:"vendorOrderFulfillment,omitempty"`
+	BuyerOrderCompletion    *OrderCompletion      `protobuf:"bytes,5,opt,name=buyerOrderCompletion" json:"buyerOrderCompletion,omitempty"`
+	Dispute                 *Dispute              `protobuf:"bytes,6,opt,name=dispute" json:"dispute,omitempty"`
+	DisputeResolution       *DisputeResolution    `protobuf:"bytes,7,opt,name=disputeResolution" json:"disputeResolution,omitempty"`
+	Refund                  *Refund               `protobuf:"bytes,8,opt,name=refund" json:"refund,omitempty"`
+	Signatures              []*Signature          `protobuf:"bytes,9,rep,name=signatures" json:"signatures,omitempty"`
+	PayoutProposal          *PayoutProposal       `protobuf:"bytes,10,opt,name=payoutProposal" json:"payoutProposal,omitempty"`
+	DisputeEndorsements     []*DisputeEndorsement `protobuf:"bytes,11,rep,name=disputeEndorsements" json:"disputeEndorsements,omitempty"`
 }
 
 func (m *RicardianContract) Reset()                    { *m = RicardianContract{} }
@@ -264,6 +266,20 @@ func (m *RicardianContract) GetSignatures() []*Signature {
 	return nil
 }
 
+func (m *RicardianContract) GetPayoutProposal() *PayoutProposal {
+	if m != nil {
+		return m.PayoutProposal
+	}
+	return nil
+}
+
+func (m *RicardianContract) GetDisputeEndorsements() []*DisputeEndorsement {
+	if m != nil {
+		return m.DisputeEndorsements
+	}
+	return nil
+}
+
 type Listing struct {
 	Slug               string                    `protobuf:"bytes,1,opt,name=slug" json:"slug,omitempty"`
 	VendorID           *ID                       `protobuf:"bytes,2,opt,name=vendorID" json:"vendorID,omitempty"`
@@ -275,6 +291,7 @@ type Listing struct {
 	Moderators         []string                  `protobuf:"bytes,8,rep,name=moderators" json:"moderators,omitempty"`
 	TermsAndConditions string                    `protobuf:"bytes,9,opt,name=termsAndConditions" json:"termsAndConditions,omitempty"`
 	RefundPolicy       string                    `protobuf:"bytes,10,opt,name=refundPolicy" json:"refundPolicy,omitempty"`
+	ShippingProfile    string                    `protobuf:"bytes,11,opt,name=shippingProfile" json:"shippingProfile,omitempty"`
 }
 
 func (m *Listing) Reset()                    { *m = Listing{} }
@@ -352,6 +369,13 @@ func (m *Listing) GetRefundPolicy() string {
 	return ""
 }
 
+func (m *Listing) GetShippingProfile() string {
+	if m != nil {
+		return m.ShippingProfile
+	}
+	return ""
+}
+
 type Listing_Metadata struct {
 	Version          uint32                        `protobuf:"varint,1,opt,name=version" json:"version,omitempty"`
 	ContractType     Listing_Metadata_ContractType `protobuf:"varint,2,opt,name=contractType,enum=Listing_Metadata_ContractType" json:"contractType,omitempty"`
@@ -360,6 +384,10 @@ type Listing_Metadata struct {
 	AcceptedCurrency string                        `protobuf:"bytes,5,opt,name=acceptedCurrency" json:"acceptedCurrency,omitempty"`
 	PricingCurrency  string                        `protobuf:"bytes,6,opt,name=pricingCurrency" json:"pricingCurrency,omitempty"`
 	Language         string                        `protobuf:"bytes,7,opt,name=language" json:"language,omitempty"`
+	BillingInterval  uint32                        `protobuf:"varint,8,opt,name=billingInterval" json:"billingInterval,omitempty"`
+	Preorder         bool                          `protobuf:"varint,9,opt,name=preorder" json:"preorder,omitempty"`
+	MaxBackorder     uint32                        `protobuf:"varint,10,opt,name=maxBackorder" json:"maxBackorder,omitempty"`
+	ExpectedShipDate *google_protobuf.Timestamp    `protobuf:"bytes,11,opt,name=expectedShipDate" json:"expectedShipDate,omitempty"`
 }
 
 func (m *Listing_Metadata) Reset()                    { *m = Listing_Metadata{} }
@@ -416,19 +444,48 @@ func (m *Listing_Metadata) GetLanguage() string {
 	return ""
 }
 
+func (m *Listing_Metadata) GetBillingInterval() uint32 {
+	if m != nil {
+		return m.BillingInterval
+	}
+	return 0
+}
+
+func (m *Listing_Metadata) GetPreorder() bool {
+	if m != nil {
+		return m.Preorder
+	}
+	return false
+}
+
+func (m *Listing_Metadata) GetMaxBackorder() uint32 {
+	if m != nil {
+		return m.MaxBackorder
+	}
+	return 0
+}
+
+func (m *Listing_Metadata) GetExpectedShipDate() *google_protobuf.Timestamp {
+	if m != nil {
+		return m.ExpectedShipDate
+	}
+	return nil
+}
+
 type Listing_Item struct {
-	Title          string                 `protobuf:"bytes,1,opt,name=title" json:"title,omitempty"`
-	Description    string                 `protobuf:"bytes,2,opt,name=description" json:"description,omitempty"`
-	ProcessingTime string                 `protobuf:"bytes,3,opt,name=processingTime" json:"processingTime,omitempty"`
-	Price          uint64                 `protobuf:"varint,4,opt,name=price" json:"price,omitempty"`
-	Nsfw           bool                   `protobuf:"varint,5,opt,name=nsfw" json:"nsfw,omitempty"`
-	Tags           []string               `protobuf:"bytes,6,rep,name=tags" json:"tags,omitempty"`
-	Images         []*Listing_Item_Image  `protobuf:"bytes,7,rep,name=images" json:"images,omitempty"`
-	Categories     []string               `protobuf:"bytes,8,rep,name=categories" json:"categories,omitempty"`
-	Grams          float32                `protobuf:"fixed32,9,opt,name=grams" json:"grams,omitempty"`
-	Condition      string                 `protobuf:"bytes,10,opt,name=condition" json:"condition,omitempty"`
-	Options        []*Listing_Item_Option `protobuf:"bytes,11,rep,name=options" json:"options,omitempty"`
-	Skus           []*Listing_Item_Sku    `protobuf:"bytes,12,rep,name=skus" json:"skus,omitempty"`
+	Title          string                     `protobuf:"bytes,1,opt,name=title" json:"title,omitempty"`
+	Description    string                     `protobuf:"bytes,2,opt,name=description" json:"description,omitempty"`
+	ProcessingTime string                     `protobuf:"bytes,3,opt,name=processingTime" json:"processingTime,omitempty"`
+	Price          uint64                     `protobuf:"varint,4,opt,name=price" json:"price,omitempty"`
+	Nsfw           bool                       `protobuf:"varint,5,opt,name=nsfw" json:"nsfw,omitempty"`
+	Tags           []string                   `protobuf:"bytes,6,rep,name=tags" json:"tags,omitempty"`
+	Images         []*Listing_Item_Image      `protobuf:"bytes,7,rep,name=images" json:"images,omitempty"`
+	Categories     []string                   `protobuf:"bytes,8,rep,name=categories" json:"categories,omitempty"`
+	Grams          float32                    `protobuf:"fixed32,9,opt,name=grams" json:"grams,omitempty"`
+	Condition      string                     `protobuf:"bytes,10,opt,name=condition" json:"condition,omitempty"`
+	Options        []*Listing_Item_Option     `protobuf:"bytes,11,rep,name=options" json:"options,omitempty"`
+	Skus           []*Listing_Item_Sku        `protobuf:"bytes,12,rep,name=skus" json:"skus,omitempty"`
+	Bundle         []*Listing_Item_BundleItem `protobuf:"bytes,13,rep,name=bundle" json:"bundle,omitempty"`
 }
 
 func (m *Listing_Item) Reset()                    { *m = Listing_Item{} }
@@ -520,6 +577,13 @@ func (m *Listing_Item) GetSkus() []*Listing_Item_Sku {
 	return nil
 }
 
+func (m *Listing_Item) GetBundle() []*Listing_Item_BundleItem {
+	if m != nil {
+		return m.Bundle
+	}
+	return nil
+}
+
 type Listing_Item_Option struct {
 	Name        string   `protobuf:"bytes,1,opt,name=name" json:"name,omitempty"`
 	Description string   `protobuf:"bytes,2,opt,name=description" json:"description,omitempty"`
@@ -553,10 +617,13 @@ func (m *Listing_Item_Option) GetVariants() []string {
 }
 
 type Listing_Item_Sku struct {
-	VariantCombo []uint32 `protobuf:"varint,1,rep,packed,name=variantCombo" json:"variantCombo,omitempty"`
-	ProductID    string   `protobuf:"bytes,2,opt,name=productID" json:"productID,omitempty"`
-	Surcharge    int64    `protobuf:"varint,3,opt,name=surcharge" json:"surcharge,omitempty"`
-	Quantity     int64    `protobuf:"varint,4,opt,name=quantity" json:"quantity,omitempty"`
+	VariantCombo []uint32              `protobuf:"varint,1,rep,packed,name=variantCombo" json:"variantCombo,omitempty"`
+	ProductID    string                `protobuf:"bytes,2,opt,name=productID" json:"productID,omitempty"`
+	Surcharge    int64                 `protobuf:"varint,3,opt,name=surcharge" json:"surcharge,omitempty"`
+	Quantity     int64                 `protobuf:"varint,4,opt,name=quantity" json:"quantity,omitempty"`
+	Images       []*Listing_Item_Image `protobuf:"bytes,5,rep,name=images" json:"images,omitempty"`
+	Grams        float32               `protobuf:"fixed32,6,opt,name=grams" json:"grams,omitempty"`
+	TitleSuffix  string                `protobuf:"bytes,7,opt,name=titleSuffix" json:"titleSuffix,omitempty"`
 }
 
 func (m *Listing_Item_Sku) Reset()                    { *m = Listing_Item_Sku{} }
@@ -592,6 +659,60 @@ func (m *Listing_Item_Sku) GetQuantity() int64 {
 	return 0
 }
 
+func (m *Listing_Item_Sku) GetImages() []*Listing_Item_Image {
+	if m != nil {
+		return m.Images
+	}
+	return nil
+}
+
+func (m *Listing_Item_Sku) GetGrams() float32 {
+	if m != nil {
+		return m.Grams
+	}
+	return 0
+}
+
+func (m *Listing_Item_Sku) GetTitleSuffix() string {
+	if m != nil {
+		return m.TitleSuffix
+	}
+	return ""
+}
+
+// A component of a bundle listing. Inventory is drawn from the component sku.
+type Listing_Item_BundleItem struct {
+	Slug     string `protobuf:"bytes,1,opt,name=slug" json:"slug,omitempty"`
+	Variant  uint32 `protobuf:"varint,2,opt,name=variant" json:"variant,omitempty"`
+	Quantity uint32 `protobuf:"varint,3,opt,name=quantity" json:"quantity,omitempty"`
+}
+
+func (m *Listing_Item_BundleItem) Reset()                    { *m = Listing_Item_BundleItem{} }
+func (m *Listing_Item_BundleItem) String() string            { return proto.CompactTextString(m) }
+func (*Listing_Item_BundleItem) ProtoMessage()               {}
+func (*Listing_Item_BundleItem) Descriptor() ([]byte, []int) { return fileDescriptor1, []int{1, 1, 2} }
+
+func (m *Listing_Item_BundleItem) GetSlug() string {
+	if m != nil {
+		return m.Slug
+	}
+	return ""
+}
+
+func (m *Listing_Item_BundleItem) GetVariant() uint32 {
+	if m != nil {
+		return m.Variant
+	}
+	return 0
+}
+
+func (m *Listing_Item_BundleItem) GetQuantity() uint32 {
+	if m != nil {
+		return m.Quantity
+	}
+	return 0
+}
+
 type Listing_Item_Image struct {
 	Filename string `protobuf:"bytes,1,opt,name=filename" json:"filename,omitempty"`
 	Original string `protobuf:"bytes,2,opt,name=original" json:"original,omitempty"`
@@ -604,7 +725,7 @@ type Listing_Item_Image struct {
 func (m *Listing_Item_Image) Reset()                    { *m = Listing_Item_Image{} }
 func (m *Listing_Item_Image) String() string            { return proto.CompactTextString(m) }
 func (*Listing_Item_Image) ProtoMessage()               {}
-func (*Listing_Item_Image) Descriptor() ([]byte, []int) { return fileDescriptor1, []int{1, 1, 2} }
+func (*Listing_Item_Image) Descriptor() ([]byte, []int) { return fileDescriptor1, []int{1, 1, 3} }
 
 func (m *Listing_Item_Image) GetFilename() string {
 	if m != nil {
@@ -654,6 +775,7 @@ type Listing_ShippingOption struct {
 	Regions       []CountryCode                         `protobuf:"varint,3,rep,packed,name=regions,enum=CountryCode" json:"regions,omitempty"`
 	ShippingRules *Listing_ShippingOption_ShippingRules `protobuf:"bytes,4,opt,name=shippingRules" json:"shippingRules,omitempty"`
 	Services      []*Listing_ShippingOption_Service     `protobuf:"bytes,5,rep,name=services" json:"services,omitempty"`
+	Zones         []*Listing_ShippingOption_Zone        `protobuf:"bytes,6,rep,name=zones" json:"zones,omitempty"`
 }
 
 func (m *Listing_ShippingOption) Reset()                    { *m = Listing_ShippingOption{} }
@@ -696,6 +818,13 @@ func (m *Listing_ShippingOption) GetServices() []*Listing_ShippingOption_Service
 	return nil
 }
 
+func (m *Listing_ShippingOption) GetZones() []*Listing_ShippingOption_Zone {
+	if m != nil {
+		return m.Zones
+	}
+	return nil
+}
+
 type Listing_ShippingOption_Service struct {
 	Name              string `protobuf:"bytes,1,opt,name=name" json:"name,omitempty"`
 	Price             uint64 `protobuf:"varint,2,opt,name=price" json:"price,omitempty"`
@@ -730,6 +859,49 @@ func (m *Listing_ShippingOption_Service) GetEstimatedDelivery() string {
 	return ""
 }
 
+// Restricts or excludes part of a country by state/province or postal code prefix
+type Listing_ShippingOption_Zone struct {
+	Country     CountryCode `protobuf:"varint,1,opt,name=country,enum=CountryCode" json:"country,omitempty"`
+	States      []string    `protobuf:"bytes,2,rep,name=states" json:"states,omitempty"`
+	PostalCodes []string    `protobuf:"bytes,3,rep,name=postalCodes" json:"postalCodes,omitempty"`
+	Exclude     bool        `protobuf:"varint,4,opt,name=exclude" json:"exclude,omitempty"`
+}
+
+func (m *Listing_ShippingOption_Zone) Reset()         { *m = Listing_ShippingOption_Zone{} }
+func (m *Listing_ShippingOption_Zone) String() string { return proto.CompactTextString(m) }
+func (*Listing_ShippingOption_Zone) ProtoMessage()    {}
+func (*Listing_ShippingOption_Zone) Descriptor() ([]byte, []int) {
+	return fileDescriptor1, []int{1, 2, 1}
+}
+
+func (m *Listing_ShippingOption_Zone) GetCountry() CountryCode {
+	if m != nil {
+		return m.Country
+	}
+	return CountryCode_NA
+}
+
+func (m *Listing_ShippingOption_Zone) GetStates() []string {
+	if m != nil {
+		return m.States
+	}
+	return nil
+}
+
+func (m *Listing_ShippingOption_Zone) GetPostalCodes() []string {
+	if m != nil {
+		return m.PostalCodes
+	}
+	return nil
+}
+
+func (m *Listing_ShippingOption_Zone) GetExclude() bool {
+	if m != nil {
+		return m.Exclude
+	}
+	return false
+}
+
 type Listing_ShippingOption_ShippingRules struct {
 	RuleType Listing_ShippingOption_ShippingRules_RuleType `protobuf:"varint,1,opt,name=ruleType,enum=Listing_ShippingOption_ShippingRules_RuleType" json:"ruleType,omitempty"`
 	Rules    []*Listing_ShippingOption_ShippingRules_Rule  `protobuf:"bytes,2,rep,name=rules" json:"rules,omitempty"`
@@ -739,7 +911,7 @@ func (m *Listing_ShippingOption_ShippingRules) Reset()         { *m = Listing_Sh
 func (m *Listing_ShippingOption_ShippingRules) String() string { return proto.CompactTextString(m) }
 func (*Listing_ShippingOption_ShippingRules) ProtoMessage()    {}
 func (*Listing_ShippingOption_ShippingRules) Descriptor() ([]byte, []int) {
-	return fileDescriptor1, []int{1, 2, 1}
+	return fileDescriptor1, []int{1, 2, 2}
 }
 
 func (m *Listing_ShippingOption_ShippingRules) GetRuleType() Listing_ShippingOption_ShippingRules_RuleType {
@@ -768,7 +940,7 @@ func (m *Listing_ShippingOption_ShippingRules_Rule) Reset() {
 func (m *Listing_ShippingOption_ShippingRules_Rule) String() string { return proto.CompactTextString(m) }
 func (*Listing_ShippingOption_ShippingRules_Rule) ProtoMessage()    {}
 func (*Listing_ShippingOption_ShippingRules_Rule) Descriptor() ([]byte, []int) {
-	return fileDescriptor1, []int{1, 2, 1, 0}
+	return fileDescriptor1, []int{1, 2, 2, 0}
 }
 
 func (m *Listing_ShippingOption_ShippingRules_Rule) GetMinRange() uint32 {
@@ -1038,6 +1210,9 @@ type Order struct {
 	Payment              *Order_Payment             `protobuf:"bytes,7,opt,name=payment" json:"payment,omitempty"`
 	RatingKeys           [][]byte                   `protobuf:"bytes,8,rep,name=ratingKeys,proto3" json:"ratingKeys,omitempty"`
 	AlternateContactInfo string                     `protobuf:"bytes,9,opt,name=alternateContactInfo" json:"alternateContactInfo,omitempty"`
+	Credit               *Order_Credit              `protobuf:"bytes,10,opt,name=credit" json:"credit,omitempty"`
+	SubscriptionID       string                     `protobuf:"bytes,11,opt,name=subscriptionID" json:"subscriptionID,omitempty"`
+	RatingTicket         *BuyerRatingTicket         `protobuf:"bytes,12,opt,name=ratingTicket" json:"ratingTicket,omitempty"`
 }
 
 func (m *Order) Reset()                    { *m = Order{} }
@@ -1108,6 +1283,27 @@ func (m *Order) GetAlternateContactInfo() string {
 	return ""
 }
 
+func (m *Order) GetCredit() *Order_Credit {
+	if m != nil {
+		return m.Credit
+	}
+	return nil
+}
+
+func (m *Order) GetSubscriptionID() string {
+	if m != nil {
+		return m.SubscriptionID
+	}
+	return ""
+}
+
+func (m *Order) GetRatingTicket() *BuyerRatingTicket {
+	if m != nil {
+		return m.RatingTicket
+	}
+	return nil
+}
+
 type Order_Shipping struct {
 	ShipTo       string      `protobuf:"bytes,1,opt,name=shipTo" json:"shipTo,omitempty"`
 	Address      string      `protobuf:"bytes,2,opt,name=address" json:"address,omitempty"`
@@ -1277,13 +1473,17 @@ func (m *Order_Item_ShippingOption) GetService() string {
 }
 
 type Order_Payment struct {
-	Method       Order_Payment_Method `protobuf:"varint,1,opt,name=method,enum=Order_Payment_Method" json:"method,omitempty"`
-	Moderator    string               `protobuf:"bytes,2,opt,name=moderator" json:"moderator,omitempty"`
-	Amount       uint64               `protobuf:"varint,3,opt,name=amount" json:"amount,omitempty"`
-	ExchangeRate uint64               `protobuf:"varint,4,opt,name=exchangeRate" json:"exchangeRate,omitempty"`
-	Chaincode    string               `protobuf:"bytes,6,opt,name=chaincode" json:"chaincode,omitempty"`
-	Address      string               `protobuf:"bytes,7,opt,name=address" json:"address,omitempty"`
-	RedeemScript string               `protobuf:"bytes,8,opt,name=redeemScript" json:"redeemScript,omitempty"`
+	Method                  Order_Payment_Method `protobuf:"varint,1,opt,name=method,enum=Order_Payment_Method" json:"method,omitempty"`
+	Moderator               string               `protobuf:"bytes,2,opt,name=moderator" json:"moderator,omitempty"`
+	Amount                  uint64               `protobuf:"varint,3,opt,name=amount" json:"amount,omitempty"`
+	ExchangeRate            uint64               `protobuf:"varint,4,opt,name=exchangeRate" json:"exchangeRate,omitempty"`
+	Chaincode               string               `protobuf:"bytes,6,opt,name=chaincode" json:"chaincode,omitempty"`
+	Address                 string               `protobuf:"bytes,7,opt,name=address" json:"address,omitempty"`
+	RedeemScript            string               `protobuf:"bytes,8,opt,name=redeemScript" json:"redeemScript,omitempty"`
+	BackupModerators        []string             `protobuf:"bytes,9,rep,name=backupModerators" json:"backupModerators,omitempty"`
+	PanelModerators         []string             `protobuf:"bytes,10,rep,name=panelModerators" json:"panelModerators,omitempty"`
+	DisputeUpdateDeadline   uint32               `protobuf:"varint,11,opt,name=disputeUpdateDeadline" json:"disputeUpdateDeadline,omitempty"`
+	DisputeDecisionDeadline uint32               `protobuf:"varint,12,opt,name=disputeDecisionDeadline" json:"disputeDecisionDeadline,omitempty"`
 }
 
 func (m *Order_Payment) Reset()                    { *m = Order_Payment{} }
@@ -1340,6 +1540,67 @@ func (m *Order_Payment) GetRedeemScript() string {
 	return ""
 }
 
+func (m *Order_Payment) GetBackupModerators() []string {
+	if m != nil {
+		return m.BackupModerators
+	}
+	return nil
+}
+
+func (m *Order_Payment) GetPanelModerators() []string {
+	if m != nil {
+		return m.PanelModerators
+	}
+	return nil
+}
+
+func (m *Order_Payment) GetDisputeUpdateDeadline() uint32 {
+	if m != nil {
+		return m.DisputeUpdateDeadline
+	}
+	return 0
+}
+
+func (m *Order_Payment) GetDisputeDecisionDeadline() uint32 {
+	if m != nil {
+		return m.DisputeDecisionDeadline
+	}
+	return 0
+}
+
+// Store credit applied from a voucher issued by the vendor
+type Order_Credit struct {
+	Voucher   string `protobuf:"bytes,1,opt,name=voucher" json:"voucher,omitempty"`
+	Amount    uint64 `protobuf:"varint,2,opt,name=amount" json:"amount,omitempty"`
+	CodeProof string `protobuf:"bytes,3,opt,name=codeProof" json:"codeProof,omitempty"`
+}
+
+func (m *Order_Credit) Reset()                    { *m = Order_Credit{} }
+func (m *Order_Credit) String() string            { return proto.CompactTextString(m) }
+func (*Order_Credit) ProtoMessage()               {}
+func (*Order_Credit) Descriptor() ([]byte, []int) { return fileDescriptor1, []int{2, 3} }
+
+func (m *Order_Credit) GetVoucher() string {
+	if m != nil {
+		return m.Voucher
+	}
+	return ""
+}
+
+func (m *Order_Credit) GetAmount() uint64 {
+	if m != nil {
+		return m.Amount
+	}
+	return 0
+}
+
+func (m *Order_Credit) GetCodeProof() string {
+	if m != nil {
+		return m.CodeProof
+	}
+	return ""
+}
+
 type OrderConfirmation struct {
 	OrderID   string                     `protobuf:"bytes,1,opt,name=orderID" json:"orderID,omitempty"`
 	Timestamp *google_protobuf.Timestamp `protobuf:"bytes,2,opt,name=timestamp" json:"timestamp,omitempty"`
@@ -1348,6 +1609,8 @@ type OrderConfirmation struct {
 	RequestedAmount  uint64             `protobuf:"varint,4,opt,name=requestedAmount" json:"requestedAmount,omitempty"`
 	PayoutFee        uint64             `protobuf:"varint,5,opt,name=payoutFee" json:"payoutFee,omitempty"`
 	RatingSignatures []*RatingSignature `protobuf:"bytes,6,rep,name=ratingSignatures" json:"ratingSignatures,omitempty"`
+	// Set if any item is a pre-order or is backordered
+	ExpectedShipDate *google_protobuf.Timestamp `protobuf:"bytes,7,opt,name=expectedShipDate" json:"expectedShipDate,omitempty"`
 }
 
 func (m *OrderConfirmation) Reset()                    { *m = OrderConfirmation{} }
@@ -1397,6 +1660,87 @@ func (m *OrderConfirmation) GetRatingSignatures() []*RatingSignature {
 	return nil
 }
 
+func (m *OrderConfirmation) GetExpectedShipDate() *google_protobuf.Timestamp {
+	if m != nil {
+		return m.ExpectedShipDate
+	}
+	return nil
+}
+
+// A gift card or store credit issued by a vendor. Vouchers are either tied to a
+// buyer's peer ID or redeemable by anyone holding the bearer code.
+type Voucher struct {
+	Id        string                     `protobuf:"bytes,1,opt,name=id" json:"id,omitempty"`
+	Amount    uint64                     `protobuf:"varint,2,opt,name=amount" json:"amount,omitempty"`
+	BuyerID   string                     `protobuf:"bytes,3,opt,name=buyerID" json:"buyerID,omitempty"`
+	CodeHash  string                     `protobuf:"bytes,4,opt,name=codeHash" json:"codeHash,omitempty"`
+	Memo      string                     `protobuf:"bytes,5,opt,name=memo" json:"memo,omitempty"`
+	Timestamp *google_protobuf.Timestamp `protobuf:"bytes,6,opt,name=timestamp" json:"timestamp,omitempty"`
+	VendorID  *ID                        `protobuf:"bytes,7,opt,name=vendorID" json:"vendorID,omitempty"`
+	Signature []byte                     `protobuf:"bytes,8,opt,name=signature,proto3" json:"signature,omitempty"`
+}
+
+func (m *Voucher) Reset()                    { *m = Voucher{} }
+func (m *Voucher) String() string            { return proto.CompactTextString(m) }
+func (*Voucher) ProtoMessage()               {}
+func (*Voucher) Descriptor() ([]byte, []int) { return fileDescriptor1, []int{4} }
+
+func (m *Voucher) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *Voucher) GetAmount() uint64 {
+	if m != nil {
+		return m.Amount
+	}
+	return 0
+}
+
+func (m *Voucher) GetBuyerID() string {
+	if m != nil {
+		return m.BuyerID
+	}
+	return ""
+}
+
+func (m *Voucher) GetCodeHash() string {
+	if m != nil {
+		return m.CodeHash
+	}
+	return ""
+}
+
+func (m *Voucher) GetMemo() string {
+	if m != nil {
+		return m.Memo
+	}
+	return ""
+}
+
+func (m *Voucher) GetTimestamp() *google_protobuf.Timestamp {
+	if m != nil {
+		return m.Timestamp
+	}
+	return nil
+}
+
+func (m *Voucher) GetVendorID() *ID {
+	if m != nil {
+		return m.VendorID
+	}
+	return nil
+}
+
+func (m *Voucher) GetSignature() []byte {
+	if m != nil {
+		return m.Signature
+	}
+	return nil
+}
+
 type OrderReject struct {
 	OrderID   string                     `protobuf:"bytes,1,opt,name=orderID" json:"orderID,omitempty"`
 	Timestamp *google_protobuf.Timestamp `protobuf:"bytes,2,opt,name=timestamp" json:"timestamp,omitempty"`
@@ -1406,7 +1750,7 @@ type OrderReject struct {
 func (m *OrderReject) Reset()                    { *m = OrderReject{} }
 func (m *OrderReject) String() string            { return proto.CompactTextString(m) }
 func (*OrderReject) ProtoMessage()               {}
-func (*OrderReject) Descriptor() ([]byte, []int) { return fileDescriptor1, []int{4} }
+func (*OrderReject) Descriptor() ([]byte, []int) { return fileDescriptor1, []int{5} }
 
 func (m *OrderReject) GetOrderID() string {
 	if m != nil {
@@ -1437,7 +1781,7 @@ type RatingSignature struct {
 func (m *RatingSignature) Reset()                    { *m = RatingSignature{} }
 func (m *RatingSignature) String() string            { return proto.CompactTextString(m) }
 func (*RatingSignature) ProtoMessage()               {}
-func (*RatingSignature) Descriptor() ([]byte, []int) { return fileDescriptor1, []int{5} }
+func (*RatingSignature) Descriptor() ([]byte, []int) { return fileDescriptor1, []int{6} }
 
 func (m *RatingSignature) GetMetadata() *RatingSignature_TransactionMetadata {
 	if m != nil {
@@ -1463,7 +1807,7 @@ func (m *RatingSignature_TransactionMetadata) Reset()         { *m = RatingSigna
 func (m *RatingSignature_TransactionMetadata) String() string { return proto.CompactTextString(m) }
 func (*RatingSignature_TransactionMetadata) ProtoMessage()    {}
 func (*RatingSignature_TransactionMetadata) Descriptor() ([]byte, []int) {
-	return fileDescriptor1, []int{5, 0}
+	return fileDescriptor1, []int{6, 0}
 }
 
 func (m *RatingSignature_TransactionMetadata) GetListingSlug() string {
@@ -1495,7 +1839,7 @@ type BitcoinSignature struct {
 func (m *BitcoinSignature) Reset()                    { *m = BitcoinSignature{} }
 func (m *BitcoinSignature) String() string            { return proto.CompactTextString(m) }
 func (*BitcoinSignature) ProtoMessage()               {}
-func (*BitcoinSignature) Descriptor() ([]byte, []int) { return fileDescriptor1, []int{6} }
+func (*BitcoinSignature) Descriptor() ([]byte, []int) { return fileDescriptor1, []int{7} }
 
 func (m *BitcoinSignature) GetInputIndex() uint32 {
 	if m != nil {
@@ -1527,7 +1871,7 @@ type OrderFulfillment struct {
 func (m *OrderFulfillment) Reset()                    { *m = OrderFulfillment{} }
 func (m *OrderFulfillment) String() string            { return proto.CompactTextString(m) }
 func (*OrderFulfillment) ProtoMessage()               {}
-func (*OrderFulfillment) Descriptor() ([]byte, []int) { return fileDescriptor1, []int{7} }
+func (*OrderFulfillment) Descriptor() ([]byte, []int) { return fileDescriptor1, []int{8} }
 
 func (m *OrderFulfillment) GetOrderId() string {
 	if m != nil {
@@ -1587,7 +1931,7 @@ func (m *OrderFulfillment_PhysicalDelivery) Reset()         { *m = OrderFulfillm
 func (m *OrderFulfillment_PhysicalDelivery) String() string { return proto.CompactTextString(m) }
 func (*OrderFulfillment_PhysicalDelivery) ProtoMessage()    {}
 func (*OrderFulfillment_PhysicalDelivery) Descriptor() ([]byte, []int) {
-	return fileDescriptor1, []int{7, 0}
+	return fileDescriptor1, []int{8, 0}
 }
 
 func (m *OrderFulfillment_PhysicalDelivery) GetShipper() string {
@@ -1613,7 +1957,7 @@ func (m *OrderFulfillment_DigitalDelivery) Reset()         { *m = OrderFulfillme
 func (m *OrderFulfillment_DigitalDelivery) String() string { return proto.CompactTextString(m) }
 func (*OrderFulfillment_DigitalDelivery) ProtoMessage()    {}
 func (*OrderFulfillment_DigitalDelivery) Descriptor() ([]byte, []int) {
-	return fileDescriptor1, []int{7, 1}
+	return fileDescriptor1, []int{8, 1}
 }
 
 func (m *OrderFulfillment_DigitalDelivery) GetUrl() string {
@@ -1639,7 +1983,7 @@ type OrderFulfillment_Payout struct {
 func (m *OrderFulfillment_Payout) Reset()                    { *m = OrderFulfillment_Payout{} }
 func (m *OrderFulfillment_Payout) String() string            { return proto.CompactTextString(m) }
 func (*OrderFulfillment_Payout) ProtoMessage()               {}
-func (*OrderFulfillment_Payout) Descriptor() ([]byte, []int) { return fileDescriptor1, []int{7, 2} }
+func (*OrderFulfillment_Payout) Descriptor() ([]byte, []int) { return fileDescriptor1, []int{8, 2} }
 
 func (m *OrderFulfillment_Payout) GetSigs() []*BitcoinSignature {
 	if m != nil {
@@ -1672,7 +2016,7 @@ type OrderCompletion struct {
 func (m *OrderCompletion) Reset()                    { *m = OrderCompletion{} }
 func (m *OrderCompletion) String() string            { return proto.CompactTextString(m) }
 func (*OrderCompletion) ProtoMessage()               {}
-func (*OrderCompletion) Descriptor() ([]byte, []int) { return fileDescriptor1, []int{8} }
+func (*OrderCompletion) Descriptor() ([]byte, []int) { return fileDescriptor1, []int{9} }
 
 func (m *OrderCompletion) GetOrderId() string {
 	if m != nil {
@@ -1710,7 +2054,7 @@ type OrderCompletion_Rating struct {
 func (m *OrderCompletion_Rating) Reset()                    { *m = OrderCompletion_Rating{} }
 func (m *OrderCompletion_Rating) String() string            { return proto.CompactTextString(m) }
 func (*OrderCompletion_Rating) ProtoMessage()               {}
-func (*OrderCompletion_Rating) Descriptor() ([]byte, []int) { return fileDescriptor1, []int{8, 0} }
+func (*OrderCompletion_Rating) Descriptor() ([]byte, []int) { return fileDescriptor1, []int{9, 0} }
 
 func (m *OrderCompletion_Rating) GetRatingData() *OrderCompletion_Rating_RatingData {
 	if m != nil {
@@ -1746,7 +2090,7 @@ func (m *OrderCompletion_Rating_RatingData) Reset()         { *m = OrderCompleti
 func (m *OrderCompletion_Rating_RatingData) String() string { return proto.CompactTextString(m) }
 func (*OrderCompletion_Rating_RatingData) ProtoMessage()    {}
 func (*OrderCompletion_Rating_RatingData) Descriptor() ([]byte, []int) {
-	return fileDescriptor1, []int{8, 0, 0}
+	return fileDescriptor1, []int{9, 0, 0}
 }
 
 func (m *OrderCompletion_Rating_RatingData) GetRatingKey() []byte {
@@ -1846,12 +2190,14 @@ type Dispute struct {
 	PayoutAddress      string                     `protobuf:"bytes,3,opt,name=payoutAddress" json:"payoutAddress,omitempty"`
 	Outpoints          []*Outpoint                `protobuf:"bytes,4,rep,name=outpoints" json:"outpoints,omitempty"`
 	SerializedContract []byte                     `protobuf:"bytes,5,opt,name=serializedContract,proto3" json:"serializedContract,omitempty"`
+	Attachments        []*DisputeAttachment       `protobuf:"bytes,6,rep,name=attachments" json:"attachments,omitempty"`
+	Moderator          string                     `protobuf:"bytes,7,opt,name=moderator" json:"moderator,omitempty"`
 }
 
 func (m *Dispute) Reset()                    { *m = Dispute{} }
 func (m *Dispute) String() string            { return proto.CompactTextString(m) }
 func (*Dispute) ProtoMessage()               {}
-func (*Dispute) Descriptor() ([]byte, []int) { return fileDescriptor1, []int{9} }
+func (*Dispute) Descriptor() ([]byte, []int) { return fileDescriptor1, []int{10} }
 
 func (m *Dispute) GetTimestamp() *google_protobuf.Timestamp {
 	if m != nil {
@@ -1888,66 +2234,161 @@ func (m *Dispute) GetSerializedContract() []byte {
 	return nil
 }
 
-type DisputeResolution struct {
-	Timestamp  *google_protobuf.Timestamp `protobuf:"bytes,1,opt,name=timestamp" json:"timestamp,omitempty"`
-	OrderId    string                     `protobuf:"bytes,2,opt,name=orderId" json:"orderId,omitempty"`
-	ProposedBy string                     `protobuf:"bytes,3,opt,name=proposedBy" json:"proposedBy,omitempty"`
-	Resolution string                     `protobuf:"bytes,4,opt,name=resolution" json:"resolution,omitempty"`
-	Payout     *DisputeResolution_Payout  `protobuf:"bytes,5,opt,name=payout" json:"payout,omitempty"`
+func (m *Dispute) GetAttachments() []*DisputeAttachment {
+	if m != nil {
+		return m.Attachments
+	}
+	return nil
 }
 
-func (m *DisputeResolution) Reset()                    { *m = DisputeResolution{} }
-func (m *DisputeResolution) String() string            { return proto.CompactTextString(m) }
-func (*DisputeResolution) ProtoMessage()               {}
-func (*DisputeResolution) Descriptor() ([]byte, []int) { return fileDescriptor1, []int{10} }
+func (m *Dispute) GetModerator() string {
+	if m != nil {
+		return m.Moderator
+	}
+	return ""
+}
 
-func (m *DisputeResolution) GetTimestamp() *google_protobuf.Timestamp {
+type DisputeAttachment struct {
+	PeerID    string                   `protobuf:"bytes,1,opt,name=peerID" json:"peerID,omitempty"`
+	Filename  string                   `protobuf:"bytes,2,opt,name=filename" json:"filename,omitempty"`
+	Hash      string                   `protobuf:"bytes,3,opt,name=hash" json:"hash,omitempty"`
+	Thumbnail string                   `protobuf:"bytes,4,opt,name=thumbnail" json:"thumbnail,omitempty"`
+	Keys      []*DisputeAttachment_Key `protobuf:"bytes,5,rep,name=keys" json:"keys,omitempty"`
+}
+
+func (m *DisputeAttachment) Reset()                    { *m = DisputeAttachment{} }
+func (m *DisputeAttachment) String() string            { return proto.CompactTextString(m) }
+func (*DisputeAttachment) ProtoMessage()               {}
+func (*DisputeAttachment) Descriptor() ([]byte, []int) { return fileDescriptor1, []int{11} }
+
+func (m *DisputeAttachment) GetPeerID() string {
 	if m != nil {
-		return m.Timestamp
+		return m.PeerID
 	}
-	return nil
+	return ""
 }
 
-func (m *DisputeResolution) GetOrderId() string {
+func (m *DisputeAttachment) GetFilename() string {
 	if m != nil {
-		return m.OrderId
+		return m.Filename
 	}
 	return ""
 }
 
-func (m *DisputeResolution) GetProposedBy() string {
+func (m *DisputeAttachment) GetHash() string {
 	if m != nil {
-		return m.ProposedBy
+		return m.Hash
 	}
 	return ""
 }
 
-func (m *DisputeResolution) GetResolution() string {
+func (m *DisputeAttachment) GetThumbnail() string {
 	if m != nil {
-		return m.Resolution
+		return m.Thumbnail
 	}
 	return ""
 }
 
-func (m *DisputeResolution) GetPayout() *DisputeResolution_Payout {
+func (m *DisputeAttachment) GetKeys() []*DisputeAttachment_Key {
 	if m != nil {
-		return m.Payout
+		return m.Keys
 	}
 	return nil
 }
 
-type DisputeResolution_Payout struct {
-	Sigs            []*BitcoinSignature              `protobuf:"bytes,1,rep,name=sigs" json:"sigs,omitempty"`
-	Inputs          []*Outpoint                      `protobuf:"bytes,2,rep,name=inputs" json:"inputs,omitempty"`
-	BuyerOutput     *DisputeResolution_Payout_Output `protobuf:"bytes,3,opt,name=buyerOutput" json:"buyerOutput,omitempty"`
-	VendorOutput    *DisputeResolution_Payout_Output `protobuf:"bytes,4,opt,name=vendorOutput" json:"vendorOutput,omitempty"`
-	ModeratorOutput *DisputeResolution_Payout_Output `protobuf:"bytes,5,opt,name=moderatorOutput" json:"moderatorOutput,omitempty"`
+type DisputeAttachment_Key struct {
+	PeerID string `protobuf:"bytes,1,opt,name=peerID" json:"peerID,omitempty"`
+	Key    []byte `protobuf:"bytes,2,opt,name=key,proto3" json:"key,omitempty"`
 }
 
-func (m *DisputeResolution_Payout) Reset()                    { *m = DisputeResolution_Payout{} }
-func (m *DisputeResolution_Payout) String() string            { return proto.CompactTextString(m) }
-func (*DisputeResolution_Payout) ProtoMessage()               {}
-func (*DisputeResolution_Payout) Descriptor() ([]byte, []int) { return fileDescriptor1, []int{10, 0} }
+func (m *DisputeAttachment_Key) Reset()                    { *m = DisputeAttachment_Key{} }
+func (m *DisputeAttachment_Key) String() string            { return proto.CompactTextString(m) }
+func (*DisputeAttachment_Key) ProtoMessage()               {}
+func (*DisputeAttachment_Key) Descriptor() ([]byte, []int) { return fileDescriptor1, []int{11, 0} }
+
+func (m *DisputeAttachment_Key) GetPeerID() string {
+	if m != nil {
+		return m.PeerID
+	}
+	return ""
+}
+
+func (m *DisputeAttachment_Key) GetKey() []byte {
+	if m != nil {
+		return m.Key
+	}
+	return nil
+}
+
+type DisputeResolution struct {
+	Timestamp  *google_protobuf.Timestamp `protobuf:"bytes,1,opt,name=timestamp" json:"timestamp,omitempty"`
+	OrderId    string                     `protobuf:"bytes,2,opt,name=orderId" json:"orderId,omitempty"`
+	ProposedBy string                     `protobuf:"bytes,3,opt,name=proposedBy" json:"proposedBy,omitempty"`
+	Resolution string                     `protobuf:"bytes,4,opt,name=resolution" json:"resolution,omitempty"`
+	Payout     *DisputeResolution_Payout  `protobuf:"bytes,5,opt,name=payout" json:"payout,omitempty"`
+	Transcript []*CaseChat                `protobuf:"bytes,6,rep,name=transcript" json:"transcript,omitempty"`
+}
+
+func (m *DisputeResolution) Reset()                    { *m = DisputeResolution{} }
+func (m *DisputeResolution) String() string            { return proto.CompactTextString(m) }
+func (*DisputeResolution) ProtoMessage()               {}
+func (*DisputeResolution) Descriptor() ([]byte, []int) { return fileDescriptor1, []int{12} }
+
+func (m *DisputeResolution) GetTimestamp() *google_protobuf.Timestamp {
+	if m != nil {
+		return m.Timestamp
+	}
+	return nil
+}
+
+func (m *DisputeResolution) GetOrderId() string {
+	if m != nil {
+		return m.OrderId
+	}
+	return ""
+}
+
+func (m *DisputeResolution) GetProposedBy() string {
+	if m != nil {
+		return m.ProposedBy
+	}
+	return ""
+}
+
+func (m *DisputeResolution) GetResolution() string {
+	if m != nil {
+		return m.Resolution
+	}
+	return ""
+}
+
+func (m *DisputeResolution) GetPayout() *DisputeResolution_Payout {
+	if m != nil {
+		return m.Payout
+	}
+	return nil
+}
+
+func (m *DisputeResolution) GetTranscript() []*CaseChat {
+	if m != nil {
+		return m.Transcript
+	}
+	return nil
+}
+
+type DisputeResolution_Payout struct {
+	Sigs            []*BitcoinSignature                `protobuf:"bytes,1,rep,name=sigs" json:"sigs,omitempty"`
+	Inputs          []*Outpoint                        `protobuf:"bytes,2,rep,name=inputs" json:"inputs,omitempty"`
+	BuyerOutput     *DisputeResolution_Payout_Output   `protobuf:"bytes,3,opt,name=buyerOutput" json:"buyerOutput,omitempty"`
+	VendorOutput    *DisputeResolution_Payout_Output   `protobuf:"bytes,4,opt,name=vendorOutput" json:"vendorOutput,omitempty"`
+	ModeratorOutput *DisputeResolution_Payout_Output   `protobuf:"bytes,5,opt,name=moderatorOutput" json:"moderatorOutput,omitempty"`
+	PanelOutputs    []*DisputeResolution_Payout_Output `protobuf:"bytes,6,rep,name=panelOutputs" json:"panelOutputs,omitempty"`
+}
+
+func (m *DisputeResolution_Payout) Reset()                    { *m = DisputeResolution_Payout{} }
+func (m *DisputeResolution_Payout) String() string            { return proto.CompactTextString(m) }
+func (*DisputeResolution_Payout) ProtoMessage()               {}
+func (*DisputeResolution_Payout) Descriptor() ([]byte, []int) { return fileDescriptor1, []int{12, 0} }
 
 func (m *DisputeResolution_Payout) GetSigs() []*BitcoinSignature {
 	if m != nil {
@@ -1984,6 +2425,13 @@ func (m *DisputeResolution_Payout) GetModeratorOutput() *DisputeResolution_Payou
 	return nil
 }
 
+func (m *DisputeResolution_Payout) GetPanelOutputs() []*DisputeResolution_Payout_Output {
+	if m != nil {
+		return m.PanelOutputs
+	}
+	return nil
+}
+
 type DisputeResolution_Payout_Output struct {
 	Script string `protobuf:"bytes,1,opt,name=script" json:"script,omitempty"`
 	Amount uint64 `protobuf:"varint,2,opt,name=amount" json:"amount,omitempty"`
@@ -1993,7 +2441,7 @@ func (m *DisputeResolution_Payout_Output) Reset()         { *m = DisputeResoluti
 func (m *DisputeResolution_Payout_Output) String() string { return proto.CompactTextString(m) }
 func (*DisputeResolution_Payout_Output) ProtoMessage()    {}
 func (*DisputeResolution_Payout_Output) Descriptor() ([]byte, []int) {
-	return fileDescriptor1, []int{10, 0, 0}
+	return fileDescriptor1, []int{12, 0, 0}
 }
 
 func (m *DisputeResolution_Payout_Output) GetScript() string {
@@ -2010,6 +2458,168 @@ func (m *DisputeResolution_Payout_Output) GetAmount() uint64 {
 	return 0
 }
 
+// A panel moderator's signatures on the payout decided by the lead moderator
+type DisputeEndorsement struct {
+	Timestamp *google_protobuf.Timestamp `protobuf:"bytes,1,opt,name=timestamp" json:"timestamp,omitempty"`
+	OrderId   string                     `protobuf:"bytes,2,opt,name=orderId" json:"orderId,omitempty"`
+	Moderator string                     `protobuf:"bytes,3,opt,name=moderator" json:"moderator,omitempty"`
+	Sigs      []*BitcoinSignature        `protobuf:"bytes,4,rep,name=sigs" json:"sigs,omitempty"`
+	Pubkey    []byte                     `protobuf:"bytes,5,opt,name=pubkey,proto3" json:"pubkey,omitempty"`
+	Signature []byte                     `protobuf:"bytes,6,opt,name=signature,proto3" json:"signature,omitempty"`
+}
+
+func (m *DisputeEndorsement) Reset()                    { *m = DisputeEndorsement{} }
+func (m *DisputeEndorsement) String() string            { return proto.CompactTextString(m) }
+func (*DisputeEndorsement) ProtoMessage()               {}
+func (*DisputeEndorsement) Descriptor() ([]byte, []int) { return fileDescriptor1, []int{13} }
+
+func (m *DisputeEndorsement) GetTimestamp() *google_protobuf.Timestamp {
+	if m != nil {
+		return m.Timestamp
+	}
+	return nil
+}
+
+func (m *DisputeEndorsement) GetOrderId() string {
+	if m != nil {
+		return m.OrderId
+	}
+	return ""
+}
+
+func (m *DisputeEndorsement) GetModerator() string {
+	if m != nil {
+		return m.Moderator
+	}
+	return ""
+}
+
+func (m *DisputeEndorsement) GetSigs() []*BitcoinSignature {
+	if m != nil {
+		return m.Sigs
+	}
+	return nil
+}
+
+func (m *DisputeEndorsement) GetPubkey() []byte {
+	if m != nil {
+		return m.Pubkey
+	}
+	return nil
+}
+
+func (m *DisputeEndorsement) GetSignature() []byte {
+	if m != nil {
+		return m.Signature
+	}
+	return nil
+}
+
+// An alternative to the moderator's payout agreed between the buyer and vendor
+type PayoutProposal struct {
+	Timestamp  *google_protobuf.Timestamp `protobuf:"bytes,1,opt,name=timestamp" json:"timestamp,omitempty"`
+	OrderId    string                     `protobuf:"bytes,2,opt,name=orderId" json:"orderId,omitempty"`
+	ProposedBy string                     `protobuf:"bytes,3,opt,name=proposedBy" json:"proposedBy,omitempty"`
+	Payout     *DisputeResolution_Payout  `protobuf:"bytes,4,opt,name=payout" json:"payout,omitempty"`
+}
+
+func (m *PayoutProposal) Reset()                    { *m = PayoutProposal{} }
+func (m *PayoutProposal) String() string            { return proto.CompactTextString(m) }
+func (*PayoutProposal) ProtoMessage()               {}
+func (*PayoutProposal) Descriptor() ([]byte, []int) { return fileDescriptor1, []int{14} }
+
+func (m *PayoutProposal) GetTimestamp() *google_protobuf.Timestamp {
+	if m != nil {
+		return m.Timestamp
+	}
+	return nil
+}
+
+func (m *PayoutProposal) GetOrderId() string {
+	if m != nil {
+		return m.OrderId
+	}
+	return ""
+}
+
+func (m *PayoutProposal) GetProposedBy() string {
+	if m != nil {
+		return m.ProposedBy
+	}
+	return ""
+}
+
+func (m *PayoutProposal) GetPayout() *DisputeResolution_Payout {
+	if m != nil {
+		return m.Payout
+	}
+	return nil
+}
+
+type CaseChat struct {
+	MessageId string                     `protobuf:"bytes,1,opt,name=messageId" json:"messageId,omitempty"`
+	CaseId    string                     `protobuf:"bytes,2,opt,name=caseId" json:"caseId,omitempty"`
+	PeerID    string                     `protobuf:"bytes,3,opt,name=peerID" json:"peerID,omitempty"`
+	Message   string                     `protobuf:"bytes,4,opt,name=message" json:"message,omitempty"`
+	Timestamp *google_protobuf.Timestamp `protobuf:"bytes,5,opt,name=timestamp" json:"timestamp,omitempty"`
+	Pubkey    []byte                     `protobuf:"bytes,6,opt,name=pubkey,proto3" json:"pubkey,omitempty"`
+	Signature []byte                     `protobuf:"bytes,7,opt,name=signature,proto3" json:"signature,omitempty"`
+}
+
+func (m *CaseChat) Reset()                    { *m = CaseChat{} }
+func (m *CaseChat) String() string            { return proto.CompactTextString(m) }
+func (*CaseChat) ProtoMessage()               {}
+func (*CaseChat) Descriptor() ([]byte, []int) { return fileDescriptor1, []int{15} }
+
+func (m *CaseChat) GetMessageId() string {
+	if m != nil {
+		return m.MessageId
+	}
+	return ""
+}
+
+func (m *CaseChat) GetCaseId() string {
+	if m != nil {
+		return m.CaseId
+	}
+	return ""
+}
+
+func (m *CaseChat) GetPeerID() string {
+	if m != nil {
+		return m.PeerID
+	}
+	return ""
+}
+
+func (m *CaseChat) GetMessage() string {
+	if m != nil {
+		return m.Message
+	}
+	return ""
+}
+
+func (m *CaseChat) GetTimestamp() *google_protobuf.Timestamp {
+	if m != nil {
+		return m.Timestamp
+	}
+	return nil
+}
+
+func (m *CaseChat) GetPubkey() []byte {
+	if m != nil {
+		return m.Pubkey
+	}
+	return nil
+}
+
+func (m *CaseChat) GetSignature() []byte {
+	if m != nil {
+		return m.Signature
+	}
+	return nil
+}
+
 type Outpoint struct {
 	Hash  string `protobuf:"bytes,1,opt,name=hash" json:"hash,omitempty"`
 	Index uint32 `protobuf:"varint,2,opt,name=index" json:"index,omitempty"`
@@ -2019,7 +2629,7 @@ type Outpoint struct {
 func (m *Outpoint) Reset()                    { *m = Outpoint{} }
 func (m *Outpoint) String() string            { return proto.CompactTextString(m) }
 func (*Outpoint) ProtoMessage()               {}
-func (*Outpoint) Descriptor() ([]byte, []int) { return fileDescriptor1, []int{11} }
+func (*Outpoint) Descriptor() ([]byte, []int) { return fileDescriptor1, []int{16} }
 
 func (m *Outpoint) GetHash() string {
 	if m != nil {
@@ -2052,7 +2662,7 @@ type Refund struct {
 func (m *Refund) Reset()                    { *m = Refund{} }
 func (m *Refund) String() string            { return proto.CompactTextString(m) }
 func (*Refund) ProtoMessage()               {}
-func (*Refund) Descriptor() ([]byte, []int) { return fileDescriptor1, []int{12} }
+func (*Refund) Descriptor() ([]byte, []int) { return fileDescriptor1, []int{17} }
 
 func (m *Refund) GetOrderID() string {
 	if m != nil {
@@ -2092,7 +2702,7 @@ type ID struct {
 func (m *ID) Reset()                    { *m = ID{} }
 func (m *ID) String() string            { return proto.CompactTextString(m) }
 func (*ID) ProtoMessage()               {}
-func (*ID) Descriptor() ([]byte, []int) { return fileDescriptor1, []int{13} }
+func (*ID) Descriptor() ([]byte, []int) { return fileDescriptor1, []int{18} }
 
 func (m *ID) GetPeerID() string {
 	if m != nil {
@@ -2130,7 +2740,7 @@ type ID_Pubkeys struct {
 func (m *ID_Pubkeys) Reset()                    { *m = ID_Pubkeys{} }
 func (m *ID_Pubkeys) String() string            { return proto.CompactTextString(m) }
 func (*ID_Pubkeys) ProtoMessage()               {}
-func (*ID_Pubkeys) Descriptor() ([]byte, []int) { return fileDescriptor1, []int{13, 0} }
+func (*ID_Pubkeys) Descriptor() ([]byte, []int) { return fileDescriptor1, []int{18, 0} }
 
 func (m *ID_Pubkeys) GetIdentity() []byte {
 	if m != nil {
@@ -2147,14 +2757,15 @@ func (m *ID_Pubkeys) GetBitcoin() []byte {
 }
 
 type Signature struct {
-	Section        Signature_Section `protobuf:"varint,1,opt,name=section,enum=Signature_Section" json:"section,omitempty"`
-	SignatureBytes []byte            `protobuf:"bytes,2,opt,name=signatureBytes,proto3" json:"signatureBytes,omitempty"`
+	Section        Signature_Section     `protobuf:"varint,1,opt,name=section,enum=Signature_Section" json:"section,omitempty"`
+	SignatureBytes []byte                `protobuf:"bytes,2,opt,name=signatureBytes,proto3" json:"signatureBytes,omitempty"`
+	Successions    []*IdentitySuccession `protobuf:"bytes,3,rep,name=successions" json:"successions,omitempty"`
 }
 
 func (m *Signature) Reset()                    { *m = Signature{} }
 func (m *Signature) String() string            { return proto.CompactTextString(m) }
 func (*Signature) ProtoMessage()               {}
-func (*Signature) Descriptor() ([]byte, []int) { return fileDescriptor1, []int{14} }
+func (*Signature) Descriptor() ([]byte, []int) { return fileDescriptor1, []int{19} }
 
 func (m *Signature) GetSection() Signature_Section {
 	if m != nil {
@@ -2170,6 +2781,475 @@ func (m *Signature) GetSignatureBytes() []byte {
 	return nil
 }
 
+func (m *Signature) GetSuccessions() []*IdentitySuccession {
+	if m != nil {
+		return m.Successions
+	}
+	return nil
+}
+
+// A vendor's public reply to a rating. Editing the reply publishes a new revision
+// with a higher version which links to the revision it replaces.
+type RatingReply struct {
+	Timestamp  *google_protobuf.Timestamp `protobuf:"bytes,1,opt,name=timestamp" json:"timestamp,omitempty"`
+	RatingHash string                     `protobuf:"bytes,2,opt,name=ratingHash" json:"ratingHash,omitempty"`
+	VendorID   string                     `protobuf:"bytes,3,opt,name=vendorID" json:"vendorID,omitempty"`
+	Reply      string                     `protobuf:"bytes,4,opt,name=reply" json:"reply,omitempty"`
+	Version    uint32                     `protobuf:"varint,5,opt,name=version" json:"version,omitempty"`
+	Pubkey     []byte                     `protobuf:"bytes,6,opt,name=pubkey,proto3" json:"pubkey,omitempty"`
+	Signature  []byte                     `protobuf:"bytes,7,opt,name=signature,proto3" json:"signature,omitempty"`
+	Previous   string                     `protobuf:"bytes,8,opt,name=previous" json:"previous,omitempty"`
+}
+
+func (m *RatingReply) Reset()                    { *m = RatingReply{} }
+func (m *RatingReply) String() string            { return proto.CompactTextString(m) }
+func (*RatingReply) ProtoMessage()               {}
+func (*RatingReply) Descriptor() ([]byte, []int) { return fileDescriptor1, []int{20} }
+
+func (m *RatingReply) GetTimestamp() *google_protobuf.Timestamp {
+	if m != nil {
+		return m.Timestamp
+	}
+	return nil
+}
+
+func (m *RatingReply) GetRatingHash() string {
+	if m != nil {
+		return m.RatingHash
+	}
+	return ""
+}
+
+func (m *RatingReply) GetVendorID() string {
+	if m != nil {
+		return m.VendorID
+	}
+	return ""
+}
+
+func (m *RatingReply) GetReply() string {
+	if m != nil {
+		return m.Reply
+	}
+	return ""
+}
+
+func (m *RatingReply) GetVersion() uint32 {
+	if m != nil {
+		return m.Version
+	}
+	return 0
+}
+
+func (m *RatingReply) GetPubkey() []byte {
+	if m != nil {
+		return m.Pubkey
+	}
+	return nil
+}
+
+func (m *RatingReply) GetSignature() []byte {
+	if m != nil {
+		return m.Signature
+	}
+	return nil
+}
+
+func (m *RatingReply) GetPrevious() string {
+	if m != nil {
+		return m.Previous
+	}
+	return ""
+}
+
+// A vendor's rating of a buyer. The buyer's rating ticket ties it to one of the
+// buyer's orders with the vendor.
+type BuyerRating struct {
+	Timestamp *google_protobuf.Timestamp `protobuf:"bytes,1,opt,name=timestamp" json:"timestamp,omitempty"`
+	OrderId   string                     `protobuf:"bytes,2,opt,name=orderId" json:"orderId,omitempty"`
+	BuyerID   string                     `protobuf:"bytes,3,opt,name=buyerID" json:"buyerID,omitempty"`
+	VendorID  *ID                        `protobuf:"bytes,4,opt,name=vendorID" json:"vendorID,omitempty"`
+	RatingKey []byte                     `protobuf:"bytes,5,opt,name=ratingKey,proto3" json:"ratingKey,omitempty"`
+	Overall   uint32                     `protobuf:"varint,6,opt,name=overall" json:"overall,omitempty"`
+	Review    string                     `protobuf:"bytes,7,opt,name=review" json:"review,omitempty"`
+	Disputed  bool                       `protobuf:"varint,8,opt,name=disputed" json:"disputed,omitempty"`
+	Signature []byte                     `protobuf:"bytes,9,opt,name=signature,proto3" json:"signature,omitempty"`
+	Ticket    *BuyerRatingTicket         `protobuf:"bytes,10,opt,name=ticket" json:"ticket,omitempty"`
+}
+
+func (m *BuyerRating) Reset()                    { *m = BuyerRating{} }
+func (m *BuyerRating) String() string            { return proto.CompactTextString(m) }
+func (*BuyerRating) ProtoMessage()               {}
+func (*BuyerRating) Descriptor() ([]byte, []int) { return fileDescriptor1, []int{21} }
+
+func (m *BuyerRating) GetTimestamp() *google_protobuf.Timestamp {
+	if m != nil {
+		return m.Timestamp
+	}
+	return nil
+}
+
+func (m *BuyerRating) GetOrderId() string {
+	if m != nil {
+		return m.OrderId
+	}
+	return ""
+}
+
+func (m *BuyerRating) GetBuyerID() string {
+	if m != nil {
+		return m.BuyerID
+	}
+	return ""
+}
+
+func (m *BuyerRating) GetVendorID() *ID {
+	if m != nil {
+		return m.VendorID
+	}
+	return nil
+}
+
+func (m *BuyerRating) GetRatingKey() []byte {
+	if m != nil {
+		return m.RatingKey
+	}
+	return nil
+}
+
+func (m *BuyerRating) GetOverall() uint32 {
+	if m != nil {
+		return m.Overall
+	}
+	return 0
+}
+
+func (m *BuyerRating) GetReview() string {
+	if m != nil {
+		return m.Review
+	}
+	return ""
+}
+
+func (m *BuyerRating) GetDisputed() bool {
+	if m != nil {
+		return m.Disputed
+	}
+	return false
+}
+
+func (m *BuyerRating) GetSignature() []byte {
+	if m != nil {
+		return m.Signature
+	}
+	return nil
+}
+
+func (m *BuyerRating) GetTicket() *BuyerRatingTicket {
+	if m != nil {
+		return m.Ticket
+	}
+	return nil
+}
+
+// A portable copy of a store's ratings. Each rating carries its full signature chain
+// so the bundle can be verified without the store's IPFS root.
+type ReputationBundle struct {
+	PeerID    string                     `protobuf:"bytes,1,opt,name=peerID" json:"peerID,omitempty"`
+	Timestamp *google_protobuf.Timestamp `protobuf:"bytes,2,opt,name=timestamp" json:"timestamp,omitempty"`
+	Ratings   []*ReputationBundle_Rating `protobuf:"bytes,3,rep,name=ratings" json:"ratings,omitempty"`
+	Pubkey    []byte                     `protobuf:"bytes,4,opt,name=pubkey,proto3" json:"pubkey,omitempty"`
+	Signature []byte                     `protobuf:"bytes,5,opt,name=signature,proto3" json:"signature,omitempty"`
+}
+
+func (m *ReputationBundle) Reset()                    { *m = ReputationBundle{} }
+func (m *ReputationBundle) String() string            { return proto.CompactTextString(m) }
+func (*ReputationBundle) ProtoMessage()               {}
+func (*ReputationBundle) Descriptor() ([]byte, []int) { return fileDescriptor1, []int{22} }
+
+func (m *ReputationBundle) GetPeerID() string {
+	if m != nil {
+		return m.PeerID
+	}
+	return ""
+}
+
+func (m *ReputationBundle) GetTimestamp() *google_protobuf.Timestamp {
+	if m != nil {
+		return m.Timestamp
+	}
+	return nil
+}
+
+func (m *ReputationBundle) GetRatings() []*ReputationBundle_Rating {
+	if m != nil {
+		return m.Ratings
+	}
+	return nil
+}
+
+func (m *ReputationBundle) GetPubkey() []byte {
+	if m != nil {
+		return m.Pubkey
+	}
+	return nil
+}
+
+func (m *ReputationBundle) GetSignature() []byte {
+	if m != nil {
+		return m.Signature
+	}
+	return nil
+}
+
+type ReputationBundle_Rating struct {
+	RatingHash   string                  `protobuf:"bytes,1,opt,name=ratingHash" json:"ratingHash,omitempty"`
+	OrderId      string                  `protobuf:"bytes,2,opt,name=orderId" json:"orderId,omitempty"`
+	Rating       *OrderCompletion_Rating `protobuf:"bytes,3,opt,name=rating" json:"rating,omitempty"`
+}
+
+func (m *ReputationBundle_Rating) Reset()                    { *m = ReputationBundle_Rating{} }
+func (m *ReputationBundle_Rating) String() string            { return proto.CompactTextString(m) }
+func (*ReputationBundle_Rating) ProtoMessage()               {}
+func (*ReputationBundle_Rating) Descriptor() ([]byte, []int) { return fileDescriptor1, []int{22, 0} }
+
+func (m *ReputationBundle_Rating) GetRatingHash() string {
+	if m != nil {
+		return m.RatingHash
+	}
+	return ""
+}
+
+func (m *ReputationBundle_Rating) GetOrderId() string {
+	if m != nil {
+		return m.OrderId
+	}
+	return ""
+}
+
+func (m *ReputationBundle_Rating) GetRating() *OrderCompletion_Rating {
+	if m != nil {
+		return m.Rating
+	}
+	return nil
+}
+
+// Hands an identity over from one key to the next. Both keys sign the succession
+// with the signatures unset.
+type IdentitySuccession struct {
+	PredecessorID        string                     `protobuf:"bytes,1,opt,name=predecessorID" json:"predecessorID,omitempty"`
+	PredecessorPubkey    []byte                     `protobuf:"bytes,2,opt,name=predecessorPubkey,proto3" json:"predecessorPubkey,omitempty"`
+	SuccessorID          string                     `protobuf:"bytes,3,opt,name=successorID" json:"successorID,omitempty"`
+	SuccessorPubkey      []byte                     `protobuf:"bytes,4,opt,name=successorPubkey,proto3" json:"successorPubkey,omitempty"`
+	Timestamp            *google_protobuf.Timestamp `protobuf:"bytes,5,opt,name=timestamp" json:"timestamp,omitempty"`
+	PredecessorSignature []byte                     `protobuf:"bytes,6,opt,name=predecessorSignature,proto3" json:"predecessorSignature,omitempty"`
+	SuccessorSignature   []byte                     `protobuf:"bytes,7,opt,name=successorSignature,proto3" json:"successorSignature,omitempty"`
+}
+
+func (m *IdentitySuccession) Reset()                    { *m = IdentitySuccession{} }
+func (m *IdentitySuccession) String() string            { return proto.CompactTextString(m) }
+func (*IdentitySuccession) ProtoMessage()               {}
+func (*IdentitySuccession) Descriptor() ([]byte, []int) { return fileDescriptor1, []int{23} }
+
+func (m *IdentitySuccession) GetPredecessorID() string {
+	if m != nil {
+		return m.PredecessorID
+	}
+	return ""
+}
+
+func (m *IdentitySuccession) GetPredecessorPubkey() []byte {
+	if m != nil {
+		return m.PredecessorPubkey
+	}
+	return nil
+}
+
+func (m *IdentitySuccession) GetSuccessorID() string {
+	if m != nil {
+		return m.SuccessorID
+	}
+	return ""
+}
+
+func (m *IdentitySuccession) GetSuccessorPubkey() []byte {
+	if m != nil {
+		return m.SuccessorPubkey
+	}
+	return nil
+}
+
+func (m *IdentitySuccession) GetTimestamp() *google_protobuf.Timestamp {
+	if m != nil {
+		return m.Timestamp
+	}
+	return nil
+}
+
+func (m *IdentitySuccession) GetPredecessorSignature() []byte {
+	if m != nil {
+		return m.PredecessorSignature
+	}
+	return nil
+}
+
+func (m *IdentitySuccession) GetSuccessorSignature() []byte {
+	if m != nil {
+		return m.SuccessorSignature
+	}
+	return nil
+}
+
+// Every succession from a peer's first identity to its current one, oldest first
+type IdentityChain struct {
+	Successions []*IdentitySuccession `protobuf:"bytes,1,rep,name=successions" json:"successions,omitempty"`
+}
+
+func (m *IdentityChain) Reset()                    { *m = IdentityChain{} }
+func (m *IdentityChain) String() string            { return proto.CompactTextString(m) }
+func (*IdentityChain) ProtoMessage()               {}
+func (*IdentityChain) Descriptor() ([]byte, []int) { return fileDescriptor1, []int{24} }
+
+func (m *IdentityChain) GetSuccessions() []*IdentitySuccession {
+	if m != nil {
+		return m.Successions
+	}
+	return nil
+}
+
+// An announcement published by a store in its posts directory and pushed to its
+// followers. Signed by the vendor.
+type Post struct {
+	Slug      string                     `protobuf:"bytes,1,opt,name=slug" json:"slug,omitempty"`
+	VendorID  string                     `protobuf:"bytes,2,opt,name=vendorID" json:"vendorID,omitempty"`
+	Title     string                     `protobuf:"bytes,3,opt,name=title" json:"title,omitempty"`
+	LongForm  string                     `protobuf:"bytes,4,opt,name=longForm" json:"longForm,omitempty"`
+	Images    []*Listing_Item_Image      `protobuf:"bytes,5,rep,name=images" json:"images,omitempty"`
+	Timestamp *google_protobuf.Timestamp `protobuf:"bytes,6,opt,name=timestamp" json:"timestamp,omitempty"`
+	Expiry    *google_protobuf.Timestamp `protobuf:"bytes,7,opt,name=expiry" json:"expiry,omitempty"`
+	Pubkey    []byte                     `protobuf:"bytes,8,opt,name=pubkey,proto3" json:"pubkey,omitempty"`
+	Signature []byte                     `protobuf:"bytes,9,opt,name=signature,proto3" json:"signature,omitempty"`
+}
+
+func (m *Post) Reset()                    { *m = Post{} }
+func (m *Post) String() string            { return proto.CompactTextString(m) }
+func (*Post) ProtoMessage()               {}
+func (*Post) Descriptor() ([]byte, []int) { return fileDescriptor1, []int{25} }
+
+func (m *Post) GetSlug() string {
+	if m != nil {
+		return m.Slug
+	}
+	return ""
+}
+
+func (m *Post) GetVendorID() string {
+	if m != nil {
+		return m.VendorID
+	}
+	return ""
+}
+
+func (m *Post) GetTitle() string {
+	if m != nil {
+		return m.Title
+	}
+	return ""
+}
+
+func (m *Post) GetLongForm() string {
+	if m != nil {
+		return m.LongForm
+	}
+	return ""
+}
+
+func (m *Post) GetImages() []*Listing_Item_Image {
+	if m != nil {
+		return m.Images
+	}
+	return nil
+}
+
+func (m *Post) GetTimestamp() *google_protobuf.Timestamp {
+	if m != nil {
+		return m.Timestamp
+	}
+	return nil
+}
+
+func (m *Post) GetExpiry() *google_protobuf.Timestamp {
+	if m != nil {
+		return m.Expiry
+	}
+	return nil
+}
+
+func (m *Post) GetPubkey() []byte {
+	if m != nil {
+		return m.Pubkey
+	}
+	return nil
+}
+
+func (m *Post) GetSignature() []byte {
+	if m != nil {
+		return m.Signature
+	}
+	return nil
+}
+
+// Issued by the buyer in an order so the vendor can rate them. Binds one of the order's
+// rating keys to the buyer and vendor without revealing the rest of the order. Signed
+// by the buyer with the signature unset.
+type BuyerRatingTicket struct {
+	RatingKey   []byte `protobuf:"bytes,1,opt,name=ratingKey,proto3" json:"ratingKey,omitempty"`
+	BuyerID     string `protobuf:"bytes,2,opt,name=buyerID" json:"buyerID,omitempty"`
+	VendorID    string `protobuf:"bytes,3,opt,name=vendorID" json:"vendorID,omitempty"`
+	BuyerPubkey []byte `protobuf:"bytes,4,opt,name=buyerPubkey,proto3" json:"buyerPubkey,omitempty"`
+	Signature   []byte `protobuf:"bytes,5,opt,name=signature,proto3" json:"signature,omitempty"`
+}
+
+func (m *BuyerRatingTicket) Reset()                    { *m = BuyerRatingTicket{} }
+func (m *BuyerRatingTicket) String() string            { return proto.CompactTextString(m) }
+func (*BuyerRatingTicket) ProtoMessage()               {}
+func (*BuyerRatingTicket) Descriptor() ([]byte, []int) { return fileDescriptor1, []int{26} }
+
+func (m *BuyerRatingTicket) GetRatingKey() []byte {
+	if m != nil {
+		return m.RatingKey
+	}
+	return nil
+}
+
+func (m *BuyerRatingTicket) GetBuyerID() string {
+	if m != nil {
+		return m.BuyerID
+	}
+	return ""
+}
+
+func (m *BuyerRatingTicket) GetVendorID() string {
+	if m != nil {
+		return m.VendorID
+	}
+	return ""
+}
+
+func (m *BuyerRatingTicket) GetBuyerPubkey() []byte {
+	if m != nil {
+		return m.BuyerPubkey
+	}
+	return nil
+}
+
+func (m *BuyerRatingTicket) GetSignature() []byte {
+	if m != nil {
+		return m.Signature
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*RicardianContract)(nil), "RicardianContract")
 	proto.RegisterType((*Listing)(nil), "Listing")
@@ -2177,9 +3257,11 @@ func init() {
 	proto.RegisterType((*Listing_Item)(nil), "Listing.Item")
 	proto.RegisterType((*Listing_Item_Option)(nil), "Listing.Item.Option")
 	proto.RegisterType((*Listing_Item_Sku)(nil), "Listing.Item.Sku")
+	proto.RegisterType((*Listing_Item_BundleItem)(nil), "Listing.Item.BundleItem")
 	proto.RegisterType((*Listing_Item_Image)(nil), "Listing.Item.Image")
 	proto.RegisterType((*Listing_ShippingOption)(nil), "Listing.ShippingOption")
 	proto.RegisterType((*Listing_ShippingOption_Service)(nil), "Listing.ShippingOption.Service")
+	proto.RegisterType((*Listing_ShippingOption_Zone)(nil), "Listing.ShippingOption.Zone")
 	proto.RegisterType((*Listing_ShippingOption_ShippingRules)(nil), "Listing.ShippingOption.ShippingRules")
 	proto.RegisterType((*Listing_ShippingOption_ShippingRules_Rule)(nil), "Listing.ShippingOption.ShippingRules.Rule")
 	proto.RegisterType((*Listing_Tax)(nil), "Listing.Tax")
@@ -2190,7 +3272,9 @@ func init() {
 	proto.RegisterType((*Order_Item_Option)(nil), "Order.Item.Option")
 	proto.RegisterType((*Order_Item_ShippingOption)(nil), "Order.Item.ShippingOption")
 	proto.RegisterType((*Order_Payment)(nil), "Order.Payment")
+	proto.RegisterType((*Order_Credit)(nil), "Order.Credit")
 	proto.RegisterType((*OrderConfirmation)(nil), "OrderConfirmation")
+	proto.RegisterType((*Voucher)(nil), "Voucher")
 	proto.RegisterType((*OrderReject)(nil), "OrderReject")
 	proto.RegisterType((*RatingSignature)(nil), "RatingSignature")
 	proto.RegisterType((*RatingSignature_TransactionMetadata)(nil), "RatingSignature.TransactionMetadata")
@@ -2203,14 +3287,27 @@ func init() {
 	proto.RegisterType((*OrderCompletion_Rating)(nil), "OrderCompletion.Rating")
 	proto.RegisterType((*OrderCompletion_Rating_RatingData)(nil), "OrderCompletion.Rating.RatingData")
 	proto.RegisterType((*Dispute)(nil), "Dispute")
+	proto.RegisterType((*DisputeAttachment)(nil), "DisputeAttachment")
+	proto.RegisterType((*DisputeAttachment_Key)(nil), "DisputeAttachment.Key")
 	proto.RegisterType((*DisputeResolution)(nil), "DisputeResolution")
 	proto.RegisterType((*DisputeResolution_Payout)(nil), "DisputeResolution.Payout")
 	proto.RegisterType((*DisputeResolution_Payout_Output)(nil), "DisputeResolution.Payout.Output")
+	proto.RegisterType((*DisputeEndorsement)(nil), "DisputeEndorsement")
+	proto.RegisterType((*PayoutProposal)(nil), "PayoutProposal")
+	proto.RegisterType((*CaseChat)(nil), "CaseChat")
 	proto.RegisterType((*Outpoint)(nil), "Outpoint")
 	proto.RegisterType((*Refund)(nil), "Refund")
 	proto.RegisterType((*ID)(nil), "ID")
 	proto.RegisterType((*ID_Pubkeys)(nil), "ID.Pubkeys")
 	proto.RegisterType((*Signature)(nil), "Signature")
+	proto.RegisterType((*RatingReply)(nil), "RatingReply")
+	proto.RegisterType((*BuyerRating)(nil), "BuyerRating")
+	proto.RegisterType((*ReputationBundle)(nil), "ReputationBundle")
+	proto.RegisterType((*ReputationBundle_Rating)(nil), "ReputationBundle.Rating")
+	proto.RegisterType((*IdentitySuccession)(nil), "IdentitySuccession")
+	proto.RegisterType((*IdentityChain)(nil), "IdentityChain")
+	proto.RegisterType((*Post)(nil), "Post")
+	proto.RegisterType((*BuyerRatingTicket)(nil), "BuyerRatingTicket")
 	proto.RegisterEnum("Listing_Metadata_ContractType", Listing_Metadata_ContractType_name, Listing_Metadata_ContractType_value)
 	proto.RegisterEnum("Listing_Metadata_Format", Listing_Metadata_Format_name, Listing_Metadata_Format_value)
 	proto.RegisterEnum("Listing_ShippingOption_ShippingType", Listing_ShippingOption_ShippingType_name, Listing_ShippingOption_ShippingType_value)
@@ -2222,196 +3319,263 @@ func init() {
 func init() { proto.RegisterFile("contracts.proto", fileDescriptor1) }
 
 var fileDescriptor1 = []byte{
-	// 3048 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x09, 0x6e, 0x88, 0x02, 0xff, 0xc4, 0x59, 0x4d, 0x70, 0x1b, 0x49,
-	0xf5, 0xcf, 0xe8, 0x5b, 0xcf, 0xb2, 0x2d, 0xf7, 0x3a, 0x89, 0x76, 0xfe, 0x7f, 0x36, 0x89, 0x2a,
-	0x09, 0x21, 0x9b, 0x9d, 0xdd, 0x98, 0x4b, 0x0a, 0x28, 0x76, 0x65, 0x8d, 0x1c, 0xcf, 0xc6, 0xb1,
-	0xb4, 0x2d, 0x99, 0x65, 0xf7, 0xe2, 0x6a, 0xcf, 0xb4, 0xe5, 0x21, 0xa3, 0x19, 0xed, 0x7c, 0x78,
-	0x2d, 0x6e, 0xdc, 0x28, 0x2e, 0x5c, 0xa0, 0x96, 0x23, 0x47, 0xee, 0xdc, 0xb8, 0x50, 0xc5, 0x89,
-	0xe2, 0x48, 0x15, 0x55, 0x70, 0xa0, 0x8a, 0xe2, 0xcc, 0x99, 0x0b, 0x07, 0xa8, 0xfe, 0x1a, 0xcd,
-	0x8c, 0xec, 0x6c, 0x02, 0x45, 0x71, 0xb2, 0xde, 0xef, 0xbd, 0x6e, 0x77, 0xbf, 0xef, 0xd7, 0x03,
-	0x9b, 0x76, 0xe0, 0xc7, 0x21, 0xb1, 0xe3, 0xc8, 0x98, 0x87, 0x41, 0x1c, 0xe8, 0xc8, 0x0e, 0x12,
-	0x3f, 0x0e, 0x17, 0x76, 0xe0, 0x50, 0x85, 0xdd, 0x9a, 0x06, 0xc1, 0xd4, 0xa3, 0xef, 0x72, 0xea,
-	0x24, 0x39, 0x7d, 0x37, 0x76, 0x67, 0x34, 0x8a, 0xc9, 0x6c, 0x2e, 0x04, 0xba, 0xff, 0x2c, 0xc3,
-	0x16, 0x76, 0x6d, 0x12, 0x3a, 0x2e, 0xf1, 0xfb, 0x72, 0x47, 0xf4, 0x1e, 0x6c, 0x9c, 0x53, 0xdf,
-	0x09, 0xc2, 0x03, 0x37, 0x8a, 0x5d, 0x7f, 0x1a, 0x75, 0xb4, 0xdb, 0xe5, 0x07, 0x6b, 0x3b, 0x0d,
-	0x43, 0x02, 0xb8, 0xc0, 0x47, 0xf7, 0x01, 0x4e, 0x92, 0x05, 0x0d, 0x87, 0xa1, 0x43, 0xc3, 0x4e,
-	0xe9, 0xb6, 0xf6, 0x60, 0x6d, 0xa7, 0x66, 0x70, 0x0a, 0x67, 0x38, 0xe8, 0x00, 0x6e, 0x8a, 0x95,
-	0x9c, 0xec, 0x07, 0xfe, 0xa9, 0x1b, 0xce, 0x48, 0xec, 0x06, 0x7e, 0xa7, 0xcc, 0x17, 0x21, 0x63,
-	0x85, 0x83, 0xaf, 0x5a, 0x82, 0x2c, 0xb8, 0x91, 0x61, 0xed, 0x25, 0xde, 0xa9, 0xeb, 0x79, 0x33,
-	0xea, 0xc7, 0x9d, 0x0a, 0x3f, 0xef, 0x96, 0x51, 0x64, 0xe0, 0x2b, 0x16, 0x20, 0x13, 0xb6, 0x97,
-	0xc7, 0xec, 0x07, 0xb3, 0xb9, 0x47, 0xf9, 0xa9, 0xaa, 0xfc, 0x54, 0x6d, 0xa3, 0x80, 0xe3, 0x4b,
-	0xa5, 0x51, 0x17, 0xea, 0x8e, 0x1b, 0xcd, 0x93, 0x98, 0x76, 0x6a, 0x7c, 0x61, 0xc3, 0x30, 0x05,
-	0x8d, 0x15, 0x03, 0x7d, 0x00, 0x5b, 0xf2, 0x27, 0xa6, 0x51, 0xe0, 0x25, 0xfc, 0xdf, 0xd4, 0xe5,
-	0xe5, 0xcd, 0x22, 0x07, 0xaf, 0x0a, 0xa3, 0x5b, 0x50, 0x0b, 0xe9, 0x69, 0xe2, 0x3b, 0x9d, 0x06,
-	0x5f, 0x56, 0x37, 0x30, 0x27, 0xb1, 0x84, 0xd1, 0x43, 0x80, 0xc8, 0x9d, 0xfa, 0x24, 0x4e, 0x42,
-	0x1a, 0x75, 0x9a, 0x5c, 0x17, 0x60, 0x8c, 0x15, 0x84, 0x33, 0xdc, 0xee, 0x9f, 0xae, 0x43, 0x5d,
-	0x9a, 0x11, 0x21, 0xa8, 0x44, 0x5e, 0x32, 0xed, 0x68, 0xb7, 0xb5, 0x07, 0x4d, 0xcc, 0x7f, 0xa3,
-	0x5b, 0xd0, 0x10, 0x2a, 0xb3, 0x4c, 0x69, 0xd7, 0xb2, 0x61, 0x99, 0x38, 0x05, 0xd1, 0x3b, 0xd0,
-	0x98, 0xd1, 0x98, 0x38, 0x24, 0x26, 0xd2, 0x86, 0x5b, 0xca, 0x4d, 0x8c, 0xe7, 0x92, 0x81, 0x53,
-	0x11, 0x74, 0x07, 0x2a, 0x6e, 0x4c, 0x67, 0x9d, 0x0a, 0x17, 0x5d, 0x4f, 0x45, 0xad, 0x98, 0xce,
-	0x30, 0x67, 0xa1, 0x1e, 0x6c, 0x46, 0x67, 0xee, 0x7c, 0xee, 0xfa, 0xd3, 0xe1, 0x9c, 0xdd, 0x38,
-	0xea, 0x54, 0xf9, 0x1d, 0x6e, 0xa6, 0xd2, 0xe3, 0x1c, 0x1f, 0x17, 0xe5, 0x51, 0x17, 0xaa, 0x31,
-	0xb9, 0xa0, 0x51, 0xa7, 0xc6, 0x17, 0xb6, 0xd2, 0x85, 0x13, 0x72, 0x81, 0x05, 0x0b, 0x7d, 0x0d,
-	0xea, 0x76, 0x90, 0xcc, 0xd9, 0xf6, 0x75, 0x2e, 0xb5, 0x99, 0x4a, 0xf5, 0x39, 0x8e, 0x15, 0x1f,
-	0xbd, 0x05, 0x30, 0x0b, 0x1c, 0x1a, 0x92, 0x38, 0x08, 0xa3, 0x4e, 0xe3, 0x76, 0xf9, 0x41, 0x13,
-	0x67, 0x10, 0x64, 0x00, 0x8a, 0x69, 0x38, 0x8b, 0x7a, 0xbe, 0xd3, 0x0f, 0x7c, 0xc7, 0x15, 0x87,
-	0x6e, 0x72, 0x35, 0x5e, 0xc2, 0x41, 0x5d, 0x68, 0x09, 0x53, 0x8d, 0x02, 0xcf, 0xb5, 0x17, 0x1d,
-	0xe0, 0x92, 0x39, 0x4c, 0xff, 0x55, 0x19, 0x1a, 0x4a, 0x7f, 0xa8, 0x03, 0xf5, 0x73, 0x1a, 0x46,
-	0xcc, 0x55, 0x98, 0x71, 0xd6, 0xb1, 0x22, 0xd1, 0x2e, 0xb4, 0x54, 0x26, 0x98, 0x2c, 0xe6, 0x94,
-	0xdb, 0x68, 0x63, 0xe7, 0xad, 0x15, 0x13, 0x18, 0xfd, 0x8c, 0x14, 0xce, 0xad, 0x41, 0xef, 0x41,
-	0xed, 0x34, 0x60, 0x41, 0xc5, 0x0d, 0xb8, 0xb1, 0xd3, 0x59, 0x5d, 0xbd, 0xc7, 0xf9, 0x58, 0xca,
-	0xa1, 0x1d, 0xa8, 0xd1, 0x8b, 0xb9, 0x1b, 0x2e, 0xa4, 0x1d, 0x75, 0x43, 0x64, 0x1a, 0x43, 0x65,
-	0x1a, 0x63, 0xa2, 0x32, 0x0d, 0x96, 0x92, 0xe8, 0x21, 0xb4, 0x89, 0x6d, 0xd3, 0x79, 0x4c, 0x9d,
-	0x7e, 0x12, 0x86, 0xd4, 0xb7, 0x17, 0x3c, 0xbc, 0x9a, 0x78, 0x05, 0x47, 0x0f, 0x60, 0x73, 0x1e,
-	0xba, 0xb6, 0xeb, 0x4f, 0x53, 0xd1, 0x1a, 0x17, 0x2d, 0xc2, 0x48, 0x87, 0x86, 0x47, 0xfc, 0x69,
-	0x42, 0xa6, 0x94, 0x47, 0x51, 0x13, 0xa7, 0x74, 0x77, 0x04, 0xad, 0xec, 0xad, 0xd1, 0x16, 0xac,
-	0x8f, 0xf6, 0x3f, 0x19, 0x5b, 0xfd, 0xde, 0xc1, 0xf1, 0xd3, 0xe1, 0xd0, 0x6c, 0x5f, 0x43, 0x6d,
-	0x68, 0x99, 0xd6, 0x53, 0x6b, 0xa2, 0x10, 0x0d, 0xad, 0x41, 0x7d, 0x3c, 0xc0, 0xdf, 0xb1, 0xfa,
-	0x83, 0x76, 0x09, 0x6d, 0x00, 0xf4, 0xf1, 0xf0, 0x63, 0xf3, 0x78, 0xef, 0xe8, 0xd0, 0x6c, 0x97,
-	0xbb, 0xf7, 0xa1, 0x26, 0x34, 0x81, 0x36, 0x61, 0x6d, 0xcf, 0xfa, 0xee, 0xc0, 0x3c, 0x1e, 0x61,
-	0x26, 0x7a, 0x8d, 0xad, 0xeb, 0x1d, 0xf5, 0x27, 0xd6, 0xf0, 0xb0, 0xad, 0xe9, 0x7f, 0xaf, 0x42,
-	0x85, 0x79, 0x34, 0xda, 0x86, 0x6a, 0xec, 0xc6, 0x1e, 0x95, 0x31, 0x25, 0x08, 0x74, 0x1b, 0xd6,
-	0x1c, 0x1a, 0xd9, 0xa1, 0xcb, 0xdd, 0x95, 0xdb, 0xac, 0x89, 0xb3, 0x10, 0xba, 0x0f, 0x1b, 0xf3,
-	0x30, 0xb0, 0x69, 0x14, 0xb9, 0xfe, 0x94, 0xe9, 0x92, 0x9b, 0xa6, 0x89, 0x0b, 0x28, 0xdb, 0x9f,
-	0x69, 0x84, 0x72, 0x3b, 0x54, 0xb0, 0x20, 0x58, 0x20, 0xfb, 0xd1, 0xe9, 0xe7, 0x5c, 0xbd, 0x0d,
-	0xcc, 0x7f, 0x33, 0x2c, 0x26, 0x53, 0x11, 0x11, 0x4d, 0xcc, 0x7f, 0xa3, 0xb7, 0xa1, 0xe6, 0xce,
-	0xc8, 0x94, 0xaa, 0x08, 0x78, 0x23, 0x17, 0x8e, 0x86, 0xc5, 0x78, 0x58, 0x8a, 0xb0, 0x20, 0xb0,
-	0x49, 0x4c, 0xa7, 0x41, 0xe8, 0xd2, 0x34, 0x08, 0x96, 0x08, 0x3b, 0xca, 0x34, 0x24, 0x33, 0xe1,
-	0xf7, 0x25, 0x2c, 0x08, 0xf4, 0xff, 0xd0, 0xb4, 0x95, 0xe3, 0x4b, 0x3f, 0x5f, 0x02, 0xc8, 0x80,
-	0x7a, 0x20, 0x43, 0x7c, 0x8d, 0x9f, 0x60, 0x3b, 0x7f, 0x02, 0x19, 0xdf, 0x4a, 0x08, 0xdd, 0x83,
-	0x4a, 0xf4, 0x22, 0x89, 0x3a, 0x2d, 0x99, 0xdf, 0x73, 0xc2, 0xe3, 0x17, 0x09, 0xe6, 0x6c, 0xfd,
-	0x53, 0xa8, 0x89, 0x95, 0x5c, 0x13, 0x64, 0xa6, 0xd4, 0xcf, 0x7f, 0xbf, 0x82, 0xf6, 0x75, 0x68,
-	0x9c, 0x93, 0xd0, 0x25, 0x7e, 0x1c, 0x75, 0xca, 0xfc, 0xa2, 0x29, 0xad, 0xff, 0x40, 0x83, 0xf2,
-	0xf8, 0x45, 0xc2, 0x62, 0x58, 0x62, 0xfd, 0x60, 0x76, 0x12, 0xf0, 0x12, 0xb9, 0x8e, 0x73, 0x18,
-	0xbb, 0xfc, 0x3c, 0x0c, 0x9c, 0xc4, 0x8e, 0x65, 0xf6, 0x6c, 0xe2, 0x25, 0xc0, 0xb8, 0x51, 0x12,
-	0xda, 0x67, 0x24, 0x9c, 0x0a, 0xf3, 0x96, 0xf1, 0x12, 0x60, 0x67, 0xf8, 0x2c, 0x21, 0x7e, 0xec,
-	0xc6, 0x22, 0xc8, 0xca, 0x38, 0xa5, 0xf5, 0x2f, 0x34, 0xa8, 0x72, 0xe3, 0x30, 0xa9, 0x53, 0xd7,
-	0xa3, 0x99, 0x3b, 0xa6, 0x34, 0xe3, 0x05, 0xa1, 0x3b, 0x75, 0x7d, 0xe2, 0xc9, 0x7f, 0x9e, 0xd2,
-	0xcc, 0x58, 0x5e, 0xfa, 0x7f, 0x9b, 0x58, 0x10, 0xe8, 0x06, 0xd4, 0x66, 0xd4, 0x71, 0x13, 0x91,
-	0x9e, 0x9b, 0x58, 0x52, 0x4c, 0x3a, 0x9a, 0x11, 0xcf, 0x93, 0xf1, 0x2a, 0x08, 0xee, 0x51, 0xae,
-	0xaf, 0x22, 0x93, 0xff, 0xd6, 0x7f, 0x59, 0x83, 0x8d, 0x7c, 0x72, 0xbe, 0xd4, 0x04, 0x4f, 0xa0,
-	0x12, 0x2f, 0xb3, 0xd5, 0xdd, 0x2b, 0xf2, 0x7a, 0x4a, 0xf2, 0x9c, 0xc5, 0x57, 0xa0, 0xfb, 0x50,
-	0x0f, 0xe9, 0x94, 0x7b, 0x0c, 0xb3, 0xcc, 0xc6, 0x4e, 0x8b, 0x65, 0x6b, 0xd6, 0xf8, 0xf4, 0x03,
-	0x87, 0x62, 0xc5, 0x44, 0xcf, 0x60, 0x5d, 0x15, 0x05, 0x9c, 0x78, 0x34, 0x92, 0x89, 0xea, 0xde,
-	0x97, 0xfd, 0x2b, 0x2e, 0x8c, 0xf3, 0x6b, 0xd1, 0x37, 0xa1, 0x11, 0xd1, 0xf0, 0xdc, 0xb5, 0xa9,
-	0x2a, 0x45, 0xb7, 0xae, 0xdc, 0x47, 0xc8, 0xe1, 0x74, 0x81, 0x4e, 0xa0, 0x2e, 0xc1, 0x4b, 0x55,
-	0x91, 0x46, 0x70, 0x29, 0x1b, 0xc1, 0x8f, 0x60, 0x8b, 0x46, 0xb1, 0x3b, 0x23, 0x31, 0x75, 0x4c,
-	0xea, 0xb9, 0xe7, 0x34, 0x5c, 0x48, 0x5b, 0xad, 0x32, 0xf4, 0x1f, 0x95, 0x61, 0x3d, 0x77, 0x01,
-	0xf4, 0x21, 0x34, 0xc2, 0xc4, 0xa3, 0xbc, 0x24, 0x68, 0x5c, 0xc9, 0xc6, 0x2b, 0xdd, 0xdc, 0xc0,
-	0x72, 0x15, 0x4e, 0xd7, 0xa3, 0x0f, 0xa0, 0x1a, 0x72, 0x15, 0x96, 0xf8, 0xd5, 0x1f, 0xbe, 0xfa,
-	0x46, 0x58, 0x2c, 0xd4, 0x27, 0x50, 0x61, 0x24, 0xf3, 0xc8, 0x99, 0xeb, 0x63, 0xe2, 0x4f, 0xa9,
-	0xac, 0x63, 0x29, 0xcd, 0x79, 0xe4, 0x42, 0xf0, 0x4a, 0x92, 0x27, 0xe9, 0xa5, 0x8e, 0xca, 0x19,
-	0x1d, 0x75, 0x7f, 0xa2, 0x41, 0x43, 0x1d, 0x17, 0x5d, 0x87, 0xad, 0x8f, 0x8e, 0x7a, 0x87, 0x13,
-	0x6b, 0xf2, 0xc9, 0xb1, 0x69, 0x8d, 0xfb, 0xc3, 0xa3, 0xc3, 0x49, 0xfb, 0x1a, 0xfa, 0x3f, 0xb8,
-	0xb9, 0x77, 0xd0, 0x9b, 0x1c, 0xef, 0x0d, 0x06, 0xc7, 0x29, 0x1f, 0xf7, 0x0e, 0x9f, 0x0e, 0xda,
-	0x1a, 0x7a, 0x13, 0xae, 0xa7, 0xcc, 0x8f, 0x07, 0xd6, 0xd3, 0xfd, 0x89, 0x64, 0x95, 0x18, 0xab,
-	0x3f, 0x7c, 0xbe, 0x6b, 0x1d, 0x0e, 0xcc, 0xe3, 0xf1, 0xbe, 0x35, 0x1a, 0x59, 0x87, 0x4f, 0x8f,
-	0x7b, 0xa6, 0xd9, 0x2e, 0xa3, 0xb7, 0x40, 0x5f, 0x65, 0x8d, 0x8f, 0x76, 0x27, 0xb8, 0xd7, 0x9f,
-	0xb4, 0x2b, 0xdd, 0xc7, 0xd0, 0xca, 0xfa, 0x2d, 0x2b, 0x31, 0x07, 0x43, 0x56, 0x72, 0x46, 0x56,
-	0xff, 0xd9, 0xd1, 0xa8, 0x7d, 0xad, 0x58, 0x3b, 0x34, 0xfd, 0xc7, 0x1a, 0x94, 0x27, 0xe4, 0x82,
-	0x95, 0xf9, 0x98, 0x5c, 0xa4, 0x46, 0x6b, 0x62, 0x45, 0xa2, 0x47, 0x00, 0x31, 0xb9, 0xc0, 0xd2,
-	0xf3, 0x4b, 0x97, 0x78, 0x7e, 0x86, 0xcf, 0x32, 0x5c, 0x4c, 0x2e, 0xd4, 0x29, 0xb8, 0xd6, 0x1a,
-	0x38, 0x0b, 0xb1, 0x64, 0x3e, 0xa7, 0xa1, 0x4d, 0xfd, 0x98, 0x15, 0xce, 0x0a, 0xcf, 0xd8, 0x19,
-	0x44, 0xff, 0x8d, 0x06, 0x35, 0xd1, 0x05, 0x5d, 0x51, 0xc2, 0xb6, 0xa1, 0x72, 0x46, 0xa2, 0x33,
-	0x91, 0x58, 0xf6, 0xaf, 0x61, 0x4e, 0xa1, 0xbb, 0xd0, 0x72, 0xdc, 0x88, 0x4f, 0x22, 0xec, 0x50,
-	0xc2, 0x63, 0xf7, 0xaf, 0xe1, 0x1c, 0x8a, 0x1e, 0xc2, 0xa6, 0xfc, 0x57, 0xa6, 0x84, 0x79, 0x62,
-	0x29, 0xed, 0x6b, 0xb8, 0xc8, 0x40, 0xf7, 0x61, 0x9d, 0x5b, 0x3b, 0x95, 0x64, 0xd9, 0xa6, 0xb2,
-	0xaf, 0xe1, 0x3c, 0xbc, 0x5b, 0x83, 0x0a, 0x9b, 0x7c, 0x76, 0x01, 0x1a, 0xea, 0x7f, 0x75, 0xff,
-	0xd0, 0x84, 0xaa, 0x98, 0x3b, 0xee, 0xc2, 0xba, 0x68, 0xae, 0x7a, 0x8e, 0x13, 0xd2, 0x28, 0x92,
-	0x77, 0xc9, 0x83, 0x2c, 0x21, 0x0b, 0x60, 0x8f, 0xaa, 0x70, 0x5c, 0x02, 0xe8, 0x6d, 0x68, 0x44,
-	0x59, 0x8d, 0xb2, 0x86, 0x91, 0xef, 0xbe, 0x74, 0xfc, 0x54, 0x00, 0x7d, 0x05, 0xea, 0x7c, 0x42,
-	0xb0, 0x4c, 0x99, 0x78, 0x78, 0xd7, 0xac, 0x30, 0xf4, 0x04, 0x9a, 0xe9, 0x28, 0x26, 0x67, 0x8c,
-	0x97, 0xb5, 0x50, 0x4b, 0x61, 0x74, 0x07, 0xaa, 0xac, 0x49, 0x56, 0x9d, 0xed, 0x9a, 0x3c, 0x02,
-	0x6f, 0x9f, 0x05, 0x07, 0x3d, 0x80, 0xfa, 0x9c, 0x2c, 0xf8, 0x1c, 0x24, 0xe6, 0x8a, 0x0d, 0x29,
-	0x34, 0x12, 0x28, 0x56, 0x6c, 0xe6, 0x05, 0x21, 0x61, 0xa1, 0xfc, 0x8c, 0x2e, 0x44, 0x49, 0x6f,
-	0xe1, 0x0c, 0x82, 0x76, 0x60, 0x9b, 0x78, 0x31, 0x0d, 0x7d, 0x12, 0x53, 0xd6, 0x49, 0x11, 0x3b,
-	0xb6, 0xfc, 0xd3, 0x40, 0x76, 0xb6, 0x97, 0xf2, 0xf4, 0xdf, 0x6b, 0xd0, 0x48, 0xdd, 0xec, 0x06,
-	0xd4, 0x98, 0x4a, 0x26, 0x81, 0x54, 0xb8, 0xa4, 0x98, 0xa3, 0x13, 0x69, 0x09, 0x51, 0x99, 0x14,
-	0xc9, 0x52, 0xa4, 0xcd, 0x4a, 0x9e, 0xc8, 0x75, 0xfc, 0x37, 0x2f, 0x3f, 0x31, 0x89, 0xa9, 0xac,
-	0x4a, 0x82, 0xe0, 0x2e, 0x1c, 0x44, 0x31, 0xf1, 0xb8, 0xa7, 0x89, 0xca, 0x94, 0x41, 0x58, 0xa5,
-	0x90, 0x23, 0x31, 0xf7, 0x99, 0x95, 0x4a, 0x21, 0x99, 0xac, 0x90, 0xcb, 0x7f, 0x7e, 0x18, 0xc4,
-	0xbc, 0x15, 0xe2, 0xcd, 0x78, 0x16, 0xd3, 0xff, 0x52, 0x92, 0xfd, 0xdc, 0x6d, 0x58, 0xf3, 0x44,
-	0xf6, 0xdb, 0x67, 0xde, 0x2f, 0x6e, 0x95, 0x85, 0x72, 0x75, 0x5b, 0xe6, 0x31, 0x45, 0xa3, 0x47,
-	0xcb, 0x76, 0xa7, 0xcc, 0xcd, 0x87, 0x32, 0xe6, 0x5b, 0x69, 0x76, 0x76, 0x61, 0x23, 0x3f, 0xd7,
-	0xa4, 0xcd, 0x76, 0x66, 0x51, 0x61, 0x12, 0x2a, 0xac, 0x60, 0xea, 0x9c, 0xd1, 0x59, 0x20, 0xd5,
-	0xc3, 0x7f, 0xb3, 0x3b, 0x88, 0xc1, 0x86, 0xe9, 0x41, 0x35, 0x84, 0x59, 0x48, 0xdf, 0x79, 0x69,
-	0xff, 0xb4, 0x0d, 0xd5, 0x73, 0xe2, 0x25, 0x54, 0x9a, 0x4e, 0x10, 0xfa, 0xb7, 0x5f, 0xa9, 0xf0,
-	0x77, 0xa0, 0x2e, 0x0b, 0xa3, 0x32, 0xbc, 0x24, 0xf5, 0x5f, 0x94, 0xa0, 0x2e, 0x1d, 0x14, 0xbd,
-	0xc3, 0xfa, 0x90, 0xf8, 0x2c, 0x70, 0x64, 0xed, 0xba, 0x9e, 0x77, 0x60, 0x36, 0x96, 0x9c, 0x05,
-	0x0e, 0x96, 0x42, 0x2c, 0x6e, 0xd3, 0x61, 0x4c, 0xb5, 0x59, 0x29, 0xc0, 0x7c, 0x90, 0xcc, 0x78,
-	0xea, 0x10, 0xd5, 0x43, 0x52, 0xcc, 0xee, 0xf4, 0xc2, 0x3e, 0x63, 0x05, 0x06, 0x2b, 0xe7, 0xaa,
-	0xe0, 0x1c, 0xc6, 0xbb, 0xd7, 0x33, 0xe2, 0xfa, 0x2c, 0xb5, 0xc8, 0x3e, 0x67, 0x09, 0x64, 0xbd,
-	0xb8, 0x9e, 0xf7, 0x62, 0x3e, 0xe0, 0x39, 0x94, 0xce, 0xc6, 0xbc, 0xa7, 0xe4, 0x83, 0x3a, 0x1f,
-	0xf0, 0x96, 0x58, 0xf7, 0x09, 0xd4, 0xc4, 0x3d, 0xd0, 0x1b, 0xb0, 0xd9, 0x33, 0x4d, 0x3c, 0x18,
-	0x8f, 0x8f, 0xf1, 0xe0, 0xa3, 0xa3, 0xc1, 0x98, 0x55, 0x2e, 0x80, 0x9a, 0x69, 0xe1, 0x41, 0x7f,
-	0xd2, 0xd6, 0xd0, 0x3a, 0x34, 0x9f, 0x0f, 0xcd, 0x01, 0xee, 0x4d, 0x06, 0x66, 0xbb, 0xd4, 0xfd,
-	0x69, 0x09, 0xb6, 0x56, 0x5f, 0x43, 0x3a, 0x50, 0x0f, 0x18, 0x68, 0x99, 0xaa, 0x78, 0x48, 0x32,
-	0x9f, 0x6d, 0x4a, 0xaf, 0x93, 0x6d, 0xd8, 0x18, 0x22, 0x74, 0xae, 0x12, 0xa7, 0x1a, 0x43, 0x72,
-	0x28, 0x9b, 0xd7, 0x42, 0xfa, 0x59, 0x42, 0xa3, 0x98, 0x3a, 0x3d, 0xa1, 0x6c, 0xa1, 0xce, 0x22,
-	0xcc, 0x5b, 0x62, 0xb2, 0x08, 0x92, 0x98, 0xe5, 0xd8, 0xaa, 0xc8, 0xb1, 0x29, 0x80, 0xbe, 0x05,
-	0x6d, 0x91, 0x7e, 0xc6, 0xcb, 0xf7, 0x0b, 0x91, 0xe8, 0xda, 0x06, 0xce, 0x33, 0xf0, 0x8a, 0x64,
-	0xf7, 0x87, 0x1a, 0xac, 0x89, 0x37, 0x27, 0xfa, 0x3d, 0x6a, 0xc7, 0xff, 0x15, 0x8d, 0xb0, 0x09,
-	0xc4, 0x9d, 0xaa, 0xf8, 0xdd, 0x32, 0x76, 0xdd, 0xd8, 0x0e, 0x5c, 0x7f, 0x79, 0x2c, 0xce, 0xee,
-	0xfe, 0x4d, 0x83, 0xcd, 0xc2, 0x81, 0xd1, 0x07, 0x99, 0x97, 0x12, 0x8d, 0xff, 0xcf, 0xbb, 0xc5,
-	0x4b, 0x19, 0x93, 0x90, 0xf8, 0x11, 0xb1, 0x99, 0x41, 0x2f, 0x79, 0x3c, 0x61, 0x13, 0x83, 0x12,
-	0xe5, 0xc7, 0x6e, 0xe1, 0x25, 0xa0, 0x2f, 0xe0, 0x8d, 0x4b, 0x96, 0x67, 0x52, 0xd6, 0x78, 0xf9,
-	0xb8, 0x93, 0x85, 0x78, 0xdd, 0x53, 0x49, 0x5f, 0x6d, 0x9b, 0x02, 0xcc, 0x97, 0xd3, 0x60, 0x62,
-	0x02, 0x65, 0x2e, 0x90, 0xc3, 0xba, 0x23, 0x68, 0x17, 0x15, 0xc1, 0xf2, 0xb3, 0xeb, 0xcf, 0x93,
-	0xd8, 0xf2, 0x1d, 0x7a, 0x21, 0xdb, 0xbd, 0x0c, 0xf2, 0xf2, 0xcb, 0x74, 0x7f, 0x5e, 0x85, 0xf6,
-	0xca, 0x2b, 0x5d, 0x6a, 0x50, 0x27, 0x6f, 0x50, 0x27, 0x7d, 0xba, 0x2a, 0x65, 0x9e, 0xae, 0x72,
-	0x46, 0x2e, 0xbf, 0x8e, 0x91, 0x0f, 0xa1, 0x3d, 0x3f, 0x5b, 0x44, 0xae, 0x4d, 0xbc, 0xb4, 0xf9,
-	0x16, 0x4f, 0x8a, 0xdd, 0x95, 0x27, 0x45, 0x63, 0x54, 0x90, 0xc4, 0x2b, 0x6b, 0xd1, 0x33, 0xd8,
-	0x74, 0xdc, 0xa9, 0x1b, 0x67, 0xb6, 0x13, 0x63, 0xc4, 0x9d, 0xd5, 0xed, 0xcc, 0xbc, 0x20, 0x2e,
-	0xae, 0x44, 0xef, 0x41, 0x4d, 0x04, 0x8c, 0x7c, 0x63, 0xec, 0x5c, 0x72, 0x24, 0xce, 0xc7, 0x52,
-	0x0e, 0x7d, 0x03, 0x36, 0x0b, 0xb1, 0x22, 0x1b, 0x83, 0xd5, 0xa0, 0x2a, 0x0a, 0xea, 0x13, 0x68,
-	0x17, 0x2f, 0xc8, 0x93, 0x38, 0x4b, 0xf5, 0x34, 0x54, 0x66, 0x90, 0x24, 0xcb, 0x17, 0x71, 0x48,
-	0xec, 0x17, 0xae, 0x3f, 0x3d, 0x4c, 0x66, 0x27, 0x54, 0xa5, 0xe3, 0x02, 0xaa, 0xbf, 0x0f, 0x9b,
-	0x85, 0x7b, 0xa2, 0x36, 0x94, 0x93, 0xd0, 0x93, 0x1b, 0xb2, 0x9f, 0xac, 0x92, 0xce, 0x49, 0x14,
-	0x7d, 0x1e, 0x84, 0x8e, 0x9a, 0x5f, 0x15, 0xcd, 0xa6, 0xf0, 0x9a, 0xb8, 0x65, 0x1a, 0x91, 0xda,
-	0x4b, 0x23, 0x92, 0xb5, 0x80, 0x42, 0x1d, 0xbd, 0x5c, 0xe3, 0x91, 0x07, 0xd1, 0x43, 0x68, 0xa7,
-	0xd9, 0x68, 0x44, 0xc3, 0xdd, 0x45, 0xac, 0x86, 0x8e, 0x15, 0xbc, 0xfb, 0xb3, 0x1a, 0x6c, 0x16,
-	0x5f, 0x80, 0xaf, 0xf6, 0xd0, 0x7f, 0x3f, 0xe5, 0x3c, 0x06, 0x10, 0xff, 0x7b, 0xfc, 0xd2, 0xc4,
-	0x93, 0x11, 0x42, 0x8f, 0xa1, 0x2e, 0x0c, 0x19, 0x49, 0xbf, 0xbd, 0x59, 0x7c, 0xc1, 0x96, 0x96,
-	0xc7, 0x4a, 0x4e, 0xff, 0x5d, 0x05, 0x6a, 0x02, 0x43, 0xbb, 0xaa, 0x2d, 0x34, 0x97, 0xa9, 0xaa,
-	0x7b, 0xc5, 0x06, 0xf2, 0x0f, 0x93, 0xc4, 0x99, 0x55, 0x5f, 0x92, 0xaa, 0xfe, 0x5c, 0x06, 0xc0,
-	0x39, 0xe1, 0x65, 0x02, 0xd2, 0x8a, 0x09, 0xe8, 0x4b, 0x9f, 0xa0, 0x33, 0xcd, 0x76, 0xf9, 0x92,
-	0x66, 0xfb, 0x1e, 0xac, 0xa5, 0xc9, 0x2a, 0xdf, 0x8f, 0x67, 0x71, 0x64, 0x40, 0x53, 0xec, 0x38,
-	0x76, 0xa7, 0xe9, 0xbb, 0x7f, 0x31, 0x3e, 0x96, 0x22, 0xb9, 0xbc, 0xc8, 0x96, 0xd4, 0x0a, 0x79,
-	0x91, 0xc9, 0xe4, 0x8c, 0x5e, 0x7f, 0x1d, 0xa3, 0x33, 0x47, 0x3a, 0xa7, 0x21, 0xf1, 0x3c, 0xde,
-	0x3c, 0xac, 0x63, 0x45, 0x32, 0xce, 0x67, 0x09, 0xf1, 0x58, 0x7f, 0xd9, 0x14, 0x1c, 0x49, 0x16,
-	0x1f, 0xb6, 0x80, 0x73, 0x73, 0x0f, 0x5b, 0x77, 0x61, 0xdd, 0x91, 0x01, 0x37, 0x9e, 0x53, 0xea,
-	0x74, 0xd6, 0xb8, 0x4c, 0x1e, 0x64, 0xd5, 0xdc, 0x4e, 0xa2, 0x38, 0x98, 0xd1, 0x50, 0xbe, 0x5c,
-	0x74, 0x5a, 0x5c, 0xae, 0x08, 0xb3, 0xde, 0x2a, 0xa4, 0xe7, 0x2e, 0xfd, 0xbc, 0xb3, 0x2e, 0xfa,
-	0x7b, 0x41, 0x75, 0xff, 0xa8, 0x41, 0x5d, 0x7e, 0xcb, 0xc8, 0xeb, 0x40, 0x7b, 0x1d, 0x1d, 0x6c,
-	0x43, 0xd5, 0xf6, 0x88, 0x3b, 0x53, 0x8d, 0x26, 0x27, 0x56, 0x03, 0xb9, 0x7c, 0x59, 0x20, 0x7f,
-	0x15, 0x9a, 0x41, 0x12, 0xcf, 0x03, 0xd7, 0x8f, 0x55, 0x0c, 0x34, 0x8d, 0xa1, 0x44, 0xf0, 0x92,
-	0x87, 0x0c, 0x40, 0x11, 0x0d, 0x5d, 0xe2, 0xb9, 0xdf, 0xa7, 0x8e, 0x7a, 0x2e, 0xe6, 0xf6, 0x6f,
-	0xe1, 0x4b, 0x38, 0xdd, 0x5f, 0x57, 0x60, 0x6b, 0xe5, 0x33, 0xcd, 0x7f, 0x70, 0xc9, 0x4c, 0xc6,
-	0x28, 0xe5, 0x33, 0x06, 0x1b, 0x70, 0xc2, 0x60, 0x1e, 0x44, 0xd4, 0xd9, 0x55, 0x03, 0x51, 0x06,
-	0xe1, 0xd3, 0xdb, 0xf2, 0x13, 0x92, 0x98, 0x8d, 0x32, 0x08, 0x7a, 0x9c, 0x16, 0x0a, 0xe1, 0xcd,
-	0x6f, 0xae, 0x7e, 0x5e, 0x2a, 0x54, 0x0a, 0xfd, 0xaf, 0xa5, 0xd7, 0x4d, 0xab, 0x77, 0xa0, 0xc6,
-	0x6b, 0xba, 0x7a, 0x1d, 0xca, 0x28, 0x59, 0x32, 0xd0, 0x2e, 0xac, 0x89, 0xaf, 0x65, 0x49, 0x3c,
-	0x4f, 0x62, 0x19, 0xa2, 0xb7, 0xaf, 0x3c, 0x8c, 0x21, 0xe4, 0x70, 0x76, 0x11, 0x32, 0xa1, 0x25,
-	0xbf, 0xdc, 0x89, 0x4d, 0x2a, 0xaf, 0xb8, 0x49, 0x6e, 0x15, 0xfa, 0x10, 0x36, 0xd3, 0xf0, 0x94,
-	0x1b, 0x55, 0x5f, 0x71, 0xa3, 0xe2, 0x42, 0xfd, 0x09, 0xd4, 0xe4, 0xae, 0x6c, 0xc8, 0x15, 0x6d,
-	0xbe, 0x1a, 0x72, 0x39, 0x95, 0x19, 0x3c, 0x4a, 0xd9, 0xc1, 0xa3, 0xfb, 0x21, 0x34, 0x94, 0x8e,
-	0x58, 0xdf, 0x72, 0xb6, 0x1c, 0x24, 0xc5, 0x23, 0xca, 0x36, 0x54, 0x5d, 0xde, 0x33, 0x89, 0xf1,
-	0x51, 0x10, 0xcb, 0xa9, 0x4b, 0xbe, 0x81, 0x71, 0xa2, 0xfb, 0x85, 0x06, 0x35, 0xf1, 0xf5, 0xef,
-	0x7f, 0xd8, 0xed, 0xa6, 0x53, 0x66, 0x65, 0x39, 0x65, 0x76, 0x7f, 0xab, 0x41, 0xc9, 0x32, 0x99,
-	0x12, 0xe6, 0x34, 0x73, 0x28, 0x49, 0xb1, 0xec, 0x79, 0xe2, 0x05, 0xf6, 0x0b, 0x3e, 0x4d, 0xa5,
-	0xaf, 0xe3, 0x39, 0x0c, 0xdd, 0x83, 0xfa, 0x3c, 0x39, 0x79, 0x41, 0x17, 0x91, 0x74, 0x9a, 0x35,
-	0xc3, 0x32, 0x8d, 0x91, 0x80, 0xb0, 0xe2, 0xb1, 0x38, 0x38, 0x49, 0xcf, 0xc5, 0xcf, 0xd0, 0xc2,
-	0x19, 0x44, 0x7f, 0x1f, 0xea, 0x72, 0x0d, 0x6b, 0x29, 0x5c, 0x87, 0x8a, 0xe1, 0x5c, 0xd4, 0x99,
-	0x94, 0x66, 0xfa, 0x93, 0x8b, 0x64, 0xbd, 0x52, 0x64, 0xf7, 0x1f, 0x1a, 0x34, 0x97, 0x7d, 0xed,
-	0x23, 0x36, 0xc2, 0xf2, 0x16, 0x5b, 0x4e, 0xa7, 0x68, 0xf9, 0x69, 0xd5, 0x18, 0x0b, 0x0e, 0x56,
-	0x22, 0xac, 0x23, 0x4a, 0xcb, 0x1e, 0xeb, 0x1a, 0x22, 0xb9, 0x79, 0x01, 0x65, 0x86, 0xac, 0xcb,
-	0xc5, 0x68, 0x0d, 0xea, 0x07, 0xd6, 0x78, 0x62, 0x1d, 0x3e, 0x6d, 0x5f, 0x43, 0x4d, 0xa8, 0x0e,
-	0xb1, 0x39, 0xc0, 0x6d, 0x0d, 0xdd, 0x00, 0xc4, 0x7f, 0x1e, 0xf7, 0x87, 0x87, 0x7b, 0x16, 0x7e,
-	0xde, 0xe3, 0x5f, 0x9b, 0x4a, 0xe8, 0x3a, 0x6c, 0x09, 0x7c, 0xef, 0xe8, 0x60, 0xcf, 0x3a, 0x38,
-	0x78, 0x3e, 0x38, 0x9c, 0xb4, 0xcb, 0x68, 0x1b, 0xda, 0x4a, 0xfc, 0xf9, 0xe8, 0x60, 0xc0, 0x85,
-	0x2b, 0x6c, 0x73, 0xd3, 0x1a, 0x8f, 0x8e, 0x26, 0x83, 0x76, 0x95, 0xed, 0x28, 0x89, 0x63, 0x3c,
-	0x18, 0x0f, 0x0f, 0x8e, 0xb8, 0x50, 0x8d, 0x0d, 0x9f, 0x78, 0xc0, 0xbf, 0x79, 0xd5, 0x77, 0x2b,
-	0x9f, 0x96, 0xe6, 0x27, 0x27, 0x35, 0xee, 0x28, 0x5f, 0xff, 0x57, 0x00, 0x00, 0x00, 0xff, 0xff,
-	0x3c, 0x18, 0xbe, 0x3c, 0x78, 0x20, 0x00, 0x00,
+	// 4128 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc5, 0x3a, 0x4b, 0x8c, 0x23, 0x49,
+	0x95, 0xe3, 0xbf, 0x1d, 0xf5, 0x73, 0x65, 0xf7, 0x4c, 0x17, 0xe6, 0x33, 0x3d, 0xd6, 0xcc, 0xec,
+	0x30, 0x80, 0x07, 0x7a, 0x61, 0x17, 0x01, 0x02, 0xaa, 0x6c, 0x57, 0xb7, 0x67, 0xaa, 0xab, 0x4c,
+	0xd8, 0x35, 0xc0, 0x5c, 0x4a, 0x59, 0x76, 0x94, 0x2b, 0xb7, 0x6d, 0xa7, 0xc9, 0x4f, 0x4f, 0x17,
+	0x37, 0x90, 0x90, 0x10, 0x17, 0x2e, 0x48, 0x70, 0xe4, 0x06, 0x07, 0x0e, 0xab, 0xbd, 0x20, 0xae,
+	0x70, 0x41, 0x1c, 0x39, 0xec, 0x6d, 0xa4, 0xd5, 0xae, 0x76, 0x4f, 0xa3, 0x3d, 0xed, 0x71, 0xa5,
+	0xdd, 0xf7, 0x5e, 0x7c, 0x32, 0x32, 0x6d, 0x57, 0x57, 0xf7, 0x68, 0xc4, 0xc9, 0x7e, 0x9f, 0xc8,
+	0x8c, 0x78, 0xff, 0xf7, 0x32, 0xd8, 0xce, 0xc8, 0x9f, 0x47, 0x81, 0x3b, 0x8a, 0xc2, 0xd6, 0x22,
+	0xf0, 0x23, 0xbf, 0xe1, 0x8c, 0xfc, 0x18, 0x30, 0x57, 0x23, 0x7f, 0x2c, 0x34, 0xee, 0xe5, 0x89,
+	0xef, 0x4f, 0xa6, 0xe2, 0x2d, 0x82, 0xce, 0xe3, 0x8b, 0xb7, 0x22, 0x6f, 0x26, 0xc2, 0xc8, 0x9d,
+	0x2d, 0x24, 0x43, 0xf3, 0xff, 0x8a, 0x6c, 0x97, 0x7b, 0x23, 0x37, 0x18, 0x7b, 0xee, 0xbc, 0xad,
+	0x9e, 0xe8, 0x7c, 0x91, 0x6d, 0x3f, 0x16, 0xf3, 0xb1, 0x1f, 0x1c, 0x79, 0x61, 0xe4, 0xcd, 0x27,
+	0xe1, 0x5e, 0xee, 0x6e, 0xe1, 0x8d, 0x8d, 0x7b, 0xd5, 0x96, 0x42, 0xf0, 0x0c, 0xdd, 0x79, 0x9d,
+	0xb1, 0xf3, 0xf8, 0x4a, 0x04, 0x27, 0xc1, 0x58, 0x04, 0x7b, 0xf9, 0xbb, 0x39, 0xe0, 0x2e, 0xb7,
+	0x08, 0xe2, 0x16, 0xc5, 0x39, 0x62, 0x77, 0xe4, 0x4a, 0x02, 0xe1, 0x85, 0x17, 0x5e, 0x30, 0x73,
+	0x23, 0xcf, 0x9f, 0xef, 0x15, 0x68, 0x91, 0xd3, 0x5a, 0xa2, 0xf0, 0x75, 0x4b, 0x9c, 0x1e, 0x7b,
+	0xc9, 0x22, 0x1d, 0xc6, 0xd3, 0x0b, 0x6f, 0x3a, 0x9d, 0x89, 0x79, 0xb4, 0x57, 0xa4, 0xfd, 0xee,
+	0xb6, 0xb2, 0x04, 0xbe, 0x66, 0x81, 0xd3, 0x61, 0xb7, 0x93, 0x6d, 0xb6, 0xfd, 0xd9, 0x62, 0x2a,
+	0x68, 0x57, 0x25, 0xda, 0x55, 0xbd, 0x95, 0xc1, 0xf3, 0x95, 0xdc, 0x4e, 0x93, 0x55, 0xc6, 0x5e,
+	0xb8, 0x88, 0x23, 0xb1, 0x57, 0xa6, 0x85, 0xd5, 0x56, 0x47, 0xc2, 0x5c, 0x13, 0x9c, 0x6f, 0xb3,
+	0x5d, 0xf5, 0x97, 0x8b, 0xd0, 0x9f, 0xc6, 0xf4, 0x9a, 0x8a, 0x3a, 0x7c, 0x27, 0x4b, 0xe1, 0xcb,
+	0xcc, 0xce, 0xcb, 0xac, 0x1c, 0x88, 0x8b, 0x78, 0x3e, 0xde, 0xab, 0xd2, 0xb2, 0x4a, 0x8b, 0x13,
+	0xc8, 0x15, 0xda, 0x79, 0x93, 0xb1, 0xd0, 0x9b, 0xcc, 0xdd, 0x28, 0x0e, 0x44, 0xb8, 0x57, 0x23,
+	0x59, 0xb0, 0xd6, 0x40, 0xa3, 0xb8, 0x45, 0x75, 0xfe, 0x91, 0x6d, 0x2f, 0xdc, 0x2b, 0x3f, 0x8e,
+	0xfa, 0x81, 0xbf, 0xf0, 0x43, 0x77, 0xba, 0xc7, 0xe8, 0xa1, 0x3b, 0xad, 0x7e, 0x0a, 0xcd, 0x33,
+	0x6c, 0x4e, 0x97, 0xdd, 0x52, 0x5b, 0xeb, 0xa2, 0x44, 0x43, 0x81, 0x72, 0x0c, 0xf7, 0x36, 0xe8,
+	0x6d, 0xb7, 0xf4, 0x49, 0x2c, 0x1a, 0x5f, 0xc5, 0xdf, 0xfc, 0xa0, 0xc1, 0x2a, 0xca, 0x8c, 0x1c,
+	0x87, 0x15, 0xc3, 0x69, 0x3c, 0x01, 0x6b, 0xcb, 0xbd, 0x51, 0xe3, 0xf4, 0x1f, 0x0e, 0x5b, 0x95,
+	0x2a, 0xeb, 0x75, 0x94, 0x5d, 0x15, 0x5a, 0xbd, 0x0e, 0x37, 0x48, 0xe7, 0x0b, 0xac, 0x3a, 0x13,
+	0x91, 0x3b, 0x76, 0x23, 0x57, 0xd9, 0xd0, 0xae, 0x36, 0xd3, 0xd6, 0x43, 0x45, 0xe0, 0x86, 0xc5,
+	0x79, 0x85, 0x15, 0xbd, 0x48, 0xcc, 0xc0, 0x42, 0x90, 0x75, 0xcb, 0xb0, 0xf6, 0x00, 0xc9, 0x89,
+	0xe4, 0xec, 0xb3, 0x9d, 0xf0, 0xd2, 0x5b, 0x2c, 0x00, 0x7d, 0xb2, 0x40, 0x89, 0x87, 0x60, 0x06,
+	0x78, 0xaa, 0x3b, 0x86, 0x7b, 0x90, 0xa2, 0xf3, 0x2c, 0x3f, 0x18, 0x42, 0x29, 0x72, 0x9f, 0x80,
+	0xf0, 0xcb, 0xb4, 0x70, 0xd3, 0x2c, 0x1c, 0xba, 0x4f, 0xb8, 0x24, 0x39, 0x9f, 0x65, 0x15, 0x70,
+	0xd9, 0x05, 0x3e, 0xbe, 0x42, 0x5c, 0x3b, 0x86, 0xab, 0x4d, 0x78, 0xae, 0xe9, 0xce, 0x67, 0x18,
+	0x9b, 0x81, 0x5b, 0x07, 0x6e, 0x04, 0x82, 0x03, 0xad, 0x17, 0x40, 0x3c, 0x16, 0xc6, 0x69, 0x31,
+	0x27, 0x12, 0xc1, 0x2c, 0xdc, 0x9f, 0x8f, 0xc1, 0x41, 0xc6, 0x9e, 0xdc, 0x74, 0x8d, 0xc4, 0xb8,
+	0x82, 0x02, 0xdb, 0xdb, 0x94, 0xa6, 0xd2, 0xf7, 0xa7, 0xde, 0xe8, 0x8a, 0x54, 0x5e, 0xe3, 0x29,
+	0x9c, 0xf3, 0x46, 0x22, 0x05, 0xd0, 0x39, 0x38, 0x8a, 0x00, 0xdd, 0x22, 0x5b, 0x16, 0xdd, 0xf8,
+	0xb0, 0xc8, 0xaa, 0x5a, 0xd2, 0xce, 0x1e, 0xab, 0x3c, 0x16, 0x41, 0x88, 0x46, 0x8d, 0x6a, 0xdc,
+	0xe2, 0x1a, 0x74, 0x0e, 0xd8, 0xa6, 0x8e, 0x59, 0xc3, 0xab, 0x85, 0x20, 0x6d, 0x6e, 0xdf, 0xfb,
+	0xcc, 0x92, 0xb2, 0x5a, 0x6d, 0x8b, 0x8b, 0xa7, 0xd6, 0x40, 0x64, 0x2a, 0x5f, 0xf8, 0xe8, 0xfe,
+	0xa4, 0xea, 0xed, 0x7b, 0x7b, 0xcb, 0xab, 0x0f, 0x89, 0xce, 0x15, 0x9f, 0x73, 0x8f, 0x95, 0xc5,
+	0x93, 0x85, 0x17, 0x5c, 0x29, 0x8d, 0x37, 0x5a, 0x32, 0x26, 0xb6, 0x74, 0x4c, 0x6c, 0x0d, 0x75,
+	0x4c, 0xe4, 0x8a, 0x13, 0xfc, 0xa7, 0xee, 0x8e, 0x46, 0x62, 0x11, 0x89, 0x71, 0x3b, 0x0e, 0x02,
+	0x31, 0x07, 0x11, 0x95, 0xe8, 0xec, 0x4b, 0x78, 0x14, 0xd3, 0x22, 0xf0, 0x46, 0xb0, 0x05, 0xc3,
+	0x5a, 0x96, 0x62, 0xca, 0xa0, 0x9d, 0x06, 0xab, 0x4e, 0xdd, 0xf9, 0x24, 0x76, 0x27, 0x82, 0xfc,
+	0xbd, 0xc6, 0x0d, 0x8c, 0x4f, 0x39, 0x87, 0x50, 0x04, 0xec, 0xbd, 0x39, 0xe8, 0xeb, 0x31, 0xb8,
+	0x61, 0x95, 0xa4, 0x97, 0x45, 0xe3, 0x53, 0x16, 0x81, 0xf0, 0x29, 0xce, 0xa2, 0x82, 0xab, 0xdc,
+	0xc0, 0xa8, 0xd6, 0x99, 0xfb, 0xe4, 0xc0, 0x1d, 0x3d, 0x92, 0x74, 0x46, 0x8f, 0x48, 0xe1, 0x9c,
+	0x43, 0x56, 0x87, 0x53, 0x8a, 0x11, 0x9c, 0x01, 0x8d, 0xb8, 0xe3, 0x46, 0x52, 0xaf, 0xd7, 0x4b,
+	0x66, 0x69, 0x4d, 0xb3, 0xcf, 0x36, 0x6d, 0x3d, 0x39, 0xbb, 0x6c, 0xab, 0xff, 0xe0, 0xfb, 0x83,
+	0x5e, 0x7b, 0xff, 0xe8, 0xec, 0xfe, 0xc9, 0x49, 0xa7, 0xfe, 0x82, 0x53, 0x67, 0x9b, 0x9d, 0xde,
+	0xfd, 0xde, 0x50, 0x63, 0x72, 0xce, 0x06, 0xab, 0x0c, 0xba, 0xfc, 0xdd, 0x5e, 0xbb, 0x5b, 0xcf,
+	0x3b, 0xdb, 0x8c, 0xb5, 0xf9, 0xc9, 0x77, 0x3b, 0x67, 0x87, 0xa7, 0xc7, 0x9d, 0x7a, 0xa1, 0xf9,
+	0x3a, 0x2b, 0x4b, 0xdd, 0x39, 0x3b, 0x6c, 0xe3, 0xb0, 0xf7, 0xbd, 0x6e, 0xe7, 0xac, 0xcf, 0x91,
+	0xf5, 0x05, 0x5c, 0xb7, 0x7f, 0xda, 0x1e, 0xf6, 0x4e, 0x8e, 0xeb, 0xb9, 0xc6, 0x7f, 0x56, 0x58,
+	0x11, 0xbd, 0xd5, 0xb9, 0x0d, 0x4e, 0xe6, 0x45, 0x60, 0x97, 0x32, 0x5e, 0x48, 0xc0, 0xb9, 0xcb,
+	0x36, 0x20, 0x01, 0x8e, 0x02, 0x8f, 0x5c, 0x91, 0xac, 0xac, 0xc6, 0x6d, 0x14, 0x24, 0xab, 0x6d,
+	0x38, 0xe2, 0x48, 0x84, 0x21, 0x08, 0x16, 0xcf, 0x48, 0xc6, 0x54, 0xe3, 0x19, 0x2c, 0x3e, 0x1f,
+	0x75, 0x28, 0xc8, 0x72, 0x8a, 0x5c, 0x02, 0x18, 0xa4, 0xe6, 0xe1, 0xc5, 0xfb, 0x64, 0x10, 0x55,
+	0x4e, 0xff, 0x11, 0x17, 0xb9, 0x13, 0xe9, 0xed, 0x10, 0xb8, 0xf0, 0xbf, 0xf3, 0x39, 0x56, 0xf6,
+	0x66, 0xa0, 0x5b, 0xed, 0xdd, 0xb7, 0x52, 0xa1, 0xa6, 0xd5, 0x43, 0x1a, 0x57, 0x2c, 0xe8, 0xe0,
+	0x23, 0x90, 0xea, 0xc4, 0x0f, 0x3c, 0x61, 0x1c, 0x3c, 0xc1, 0xe0, 0x56, 0x26, 0x81, 0x3b, 0x93,
+	0x3e, 0x9d, 0xe7, 0x12, 0x70, 0x3e, 0xc5, 0x6a, 0x23, 0xed, 0xd4, 0xca, 0x87, 0x13, 0x04, 0x04,
+	0x85, 0x8a, 0xaf, 0xc2, 0x97, 0x0c, 0xca, 0xb7, 0xd3, 0x3b, 0x50, 0xb1, 0x4b, 0x33, 0x39, 0xaf,
+	0x41, 0xf4, 0x7d, 0x14, 0x87, 0x7b, 0x9b, 0x2a, 0x77, 0xa6, 0x98, 0x07, 0x8f, 0x62, 0x4e, 0x64,
+	0x74, 0xc1, 0x73, 0x88, 0x12, 0x20, 0xf6, 0x2d, 0x62, 0xdc, 0x4b, 0x33, 0x1e, 0x10, 0x8d, 0xa2,
+	0xa9, 0xe2, 0x6b, 0xbc, 0xc7, 0xca, 0xf2, 0x5d, 0x24, 0x3b, 0x77, 0xa6, 0x15, 0x46, 0xff, 0x6f,
+	0xa0, 0x2f, 0x30, 0xf9, 0xc7, 0x6e, 0x00, 0x05, 0x0a, 0xa4, 0x97, 0x02, 0x89, 0xc6, 0xc0, 0x8d,
+	0xff, 0xca, 0xb1, 0x02, 0xec, 0x0d, 0x4d, 0x5f, 0xe1, 0x20, 0x1d, 0x9f, 0xfb, 0x54, 0xb0, 0x80,
+	0xe9, 0xdb, 0x38, 0x14, 0x17, 0x68, 0x78, 0x1c, 0x8f, 0x22, 0x95, 0x4b, 0x40, 0x5c, 0x06, 0x81,
+	0xd4, 0x30, 0x0e, 0x46, 0x97, 0x6e, 0x30, 0x91, 0x06, 0x51, 0xe0, 0x09, 0x02, 0xf7, 0xf0, 0x83,
+	0x18, 0x9e, 0xe4, 0x45, 0x32, 0x90, 0x14, 0xb8, 0x81, 0x2d, 0x4d, 0x97, 0x9e, 0xae, 0x69, 0xa3,
+	0xc9, 0xb2, 0xad, 0x49, 0x10, 0x02, 0x59, 0xef, 0x20, 0xbe, 0xb8, 0xf0, 0x9e, 0xa8, 0xf0, 0x60,
+	0xa3, 0x1a, 0xef, 0x32, 0x96, 0x88, 0x76, 0x65, 0xa6, 0xc4, 0xc8, 0x2b, 0x8f, 0x4b, 0x87, 0xc3,
+	0xc8, 0x2b, 0xc1, 0xd4, 0xe6, 0x0b, 0x44, 0x32, 0x70, 0xe3, 0x97, 0x39, 0x56, 0xa2, 0x1d, 0x22,
+	0x17, 0x86, 0x73, 0x4b, 0x41, 0x06, 0x46, 0x1a, 0x18, 0xe2, 0xc4, 0x9b, 0x43, 0x60, 0x92, 0x92,
+	0x33, 0x30, 0x9e, 0x68, 0x6a, 0x84, 0x06, 0x6e, 0x48, 0x80, 0xf3, 0x12, 0x2b, 0xcf, 0xc4, 0xd8,
+	0x8b, 0x65, 0xa6, 0xad, 0x71, 0x05, 0x21, 0x77, 0x38, 0x73, 0xa7, 0x53, 0x15, 0x50, 0x25, 0x40,
+	0x0e, 0xe4, 0xcd, 0x75, 0xe8, 0xa4, 0xff, 0x8d, 0x0f, 0x2b, 0x6c, 0x3b, 0x9d, 0x67, 0x57, 0xda,
+	0xcf, 0x57, 0x61, 0x69, 0x92, 0x4e, 0x5e, 0x5d, 0x93, 0xa2, 0x0d, 0x48, 0x49, 0x85, 0x56, 0x40,
+	0x1c, 0xa8, 0x04, 0x62, 0x42, 0x0e, 0x82, 0x66, 0xb5, 0x0d, 0x69, 0xba, 0x2d, 0x6b, 0xe8, 0x36,
+	0xa4, 0x56, 0xae, 0x89, 0xce, 0x3b, 0x6c, 0x4b, 0xa7, 0x3c, 0x1e, 0x4f, 0x41, 0xcd, 0x32, 0x93,
+	0xbc, 0xf6, 0xb4, 0x57, 0x11, 0x33, 0x4f, 0xaf, 0x75, 0xbe, 0xce, 0xaa, 0x21, 0x44, 0x72, 0x88,
+	0x24, 0xda, 0x5c, 0x5e, 0x5e, 0xfb, 0x1c, 0xc9, 0xc7, 0xcd, 0x02, 0x48, 0x66, 0xa5, 0x1f, 0xfa,
+	0x73, 0x53, 0x56, 0x7c, 0x6a, 0xdd, 0xca, 0xf7, 0x80, 0x89, 0x4b, 0xd6, 0x86, 0x0b, 0x31, 0x57,
+	0xae, 0x5f, 0x29, 0x3e, 0x13, 0xe4, 0xf2, 0x76, 0x90, 0xfb, 0x3c, 0xdb, 0x85, 0xc8, 0x0f, 0x26,
+	0x0b, 0x21, 0xbf, 0x23, 0xa6, 0x1e, 0xe4, 0xf0, 0x2b, 0xa5, 0xdf, 0x65, 0x42, 0xe3, 0xc7, 0x39,
+	0x56, 0xc4, 0x57, 0xa2, 0x44, 0x55, 0x17, 0x42, 0xef, 0x58, 0x92, 0xa8, 0x22, 0xa2, 0x71, 0x40,
+	0x5e, 0x89, 0xe0, 0x20, 0x79, 0xf2, 0x67, 0x05, 0xa1, 0x1b, 0x40, 0x71, 0x19, 0xb9, 0x53, 0x64,
+	0xd7, 0xce, 0x6e, 0xa3, 0xd0, 0xc8, 0xc5, 0x93, 0xd1, 0x34, 0x1e, 0xcb, 0xa8, 0x5c, 0xe5, 0x1a,
+	0x6c, 0xfc, 0xac, 0xc0, 0xb6, 0x52, 0x92, 0x77, 0xde, 0x66, 0xd5, 0x00, 0xfe, 0x50, 0xb1, 0x21,
+	0xb7, 0xd3, 0xba, 0x91, 0xca, 0x5a, 0x5c, 0xad, 0xe2, 0x66, 0x3d, 0x54, 0xed, 0xa5, 0x80, 0x74,
+	0x9f, 0x27, 0xc9, 0xbf, 0x79, 0xf3, 0x07, 0x71, 0xb9, 0xb0, 0x31, 0x64, 0x45, 0x04, 0xd1, 0x95,
+	0x66, 0xde, 0x9c, 0x43, 0xe6, 0x17, 0xaa, 0x42, 0x32, 0x30, 0xd1, 0xa0, 0x40, 0x24, 0x5a, 0x5e,
+	0xd1, 0x14, 0x9c, 0x28, 0xaa, 0x60, 0x29, 0xaa, 0xf9, 0x8b, 0x1c, 0xab, 0xea, 0xed, 0x3a, 0x2f,
+	0xb2, 0xdd, 0xef, 0x9c, 0xee, 0x1f, 0x0f, 0x7b, 0xc3, 0xef, 0x9f, 0x75, 0x7a, 0x83, 0xf6, 0xc9,
+	0xe9, 0xf1, 0x10, 0xb2, 0xe7, 0x27, 0xd9, 0x9d, 0xc3, 0xa3, 0xfd, 0xe1, 0xd9, 0x61, 0xb7, 0x7b,
+	0x66, 0xe8, 0x7c, 0xff, 0xf8, 0x7e, 0x17, 0x52, 0xf2, 0x27, 0xd8, 0x8b, 0x86, 0xf8, 0xdd, 0x6e,
+	0xef, 0xfe, 0x83, 0xa1, 0x22, 0xe5, 0x91, 0xd4, 0x3e, 0x79, 0x78, 0xd0, 0x3b, 0x86, 0x4c, 0x3c,
+	0x78, 0xd0, 0xeb, 0xf7, 0x7b, 0xc7, 0xf7, 0xcf, 0xf6, 0x3b, 0x90, 0xab, 0x21, 0x5f, 0x35, 0x96,
+	0x49, 0x83, 0xd3, 0x83, 0x21, 0xdf, 0x6f, 0x0f, 0xeb, 0xc5, 0xe6, 0x97, 0xd8, 0xa6, 0xed, 0x70,
+	0x58, 0x0a, 0x1c, 0x9d, 0x60, 0x69, 0xd0, 0xef, 0xb5, 0xdf, 0x39, 0xed, 0xc3, 0xa6, 0x32, 0x39,
+	0x3e, 0xd7, 0xf8, 0x39, 0x44, 0x72, 0xa8, 0x8e, 0x51, 0xc3, 0x50, 0x1f, 0x1b, 0xa5, 0xd5, 0xb8,
+	0x06, 0xc1, 0x28, 0x19, 0xfc, 0xe5, 0xca, 0x65, 0xf3, 0x2b, 0x5c, 0xd6, 0xa2, 0x53, 0x48, 0x75,
+	0x9f, 0xe8, 0x5d, 0x90, 0xd4, 0xaa, 0xdc, 0x46, 0x61, 0xd2, 0x5d, 0x88, 0x60, 0x04, 0x6d, 0x08,
+	0x96, 0x64, 0x45, 0x8a, 0xc7, 0x16, 0xa6, 0xf1, 0xc7, 0x1c, 0x2b, 0xcb, 0x4a, 0x7c, 0x4d, 0xa9,
+	0x71, 0x9b, 0x15, 0x2f, 0xdd, 0xf0, 0x52, 0x46, 0xc4, 0x07, 0x2f, 0x70, 0x82, 0x9c, 0x57, 0xd9,
+	0x26, 0x34, 0x3a, 0x64, 0xea, 0xb8, 0x29, 0xe9, 0x36, 0x40, 0x4d, 0x61, 0xa1, 0xc6, 0xdc, 0x51,
+	0xaf, 0xea, 0x28, 0x34, 0x45, 0xc4, 0xfc, 0x83, 0x1c, 0xcf, 0x12, 0xc0, 0xad, 0xb6, 0x48, 0xdb,
+	0x86, 0x13, 0xc3, 0x64, 0x11, 0x38, 0xd3, 0xe8, 0x83, 0x32, 0x2b, 0x62, 0xf7, 0x7f, 0xc0, 0x58,
+	0x55, 0xbf, 0xab, 0xf9, 0xbf, 0x9b, 0xac, 0x24, 0x7b, 0xef, 0x57, 0xd9, 0x96, 0x2c, 0xf0, 0xf7,
+	0xc7, 0x63, 0xe8, 0xfc, 0x42, 0x75, 0x96, 0x34, 0x12, 0xd3, 0xa0, 0x44, 0x1c, 0x0a, 0x1d, 0x13,
+	0x12, 0x04, 0xa4, 0xba, 0x6a, 0x68, 0x4b, 0x14, 0x9b, 0x16, 0x7a, 0x7a, 0x62, 0xf8, 0x86, 0xc1,
+	0xf9, 0x34, 0xab, 0x50, 0x97, 0x0c, 0xd9, 0xb6, 0x98, 0x74, 0x6e, 0x1a, 0x07, 0x81, 0xbb, 0x66,
+	0xc6, 0x11, 0xaa, 0xcf, 0xbe, 0xae, 0x04, 0x4d, 0x98, 0xa1, 0x87, 0x2b, 0x61, 0xa3, 0xa6, 0xc3,
+	0xe0, 0x86, 0xda, 0x02, 0x15, 0x1d, 0x92, 0x02, 0x05, 0x75, 0x05, 0xfa, 0x55, 0x9a, 0x05, 0xc8,
+	0xde, 0x7a, 0x5b, 0x31, 0xf5, 0x25, 0x96, 0x6b, 0x32, 0x5a, 0x01, 0x74, 0x51, 0xb0, 0xdf, 0x77,
+	0xc4, 0x95, 0x2c, 0xbd, 0x36, 0xb9, 0x85, 0x81, 0x98, 0x7b, 0xdb, 0x9d, 0x42, 0xf1, 0x0d, 0x0d,
+	0xb3, 0xc0, 0x8a, 0x17, 0x0a, 0xde, 0xde, 0xfc, 0xc2, 0x57, 0xdd, 0xd5, 0x4a, 0x1a, 0x94, 0x52,
+	0xe5, 0x51, 0x00, 0xf9, 0x2e, 0x52, 0xcd, 0xf4, 0x96, 0x7a, 0x79, 0x9b, 0x90, 0x5c, 0x11, 0xb1,
+	0x10, 0x0d, 0xe3, 0x73, 0x53, 0xe8, 0x80, 0x9c, 0x64, 0x87, 0x95, 0xc1, 0x3a, 0xff, 0x00, 0xed,
+	0x1a, 0x6d, 0x68, 0xe8, 0x8d, 0x1e, 0x89, 0x08, 0x2a, 0x34, 0x39, 0x2d, 0x38, 0x40, 0x49, 0x72,
+	0x8b, 0xc2, 0x53, 0x7c, 0x8d, 0xbf, 0x42, 0x70, 0x30, 0xd6, 0x8e, 0x31, 0x17, 0xfe, 0x0f, 0x7d,
+	0xa5, 0x77, 0x05, 0xa1, 0xbf, 0xb9, 0xca, 0x20, 0x64, 0x66, 0xd7, 0x20, 0xa6, 0x8b, 0x91, 0x2e,
+	0x19, 0x20, 0x5d, 0xe0, 0x7f, 0x4a, 0xdf, 0x18, 0xab, 0x55, 0x56, 0x97, 0x00, 0x79, 0x92, 0x09,
+	0xd2, 0x2a, 0xb3, 0x5b, 0x18, 0x3b, 0x2f, 0x94, 0xaf, 0xcb, 0x0b, 0x50, 0xc5, 0xa9, 0x97, 0x1f,
+	0xfb, 0x11, 0x55, 0xce, 0xd4, 0x97, 0xda, 0xb8, 0xc6, 0xbf, 0xe5, 0x55, 0xf9, 0x0f, 0x0e, 0x3e,
+	0x95, 0x41, 0xf8, 0x01, 0x3a, 0xa1, 0x3c, 0x95, 0x8d, 0x4a, 0xd5, 0x3d, 0xf9, 0x74, 0xdd, 0x03,
+	0xc1, 0xc4, 0x54, 0xc7, 0x05, 0xb2, 0x22, 0xc7, 0xb2, 0xa2, 0xa5, 0xda, 0xf8, 0x00, 0x34, 0x95,
+	0x0a, 0xf4, 0xa6, 0x9b, 0xb4, 0x16, 0x65, 0x86, 0x02, 0x99, 0x15, 0x28, 0xce, 0x99, 0x98, 0xf9,
+	0x4a, 0x3c, 0xf4, 0x1f, 0xcf, 0x20, 0x7b, 0x7c, 0x99, 0xf0, 0x64, 0xff, 0x60, 0xa3, 0x1a, 0xf7,
+	0xae, 0x2d, 0x9e, 0x41, 0x1d, 0xd0, 0x14, 0xc6, 0x42, 0xa9, 0x4e, 0x02, 0x8d, 0x6f, 0xde, 0xa8,
+	0x70, 0x02, 0xc5, 0xab, 0xc2, 0x42, 0x2b, 0x5e, 0x81, 0x8d, 0xff, 0x2e, 0xb0, 0x8a, 0xf2, 0x13,
+	0xe7, 0x0b, 0x58, 0xc7, 0x45, 0x97, 0xfe, 0x58, 0xa5, 0xd0, 0x17, 0xd3, 0x7e, 0x84, 0x7d, 0x37,
+	0x10, 0xb9, 0x62, 0xc2, 0xf0, 0x61, 0xe6, 0x12, 0xba, 0xc6, 0x36, 0x08, 0xb4, 0x41, 0x77, 0x46,
+	0x11, 0x4c, 0x26, 0x31, 0x05, 0xa1, 0xde, 0x21, 0x8d, 0x5f, 0x62, 0x9e, 0xe3, 0xda, 0xb8, 0x8a,
+	0x3c, 0x85, 0xa3, 0x66, 0xe7, 0xd2, 0xf5, 0xe6, 0x18, 0xe1, 0x54, 0x9d, 0x98, 0x20, 0x6c, 0x2b,
+	0xae, 0xa4, 0xad, 0x98, 0x66, 0x1d, 0x63, 0x21, 0x66, 0x03, 0xf2, 0x28, 0xea, 0xab, 0x69, 0xd6,
+	0x91, 0xe0, 0xb0, 0xe1, 0x3f, 0x87, 0x0e, 0x39, 0x5e, 0x3c, 0x4c, 0xa6, 0x2c, 0x35, 0xd2, 0xc5,
+	0x12, 0x9e, 0x1a, 0x7e, 0x77, 0x2e, 0xa6, 0x16, 0x2b, 0x23, 0xd6, 0x2c, 0xda, 0xf9, 0x32, 0x7b,
+	0x51, 0x4d, 0xbc, 0x4e, 0x17, 0x63, 0x38, 0x42, 0x47, 0xb8, 0x63, 0xe8, 0xe5, 0x65, 0xbf, 0xbd,
+	0xc5, 0x57, 0x13, 0x21, 0x2c, 0xde, 0x51, 0x84, 0x8e, 0x18, 0x79, 0x38, 0x39, 0x31, 0xeb, 0x36,
+	0x69, 0xdd, 0x3a, 0x72, 0xf3, 0xab, 0xac, 0x2c, 0xb5, 0xe1, 0xdc, 0x62, 0x3b, 0x90, 0xa7, 0x79,
+	0x77, 0x30, 0x38, 0xe3, 0xdd, 0xef, 0x9c, 0x76, 0x07, 0x58, 0x06, 0x30, 0x56, 0xee, 0xf4, 0x78,
+	0x17, 0xf2, 0x73, 0xce, 0xd9, 0x62, 0xb5, 0x87, 0x27, 0x9d, 0x2e, 0xdf, 0x1f, 0x76, 0x3b, 0xf5,
+	0x7c, 0xe3, 0x7b, 0x90, 0xe8, 0x64, 0x48, 0xc2, 0x26, 0xc2, 0x8f, 0x47, 0x97, 0x22, 0xd0, 0xd9,
+	0x57, 0x81, 0x96, 0xee, 0xf2, 0x29, 0xdd, 0x51, 0x13, 0x3a, 0x16, 0xfd, 0xc0, 0xf7, 0x2f, 0x54,
+	0xa8, 0x48, 0x10, 0xcd, 0x0f, 0xf2, 0x6c, 0x77, 0x79, 0x70, 0x0b, 0x6f, 0xa1, 0x69, 0x04, 0x44,
+	0x3c, 0xf5, 0x16, 0x05, 0xa6, 0x93, 0x42, 0xfe, 0x59, 0x92, 0xc2, 0xeb, 0x34, 0xc8, 0x44, 0x9b,
+	0xd4, 0xf9, 0x4d, 0x77, 0xf5, 0x29, 0x2c, 0xea, 0x2f, 0x10, 0x3f, 0x88, 0x61, 0x95, 0x18, 0xef,
+	0xcb, 0x03, 0x49, 0x73, 0xcb, 0xa2, 0xa9, 0x5f, 0xa4, 0x99, 0x27, 0xa6, 0xc2, 0x92, 0x4c, 0x85,
+	0x06, 0xe1, 0x7c, 0x83, 0xd5, 0x65, 0xb0, 0x1d, 0x24, 0xa3, 0x56, 0x99, 0x8f, 0xea, 0x2d, 0x9e,
+	0x26, 0xf0, 0x25, 0xce, 0x95, 0x63, 0x98, 0xca, 0x73, 0x8c, 0x61, 0xfe, 0x27, 0xc7, 0x2a, 0xef,
+	0x2a, 0x0d, 0x6d, 0xb3, 0xbc, 0x37, 0x56, 0x02, 0x85, 0x7f, 0x6b, 0x35, 0xb6, 0x97, 0xe4, 0x65,
+	0x29, 0x22, 0x93, 0x92, 0x21, 0x60, 0xa2, 0xea, 0x28, 0x9e, 0xca, 0x00, 0x6f, 0xe0, 0x95, 0xe1,
+	0x2b, 0xa5, 0xad, 0xf2, 0xb3, 0x68, 0xcb, 0x1e, 0xeb, 0x56, 0x56, 0x8d, 0x75, 0xb1, 0x1d, 0xd7,
+	0xe2, 0x22, 0x9f, 0xdd, 0xe4, 0x09, 0xa2, 0xf9, 0xd3, 0x1c, 0xdb, 0x90, 0x5f, 0x17, 0xc4, 0x3f,
+	0x81, 0x40, 0x3e, 0x16, 0x83, 0xc2, 0x79, 0x88, 0x37, 0xd1, 0xe9, 0x61, 0xb7, 0x75, 0xe0, 0x45,
+	0x23, 0xdf, 0x9b, 0x27, 0x5a, 0x25, 0x72, 0xf3, 0xc3, 0x1c, 0xdb, 0xc9, 0xe8, 0x1b, 0xba, 0x85,
+	0x64, 0x26, 0x9d, 0xa3, 0x77, 0xbe, 0x9a, 0xb5, 0x89, 0xd6, 0x30, 0x70, 0xe7, 0x21, 0x14, 0x0c,
+	0xe0, 0x0f, 0x2b, 0xc6, 0xd4, 0xa9, 0xe3, 0xe7, 0x33, 0xc7, 0x6f, 0x5c, 0xb1, 0x5b, 0x2b, 0x96,
+	0x5b, 0x19, 0x71, 0x90, 0x0c, 0x07, 0x6c, 0x14, 0x55, 0x77, 0xba, 0xb4, 0xd1, 0x8f, 0x35, 0x08,
+	0x9a, 0x1f, 0xea, 0xf0, 0x85, 0x0c, 0x05, 0x62, 0x48, 0xe1, 0x9a, 0x7d, 0x56, 0xcf, 0x0a, 0x02,
+	0xd3, 0xbf, 0x37, 0x87, 0x90, 0xd4, 0x9b, 0x8f, 0xc5, 0x13, 0xd5, 0xd4, 0x58, 0x98, 0xeb, 0x0f,
+	0xd3, 0xfc, 0x75, 0x89, 0xd5, 0x97, 0xbe, 0xc7, 0x18, 0x85, 0x8e, 0xd3, 0x0a, 0x1d, 0x9b, 0xd1,
+	0x47, 0xde, 0x1a, 0x7d, 0xa4, 0x94, 0x5c, 0x78, 0x16, 0x25, 0x1f, 0xb3, 0xfa, 0xe2, 0xf2, 0x2a,
+	0xf4, 0x46, 0xee, 0xd4, 0xf4, 0xb9, 0xf2, 0xe3, 0x51, 0x73, 0xe9, 0xe3, 0x51, 0xab, 0x9f, 0xe1,
+	0xe4, 0x4b, 0x6b, 0x9d, 0x77, 0xd8, 0xce, 0xd8, 0x9b, 0x78, 0x91, 0xf5, 0x38, 0xd9, 0xe5, 0xbf,
+	0xb2, 0xfc, 0xb8, 0x4e, 0x9a, 0x91, 0x67, 0x57, 0xe2, 0xa8, 0x4d, 0xc6, 0x1b, 0xe5, 0x5b, 0x7b,
+	0x2b, 0xb6, 0x44, 0x74, 0xae, 0xf8, 0x9c, 0xaf, 0x41, 0x70, 0x4b, 0xdb, 0x99, 0xf2, 0xae, 0xe5,
+	0x98, 0x94, 0x65, 0x84, 0x06, 0xb5, 0x9e, 0x3d, 0x20, 0xd5, 0x08, 0x58, 0x49, 0x24, 0xe9, 0x40,
+	0x81, 0x18, 0x6e, 0x71, 0xf8, 0xfb, 0x08, 0x1e, 0x71, 0x1c, 0xcf, 0xce, 0x85, 0xce, 0xf6, 0x19,
+	0x6c, 0xe3, 0x5b, 0x6c, 0x27, 0x73, 0x4e, 0x68, 0x06, 0x0b, 0x71, 0x30, 0x55, 0x0f, 0xc4, 0xbf,
+	0x34, 0xd4, 0x76, 0xc3, 0xf0, 0x7d, 0xd0, 0xb1, 0x1e, 0x2f, 0x69, 0xb8, 0xf1, 0x23, 0xe8, 0xc2,
+	0xe4, 0x29, 0x8d, 0x47, 0xe6, 0xae, 0xf5, 0x48, 0x6c, 0x74, 0xa4, 0x38, 0xf6, 0x53, 0x75, 0x6d,
+	0x1a, 0x89, 0x39, 0xdf, 0x04, 0xf3, 0xbe, 0x08, 0x0e, 0xae, 0x22, 0xdd, 0x5a, 0x2f, 0xe1, 0x9b,
+	0xbf, 0x2a, 0xb3, 0x9d, 0xec, 0xb7, 0xbe, 0xf5, 0x16, 0xfa, 0xfc, 0x21, 0xe7, 0x4b, 0x50, 0x47,
+	0xd3, 0xbb, 0x07, 0xd7, 0x06, 0x1e, 0x8b, 0x09, 0x96, 0x54, 0xa4, 0x22, 0x43, 0x65, 0xb7, 0x77,
+	0xb2, 0xdf, 0x2a, 0x95, 0xe6, 0xb9, 0xe6, 0x6b, 0xfc, 0xa5, 0xc8, 0xca, 0x12, 0x07, 0x75, 0xad,
+	0x6a, 0x75, 0x3a, 0x49, 0xa8, 0x6a, 0xae, 0x79, 0x80, 0xfa, 0x41, 0x4e, 0x6e, 0xad, 0x7a, 0x4a,
+	0xa8, 0xfa, 0xa0, 0xc0, 0x18, 0x4f, 0x31, 0x27, 0x01, 0x28, 0x97, 0x0d, 0x40, 0x4f, 0xfd, 0xd8,
+	0xf7, 0xe9, 0x74, 0xea, 0xca, 0xb6, 0x94, 0xaf, 0xb1, 0x0d, 0x13, 0xac, 0xd2, 0x5d, 0xa7, 0x8d,
+	0x77, 0x5a, 0xac, 0x26, 0x9f, 0x08, 0x12, 0x34, 0x5f, 0x78, 0xb3, 0xfe, 0x91, 0xb0, 0xa4, 0xe2,
+	0x22, 0x2e, 0x29, 0x67, 0xe2, 0x22, 0xf2, 0xa4, 0x94, 0x5e, 0x79, 0x16, 0xa5, 0xa3, 0x21, 0x81,
+	0x5f, 0xe0, 0x4c, 0x54, 0x7e, 0xf3, 0xd1, 0x20, 0x52, 0xa0, 0x5f, 0x99, 0x62, 0xfb, 0x52, 0x93,
+	0x14, 0x05, 0x66, 0x87, 0xe6, 0xf2, 0x43, 0x4f, 0x6a, 0x68, 0x0e, 0x4e, 0x30, 0x56, 0x0e, 0x37,
+	0x58, 0x08, 0x31, 0x56, 0x45, 0x67, 0x1a, 0x89, 0xc5, 0xd0, 0x28, 0x0e, 0x23, 0x7f, 0x26, 0x02,
+	0x35, 0x24, 0x54, 0x45, 0x66, 0x16, 0x8d, 0xc5, 0x44, 0x20, 0x1e, 0x7b, 0xe2, 0xfd, 0xbd, 0x2d,
+	0xd9, 0x3e, 0x4a, 0xa8, 0xf9, 0xbb, 0x3c, 0xab, 0xa8, 0x6f, 0xbd, 0x69, 0x19, 0xe4, 0x9e, 0x45,
+	0x06, 0xd0, 0xc7, 0x8c, 0xa6, 0xae, 0x37, 0xd3, 0x7d, 0x0c, 0x01, 0xcb, 0x8e, 0x5c, 0x58, 0xe5,
+	0xc8, 0x7f, 0xc7, 0x6a, 0x00, 0x2d, 0xc0, 0x43, 0x22, 0xed, 0x03, 0xb5, 0xd6, 0x89, 0xc2, 0xf0,
+	0x84, 0x86, 0x5f, 0x49, 0xa1, 0xc3, 0xf1, 0x40, 0x82, 0x3f, 0x14, 0x63, 0xfd, 0xf1, 0x8a, 0xf4,
+	0xbf, 0xc9, 0x57, 0x50, 0xa0, 0x7e, 0xdf, 0x70, 0x23, 0xe8, 0xe9, 0x2f, 0xe5, 0x97, 0xed, 0xb2,
+	0x6a, 0x13, 0xd5, 0x69, 0xf7, 0x0d, 0x89, 0xdb, 0x6c, 0xe9, 0x0e, 0xa8, 0x92, 0xe9, 0x80, 0x9a,
+	0xff, 0x9a, 0x63, 0xbb, 0x4b, 0x0f, 0x40, 0xe1, 0x82, 0x3a, 0x92, 0xea, 0x45, 0x41, 0xa9, 0x91,
+	0x7c, 0x3e, 0x33, 0x92, 0x77, 0xd4, 0xf0, 0x49, 0x75, 0xe7, 0x34, 0x7a, 0x82, 0x77, 0x47, 0x97,
+	0x10, 0x77, 0xe7, 0xae, 0x37, 0x55, 0x05, 0x5c, 0x82, 0x80, 0x88, 0x57, 0x7c, 0x84, 0x33, 0x0e,
+	0x99, 0x90, 0x5e, 0x5a, 0x3e, 0x48, 0x0b, 0x7c, 0x90, 0x13, 0x4f, 0xe3, 0x2d, 0x56, 0x40, 0x87,
+	0x5c, 0xb7, 0x31, 0x08, 0xe1, 0x8f, 0x4c, 0x05, 0x81, 0x7f, 0x9b, 0xff, 0x5c, 0x32, 0x07, 0xb3,
+	0xae, 0x2a, 0x3c, 0xbf, 0x45, 0x58, 0xe1, 0x35, 0x9f, 0x0e, 0xaf, 0x38, 0x6c, 0xa0, 0x4b, 0x08,
+	0x62, 0x7c, 0xa0, 0x87, 0x13, 0x16, 0x86, 0x06, 0x3a, 0xc9, 0xcd, 0x0a, 0x29, 0x05, 0x0b, 0x03,
+	0x11, 0x53, 0x67, 0x55, 0xe9, 0xfa, 0x9f, 0x58, 0xbe, 0x75, 0x91, 0x4d, 0xab, 0x9f, 0x65, 0x2c,
+	0xc2, 0x7a, 0x4b, 0x76, 0x90, 0x65, 0x65, 0x63, 0x6d, 0x37, 0x14, 0xed, 0x4b, 0x37, 0xe2, 0x16,
+	0xb1, 0xf1, 0xdb, 0xc2, 0xb3, 0xa6, 0xab, 0x57, 0x58, 0x99, 0x6a, 0x25, 0x3d, 0x5b, 0xb6, 0x8c,
+	0x57, 0x11, 0x20, 0x4c, 0x6f, 0xc8, 0xfb, 0x26, 0x40, 0x88, 0x23, 0x15, 0xfa, 0xee, 0xae, 0xdd,
+	0x77, 0x4b, 0xf2, 0x71, 0x7b, 0x91, 0xd3, 0x61, 0x9b, 0xea, 0xee, 0x8b, 0x7c, 0x48, 0xf1, 0x86,
+	0x0f, 0x49, 0xad, 0x72, 0xde, 0x66, 0x3b, 0xc6, 0x98, 0xd5, 0x83, 0x4a, 0x37, 0x7c, 0x50, 0x76,
+	0x21, 0xee, 0x88, 0x5a, 0x66, 0x09, 0x6a, 0x07, 0xbb, 0xc1, 0x8e, 0xec, 0x55, 0x0d, 0xe8, 0x7a,
+	0xd5, 0xf3, 0x70, 0xc2, 0x25, 0x35, 0xa4, 0x27, 0x5c, 0xb2, 0xbb, 0x5f, 0xd3, 0x07, 0x35, 0xff,
+	0x23, 0xc7, 0x9c, 0xe5, 0x6b, 0x2a, 0x1f, 0x8b, 0xcd, 0xa6, 0x82, 0x42, 0x21, 0x3b, 0x16, 0xd1,
+	0x86, 0x52, 0xbc, 0xde, 0x50, 0xd0, 0x19, 0xe3, 0x73, 0xf4, 0x3b, 0x19, 0xb3, 0x14, 0x94, 0x4e,
+	0xc0, 0xe5, 0x6c, 0x79, 0xfd, 0xfb, 0x1c, 0xdb, 0x4e, 0x5f, 0xe5, 0xf9, 0x9b, 0x78, 0x65, 0xe2,
+	0x75, 0xc5, 0x1b, 0x7a, 0x5d, 0xf3, 0xdf, 0x73, 0xac, 0xaa, 0x7d, 0x8c, 0x24, 0x08, 0xd1, 0xde,
+	0x9d, 0x08, 0x53, 0x70, 0x25, 0x08, 0x14, 0xcd, 0x08, 0x38, 0xcd, 0xb6, 0x14, 0x64, 0xc5, 0xaf,
+	0x42, 0x2a, 0x7e, 0xc1, 0x39, 0xd4, 0x62, 0x15, 0x20, 0x34, 0xf8, 0x11, 0xa6, 0xd2, 0x89, 0x7a,
+	0xca, 0xeb, 0xd5, 0x53, 0xc9, 0xaa, 0xe7, 0x6d, 0x56, 0xd5, 0xee, 0x6e, 0x42, 0x7a, 0xce, 0x0a,
+	0xe9, 0x90, 0x19, 0x3d, 0x6a, 0xab, 0xe4, 0x00, 0x53, 0x02, 0xc9, 0xdc, 0x4f, 0x7d, 0x0c, 0x22,
+	0xa0, 0xf9, 0x4b, 0x28, 0x95, 0xe5, 0x55, 0xb0, 0xbf, 0x61, 0x43, 0x6c, 0x06, 0x05, 0xc5, 0x64,
+	0x50, 0xd0, 0xfc, 0x73, 0x8e, 0xe5, 0xe1, 0xdd, 0xeb, 0xd2, 0x09, 0x14, 0x58, 0xe7, 0x53, 0x7f,
+	0xf4, 0x88, 0xe6, 0x79, 0xe6, 0xe3, 0x7c, 0x0a, 0x07, 0x6f, 0xaf, 0x48, 0x81, 0x86, 0x2a, 0xfe,
+	0x6d, 0x40, 0x5d, 0xd7, 0xea, 0x4b, 0x14, 0xd7, 0x34, 0xb4, 0xc3, 0x73, 0xb3, 0x2f, 0xda, 0xc3,
+	0x26, 0xb7, 0x30, 0xd0, 0x8f, 0x54, 0xd4, 0x1a, 0xcc, 0xae, 0xde, 0x58, 0xc8, 0xf1, 0xb0, 0x2c,
+	0x45, 0x0d, 0x4c, 0x33, 0x12, 0xb9, 0x48, 0x25, 0x39, 0x0d, 0x36, 0xff, 0x25, 0xcf, 0x6a, 0x49,
+	0xeb, 0xfb, 0x79, 0x1c, 0xa2, 0x52, 0x17, 0xae, 0xe6, 0xa3, 0x4e, 0x72, 0xcf, 0xae, 0x35, 0x90,
+	0x14, 0xae, 0x59, 0x68, 0xe0, 0xaf, 0xa9, 0xd8, 0x58, 0x84, 0xea, 0xe1, 0x19, 0xac, 0xf3, 0x15,
+	0xb6, 0x11, 0xc6, 0x23, 0xba, 0x8b, 0x92, 0x0c, 0xa8, 0x6f, 0xb5, 0x7a, 0x6a, 0x77, 0x03, 0x43,
+	0xe3, 0x36, 0x1f, 0xea, 0xbf, 0xa2, 0xde, 0x89, 0x57, 0x66, 0x8e, 0x7a, 0x83, 0x61, 0xef, 0xf8,
+	0x7e, 0xfd, 0x05, 0xa7, 0xc6, 0x4a, 0x27, 0xbc, 0xd3, 0xe5, 0xf5, 0x1c, 0xa8, 0xc0, 0xa1, 0xbf,
+	0x67, 0xed, 0x93, 0xe3, 0xc3, 0x1e, 0x7f, 0xb8, 0x4f, 0xb7, 0x6a, 0xf2, 0xf8, 0xed, 0x50, 0xe2,
+	0x0f, 0x4f, 0x8f, 0x0e, 0x7b, 0x47, 0x47, 0x0f, 0xbb, 0xc7, 0xc3, 0x7a, 0x01, 0x0c, 0xad, 0xae,
+	0xd9, 0x1f, 0xf6, 0x8f, 0xba, 0xc4, 0x5c, 0xc4, 0x87, 0x77, 0x7a, 0x83, 0xfe, 0xe9, 0xb0, 0x5b,
+	0x2f, 0xe1, 0x13, 0x15, 0x70, 0xc6, 0xbb, 0x83, 0x93, 0xa3, 0x53, 0x62, 0x2a, 0xe3, 0xbc, 0x91,
+	0x77, 0xe9, 0x6e, 0x4f, 0xa5, 0xf9, 0x93, 0x3c, 0xdb, 0x50, 0x6d, 0x88, 0x58, 0x4c, 0xaf, 0x3e,
+	0x42, 0x04, 0x32, 0x9f, 0x6b, 0x1e, 0x98, 0x2f, 0x6f, 0xdc, 0xc2, 0xd0, 0x65, 0x11, 0xdd, 0x42,
+	0x48, 0x9f, 0x4f, 0xba, 0x07, 0xf0, 0x9a, 0x00, 0x5f, 0xaf, 0x3f, 0x5e, 0x10, 0x60, 0xdf, 0x58,
+	0x2b, 0xa5, 0x6f, 0xac, 0x3d, 0x97, 0x47, 0xab, 0x1b, 0x5a, 0x8f, 0x3d, 0x3f, 0x0e, 0xd5, 0xb0,
+	0xd9, 0xc0, 0xcd, 0xbf, 0x82, 0x1c, 0xac, 0xaf, 0x36, 0x1f, 0x4b, 0x24, 0x5e, 0x3f, 0xde, 0xb3,
+	0xdb, 0xab, 0xe2, 0x9a, 0xa1, 0x5b, 0xd2, 0x9d, 0x95, 0xb2, 0xdd, 0x99, 0xd5, 0xa8, 0x94, 0xd3,
+	0x8d, 0x4a, 0xd2, 0x1c, 0x54, 0xec, 0xe6, 0x00, 0x45, 0xa1, 0x86, 0xd5, 0xf2, 0xae, 0x6a, 0x95,
+	0x1b, 0x38, 0x2d, 0xc4, 0x5a, 0x56, 0x88, 0x6f, 0xb2, 0x72, 0x24, 0x3f, 0x76, 0xb1, 0xb5, 0x1f,
+	0xbb, 0x14, 0x47, 0xf3, 0x4f, 0x79, 0x56, 0x07, 0xb3, 0x8a, 0x23, 0x1a, 0x2e, 0xcb, 0x5b, 0x32,
+	0x6b, 0x43, 0xcd, 0xf3, 0x87, 0xbf, 0x7b, 0x49, 0xa7, 0x5d, 0x50, 0x37, 0x9f, 0xb2, 0x6f, 0xcd,
+	0xb6, 0xda, 0x96, 0x05, 0x15, 0xd7, 0x5b, 0x50, 0x29, 0xdb, 0x33, 0x87, 0xa6, 0x3f, 0x4f, 0x5b,
+	0x7b, 0x6e, 0xc9, 0xda, 0xd7, 0x5b, 0xc1, 0x5b, 0xa0, 0x12, 0xe2, 0x53, 0xd1, 0x72, 0xed, 0x58,
+	0x40, 0xb1, 0xa1, 0x69, 0x3a, 0xcb, 0x01, 0x86, 0x7a, 0x30, 0xfc, 0x56, 0x82, 0xb0, 0x9f, 0x88,
+	0x33, 0x8d, 0xc4, 0xfb, 0x22, 0x16, 0x42, 0x06, 0x58, 0x15, 0xdb, 0x96, 0x09, 0xd8, 0xbd, 0xaa,
+	0xb0, 0x65, 0xb9, 0xa9, 0x8d, 0xa2, 0xcb, 0xa7, 0x1a, 0xec, 0xdb, 0x02, 0xcc, 0xa2, 0x3f, 0x42,
+	0xbe, 0xbe, 0xc7, 0x6e, 0x5b, 0x5b, 0x1b, 0x64, 0x2a, 0xa8, 0x95, 0x34, 0x6a, 0x21, 0xf5, 0x06,
+	0x06, 0x99, 0x10, 0xb0, 0x82, 0xd2, 0x3c, 0x64, 0x5b, 0x5a, 0xa6, 0x6d, 0xcc, 0x63, 0xd9, 0xc8,
+	0x9e, 0xbb, 0x61, 0x64, 0xff, 0x43, 0x9e, 0x15, 0xfb, 0x7e, 0x18, 0xad, 0xbc, 0xf8, 0xd5, 0xc8,
+	0x4c, 0x4d, 0x32, 0x21, 0x4f, 0x5e, 0x5c, 0x28, 0xd8, 0x17, 0x17, 0xf0, 0x2a, 0xaa, 0x3f, 0x9f,
+	0xe0, 0x75, 0x4b, 0x3d, 0xe7, 0xd7, 0xf0, 0xb3, 0xdd, 0x66, 0x7b, 0xfe, 0x0f, 0x00, 0xc9, 0xbd,
+	0xdc, 0xca, 0x8d, 0xef, 0xe5, 0x26, 0xde, 0x54, 0x5d, 0xef, 0x4d, 0xd9, 0x50, 0xd2, 0xfc, 0x0d,
+	0xb4, 0xdc, 0x4b, 0xc1, 0xe3, 0x29, 0x83, 0x28, 0x2b, 0x86, 0xe6, 0x97, 0x3e, 0x91, 0xac, 0xcd,
+	0x2f, 0x77, 0x55, 0x9b, 0x96, 0xb2, 0x58, 0x1b, 0x75, 0xbd, 0xdf, 0x1f, 0x14, 0xdf, 0xcb, 0x2f,
+	0xce, 0xcf, 0xcb, 0x24, 0x81, 0xbf, 0xff, 0x7f, 0x35, 0x89, 0xa6, 0x68, 0xe2, 0x31, 0x00, 0x00,
 }
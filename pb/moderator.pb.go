@@ -7,6 +7,7 @@ package pb
 import proto "github.com/golang/protobuf/proto"
 import fmt "fmt"
 import math "math"
+import google_protobuf "github.com/golang/protobuf/ptypes/timestamp"
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
@@ -37,11 +38,44 @@ func (x Moderator_Fee_FeeType) String() string {
 }
 func (Moderator_Fee_FeeType) EnumDescriptor() ([]byte, []int) { return fileDescriptor4, []int{0, 0, 0} }
 
+type Report_Reason int32
+
+const (
+	Report_OTHER           Report_Reason = 0
+	Report_SCAM            Report_Reason = 1
+	Report_PROHIBITED_ITEM Report_Reason = 2
+	Report_COUNTERFEIT     Report_Reason = 3
+	Report_MISREPRESENTED  Report_Reason = 4
+)
+
+var Report_Reason_name = map[int32]string{
+	0: "OTHER",
+	1: "SCAM",
+	2: "PROHIBITED_ITEM",
+	3: "COUNTERFEIT",
+	4: "MISREPRESENTED",
+}
+var Report_Reason_value = map[string]int32{
+	"OTHER":           0,
+	"SCAM":            1,
+	"PROHIBITED_ITEM": 2,
+	"COUNTERFEIT":     3,
+	"MISREPRESENTED":  4,
+}
+
+func (x Report_Reason) String() string {
+	return proto.EnumName(Report_Reason_name, int32(x))
+}
+func (Report_Reason) EnumDescriptor() ([]byte, []int) { return fileDescriptor4, []int{2, 0} }
+
 type Moderator struct {
-	Description        string         `protobuf:"bytes,1,opt,name=description" json:"description,omitempty"`
-	TermsAndConditions string         `protobuf:"bytes,2,opt,name=termsAndConditions" json:"termsAndConditions,omitempty"`
-	Languages          []string       `protobuf:"bytes,3,rep,name=languages" json:"languages,omitempty"`
-	Fee                *Moderator_Fee `protobuf:"bytes,4,opt,name=fee" json:"fee,omitempty"`
+	Description        string           `protobuf:"bytes,1,opt,name=description" json:"description,omitempty"`
+	TermsAndConditions string           `protobuf:"bytes,2,opt,name=termsAndConditions" json:"termsAndConditions,omitempty"`
+	Languages          []string         `protobuf:"bytes,3,rep,name=languages" json:"languages,omitempty"`
+	Fee                *Moderator_Fee   `protobuf:"bytes,4,opt,name=fee" json:"fee,omitempty"`
+	Stats              *Moderator_Stats `protobuf:"bytes,5,opt,name=stats" json:"stats,omitempty"`
+	AcceptsReports     bool             `protobuf:"varint,6,opt,name=acceptsReports" json:"acceptsReports,omitempty"`
+	FeeAddress         string           `protobuf:"bytes,7,opt,name=feeAddress" json:"feeAddress,omitempty"`
 }
 
 func (m *Moderator) Reset()                    { *m = Moderator{} }
@@ -77,6 +111,27 @@ func (m *Moderator) GetFee() *Moderator_Fee {
 	return nil
 }
 
+func (m *Moderator) GetStats() *Moderator_Stats {
+	if m != nil {
+		return m.Stats
+	}
+	return nil
+}
+
+func (m *Moderator) GetAcceptsReports() bool {
+	if m != nil {
+		return m.AcceptsReports
+	}
+	return false
+}
+
+func (m *Moderator) GetFeeAddress() string {
+	if m != nil {
+		return m.FeeAddress
+	}
+	return ""
+}
+
 type Moderator_Fee struct {
 	FixedFee   *Moderator_Price      `protobuf:"bytes,1,opt,name=fixedFee" json:"fixedFee,omitempty"`
 	Percentage float32               `protobuf:"fixed32,2,opt,name=percentage" json:"percentage,omitempty"`
@@ -133,11 +188,94 @@ func (m *Moderator_Price) GetAmount() uint64 {
 	return 0
 }
 
+// Summary of the moderator's closed cases, signed by the moderator
+type Moderator_Stats struct {
+	CasesHandled           uint32                     `protobuf:"varint,1,opt,name=casesHandled" json:"casesHandled,omitempty"`
+	MedianResolutionTime   uint64                     `protobuf:"varint,2,opt,name=medianResolutionTime" json:"medianResolutionTime,omitempty"`
+	BuyerFavored           uint32                     `protobuf:"varint,3,opt,name=buyerFavored" json:"buyerFavored,omitempty"`
+	VendorFavored          uint32                     `protobuf:"varint,4,opt,name=vendorFavored" json:"vendorFavored,omitempty"`
+	Split                  uint32                     `protobuf:"varint,5,opt,name=split" json:"split,omitempty"`
+	AverageBuyerPercentage float32                    `protobuf:"fixed32,6,opt,name=averageBuyerPercentage" json:"averageBuyerPercentage,omitempty"`
+	Timestamp              *google_protobuf.Timestamp `protobuf:"bytes,7,opt,name=timestamp" json:"timestamp,omitempty"`
+	Pubkey                 []byte                     `protobuf:"bytes,8,opt,name=pubkey,proto3" json:"pubkey,omitempty"`
+	Signature              []byte                     `protobuf:"bytes,9,opt,name=signature,proto3" json:"signature,omitempty"`
+}
+
+func (m *Moderator_Stats) Reset()                    { *m = Moderator_Stats{} }
+func (m *Moderator_Stats) String() string            { return proto.CompactTextString(m) }
+func (*Moderator_Stats) ProtoMessage()               {}
+func (*Moderator_Stats) Descriptor() ([]byte, []int) { return fileDescriptor4, []int{0, 2} }
+
+func (m *Moderator_Stats) GetCasesHandled() uint32 {
+	if m != nil {
+		return m.CasesHandled
+	}
+	return 0
+}
+
+func (m *Moderator_Stats) GetMedianResolutionTime() uint64 {
+	if m != nil {
+		return m.MedianResolutionTime
+	}
+	return 0
+}
+
+func (m *Moderator_Stats) GetBuyerFavored() uint32 {
+	if m != nil {
+		return m.BuyerFavored
+	}
+	return 0
+}
+
+func (m *Moderator_Stats) GetVendorFavored() uint32 {
+	if m != nil {
+		return m.VendorFavored
+	}
+	return 0
+}
+
+func (m *Moderator_Stats) GetSplit() uint32 {
+	if m != nil {
+		return m.Split
+	}
+	return 0
+}
+
+func (m *Moderator_Stats) GetAverageBuyerPercentage() float32 {
+	if m != nil {
+		return m.AverageBuyerPercentage
+	}
+	return 0
+}
+
+func (m *Moderator_Stats) GetTimestamp() *google_protobuf.Timestamp {
+	if m != nil {
+		return m.Timestamp
+	}
+	return nil
+}
+
+func (m *Moderator_Stats) GetPubkey() []byte {
+	if m != nil {
+		return m.Pubkey
+	}
+	return nil
+}
+
+func (m *Moderator_Stats) GetSignature() []byte {
+	if m != nil {
+		return m.Signature
+	}
+	return nil
+}
+
 type DisputeUpdate struct {
-	OrderId            string      `protobuf:"bytes,1,opt,name=orderId" json:"orderId,omitempty"`
-	PayoutAddress      string      `protobuf:"bytes,2,opt,name=payoutAddress" json:"payoutAddress,omitempty"`
-	Outpoints          []*Outpoint `protobuf:"bytes,3,rep,name=outpoints" json:"outpoints,omitempty"`
-	SerializedContract []byte      `protobuf:"bytes,4,opt,name=serializedContract,proto3" json:"serializedContract,omitempty"`
+	OrderId            string               `protobuf:"bytes,1,opt,name=orderId" json:"orderId,omitempty"`
+	PayoutAddress      string               `protobuf:"bytes,2,opt,name=payoutAddress" json:"payoutAddress,omitempty"`
+	Outpoints          []*Outpoint          `protobuf:"bytes,3,rep,name=outpoints" json:"outpoints,omitempty"`
+	SerializedContract []byte               `protobuf:"bytes,4,opt,name=serializedContract,proto3" json:"serializedContract,omitempty"`
+	Attachments        []*DisputeAttachment `protobuf:"bytes,5,rep,name=attachments" json:"attachments,omitempty"`
+	Signature          []byte               `protobuf:"bytes,6,opt,name=signature,proto3" json:"signature,omitempty"`
 }
 
 func (m *DisputeUpdate) Reset()                    { *m = DisputeUpdate{} }
@@ -173,42 +311,265 @@ func (m *DisputeUpdate) GetSerializedContract() []byte {
 	return nil
 }
 
+func (m *DisputeUpdate) GetAttachments() []*DisputeAttachment {
+	if m != nil {
+		return m.Attachments
+	}
+	return nil
+}
+
+func (m *DisputeUpdate) GetSignature() []byte {
+	if m != nil {
+		return m.Signature
+	}
+	return nil
+}
+
+// An abuse report for a listing or a store, sent to moderators who accept reports
+type Report struct {
+	ReportId    string                     `protobuf:"bytes,1,opt,name=reportId" json:"reportId,omitempty"`
+	ReporterID  string                     `protobuf:"bytes,2,opt,name=reporterID" json:"reporterID,omitempty"`
+	ListingHash string                     `protobuf:"bytes,3,opt,name=listingHash" json:"listingHash,omitempty"`
+	PeerID      string                     `protobuf:"bytes,4,opt,name=peerID" json:"peerID,omitempty"`
+	Reason      Report_Reason              `protobuf:"varint,5,opt,name=reason,enum=Report_Reason" json:"reason,omitempty"`
+	Evidence    string                     `protobuf:"bytes,6,opt,name=evidence" json:"evidence,omitempty"`
+	Timestamp   *google_protobuf.Timestamp `protobuf:"bytes,7,opt,name=timestamp" json:"timestamp,omitempty"`
+	Pubkey      []byte                     `protobuf:"bytes,8,opt,name=pubkey,proto3" json:"pubkey,omitempty"`
+	Signature   []byte                     `protobuf:"bytes,9,opt,name=signature,proto3" json:"signature,omitempty"`
+}
+
+func (m *Report) Reset()                    { *m = Report{} }
+func (m *Report) String() string            { return proto.CompactTextString(m) }
+func (*Report) ProtoMessage()               {}
+func (*Report) Descriptor() ([]byte, []int) { return fileDescriptor4, []int{2} }
+
+func (m *Report) GetReportId() string {
+	if m != nil {
+		return m.ReportId
+	}
+	return ""
+}
+
+func (m *Report) GetReporterID() string {
+	if m != nil {
+		return m.ReporterID
+	}
+	return ""
+}
+
+func (m *Report) GetListingHash() string {
+	if m != nil {
+		return m.ListingHash
+	}
+	return ""
+}
+
+func (m *Report) GetPeerID() string {
+	if m != nil {
+		return m.PeerID
+	}
+	return ""
+}
+
+func (m *Report) GetReason() Report_Reason {
+	if m != nil {
+		return m.Reason
+	}
+	return Report_OTHER
+}
+
+func (m *Report) GetEvidence() string {
+	if m != nil {
+		return m.Evidence
+	}
+	return ""
+}
+
+func (m *Report) GetTimestamp() *google_protobuf.Timestamp {
+	if m != nil {
+		return m.Timestamp
+	}
+	return nil
+}
+
+func (m *Report) GetPubkey() []byte {
+	if m != nil {
+		return m.Pubkey
+	}
+	return nil
+}
+
+func (m *Report) GetSignature() []byte {
+	if m != nil {
+		return m.Signature
+	}
+	return nil
+}
+
+// Listings and stores flagged by a moderator. Published in the moderator's root
+// directory and signed by the moderator.
+type Blocklist struct {
+	ModeratorID string                     `protobuf:"bytes,1,opt,name=moderatorID" json:"moderatorID,omitempty"`
+	Entries     []*Blocklist_Entry         `protobuf:"bytes,2,rep,name=entries" json:"entries,omitempty"`
+	Timestamp   *google_protobuf.Timestamp `protobuf:"bytes,3,opt,name=timestamp" json:"timestamp,omitempty"`
+	Pubkey      []byte                     `protobuf:"bytes,4,opt,name=pubkey,proto3" json:"pubkey,omitempty"`
+	Signature   []byte                     `protobuf:"bytes,5,opt,name=signature,proto3" json:"signature,omitempty"`
+}
+
+func (m *Blocklist) Reset()                    { *m = Blocklist{} }
+func (m *Blocklist) String() string            { return proto.CompactTextString(m) }
+func (*Blocklist) ProtoMessage()               {}
+func (*Blocklist) Descriptor() ([]byte, []int) { return fileDescriptor4, []int{3} }
+
+func (m *Blocklist) GetModeratorID() string {
+	if m != nil {
+		return m.ModeratorID
+	}
+	return ""
+}
+
+func (m *Blocklist) GetEntries() []*Blocklist_Entry {
+	if m != nil {
+		return m.Entries
+	}
+	return nil
+}
+
+func (m *Blocklist) GetTimestamp() *google_protobuf.Timestamp {
+	if m != nil {
+		return m.Timestamp
+	}
+	return nil
+}
+
+func (m *Blocklist) GetPubkey() []byte {
+	if m != nil {
+		return m.Pubkey
+	}
+	return nil
+}
+
+func (m *Blocklist) GetSignature() []byte {
+	if m != nil {
+		return m.Signature
+	}
+	return nil
+}
+
+// An entry with a listing hash hides that listing. An entry with only a peer ID
+// blocks the whole store.
+type Blocklist_Entry struct {
+	ListingHash string                     `protobuf:"bytes,1,opt,name=listingHash" json:"listingHash,omitempty"`
+	PeerID      string                     `protobuf:"bytes,2,opt,name=peerID" json:"peerID,omitempty"`
+	Reason      Report_Reason              `protobuf:"varint,3,opt,name=reason,enum=Report_Reason" json:"reason,omitempty"`
+	Added       *google_protobuf.Timestamp `protobuf:"bytes,4,opt,name=added" json:"added,omitempty"`
+}
+
+func (m *Blocklist_Entry) Reset()                    { *m = Blocklist_Entry{} }
+func (m *Blocklist_Entry) String() string            { return proto.CompactTextString(m) }
+func (*Blocklist_Entry) ProtoMessage()               {}
+func (*Blocklist_Entry) Descriptor() ([]byte, []int) { return fileDescriptor4, []int{3, 0} }
+
+func (m *Blocklist_Entry) GetListingHash() string {
+	if m != nil {
+		return m.ListingHash
+	}
+	return ""
+}
+
+func (m *Blocklist_Entry) GetPeerID() string {
+	if m != nil {
+		return m.PeerID
+	}
+	return ""
+}
+
+func (m *Blocklist_Entry) GetReason() Report_Reason {
+	if m != nil {
+		return m.Reason
+	}
+	return Report_OTHER
+}
+
+func (m *Blocklist_Entry) GetAdded() *google_protobuf.Timestamp {
+	if m != nil {
+		return m.Added
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*Moderator)(nil), "Moderator")
 	proto.RegisterType((*Moderator_Fee)(nil), "Moderator.Fee")
 	proto.RegisterType((*Moderator_Price)(nil), "Moderator.Price")
+	proto.RegisterType((*Moderator_Stats)(nil), "Moderator.Stats")
 	proto.RegisterType((*DisputeUpdate)(nil), "DisputeUpdate")
+	proto.RegisterType((*Report)(nil), "Report")
+	proto.RegisterType((*Blocklist)(nil), "Blocklist")
+	proto.RegisterType((*Blocklist_Entry)(nil), "Blocklist.Entry")
 	proto.RegisterEnum("Moderator_Fee_FeeType", Moderator_Fee_FeeType_name, Moderator_Fee_FeeType_value)
+	proto.RegisterEnum("Report_Reason", Report_Reason_name, Report_Reason_value)
 }
 
 func init() { proto.RegisterFile("moderator.proto", fileDescriptor4) }
 
 var fileDescriptor4 = []byte{
-	// 409 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x09, 0x6e, 0x88, 0x02, 0xff, 0x6c, 0x92, 0xdf, 0x6a, 0xdb, 0x30,
-	0x14, 0xc6, 0xe7, 0x38, 0x6d, 0xe6, 0x93, 0x36, 0x0d, 0x07, 0x56, 0xbc, 0x30, 0x86, 0x09, 0x83,
-	0xe5, 0x62, 0x98, 0x91, 0x3d, 0xc0, 0xc8, 0xd2, 0x64, 0x14, 0xf6, 0x27, 0x68, 0x2d, 0x8c, 0xdd,
-	0x14, 0xd5, 0x3a, 0x09, 0x82, 0x46, 0x12, 0x92, 0x0c, 0xcb, 0x9e, 0x68, 0xb0, 0xf7, 0xd8, 0x73,
-	0x15, 0xab, 0x76, 0xeb, 0x40, 0x2e, 0x74, 0x71, 0x7e, 0xdf, 0x87, 0xf4, 0xa1, 0xef, 0xc0, 0xd9,
-	0x56, 0x0b, 0xb2, 0xdc, 0x6b, 0x9b, 0x1b, 0xab, 0xbd, 0x1e, 0x9d, 0x15, 0x5a, 0x79, 0xcb, 0x0b,
-	0xef, 0x1e, 0xc0, 0xf8, 0x5f, 0x0c, 0xc9, 0xd7, 0xc6, 0x84, 0x19, 0xf4, 0x05, 0xb9, 0xc2, 0x4a,
-	0xe3, 0xa5, 0x56, 0x69, 0x94, 0x45, 0x93, 0x84, 0xb5, 0x11, 0xe6, 0x80, 0x9e, 0xec, 0xd6, 0xcd,
-	0x94, 0x98, 0x6b, 0x25, 0x64, 0x05, 0x5d, 0xda, 0x09, 0xc6, 0x03, 0x0a, 0xbe, 0x82, 0xe4, 0x8e,
-	0xab, 0x4d, 0xc9, 0x37, 0xe4, 0xd2, 0x38, 0x8b, 0x27, 0x09, 0x7b, 0x02, 0x98, 0x41, 0xbc, 0x26,
-	0x4a, 0xbb, 0x59, 0x34, 0xe9, 0x4f, 0x07, 0xf9, 0x63, 0x90, 0x7c, 0x49, 0xc4, 0x2a, 0x69, 0xf4,
-	0x3f, 0x82, 0x78, 0x49, 0x84, 0xef, 0xe0, 0xf9, 0x5a, 0xfe, 0x26, 0xb1, 0x24, 0x0a, 0xb1, 0xfa,
-	0xd3, 0x61, 0xcb, 0xbe, 0xb2, 0xb2, 0x20, 0xf6, 0xe8, 0xc0, 0xd7, 0x00, 0x86, 0x6c, 0x41, 0xca,
-	0xf3, 0x0d, 0x85, 0x74, 0x1d, 0xd6, 0x22, 0xf8, 0x1e, 0x7a, 0x6b, 0xa2, 0xab, 0x9d, 0xa1, 0x34,
-	0xce, 0xa2, 0xc9, 0x60, 0x7a, 0xbe, 0xff, 0x76, 0x75, 0x2a, 0x95, 0x35, 0xb6, 0xf1, 0x47, 0xe8,
-	0xd5, 0x0c, 0x13, 0x38, 0x5a, 0x5e, 0xfe, 0x5c, 0x5c, 0x0c, 0x9f, 0xe1, 0x00, 0x60, 0xb5, 0x60,
-	0xf3, 0xc5, 0xb7, 0xab, 0xd9, 0xe7, 0xc5, 0x30, 0xc2, 0x97, 0xf0, 0x22, 0x48, 0x37, 0xab, 0x2f,
-	0xd7, 0x3f, 0x6e, 0x5a, 0x52, 0x67, 0x34, 0x87, 0xa3, 0x90, 0x12, 0xc7, 0x70, 0x52, 0x94, 0xd6,
-	0x92, 0x2a, 0x76, 0x73, 0x2d, 0xa8, 0xfe, 0xe4, 0x3d, 0x86, 0xe7, 0x70, 0xcc, 0xb7, 0xba, 0x54,
-	0x3e, 0x64, 0xef, 0xb2, 0x7a, 0x1a, 0xff, 0x8d, 0xe0, 0xf4, 0x42, 0x3a, 0x53, 0x7a, 0xba, 0x36,
-	0x82, 0x7b, 0xc2, 0x14, 0x7a, 0xda, 0x0a, 0xb2, 0x97, 0xa2, 0xbe, 0xa8, 0x19, 0xf1, 0x0d, 0x9c,
-	0x1a, 0xbe, 0xd3, 0xa5, 0x9f, 0x09, 0x61, 0xc9, 0x35, 0x25, 0xed, 0x43, 0x7c, 0x0b, 0x89, 0x2e,
-	0xbd, 0xd1, 0x52, 0xf9, 0x87, 0x7e, 0xfa, 0xd3, 0x24, 0xff, 0x5e, 0x13, 0xf6, 0xa4, 0x55, 0xc5,
-	0x3b, 0xb2, 0x92, 0xdf, 0xc9, 0x3f, 0x54, 0x15, 0x1c, 0xb6, 0x28, 0x34, 0x77, 0xc2, 0x0e, 0x28,
-	0x9f, 0xba, 0xbf, 0x3a, 0xe6, 0xf6, 0xf6, 0x38, 0x6c, 0xd9, 0x87, 0xfb, 0x00, 0x00, 0x00, 0xff,
-	0xff, 0xd6, 0x38, 0x96, 0x16, 0x89, 0x02, 0x00, 0x00,
+	// 907 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x09, 0x6e, 0x88, 0x02, 0xff, 0xbc, 0x55, 0xc1, 0x6e, 0xeb, 0x44,
+	0x17, 0xbe, 0x8e, 0xe3, 0xa4, 0x3e, 0x69, 0xd3, 0x68, 0xfe, 0xfb, 0x57, 0x26, 0x42, 0x10, 0x55,
+	0xa8, 0x44, 0x08, 0xf9, 0x5e, 0x05, 0x84, 0xd8, 0xa1, 0x36, 0x71, 0x68, 0x24, 0xda, 0x46, 0x53,
+	0x57, 0x20, 0x36, 0xd5, 0xc4, 0x73, 0x92, 0x6b, 0xdd, 0xc4, 0x63, 0xcd, 0x8c, 0x2b, 0xc2, 0x9e,
+	0x15, 0x8f, 0xc0, 0xfb, 0xf0, 0x2a, 0xf7, 0x25, 0x58, 0x20, 0x8f, 0x1d, 0x27, 0x29, 0x6d, 0x17,
+	0x2c, 0xd8, 0xf9, 0x7c, 0xdf, 0xe7, 0xe3, 0x73, 0xbe, 0x39, 0x67, 0x0c, 0xc7, 0x2b, 0xc1, 0x51,
+	0x32, 0x2d, 0xa4, 0x9f, 0x4a, 0xa1, 0x45, 0xf7, 0xd3, 0x85, 0x10, 0x8b, 0x25, 0xbe, 0x31, 0xd1,
+	0x2c, 0x9b, 0xbf, 0xd1, 0xf1, 0x0a, 0x95, 0x66, 0xab, 0xb4, 0x14, 0x1c, 0x47, 0x22, 0xd1, 0x92,
+	0x45, 0x5a, 0x15, 0xc0, 0xe9, 0x6f, 0x4d, 0x70, 0xaf, 0x36, 0x59, 0x48, 0x0f, 0x5a, 0x1c, 0x55,
+	0x24, 0xe3, 0x54, 0xc7, 0x22, 0xf1, 0xac, 0x9e, 0xd5, 0x77, 0xe9, 0x2e, 0x44, 0x7c, 0x20, 0x1a,
+	0xe5, 0x4a, 0x9d, 0x27, 0x7c, 0x28, 0x12, 0x1e, 0xe7, 0xa0, 0xf2, 0x6a, 0x46, 0xf8, 0x04, 0x43,
+	0x3e, 0x06, 0x77, 0xc9, 0x92, 0x45, 0xc6, 0x16, 0xa8, 0x3c, 0xbb, 0x67, 0xf7, 0x5d, 0xba, 0x05,
+	0x48, 0x0f, 0xec, 0x39, 0xa2, 0x57, 0xef, 0x59, 0xfd, 0xd6, 0xa0, 0xed, 0x57, 0x85, 0xf8, 0x63,
+	0x44, 0x9a, 0x53, 0xe4, 0x0c, 0x1c, 0xa5, 0x99, 0x56, 0x9e, 0x63, 0x34, 0x9d, 0x1d, 0xcd, 0x6d,
+	0x8e, 0xd3, 0x82, 0x26, 0x67, 0xd0, 0x66, 0x51, 0x84, 0xa9, 0x56, 0x14, 0x53, 0x21, 0xb5, 0xf2,
+	0x1a, 0x3d, 0xab, 0x7f, 0x40, 0x1f, 0xa1, 0xe4, 0x13, 0x80, 0x39, 0xe2, 0x39, 0xe7, 0x12, 0x95,
+	0xf2, 0x9a, 0xa6, 0xee, 0x1d, 0xa4, 0xfb, 0xa7, 0x05, 0xf6, 0x18, 0x91, 0x7c, 0x09, 0x07, 0xf3,
+	0xf8, 0x17, 0xe4, 0x63, 0x44, 0xcf, 0xfa, 0xc7, 0xa7, 0xa7, 0x32, 0x8e, 0x90, 0x56, 0x8a, 0x3c,
+	0x6b, 0x8a, 0x32, 0xc2, 0x44, 0xb3, 0x05, 0x1a, 0x37, 0x6a, 0x74, 0x07, 0x21, 0x6f, 0xa1, 0x39,
+	0x47, 0x0c, 0xd7, 0x29, 0x7a, 0x76, 0xcf, 0xea, 0xb7, 0x07, 0x27, 0xfb, 0xbd, 0xfa, 0xe3, 0x82,
+	0xa5, 0x1b, 0xd9, 0xe9, 0x77, 0xd0, 0x2c, 0x31, 0xe2, 0x82, 0x33, 0x9e, 0xfc, 0x14, 0x8c, 0x3a,
+	0xaf, 0x48, 0x1b, 0x60, 0x1a, 0xd0, 0x61, 0x70, 0x1d, 0x9e, 0x7f, 0x1f, 0x74, 0x2c, 0xf2, 0x11,
+	0xfc, 0xdf, 0x50, 0xf7, 0xd3, 0x1f, 0xee, 0x6e, 0xef, 0x77, 0xa8, 0x5a, 0x77, 0x08, 0x8e, 0xa9,
+	0x92, 0x9c, 0xc2, 0x61, 0x94, 0x49, 0x89, 0x49, 0xb4, 0x1e, 0x0a, 0x8e, 0xe5, 0xa1, 0xee, 0x61,
+	0xe4, 0x04, 0x1a, 0x6c, 0x25, 0xb2, 0x44, 0x9b, 0xda, 0xeb, 0xb4, 0x8c, 0xba, 0x1f, 0x6a, 0xe0,
+	0x18, 0x9b, 0x4d, 0x16, 0xa6, 0x50, 0x5d, 0xb2, 0x84, 0x2f, 0x91, 0x9b, 0x2c, 0x47, 0x74, 0x0f,
+	0x23, 0x03, 0x78, 0xbd, 0x42, 0x1e, 0xb3, 0x84, 0xa2, 0x12, 0xcb, 0x2c, 0x1f, 0x80, 0x30, 0x5e,
+	0x61, 0x99, 0xf3, 0x49, 0x2e, 0xcf, 0x3b, 0xcb, 0xd6, 0x28, 0xc7, 0xec, 0x41, 0x48, 0xe4, 0xc6,
+	0x9e, 0x23, 0xba, 0x87, 0x91, 0xcf, 0xe0, 0xe8, 0x01, 0x13, 0x2e, 0x2a, 0x51, 0xdd, 0x88, 0xf6,
+	0x41, 0xf2, 0x1a, 0x1c, 0x95, 0x2e, 0x63, 0x6d, 0x26, 0xe5, 0x88, 0x16, 0x01, 0xf9, 0x06, 0x4e,
+	0xd8, 0x03, 0x4a, 0xb6, 0xc0, 0x8b, 0x3c, 0xe5, 0x74, 0x7b, 0x4a, 0x0d, 0x73, 0x4a, 0xcf, 0xb0,
+	0xe4, 0x5b, 0x70, 0xab, 0xdd, 0x31, 0x63, 0xd2, 0x1a, 0x74, 0xfd, 0x62, 0xbb, 0xfc, 0xcd, 0x76,
+	0xf9, 0xe1, 0x46, 0x41, 0xb7, 0xe2, 0xdc, 0xcb, 0x34, 0x9b, 0xbd, 0xc7, 0xb5, 0x77, 0xd0, 0xb3,
+	0xfa, 0x87, 0xb4, 0x8c, 0xf2, 0x4d, 0x50, 0xf1, 0x22, 0x61, 0x3a, 0x93, 0xe8, 0xb9, 0x86, 0xda,
+	0x02, 0xa7, 0x7f, 0x59, 0x70, 0x34, 0x8a, 0x55, 0x9a, 0x69, 0xbc, 0x4b, 0x39, 0xd3, 0x48, 0x3c,
+	0x68, 0x0a, 0xc9, 0x51, 0x4e, 0x78, 0x79, 0x64, 0x9b, 0x30, 0xf7, 0x23, 0x65, 0x6b, 0x91, 0xe9,
+	0xcd, 0x18, 0x17, 0xeb, 0xb7, 0x0f, 0x92, 0xcf, 0xc1, 0x15, 0x99, 0x4e, 0x45, 0x9c, 0xe8, 0x62,
+	0xf3, 0x5a, 0x03, 0xd7, 0xbf, 0x29, 0x11, 0xba, 0xe5, 0xf2, 0x95, 0x56, 0x28, 0x63, 0xb6, 0x8c,
+	0x7f, 0x45, 0x3e, 0x2c, 0xef, 0x07, 0xe3, 0xf1, 0x21, 0x7d, 0x82, 0x21, 0x5f, 0x43, 0x8b, 0x69,
+	0xcd, 0xa2, 0x77, 0x2b, 0x4c, 0xcc, 0x62, 0xe6, 0xa9, 0x89, 0x5f, 0x56, 0x7f, 0x5e, 0x51, 0x74,
+	0x57, 0xb6, 0xdf, 0x7e, 0xe3, 0x71, 0xfb, 0xbf, 0xdb, 0xd0, 0x28, 0x56, 0x94, 0x74, 0xe1, 0x40,
+	0x9a, 0xa7, 0xaa, 0xf1, 0x2a, 0xce, 0xf7, 0xac, 0x78, 0x46, 0x39, 0x19, 0x95, 0x6d, 0xef, 0x20,
+	0xf9, 0xfd, 0xb5, 0x8c, 0x95, 0x8e, 0x93, 0xc5, 0x25, 0x53, 0xef, 0xcc, 0x30, 0xb9, 0x74, 0x17,
+	0x32, 0xa7, 0x83, 0xe6, 0xed, 0xba, 0x21, 0xcb, 0x88, 0x9c, 0x41, 0x43, 0x22, 0x53, 0x22, 0x31,
+	0xe3, 0xd3, 0x1e, 0xb4, 0xfd, 0xa2, 0x1c, 0x9f, 0x1a, 0x94, 0x96, 0x6c, 0x5e, 0x1d, 0x3e, 0xc4,
+	0x1c, 0x93, 0xa8, 0xe8, 0xc2, 0xa5, 0x55, 0xfc, 0x9f, 0xcf, 0xcc, 0x8f, 0xb9, 0x67, 0xa6, 0x2a,
+	0x17, 0x9c, 0x9b, 0xf0, 0x32, 0xa0, 0x9d, 0x57, 0xe4, 0x00, 0xea, 0xb7, 0xc3, 0xf3, 0xab, 0x8e,
+	0x45, 0xfe, 0x07, 0xc7, 0x53, 0x7a, 0x73, 0x39, 0xb9, 0x98, 0x84, 0xc1, 0xe8, 0x7e, 0x12, 0x06,
+	0x57, 0x9d, 0x1a, 0x39, 0x86, 0xd6, 0xf0, 0xe6, 0xee, 0x3a, 0x0c, 0xe8, 0x38, 0x98, 0x84, 0x1d,
+	0x9b, 0x10, 0x68, 0x5f, 0x4d, 0x6e, 0x69, 0x30, 0xa5, 0xc1, 0x6d, 0x70, 0x1d, 0x06, 0xa3, 0x4e,
+	0xfd, 0xf4, 0x43, 0x0d, 0xdc, 0x8b, 0xa5, 0x88, 0xde, 0xe7, 0xce, 0xe5, 0xa6, 0x56, 0xff, 0x99,
+	0xc9, 0x68, 0xf3, 0x53, 0xd8, 0x81, 0xc8, 0x17, 0xd0, 0xc4, 0x44, 0xcb, 0x18, 0xf3, 0x51, 0xb4,
+	0xcd, 0x5d, 0x59, 0xbd, 0xee, 0x07, 0x89, 0x96, 0x6b, 0xba, 0x11, 0xec, 0x9b, 0x64, 0xff, 0x3b,
+	0x93, 0xea, 0xcf, 0x9b, 0xe4, 0x3c, 0x32, 0xa9, 0xfb, 0x87, 0x05, 0x8e, 0x29, 0xe1, 0xf1, 0x70,
+	0x58, 0x2f, 0x0d, 0x47, 0xed, 0x99, 0xe1, 0xb0, 0x5f, 0x1c, 0x8e, 0xb7, 0xe0, 0x30, 0xce, 0xcb,
+	0x0b, 0xea, 0xe5, 0xbe, 0x0a, 0xe1, 0x45, 0xfd, 0xe7, 0x5a, 0x3a, 0x9b, 0x35, 0x8c, 0xe0, 0xab,
+	0xbf, 0x07, 0x00, 0x2f, 0x1a, 0xe9, 0x1b, 0xd0, 0x07, 0x00, 0x00,
 }
@@ -32,6 +32,7 @@ type Profile struct {
 	Stats            *Profile_Stats             `protobuf:"bytes,15,opt,name=stats" json:"stats,omitempty"`
 	BitcoinPubkey    string                     `protobuf:"bytes,16,opt,name=bitcoinPubkey" json:"bitcoinPubkey,omitempty"`
 	LastModified     *google_protobuf.Timestamp `protobuf:"bytes,17,opt,name=lastModified" json:"lastModified,omitempty"`
+	Revision         *Profile_Revision          `protobuf:"bytes,18,opt,name=revision" json:"revision,omitempty"`
 }
 
 func (m *Profile) Reset()                    { *m = Profile{} }
@@ -158,6 +159,13 @@ func (m *Profile) GetLastModified() *google_protobuf.Timestamp {
 	return nil
 }
 
+func (m *Profile) GetRevision() *Profile_Revision {
+	if m != nil {
+		return m.Revision
+	}
+	return nil
+}
+
 type Profile_Contact struct {
 	Website     string                   `protobuf:"bytes,1,opt,name=website" json:"website,omitempty"`
 	Email       string                   `protobuf:"bytes,2,opt,name=email" json:"email,omitempty"`
@@ -202,6 +210,7 @@ type Profile_SocialAccount struct {
 	Type     string `protobuf:"bytes,1,opt,name=type" json:"type,omitempty"`
 	Username string `protobuf:"bytes,2,opt,name=username" json:"username,omitempty"`
 	Proof    string `protobuf:"bytes,3,opt,name=proof" json:"proof,omitempty"`
+	Verified bool   `protobuf:"varint,4,opt,name=verified" json:"verified,omitempty"`
 }
 
 func (m *Profile_SocialAccount) Reset()                    { *m = Profile_SocialAccount{} }
@@ -230,6 +239,13 @@ func (m *Profile_SocialAccount) GetProof() string {
 	return ""
 }
 
+func (m *Profile_SocialAccount) GetVerified() bool {
+	if m != nil {
+		return m.Verified
+	}
+	return false
+}
+
 type Profile_Image struct {
 	Tiny     string `protobuf:"bytes,1,opt,name=tiny" json:"tiny,omitempty"`
 	Small    string `protobuf:"bytes,2,opt,name=small" json:"small,omitempty"`
@@ -374,6 +390,47 @@ func (m *Profile_Stats) GetAverageRating() float32 {
 	return 0
 }
 
+// Signed by the node each time the profile is saved
+type Profile_Revision struct {
+	Number    uint32 `protobuf:"varint,1,opt,name=number" json:"number,omitempty"`
+	Pubkey    []byte `protobuf:"bytes,2,opt,name=pubkey,proto3" json:"pubkey,omitempty"`
+	Signature []byte `protobuf:"bytes,3,opt,name=signature,proto3" json:"signature,omitempty"`
+	Verified  bool   `protobuf:"varint,4,opt,name=verified" json:"verified,omitempty"`
+}
+
+func (m *Profile_Revision) Reset()                    { *m = Profile_Revision{} }
+func (m *Profile_Revision) String() string            { return proto.CompactTextString(m) }
+func (*Profile_Revision) ProtoMessage()               {}
+func (*Profile_Revision) Descriptor() ([]byte, []int) { return fileDescriptor6, []int{0, 5} }
+
+func (m *Profile_Revision) GetNumber() uint32 {
+	if m != nil {
+		return m.Number
+	}
+	return 0
+}
+
+func (m *Profile_Revision) GetPubkey() []byte {
+	if m != nil {
+		return m.Pubkey
+	}
+	return nil
+}
+
+func (m *Profile_Revision) GetSignature() []byte {
+	if m != nil {
+		return m.Signature
+	}
+	return nil
+}
+
+func (m *Profile_Revision) GetVerified() bool {
+	if m != nil {
+		return m.Verified
+	}
+	return false
+}
+
 func init() {
 	proto.RegisterType((*Profile)(nil), "Profile")
 	proto.RegisterType((*Profile_Contact)(nil), "Profile.Contact")
@@ -381,53 +438,57 @@ func init() {
 	proto.RegisterType((*Profile_Image)(nil), "Profile.Image")
 	proto.RegisterType((*Profile_Colors)(nil), "Profile.Colors")
 	proto.RegisterType((*Profile_Stats)(nil), "Profile.Stats")
+	proto.RegisterType((*Profile_Revision)(nil), "Profile.Revision")
 }
 
 func init() { proto.RegisterFile("profile.proto", fileDescriptor6) }
 
 var fileDescriptor6 = []byte{
-	// 675 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x09, 0x6e, 0x88, 0x02, 0xff, 0x6c, 0x93, 0xcf, 0x6e, 0xd4, 0x3a,
-	0x14, 0xc6, 0x35, 0xd3, 0xf9, 0xd3, 0x7a, 0x66, 0xda, 0x5e, 0xeb, 0xaa, 0xb2, 0xa2, 0x2b, 0xdd,
-	0x51, 0x55, 0xc1, 0x88, 0x45, 0x8a, 0x86, 0x3d, 0x12, 0xb4, 0x0b, 0xba, 0x28, 0xaa, 0xd2, 0xb2,
-	0x61, 0xe7, 0x24, 0x9e, 0xc4, 0xc2, 0xb1, 0x23, 0xdb, 0x69, 0x19, 0xf1, 0x08, 0xbc, 0x00, 0xaf,
-	0xc2, 0x92, 0x37, 0x43, 0x3e, 0x76, 0x32, 0x93, 0xc2, 0xce, 0xdf, 0xef, 0x7c, 0xc7, 0x39, 0x76,
-	0x3e, 0xa3, 0x45, 0xad, 0xd5, 0x86, 0x0b, 0x16, 0xd7, 0x5a, 0x59, 0x15, 0xfd, 0x5f, 0x28, 0x55,
-	0x08, 0x76, 0x09, 0x2a, 0x6d, 0x36, 0x97, 0x96, 0x57, 0xcc, 0x58, 0x5a, 0xd5, 0xc1, 0x70, 0x52,
-	0xa9, 0x9c, 0x69, 0x6a, 0x95, 0xf6, 0xe0, 0xfc, 0x27, 0x42, 0xd3, 0x3b, 0xbf, 0x07, 0x3e, 0x43,
-	0x93, 0x9a, 0x31, 0x7d, 0x73, 0x4d, 0x06, 0xcb, 0xc1, 0xea, 0x28, 0x09, 0xca, 0xf1, 0x92, 0xca,
-	0x5c, 0x30, 0x32, 0xf4, 0xdc, 0x2b, 0x8c, 0xd1, 0x48, 0xd2, 0x8a, 0x91, 0x03, 0xa0, 0xb0, 0xc6,
-	0x11, 0x3a, 0x14, 0x2a, 0xa3, 0x96, 0x2b, 0x49, 0x46, 0xc0, 0x3b, 0x8d, 0xff, 0x45, 0x63, 0x9a,
-	0xaa, 0xc6, 0x92, 0x31, 0x14, 0xbc, 0xc0, 0xaf, 0xd0, 0xa9, 0x29, 0x95, 0xb6, 0xd7, 0xcc, 0x64,
-	0x9a, 0xd7, 0xd0, 0x39, 0x01, 0xc3, 0x1f, 0x1c, 0xbe, 0x68, 0x36, 0x4f, 0x64, 0xba, 0x1c, 0xac,
-	0x0e, 0x13, 0x58, 0xbb, 0xe9, 0x1e, 0x99, 0xcc, 0x95, 0x26, 0x87, 0x40, 0x83, 0xc2, 0xff, 0xa1,
-	0xa3, 0xee, 0xb0, 0xe4, 0x08, 0x4a, 0x3b, 0x80, 0x5f, 0xa3, 0x45, 0x27, 0x6e, 0xe4, 0x46, 0x11,
-	0xb4, 0x1c, 0xac, 0x66, 0x6b, 0x14, 0xdf, 0xb6, 0x34, 0xe9, 0x1b, 0xf0, 0x1a, 0xcd, 0x32, 0x25,
-	0x2d, 0xcd, 0x2c, 0xf8, 0x67, 0xe0, 0x3f, 0x8d, 0xc3, 0xe5, 0xc5, 0x57, 0xbe, 0x96, 0xec, 0x9b,
-	0xf0, 0x4b, 0x34, 0xc9, 0x94, 0x50, 0xda, 0x90, 0x39, 0xd8, 0x4f, 0xf6, 0xec, 0x0e, 0x27, 0xa1,
-	0x8c, 0xd7, 0x68, 0x4e, 0x1f, 0xa9, 0xa5, 0xfa, 0x03, 0x35, 0x25, 0x33, 0x64, 0x01, 0xf6, 0xe3,
-	0xce, 0x7e, 0x53, 0xd1, 0x82, 0x25, 0x3d, 0x8f, 0xeb, 0x29, 0x19, 0xcd, 0x59, 0xdb, 0x73, 0xfc,
-	0xf7, 0x9e, 0x7d, 0x0f, 0xbe, 0x40, 0x63, 0x63, 0xa9, 0x35, 0xe4, 0xe4, 0x99, 0xf9, 0xde, 0xd1,
-	0xc4, 0x17, 0xf1, 0x05, 0x5a, 0xa4, 0xdc, 0x66, 0x8a, 0xcb, 0xbb, 0x26, 0xfd, 0xc2, 0xb6, 0xe4,
-	0x14, 0xfe, 0x47, 0x1f, 0xe2, 0xb7, 0x68, 0x2e, 0xa8, 0xb1, 0xb7, 0x2a, 0xe7, 0x1b, 0xce, 0x72,
-	0xf2, 0x0f, 0x6c, 0x19, 0xc5, 0x3e, 0x83, 0x71, 0x9b, 0xc1, 0xf8, 0xa1, 0xcd, 0x60, 0xd2, 0xf3,
-	0x47, 0xdf, 0x07, 0x68, 0x1a, 0x6e, 0x0d, 0x13, 0x34, 0x7d, 0x62, 0xa9, 0xe1, 0x96, 0x85, 0xec,
-	0xb5, 0xd2, 0x85, 0x86, 0x55, 0x94, 0x8b, 0x90, 0x3d, 0x2f, 0xf0, 0x12, 0xcd, 0xea, 0x52, 0x49,
-	0xf6, 0xb1, 0xa9, 0x52, 0xa6, 0x43, 0x02, 0xf7, 0x11, 0x8e, 0xd1, 0xc4, 0xa8, 0x8c, 0x53, 0x41,
-	0x46, 0xcb, 0x83, 0xd5, 0x6c, 0x7d, 0xb6, 0x3b, 0x2a, 0xe0, 0x77, 0x59, 0xa6, 0x1a, 0x69, 0x93,
-	0xe0, 0x8a, 0x3e, 0xa1, 0x45, 0xaf, 0xe0, 0xb2, 0x66, 0xb7, 0x75, 0x3b, 0x0f, 0xac, 0x5d, 0xba,
-	0x1b, 0xc3, 0x34, 0xa4, 0xde, 0xcf, 0xd3, 0x69, 0x37, 0x68, 0xad, 0x95, 0xda, 0x84, 0x61, 0xbc,
-	0x88, 0xbe, 0xa1, 0x31, 0xfc, 0x07, 0xd8, 0x8e, 0xcb, 0x6d, 0xb7, 0x1d, 0x97, 0x5b, 0xd7, 0x62,
-	0x2a, 0x2a, 0xba, 0xb3, 0x81, 0x70, 0x81, 0xae, 0x58, 0xce, 0x9b, 0x2a, 0xec, 0x14, 0x94, 0x73,
-	0x0b, 0xaa, 0x0b, 0x16, 0xde, 0x95, 0x17, 0x6e, 0x24, 0xa5, 0x79, 0xc1, 0x25, 0x15, 0xe1, 0x5d,
-	0x75, 0x3a, 0xfa, 0x31, 0x40, 0x13, 0x1f, 0x34, 0x77, 0xc1, 0xb5, 0xe6, 0x15, 0xd5, 0xed, 0x04,
-	0xad, 0x74, 0xef, 0xc4, 0xb0, 0x4c, 0xc9, 0xdc, 0xd5, 0xfc, 0x20, 0x3b, 0x00, 0x63, 0xb3, 0xaf,
-	0xb6, 0x7d, 0xe3, 0x6e, 0xed, 0x3a, 0x4a, 0x5e, 0x94, 0x82, 0x17, 0xa5, 0x0d, 0xc3, 0xec, 0x80,
-	0x0b, 0x4f, 0x27, 0x1e, 0x5c, 0xab, 0x9f, 0xaa, 0x0f, 0xa3, 0x5f, 0x03, 0x34, 0xbe, 0x6f, 0xc3,
-	0xb6, 0x51, 0x42, 0xa8, 0x27, 0xa6, 0xaf, 0xdc, 0xc5, 0xc3, 0x7c, 0x8b, 0xa4, 0x0f, 0xf1, 0x0b,
-	0x74, 0xec, 0x01, 0x97, 0x85, 0xb7, 0x0d, 0xc1, 0xf6, 0x8c, 0xe2, 0x73, 0x34, 0x17, 0xdc, 0xd8,
-	0xce, 0x75, 0x00, 0xae, 0x1e, 0x73, 0xe1, 0xd1, 0x74, 0x67, 0x19, 0x81, 0x65, 0x1f, 0xb9, 0x99,
-	0xe8, 0x23, 0xd3, 0xee, 0xfd, 0x00, 0x85, 0x33, 0x0c, 0x93, 0x3e, 0x7c, 0x3f, 0xfa, 0x3c, 0xac,
-	0xd3, 0x74, 0x02, 0x41, 0x7f, 0xf3, 0x3b, 0x00, 0x00, 0xff, 0xff, 0x33, 0xea, 0x2f, 0x1b, 0x8b,
-	0x05, 0x00, 0x00,
+	// 736 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7d, 0x54, 0xdd, 0x6e, 0xd3, 0x30,
+	0x18, 0x55, 0xbb, 0xfe, 0xcd, 0x6d, 0xb7, 0xce, 0x42, 0x93, 0x15, 0x21, 0x31, 0x4d, 0x08, 0x10,
+	0x12, 0x19, 0x1a, 0xf7, 0x48, 0xb0, 0x5d, 0xb0, 0x0b, 0xd0, 0xe4, 0xed, 0x8a, 0x3b, 0x27, 0x75,
+	0x53, 0x8b, 0x24, 0x0e, 0xb6, 0xd3, 0x31, 0xf1, 0x08, 0xbc, 0x00, 0xaf, 0xc3, 0x6b, 0x71, 0x85,
+	0xfd, 0xd9, 0x49, 0x9b, 0x81, 0xb8, 0xa8, 0xea, 0x73, 0xbe, 0xe3, 0xf8, 0xe4, 0xf3, 0xf9, 0x82,
+	0xe6, 0x95, 0x92, 0x2b, 0x91, 0xf3, 0xd8, 0xfe, 0x1b, 0x19, 0x3d, 0xc9, 0xa4, 0xcc, 0x72, 0x7e,
+	0x06, 0x28, 0xa9, 0x57, 0x67, 0x46, 0x14, 0x5c, 0x1b, 0x56, 0x54, 0x41, 0x70, 0x58, 0xc8, 0x25,
+	0x57, 0xcc, 0x48, 0xe5, 0x89, 0xd3, 0xdf, 0x53, 0x34, 0xbe, 0xf6, 0xcf, 0xc0, 0xc7, 0x68, 0x54,
+	0x71, 0xae, 0xae, 0x2e, 0x49, 0xef, 0xa4, 0xf7, 0x62, 0x9f, 0x06, 0xe4, 0xf8, 0x35, 0x2b, 0x97,
+	0x39, 0x27, 0x7d, 0xcf, 0x7b, 0x84, 0x31, 0x1a, 0x94, 0xac, 0xe0, 0x64, 0x0f, 0x58, 0x58, 0xe3,
+	0x08, 0x4d, 0x72, 0x99, 0x32, 0x23, 0x64, 0x49, 0x06, 0xc0, 0xb7, 0x18, 0x3f, 0x42, 0x43, 0x96,
+	0xc8, 0xda, 0x90, 0x21, 0x14, 0x3c, 0xc0, 0x2f, 0xd1, 0x42, 0xaf, 0xa5, 0x32, 0x97, 0x5c, 0xa7,
+	0x4a, 0x54, 0xb0, 0x73, 0x04, 0x82, 0xbf, 0x78, 0x38, 0x51, 0xaf, 0xee, 0xc8, 0xd8, 0xd6, 0x27,
+	0x14, 0xd6, 0xce, 0xdd, 0x86, 0x97, 0x4b, 0xa9, 0xc8, 0x04, 0xd8, 0x80, 0xf0, 0x63, 0xb4, 0xdf,
+	0xbe, 0x2c, 0xd9, 0x87, 0xd2, 0x96, 0xc0, 0xaf, 0xd1, 0xbc, 0x05, 0x57, 0xe5, 0x4a, 0x12, 0x64,
+	0x15, 0xd3, 0x73, 0x14, 0x7f, 0x6c, 0x58, 0xda, 0x15, 0xe0, 0x73, 0x34, 0x4d, 0x65, 0x69, 0x58,
+	0x6a, 0x40, 0x3f, 0x05, 0xfd, 0x22, 0x0e, 0xcd, 0x8b, 0x2f, 0x7c, 0x8d, 0xee, 0x8a, 0xf0, 0x73,
+	0x34, 0x4a, 0x65, 0x2e, 0x95, 0x26, 0x33, 0x90, 0x1f, 0xee, 0xc8, 0x1d, 0x4d, 0x43, 0xd9, 0x3e,
+	0x7c, 0xc6, 0x36, 0xcc, 0x30, 0xf5, 0x81, 0xe9, 0x35, 0xd7, 0x64, 0x0e, 0xf2, 0x83, 0x56, 0x7e,
+	0x55, 0xb0, 0x8c, 0xd3, 0x8e, 0xc6, 0xed, 0x59, 0x73, 0x66, 0x2d, 0x86, 0x3d, 0x07, 0xff, 0xde,
+	0xb3, 0xab, 0xc1, 0x4f, 0xd1, 0xd0, 0xc6, 0xc1, 0x68, 0x72, 0xf8, 0x40, 0x7c, 0xe3, 0x58, 0xea,
+	0x8b, 0x56, 0x35, 0x4f, 0x84, 0x49, 0xa5, 0x28, 0xaf, 0xeb, 0xe4, 0x0b, 0xbf, 0x27, 0x0b, 0xb8,
+	0x8f, 0x2e, 0x89, 0xdf, 0xa2, 0x59, 0xce, 0xb4, 0xb1, 0x0d, 0x13, 0x2b, 0xc1, 0x97, 0xe4, 0x08,
+	0x1e, 0x19, 0xc5, 0x3e, 0x83, 0x71, 0x93, 0xc1, 0xf8, 0xb6, 0xc9, 0x20, 0xed, 0xe8, 0xf1, 0x2b,
+	0x34, 0x51, 0x7c, 0x23, 0xb4, 0xbb, 0x70, 0x0c, 0x7b, 0x8f, 0x5a, 0x3b, 0x34, 0x14, 0x68, 0x2b,
+	0x89, 0x7e, 0xf4, 0xd0, 0x38, 0x34, 0x19, 0x13, 0x34, 0xbe, 0xe3, 0x89, 0x16, 0x86, 0x87, 0xa8,
+	0x36, 0xd0, 0x65, 0x8c, 0x17, 0x4c, 0xe4, 0x21, 0xaa, 0x1e, 0xe0, 0x13, 0x34, 0xad, 0xd6, 0xb2,
+	0xe4, 0x9f, 0xea, 0x22, 0xe1, 0x2a, 0x04, 0x76, 0x97, 0xc2, 0x31, 0x1a, 0x69, 0x99, 0x0a, 0x96,
+	0xdb, 0xd4, 0xee, 0x59, 0x2b, 0xc7, 0xdb, 0xce, 0x00, 0xfd, 0x2e, 0x4d, 0x65, 0x5d, 0x1a, 0x1a,
+	0x54, 0xd1, 0x57, 0x34, 0xef, 0x14, 0x5c, 0x34, 0xcd, 0x7d, 0xd5, 0xf8, 0x81, 0xb5, 0x1b, 0x86,
+	0x5a, 0x73, 0x05, 0x43, 0xe2, 0xfd, 0xb4, 0xd8, 0x19, 0xb5, 0x1d, 0x92, 0xab, 0x60, 0xc6, 0x03,
+	0xb7, 0x63, 0xc3, 0x95, 0xef, 0xe7, 0x00, 0x32, 0xdb, 0xe2, 0xe8, 0x3b, 0x1a, 0xc2, 0x95, 0xc2,
+	0x51, 0xa2, 0xbc, 0x6f, 0x8f, 0xb2, 0x6b, 0xf7, 0x38, 0x5d, 0xb0, 0xbc, 0x7d, 0x6f, 0x00, 0x6e,
+	0x36, 0x0a, 0xbe, 0x14, 0x75, 0x11, 0x4e, 0x09, 0xc8, 0xa9, 0x73, 0xa6, 0x32, 0x1e, 0x46, 0xd4,
+	0x03, 0x77, 0xb8, 0x54, 0x22, 0x13, 0xa5, 0xed, 0x82, 0x1f, 0xd1, 0x16, 0x47, 0x3f, 0x7b, 0x68,
+	0xe4, 0x33, 0xeb, 0x9a, 0x5f, 0x29, 0x51, 0x30, 0xd5, 0x38, 0x68, 0xa0, 0x1b, 0x39, 0xcd, 0x6d,
+	0xfe, 0x97, 0xae, 0xe6, 0x8d, 0x6c, 0x09, 0xb0, 0xcd, 0xbf, 0x99, 0xe6, 0x73, 0xe1, 0xd6, 0x6e,
+	0xc7, 0x5a, 0x64, 0xeb, 0xdc, 0xfe, 0x4c, 0x30, 0xb3, 0x25, 0x5c, 0x0e, 0x5b, 0x70, 0xeb, 0xb6,
+	0x7a, 0x57, 0x5d, 0x32, 0xfa, 0xd5, 0x43, 0xc3, 0x9b, 0x26, 0xb7, 0x2b, 0x99, 0xe7, 0xf2, 0x8e,
+	0xab, 0x0b, 0x77, 0x29, 0xe0, 0x6f, 0x4e, 0xbb, 0x24, 0x7e, 0x86, 0x0e, 0x3c, 0x21, 0xca, 0xcc,
+	0xcb, 0xfa, 0x20, 0x7b, 0xc0, 0xe2, 0x53, 0x9b, 0x6f, 0xa1, 0x4d, 0xab, 0xda, 0x03, 0x55, 0x87,
+	0x73, 0xc1, 0xb2, 0x5f, 0x88, 0x56, 0x32, 0x00, 0xc9, 0x2e, 0xe5, 0x3c, 0x31, 0x7b, 0x85, 0x6e,
+	0x14, 0x81, 0x85, 0x77, 0xe8, 0xd3, 0x2e, 0x19, 0x19, 0x34, 0x69, 0x22, 0xef, 0x2e, 0xad, 0xf4,
+	0x39, 0xf5, 0xf6, 0x03, 0x82, 0xcf, 0xb3, 0x1f, 0x47, 0xe7, 0x77, 0x46, 0x03, 0x82, 0xae, 0x8b,
+	0xac, 0x64, 0xa6, 0x56, 0xfe, 0x5b, 0x3c, 0xa3, 0x5b, 0xe2, 0x7f, 0x89, 0x7a, 0x3f, 0xf8, 0xdc,
+	0xaf, 0x92, 0x64, 0x04, 0x93, 0xfa, 0xe6, 0x0f, 0x82, 0xe9, 0xc2, 0x7b, 0x4c, 0x06, 0x00, 0x00,
 }
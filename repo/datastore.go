@@ -27,6 +27,16 @@ type Datastore interface {
 	Coupons() Coupons
 	TxMetadata() TxMetadata
 	ModeratedStores() ModeratedStores
+	ShippingProfiles() ShippingProfiles
+	Vouchers() Vouchers
+	Subscriptions() Subscriptions
+	CaseChat() CaseChat
+	ResolutionTemplates() ResolutionTemplates
+	Reports() Reports
+	Ratings() Ratings
+	SocialProofs() SocialProofs
+	Successions() Successions
+	Feed() Feed
 	Close()
 }
 
@@ -41,6 +51,9 @@ type Config interface {
 	// Return the identity key
 	GetIdentityKey() ([]byte, error)
 
+	// Replace the identity key when it is rotated
+	SetIdentityKey(identityKey []byte) error
+
 	// Returns true if the database has failed to decrypt properly ex) wrong pw
 	IsEncrypted() bool
 }
@@ -142,6 +155,12 @@ type Inventory interface {
 
 	// Delete all variants of a given slug
 	DeleteAll(slug string) error
+
+	// Set the number of units sold beyond the available inventory which are awaiting stock
+	PutBackorders(slug string, variantIndex int, count int) error
+
+	// Return the number of backordered units for a specific listing variant
+	GetBackorders(slug string, variantIndex int) (int, error)
 }
 
 type Purchases interface {
@@ -206,6 +225,16 @@ type Cases interface {
 	// Mark a case as closed in the database
 	MarkAsClosed(caseID string, resolution *pb.DisputeResolution) error
 
+	// Save the resolution decided by the lead moderator of a panel while it waits to be endorsed
+	MarkAsDecided(caseID string, resolution *pb.DisputeResolution) error
+
+	// Save evidence attached to a case by the buyer or vendor. Evidence added after a
+	// dispute over one of our own orders was opened is also saved here by order ID.
+	PutAttachments(caseID string, attachments []*pb.DisputeAttachment) error
+
+	// Return the evidence attached to a case or order in the order it was added
+	GetAttachments(caseID string) ([]*pb.DisputeAttachment, error)
+
 	// Delete a case
 	Delete(caseID string) error
 
@@ -298,3 +327,131 @@ type ModeratedStores interface {
 	// Delete a moderated store from the database
 	Delete(peerId string) error
 }
+
+type ShippingProfiles interface {
+	// Put a shipping profile to the database. Replaces any profile with the same name.
+	Put(profile *pb.ShippingProfile) error
+
+	// Get a shipping profile given its name
+	Get(name string) (*pb.ShippingProfile, error)
+
+	// Return all shipping profiles in the database
+	GetAll() ([]*pb.ShippingProfile, error)
+
+	// Delete a shipping profile from the database
+	Delete(name string) error
+}
+
+type Vouchers interface {
+	// Put a newly issued voucher to the database. Its balance starts at the voucher amount.
+	Put(voucher *pb.Voucher) error
+
+	// Get a voucher and its remaining balance given its ID
+	Get(id string) (*pb.VoucherBalance, error)
+
+	// Get a voucher and its remaining balance given the hash of its bearer code
+	GetByCodeHash(hash string) (*pb.VoucherBalance, error)
+
+	// Return all vouchers in the database
+	GetAll() ([]*pb.VoucherBalance, error)
+
+	// Subtract the amount from the voucher balance. Fails if the balance is insufficient.
+	Redeem(id string, amount uint64) error
+
+	// Add a previously redeemed amount back to the voucher balance
+	Restore(id string, amount uint64) error
+
+	// Delete a voucher from the database
+	Delete(id string) error
+}
+
+type Subscriptions interface {
+	// Put a subscription to the database. Replaces any subscription with the same ID.
+	Put(subscription *pb.Subscription) error
+
+	// Get a subscription given its ID
+	Get(id string) (*pb.Subscription, error)
+
+	// Return all subscriptions in the database, both as buyer and as vendor
+	GetAll() ([]*pb.Subscription, error)
+
+	// Delete a subscription from the database
+	Delete(id string) error
+}
+
+type CaseChat interface {
+	// Put a case chat message to the database
+	Put(chat *pb.CaseChat) error
+
+	// Return the messages for a case, oldest first
+	GetMessages(caseID string) ([]*pb.CaseChat, error)
+}
+
+type ResolutionTemplates interface {
+	// Put a resolution template to the database. Replaces any template with the same name.
+	Put(template *pb.ResolutionTemplate) error
+
+	// Get a resolution template given its name
+	Get(name string) (*pb.ResolutionTemplate, error)
+
+	// Return all resolution templates in the database
+	GetAll() ([]*pb.ResolutionTemplate, error)
+
+	// Delete a resolution template from the database
+	Delete(name string) error
+}
+
+type Reports interface {
+	// Put an abuse report to the database. Ignored if the report was already received.
+	Put(report *pb.Report) error
+
+	// Return all reports in the database, newest first
+	GetAll() ([]*pb.Report, error)
+
+	// Delete a report from the database
+	Delete(reportID string) error
+}
+
+type Ratings interface {
+	// Cache a rating fetched from the network after its signatures were verified
+	Put(hash, peerID string, rating *pb.OrderCompletion_Rating) error
+
+	// Return a cached rating by its IPFS hash
+	Get(hash string) (*pb.OrderCompletion_Rating, error)
+}
+
+type SocialProofs interface {
+	// Record the result of checking a peer's proof of a social account. Replaces the
+	// result for any earlier proof of the same account.
+	Put(peerID, accountType, username, proof string, verified bool) error
+
+	// Return the last result of checking a proof and when it was checked
+	Get(peerID, accountType, username, proof string) (verified bool, checked time.Time, err error)
+}
+
+type Successions interface {
+	// Record the latest successor of a peer along with the chain of successions which
+	// proves it. Replaces any earlier successor.
+	Put(predecessorID, successorID string, chain *pb.IdentityChain) error
+
+	// Return the latest known successor of a peer and the chain which proves it
+	Get(predecessorID string) (successorID string, chain *pb.IdentityChain, err error)
+}
+
+type Feed interface {
+	// Put a new event about a followed peer to the feed and return its ID
+	Put(event FeedEvent) (int, error)
+
+	/* Return events from the feed, newest first.
+	   The offset and limit arguments can be used to for lazy loading. */
+	GetAll(offsetID int, limit int) ([]FeedEvent, error)
+
+	// Record a followed peer's listing index and profile as of the last crawl
+	PutSnapshot(peerID string, listingIndex, profile []byte) error
+
+	// Return the listing index and profile recorded at the last crawl of a peer
+	GetSnapshot(peerID string) (listingIndex, profile []byte, err error)
+
+	// Delete a peer's events and snapshot, such as when we unfollow them
+	DeletePeer(peerID string) error
+}
@@ -0,0 +1,77 @@
+package db
+
+import (
+	"database/sql"
+	"sync"
+	"time"
+
+	"github.com/OpenBazaar/jsonpb"
+	"github.com/OpenBazaar/openbazaar-go/pb"
+	"github.com/golang/protobuf/ptypes"
+)
+
+type CaseChatDB struct {
+	db   *sql.DB
+	lock sync.RWMutex
+}
+
+func (c *CaseChatDB) Put(chat *pb.CaseChat) error {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	m := jsonpb.Marshaler{
+		EnumsAsInts:  false,
+		EmitDefaults: false,
+		Indent:       "",
+		OrigName:     false,
+	}
+	out, err := m.MarshalToString(chat)
+	if err != nil {
+		return err
+	}
+	timestamp := time.Now()
+	if chat.Timestamp != nil {
+		if ts, err := ptypes.Timestamp(chat.Timestamp); err == nil {
+			timestamp = ts
+		}
+	}
+	tx, err := c.db.Begin()
+	if err != nil {
+		return err
+	}
+	stmt, err := tx.Prepare("insert or ignore into casechat(messageID, caseID, peerID, chat, timestamp) values(?,?,?,?,?)")
+	if err != nil {
+		return err
+	}
+	defer stmt.Close()
+	_, err = stmt.Exec(chat.MessageId, chat.CaseId, chat.PeerID, out, int(timestamp.Unix()))
+	if err != nil {
+		tx.Rollback()
+		return err
+	}
+	tx.Commit()
+	return nil
+}
+
+func (c *CaseChatDB) GetMessages(caseID string) ([]*pb.CaseChat, error) {
+	c.lock.RLock()
+	defer c.lock.RUnlock()
+	rows, err := c.db.Query("select chat from casechat where caseID=? order by timestamp asc, rowid asc", caseID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var ret []*pb.CaseChat
+	for rows.Next() {
+		var chatBytes []byte
+		if err := rows.Scan(&chatBytes); err != nil {
+			return ret, err
+		}
+		chat := new(pb.CaseChat)
+		if err := jsonpb.UnmarshalString(string(chatBytes), chat); err != nil {
+			log.Error(err)
+			continue
+		}
+		ret = append(ret, chat)
+	}
+	return ret, nil
+}
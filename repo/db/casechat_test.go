@@ -0,0 +1,80 @@
+package db
+
+import (
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/OpenBazaar/openbazaar-go/pb"
+	"github.com/golang/protobuf/ptypes"
+)
+
+var casechatdb CaseChatDB
+
+func init() {
+	conn, _ := sql.Open("sqlite3", ":memory:")
+	initDatabaseTables(conn, "")
+	casechatdb = CaseChatDB{
+		db: conn,
+	}
+}
+
+func TestCaseChatDB_Put(t *testing.T) {
+	ts, _ := ptypes.TimestampProto(time.Now())
+	chat := &pb.CaseChat{
+		MessageId: "put",
+		CaseId:    "case1",
+		PeerID:    "QmBuyer",
+		Message:   "hello",
+		Timestamp: ts,
+		Signature: []byte("sig"),
+	}
+	err := casechatdb.Put(chat)
+	if err != nil {
+		t.Error(err)
+	}
+	stmt, err := casechatdb.db.Prepare("select caseID, peerID from casechat where messageID=?")
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	defer stmt.Close()
+	var caseID, peerID string
+	err = stmt.QueryRow("put").Scan(&caseID, &peerID)
+	if err != nil {
+		t.Error(err)
+	}
+	if caseID != "case1" || peerID != "QmBuyer" {
+		t.Error("Case chat put failed to put correct case or peer ID")
+	}
+	err = casechatdb.Put(chat)
+	if err != nil {
+		t.Error("Putting a duplicate message should be ignored")
+	}
+}
+
+func TestCaseChatDB_GetMessages(t *testing.T) {
+	now := time.Now()
+	ts, _ := ptypes.TimestampProto(now)
+	casechatdb.Put(&pb.CaseChat{MessageId: "second", CaseId: "case2", PeerID: "QmVendor", Message: "hello", Timestamp: ts, Signature: []byte("sig")})
+	ts, _ = ptypes.TimestampProto(now.Add(-time.Minute))
+	casechatdb.Put(&pb.CaseChat{MessageId: "first", CaseId: "case2", PeerID: "QmBuyer", Message: "hello", Timestamp: ts, Signature: []byte("sig")})
+	ts, _ = ptypes.TimestampProto(now.Add(time.Minute))
+	casechatdb.Put(&pb.CaseChat{MessageId: "third", CaseId: "case2", PeerID: "QmModerator", Message: "hello", Timestamp: ts, Signature: []byte("sig")})
+	ts, _ = ptypes.TimestampProto(now)
+	casechatdb.Put(&pb.CaseChat{MessageId: "other", CaseId: "case3", PeerID: "QmBuyer", Message: "hello", Timestamp: ts, Signature: []byte("sig")})
+	messages, err := casechatdb.GetMessages("case2")
+	if err != nil {
+		t.Error(err)
+	}
+	if len(messages) != 3 {
+		t.Error("Returned incorrect number of messages")
+		return
+	}
+	if messages[0].MessageId != "first" || messages[1].MessageId != "second" || messages[2].MessageId != "third" {
+		t.Error("Messages returned in the wrong order")
+	}
+	if string(messages[2].Signature) != "sig" || messages[2].PeerID != "QmModerator" {
+		t.Error("Returned incorrect message")
+	}
+}
@@ -139,6 +139,14 @@ func (c *CasesDB) MarkAsRead(orderID string) error {
 }
 
 func (c *CasesDB) MarkAsClosed(caseID string, resolution *pb.DisputeResolution) error {
+	return c.putResolution(caseID, resolution, pb.OrderState_RESOLVED)
+}
+
+func (c *CasesDB) MarkAsDecided(caseID string, resolution *pb.DisputeResolution) error {
+	return c.putResolution(caseID, resolution, pb.OrderState_DECIDED)
+}
+
+func (c *CasesDB) putResolution(caseID string, resolution *pb.DisputeResolution, state pb.OrderState) error {
 	m := jsonpb.Marshaler{
 		EnumsAsInts:  false,
 		EmitDefaults: true,
@@ -155,7 +163,7 @@ func (c *CasesDB) MarkAsClosed(caseID string, resolution *pb.DisputeResolution)
 	}
 	c.lock.Lock()
 	defer c.lock.Unlock()
-	_, err = c.db.Exec("update cases set disputeResolution=?, state=? where caseID=?", rOut, int(pb.OrderState_RESOLVED), caseID)
+	_, err = c.db.Exec("update cases set disputeResolution=?, state=? where caseID=?", rOut, int(state), caseID)
 	if err != nil {
 		return err
 	}
@@ -169,9 +177,70 @@ func (c *CasesDB) Delete(orderID string) error {
 	if err != nil {
 		return err
 	}
+	_, err = c.db.Exec("delete from caseattachments where caseID=?", orderID)
+	if err != nil {
+		return err
+	}
 	return nil
 }
 
+func (c *CasesDB) PutAttachments(caseID string, attachments []*pb.DisputeAttachment) error {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	m := jsonpb.Marshaler{
+		EnumsAsInts:  false,
+		EmitDefaults: false,
+		Indent:       "",
+		OrigName:     false,
+	}
+	tx, err := c.db.Begin()
+	if err != nil {
+		return err
+	}
+	stmt, err := tx.Prepare("insert or replace into caseattachments(caseID, hash, attachment, timestamp) values(?,?,?,?)")
+	if err != nil {
+		return err
+	}
+	defer stmt.Close()
+	for _, attachment := range attachments {
+		out, err := m.MarshalToString(attachment)
+		if err != nil {
+			tx.Rollback()
+			return err
+		}
+		_, err = stmt.Exec(caseID, attachment.Hash, out, int(time.Now().UnixNano()))
+		if err != nil {
+			tx.Rollback()
+			return err
+		}
+	}
+	tx.Commit()
+	return nil
+}
+
+func (c *CasesDB) GetAttachments(caseID string) ([]*pb.DisputeAttachment, error) {
+	c.lock.RLock()
+	defer c.lock.RUnlock()
+	rows, err := c.db.Query("select attachment from caseattachments where caseID=? order by timestamp asc", caseID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var ret []*pb.DisputeAttachment
+	for rows.Next() {
+		var attachment string
+		if err := rows.Scan(&attachment); err != nil {
+			return ret, err
+		}
+		a := new(pb.DisputeAttachment)
+		if err := jsonpb.UnmarshalString(attachment, a); err != nil {
+			return ret, err
+		}
+		ret = append(ret, a)
+	}
+	return ret, nil
+}
+
 func (c *CasesDB) GetAll(offsetId string, limit int) ([]repo.Case, error) {
 	c.lock.RLock()
 	defer c.lock.RUnlock()
@@ -380,6 +380,37 @@ func TestMarkAsClosed(t *testing.T) {
 	}
 }
 
+func TestMarkAsDecided(t *testing.T) {
+	err := casesdb.Put("caseID", pb.OrderState_DISPUTED, true, "blah")
+	if err != nil {
+		t.Error(err)
+	}
+	err = casesdb.UpdateBuyerInfo("caseID", contract, []string{"someError", "anotherError"}, "addr1", buyerTestOutpoints)
+	if err != nil {
+		t.Error(err)
+	}
+	err = casesdb.UpdateVendorInfo("caseID", contract, []string{"someError", "anotherError"}, "addr2", vendorTestOutpoints)
+	if err != nil {
+		t.Error(err)
+	}
+	d := new(pb.DisputeResolution)
+	d.Resolution = "Decided by the lead moderator"
+	err = casesdb.MarkAsDecided("caseID", d)
+	if err != nil {
+		t.Error(err)
+	}
+	_, _, _, _, state, _, _, _, _, resolution, err := casesdb.GetCaseMetadata("caseID")
+	if err != nil {
+		t.Error(err)
+	}
+	if state != pb.OrderState_DECIDED {
+		t.Error("Mark as decided failed to set state to decided")
+	}
+	if resolution == nil || resolution.Resolution != d.Resolution {
+		t.Error("Failed to save correct dispute resolution")
+	}
+}
+
 func TestCasesDB_GetAll(t *testing.T) {
 	err := casesdb.Put("caseID", 0, true, "blah")
 	if err != nil {
@@ -427,3 +458,40 @@ func TestCasesDB_GetAll(t *testing.T) {
 		t.Error("Returned incorrect number of cases")
 	}
 }
+
+func TestCaseAttachments(t *testing.T) {
+	attachments := []*pb.DisputeAttachment{
+		{PeerID: "QmBuyer", Filename: "broken.jpg", Hash: "QmBroken", Thumbnail: "QmThumb"},
+		{PeerID: "QmBuyer", Filename: "receipt.pdf", Hash: "QmReceipt"},
+	}
+	err := casesdb.PutAttachments("attachmentCase", attachments)
+	if err != nil {
+		t.Error(err)
+	}
+	err = casesdb.PutAttachments("attachmentCase", []*pb.DisputeAttachment{{PeerID: "QmVendor", Filename: "tracking.png", Hash: "QmTracking"}})
+	if err != nil {
+		t.Error(err)
+	}
+	ret, err := casesdb.GetAttachments("attachmentCase")
+	if err != nil {
+		t.Error(err)
+	}
+	if len(ret) != 3 {
+		t.Error("Returned incorrect number of attachments")
+		return
+	}
+	if ret[0].Hash != "QmBroken" || ret[0].Thumbnail != "QmThumb" || ret[2].PeerID != "QmVendor" {
+		t.Error("Returned incorrect attachments")
+	}
+	err = casesdb.Delete("attachmentCase")
+	if err != nil {
+		t.Error(err)
+	}
+	ret, err = casesdb.GetAttachments("attachmentCase")
+	if err != nil {
+		t.Error(err)
+	}
+	if len(ret) != 0 {
+		t.Error("Failed to delete case attachments")
+	}
+}
@@ -14,28 +14,38 @@ import (
 var log = logging.MustGetLogger("db")
 
 type SQLiteDatastore struct {
-	config          repo.Config
-	followers       repo.Followers
-	following       repo.Following
-	offlineMessages repo.OfflineMessages
-	pointers        repo.Pointers
-	keys            spvwallet.Keys
-	stxos           spvwallet.Stxos
-	txns            spvwallet.Txns
-	utxos           spvwallet.Utxos
-	watchedScripts  spvwallet.WatchedScripts
-	settings        repo.Settings
-	inventory       repo.Inventory
-	purchases       repo.Purchases
-	sales           repo.Sales
-	cases           repo.Cases
-	chat            repo.Chat
-	notifications   repo.Notifications
-	coupons         repo.Coupons
-	txMetadata      repo.TxMetadata
-	moderatedStores repo.ModeratedStores
-	db              *sql.DB
-	lock            sync.RWMutex
+	config              repo.Config
+	followers           repo.Followers
+	following           repo.Following
+	offlineMessages     repo.OfflineMessages
+	pointers            repo.Pointers
+	keys                spvwallet.Keys
+	stxos               spvwallet.Stxos
+	txns                spvwallet.Txns
+	utxos               spvwallet.Utxos
+	watchedScripts      spvwallet.WatchedScripts
+	settings            repo.Settings
+	inventory           repo.Inventory
+	purchases           repo.Purchases
+	sales               repo.Sales
+	cases               repo.Cases
+	chat                repo.Chat
+	notifications       repo.Notifications
+	coupons             repo.Coupons
+	txMetadata          repo.TxMetadata
+	moderatedStores     repo.ModeratedStores
+	shippingProfiles    repo.ShippingProfiles
+	vouchers            repo.Vouchers
+	subscriptions       repo.Subscriptions
+	caseChat            repo.CaseChat
+	resolutionTemplates repo.ResolutionTemplates
+	reports             repo.Reports
+	ratings             repo.Ratings
+	socialProofs        repo.SocialProofs
+	successions         repo.Successions
+	feed                repo.Feed
+	db                  *sql.DB
+	lock                sync.RWMutex
 }
 
 func Create(repoPath, password string, testnet bool) (*SQLiteDatastore, error) {
@@ -136,13 +146,70 @@ func Create(repoPath, password string, testnet bool) (*SQLiteDatastore, error) {
 			db:   conn,
 			lock: l,
 		},
+		shippingProfiles: &ShippingProfilesDB{
+			db:   conn,
+			lock: l,
+		},
+		vouchers: &VouchersDB{
+			db:   conn,
+			lock: l,
+		},
+		subscriptions: &SubscriptionsDB{
+			db:   conn,
+			lock: l,
+		},
+		caseChat: &CaseChatDB{
+			db:   conn,
+			lock: l,
+		},
+		resolutionTemplates: &ResolutionTemplatesDB{
+			db:   conn,
+			lock: l,
+		},
+		reports: &ReportsDB{
+			db:   conn,
+			lock: l,
+		},
+		ratings: &RatingsDB{
+			db:   conn,
+			lock: l,
+		},
+		socialProofs: &SocialProofsDB{
+			db:   conn,
+			lock: l,
+		},
+		successions: &SuccessionsDB{
+			db:   conn,
+			lock: l,
+		},
+		feed: &FeedDB{
+			db:   conn,
+			lock: l,
+		},
 		db:   conn,
 		lock: l,
 	}
 
+	if err := createMissingTables(conn); err != nil {
+		return nil, err
+	}
+
 	return sqliteDB, nil
 }
 
+// createMissingTables brings the schema of an existing database up to date.
+// Databases which haven't been initialized yet, or which can't be read
+// without a password, are left alone.
+func createMissingTables(db *sql.DB) error {
+	var n int
+	err := db.QueryRow("select count(*) from sqlite_master where type='table' and name='config';").Scan(&n)
+	if err != nil || n == 0 {
+		return nil
+	}
+	_, err = db.Exec(addedTables)
+	return err
+}
+
 func (d *SQLiteDatastore) Close() {
 	d.db.Close()
 }
@@ -227,6 +294,46 @@ func (d *SQLiteDatastore) ModeratedStores() repo.ModeratedStores {
 	return d.moderatedStores
 }
 
+func (d *SQLiteDatastore) ShippingProfiles() repo.ShippingProfiles {
+	return d.shippingProfiles
+}
+
+func (d *SQLiteDatastore) Vouchers() repo.Vouchers {
+	return d.vouchers
+}
+
+func (d *SQLiteDatastore) Subscriptions() repo.Subscriptions {
+	return d.subscriptions
+}
+
+func (d *SQLiteDatastore) CaseChat() repo.CaseChat {
+	return d.caseChat
+}
+
+func (d *SQLiteDatastore) ResolutionTemplates() repo.ResolutionTemplates {
+	return d.resolutionTemplates
+}
+
+func (d *SQLiteDatastore) Reports() repo.Reports {
+	return d.reports
+}
+
+func (d *SQLiteDatastore) Ratings() repo.Ratings {
+	return d.ratings
+}
+
+func (d *SQLiteDatastore) SocialProofs() repo.SocialProofs {
+	return d.socialProofs
+}
+
+func (d *SQLiteDatastore) Successions() repo.Successions {
+	return d.successions
+}
+
+func (d *SQLiteDatastore) Feed() repo.Feed {
+	return d.feed
+}
+
 func (d *SQLiteDatastore) Copy(dbPath string, password string) error {
 	d.lock.Lock()
 	defer d.lock.Unlock()
@@ -265,6 +372,26 @@ func (d *SQLiteDatastore) Copy(dbPath string, password string) error {
 	return nil
 }
 
+// Tables added after the original schema. Repos initialized before they
+// existed won't have them, so these are also created when a database is opened.
+const addedTables = `
+	create table if not exists backorders (slug text, variantIndex integer, count integer, primary key(slug, variantIndex));
+	create table if not exists caseattachments (caseID text, hash text, attachment blob, timestamp integer, primary key(caseID, hash));
+	create table if not exists shippingprofiles (name text primary key not null, profile blob);
+	create table if not exists vouchers (id text primary key not null, codeHash text, buyerID text, amount integer, balance integer, voucher blob, timestamp integer);
+	create index if not exists index_vouchers on vouchers (codeHash);
+	create table if not exists subscriptions (id text primary key not null, buyerID text, vendorID text, status integer, subscription blob, timestamp integer);
+	create table if not exists casechat (messageID text primary key not null, caseID text, peerID text, chat blob, timestamp integer);
+	create index if not exists index_casechat on casechat (caseID, timestamp);
+	create table if not exists resolutiontemplates (name text primary key not null, template blob);
+	create table if not exists reports (reportID text primary key not null, reporterID text, listingHash text, peerID text, report blob, timestamp integer);
+	create table if not exists ratings (hash text primary key not null, peerID text, rating blob, timestamp integer);
+	create table if not exists socialproofs (peerID text not null, type text not null, username text not null, proof text, verified integer, timestamp integer, primary key (peerID, type, username));
+	create table if not exists successions (predecessorID text primary key not null, successorID text, chain blob, timestamp integer);
+	create table if not exists feed (peerID text, type text, event blob, timestamp integer);
+	create table if not exists feedsnapshots (peerID text primary key not null, listingIndex blob, profile blob, timestamp integer);
+	`
+
 func initDatabaseTables(db *sql.DB, password string) error {
 	var sqlStmt string
 	if password != "" {
@@ -297,6 +424,7 @@ func initDatabaseTables(db *sql.DB, password string) error {
 	create index index_coupons on coupons (slug);
 	create table moderatedstores (peerID text primary key not null);
 	`
+	sqlStmt += addedTables
 	_, err := db.Exec(sqlStmt)
 	if err != nil {
 		return err
@@ -368,6 +496,27 @@ func (c *ConfigDB) GetIdentityKey() ([]byte, error) {
 	return identityKey, nil
 }
 
+func (c *ConfigDB) SetIdentityKey(identityKey []byte) error {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	tx, err := c.db.Begin()
+	if err != nil {
+		return err
+	}
+	stmt, err := tx.Prepare("insert or replace into config(key, value) values(?,?)")
+	if err != nil {
+		return err
+	}
+	defer stmt.Close()
+	_, err = stmt.Exec("identityKey", identityKey)
+	if err != nil {
+		tx.Rollback()
+		return err
+	}
+	tx.Commit()
+	return nil
+}
+
 func (c *ConfigDB) IsEncrypted() bool {
 	c.lock.RLock()
 	defer c.lock.RUnlock()
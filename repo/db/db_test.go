@@ -1,6 +1,7 @@
 package db
 
 import (
+	"database/sql"
 	"os"
 	"path"
 	"testing"
@@ -31,6 +32,44 @@ func TestCreate(t *testing.T) {
 	}
 }
 
+func TestCreateMissingTables(t *testing.T) {
+	repoPath := path.Join("./", "existing")
+	os.MkdirAll(path.Join(repoPath, "datastore"), os.ModePerm)
+	defer os.RemoveAll(repoPath)
+	conn, err := sql.Open("sqlite3", path.Join(repoPath, "datastore", "mainnet.db"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = conn.Exec(`
+	create table config (key text primary key not null, value blob);
+	create table inventory (slug text, variantIndex integer, count integer);
+	create table moderatedstores (peerID text primary key not null);
+	`)
+	conn.Close()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	existing, err := Create(repoPath, "", false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer existing.Close()
+	for _, table := range []string{"backorders", "caseattachments", "shippingprofiles", "vouchers", "subscriptions", "casechat", "resolutiontemplates", "reports", "ratings", "socialproofs", "successions", "feed", "feedsnapshots"} {
+		var n int
+		err := existing.db.QueryRow("select count(*) from sqlite_master where type='table' and name=?;", table).Scan(&n)
+		if err != nil {
+			t.Error(err)
+		}
+		if n != 1 {
+			t.Errorf("Table %s was not created on an existing database", table)
+		}
+	}
+	if _, err := existing.ShippingProfiles().GetAll(); err != nil {
+		t.Error(err)
+	}
+}
+
 func TestInit(t *testing.T) {
 	mn, err := testDB.config.GetMnemonic()
 	if err != nil {
@@ -51,6 +90,20 @@ func TestInit(t *testing.T) {
 	}
 }
 
+func TestSetIdentityKey(t *testing.T) {
+	if err := testDB.config.SetIdentityKey([]byte("Rotated Key")); err != nil {
+		t.Error(err)
+	}
+	pk, err := testDB.config.GetIdentityKey()
+	if err != nil {
+		t.Error(err)
+	}
+	if string(pk) != "Rotated Key" {
+		t.Error("Config returned wrong identity key")
+	}
+	testDB.config.SetIdentityKey([]byte("Private Key"))
+}
+
 func TestInterface(t *testing.T) {
 	if testDB.Config() != testDB.config {
 		t.Error("Config() return wrong value")
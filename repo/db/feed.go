@@ -0,0 +1,131 @@
+package db
+
+import (
+	"database/sql"
+	"encoding/json"
+	"sync"
+	"time"
+
+	"github.com/OpenBazaar/openbazaar-go/repo"
+)
+
+type FeedDB struct {
+	db   *sql.DB
+	lock sync.RWMutex
+}
+
+func (f *FeedDB) Put(event repo.FeedEvent) (int, error) {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+	event.ID = 0
+	ser, err := json.Marshal(event)
+	if err != nil {
+		return 0, err
+	}
+	tx, err := f.db.Begin()
+	if err != nil {
+		return 0, err
+	}
+	stmt, err := tx.Prepare("insert into feed(peerID, type, event, timestamp) values(?,?,?,?)")
+	if err != nil {
+		return 0, err
+	}
+	defer stmt.Close()
+	res, err := stmt.Exec(event.PeerId, event.Type, string(ser), int(event.Timestamp.Unix()))
+	if err != nil {
+		tx.Rollback()
+		return 0, err
+	}
+	id, err := res.LastInsertId()
+	if err != nil {
+		tx.Rollback()
+		return 0, err
+	}
+	tx.Commit()
+	return int(id), nil
+}
+
+func (f *FeedDB) GetAll(offsetID int, limit int) ([]repo.FeedEvent, error) {
+	f.lock.RLock()
+	defer f.lock.RUnlock()
+	var rows *sql.Rows
+	var err error
+	if offsetID > 0 {
+		rows, err = f.db.Query("select rowid, event from feed where rowid<? order by rowid desc limit ?", offsetID, limit)
+	} else {
+		rows, err = f.db.Query("select rowid, event from feed order by rowid desc limit ?", limit)
+	}
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var ret []repo.FeedEvent
+	for rows.Next() {
+		var id int
+		var ser []byte
+		if err := rows.Scan(&id, &ser); err != nil {
+			return nil, err
+		}
+		var event repo.FeedEvent
+		if err := json.Unmarshal(ser, &event); err != nil {
+			return nil, err
+		}
+		event.ID = id
+		ret = append(ret, event)
+	}
+	return ret, nil
+}
+
+func (f *FeedDB) PutSnapshot(peerID string, listingIndex, profile []byte) error {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+	tx, err := f.db.Begin()
+	if err != nil {
+		return err
+	}
+	stmt, err := tx.Prepare("insert or replace into feedsnapshots(peerID, listingIndex, profile, timestamp) values(?,?,?,?)")
+	if err != nil {
+		return err
+	}
+	defer stmt.Close()
+	_, err = stmt.Exec(peerID, listingIndex, profile, int(time.Now().Unix()))
+	if err != nil {
+		tx.Rollback()
+		return err
+	}
+	tx.Commit()
+	return nil
+}
+
+func (f *FeedDB) GetSnapshot(peerID string) ([]byte, []byte, error) {
+	f.lock.RLock()
+	defer f.lock.RUnlock()
+	stmt, err := f.db.Prepare("select listingIndex, profile from feedsnapshots where peerID=?")
+	if err != nil {
+		return nil, nil, err
+	}
+	defer stmt.Close()
+	var listingIndex, profile []byte
+	if err := stmt.QueryRow(peerID).Scan(&listingIndex, &profile); err != nil {
+		return nil, nil, err
+	}
+	return listingIndex, profile, nil
+}
+
+func (f *FeedDB) DeletePeer(peerID string) error {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+	tx, err := f.db.Begin()
+	if err != nil {
+		return err
+	}
+	if _, err := tx.Exec("delete from feed where peerID=?", peerID); err != nil {
+		tx.Rollback()
+		return err
+	}
+	if _, err := tx.Exec("delete from feedsnapshots where peerID=?", peerID); err != nil {
+		tx.Rollback()
+		return err
+	}
+	return tx.Commit()
+}
@@ -0,0 +1,121 @@
+package db
+
+import (
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/OpenBazaar/openbazaar-go/repo"
+)
+
+var feeddb FeedDB
+
+func init() {
+	conn, _ := sql.Open("sqlite3", ":memory:")
+	initDatabaseTables(conn, "")
+	feeddb = FeedDB{
+		db: conn,
+	}
+}
+
+func TestFeedDB_Put(t *testing.T) {
+	event := repo.FeedEvent{Type: "newListing", PeerId: "QmStore", Slug: "test-listing", Timestamp: time.Now()}
+	id, err := feeddb.Put(event)
+	if err != nil {
+		t.Error(err)
+	}
+	stmt, err := feeddb.db.Prepare("select peerID, type from feed where rowid=?")
+	if err != nil {
+		t.Error(err)
+	}
+	defer stmt.Close()
+	var peerID, eventType string
+	err = stmt.QueryRow(id).Scan(&peerID, &eventType)
+	if err != nil {
+		t.Error(err)
+	}
+	if peerID != "QmStore" || eventType != "newListing" {
+		t.Error("Feed returned incorrect event")
+	}
+}
+
+func TestFeedDB_GetAll(t *testing.T) {
+	first, err := feeddb.Put(repo.FeedEvent{Type: "profileUpdate", PeerId: "QmFirst", Timestamp: time.Now()})
+	if err != nil {
+		t.Error(err)
+	}
+	second, err := feeddb.Put(repo.FeedEvent{Type: "priceChange", PeerId: "QmSecond", Timestamp: time.Now()})
+	if err != nil {
+		t.Error(err)
+	}
+	events, err := feeddb.GetAll(0, -1)
+	if err != nil {
+		t.Error(err)
+	}
+	if len(events) < 2 || events[0].ID != second || events[1].ID != first {
+		t.Error("Feed events were not returned newest first")
+	}
+	events, err = feeddb.GetAll(second, 1)
+	if err != nil {
+		t.Error(err)
+	}
+	if len(events) != 1 || events[0].ID != first || events[0].PeerId != "QmFirst" {
+		t.Error("Feed returned incorrect events after the offset")
+	}
+}
+
+func TestFeedDB_Snapshots(t *testing.T) {
+	if _, _, err := feeddb.GetSnapshot("QmStore"); err == nil {
+		t.Error("Expected an error for a peer without a snapshot")
+	}
+	err := feeddb.PutSnapshot("QmStore", []byte(`[]`), []byte(`{"name":"Store"}`))
+	if err != nil {
+		t.Error(err)
+	}
+	err = feeddb.PutSnapshot("QmStore", []byte(`[{"slug":"test"}]`), []byte(`{"name":"Store"}`))
+	if err != nil {
+		t.Error(err)
+	}
+	listingIndex, profile, err := feeddb.GetSnapshot("QmStore")
+	if err != nil {
+		t.Error(err)
+	}
+	if string(listingIndex) != `[{"slug":"test"}]` || string(profile) != `{"name":"Store"}` {
+		t.Error("Snapshot returned incorrect data")
+	}
+}
+
+func TestFeedDB_DeletePeer(t *testing.T) {
+	if _, err := feeddb.Put(repo.FeedEvent{Type: "newListing", PeerId: "QmUnfollowed", Timestamp: time.Now()}); err != nil {
+		t.Error(err)
+	}
+	kept, err := feeddb.Put(repo.FeedEvent{Type: "newListing", PeerId: "QmFollowed", Timestamp: time.Now()})
+	if err != nil {
+		t.Error(err)
+	}
+	if err := feeddb.PutSnapshot("QmUnfollowed", []byte(`[]`), []byte(`{}`)); err != nil {
+		t.Error(err)
+	}
+	if err := feeddb.DeletePeer("QmUnfollowed"); err != nil {
+		t.Error(err)
+	}
+	events, err := feeddb.GetAll(0, -1)
+	if err != nil {
+		t.Error(err)
+	}
+	found := false
+	for _, event := range events {
+		if event.PeerId == "QmUnfollowed" {
+			t.Error("Expected the unfollowed peer's events to be deleted")
+		}
+		if event.ID == kept {
+			found = true
+		}
+	}
+	if !found {
+		t.Error("Expected other peers' events to be kept")
+	}
+	if _, _, err := feeddb.GetSnapshot("QmUnfollowed"); err == nil {
+		t.Error("Expected the unfollowed peer's snapshot to be deleted")
+	}
+}
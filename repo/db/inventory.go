@@ -109,3 +109,32 @@ func (i *InventoryDB) DeleteAll(slug string) error {
 	_, err := i.db.Exec("delete from inventory where slug=?", slug)
 	return err
 }
+
+func (i *InventoryDB) PutBackorders(slug string, variantIndex int, count int) error {
+	i.lock.Lock()
+	defer i.lock.Unlock()
+	if count <= 0 {
+		_, err := i.db.Exec("delete from backorders where slug=? and variantIndex=?", slug, variantIndex)
+		return err
+	}
+	_, err := i.db.Exec("insert or replace into backorders(slug, variantIndex, count) values(?,?,?)", slug, variantIndex, count)
+	return err
+}
+
+func (i *InventoryDB) GetBackorders(slug string, variantIndex int) (int, error) {
+	i.lock.RLock()
+	defer i.lock.RUnlock()
+	stmt, err := i.db.Prepare("select count from backorders where slug=? and variantIndex=?")
+	if err != nil {
+		return 0, err
+	}
+	defer stmt.Close()
+	var count int
+	err = stmt.QueryRow(slug, variantIndex).Scan(&count)
+	if err == sql.ErrNoRows {
+		return 0, nil
+	} else if err != nil {
+		return 0, err
+	}
+	return count, nil
+}
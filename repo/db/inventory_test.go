@@ -125,3 +125,36 @@ func TestGetInventory(t *testing.T) {
 		t.Error("Failed to get all inventory")
 	}
 }
+
+func TestBackorders(t *testing.T) {
+	count, err := ivdb.GetBackorders("backordered", 0)
+	if err != nil {
+		t.Error(err)
+	}
+	if count != 0 {
+		t.Error("Expected no backorders for a new variant")
+	}
+	err = ivdb.PutBackorders("backordered", 0, 3)
+	if err != nil {
+		t.Error(err)
+	}
+	err = ivdb.PutBackorders("backordered", 0, 7)
+	if err != nil {
+		t.Error(err)
+	}
+	count, err = ivdb.GetBackorders("backordered", 0)
+	if err != nil {
+		t.Error(err)
+	}
+	if count != 7 {
+		t.Error("Returned incorrect backorder count")
+	}
+	err = ivdb.PutBackorders("backordered", 0, 0)
+	if err != nil {
+		t.Error(err)
+	}
+	count, _ = ivdb.GetBackorders("backordered", 0)
+	if count != 0 {
+		t.Error("Failed to clear backorders")
+	}
+}
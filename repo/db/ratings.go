@@ -0,0 +1,66 @@
+package db
+
+import (
+	"database/sql"
+	"sync"
+	"time"
+
+	"github.com/OpenBazaar/jsonpb"
+	"github.com/OpenBazaar/openbazaar-go/pb"
+)
+
+type RatingsDB struct {
+	db   *sql.DB
+	lock sync.RWMutex
+}
+
+func (r *RatingsDB) Put(hash, peerID string, rating *pb.OrderCompletion_Rating) error {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	m := jsonpb.Marshaler{
+		EnumsAsInts:  false,
+		EmitDefaults: false,
+		Indent:       "",
+		OrigName:     false,
+	}
+	out, err := m.MarshalToString(rating)
+	if err != nil {
+		return err
+	}
+	tx, err := r.db.Begin()
+	if err != nil {
+		return err
+	}
+	stmt, err := tx.Prepare("insert or replace into ratings(hash, peerID, rating, timestamp) values(?,?,?,?)")
+	if err != nil {
+		return err
+	}
+	defer stmt.Close()
+	_, err = stmt.Exec(hash, peerID, out, int(time.Now().Unix()))
+	if err != nil {
+		tx.Rollback()
+		return err
+	}
+	tx.Commit()
+	return nil
+}
+
+func (r *RatingsDB) Get(hash string) (*pb.OrderCompletion_Rating, error) {
+	r.lock.RLock()
+	defer r.lock.RUnlock()
+	stmt, err := r.db.Prepare("select rating from ratings where hash=?")
+	if err != nil {
+		return nil, err
+	}
+	defer stmt.Close()
+	var ratingBytes []byte
+	err = stmt.QueryRow(hash).Scan(&ratingBytes)
+	if err != nil {
+		return nil, err
+	}
+	rating := new(pb.OrderCompletion_Rating)
+	if err := jsonpb.UnmarshalString(string(ratingBytes), rating); err != nil {
+		return nil, err
+	}
+	return rating, nil
+}
@@ -0,0 +1,71 @@
+package db
+
+import (
+	"database/sql"
+	"testing"
+
+	"github.com/OpenBazaar/openbazaar-go/pb"
+)
+
+var ratingsdb RatingsDB
+
+func init() {
+	conn, _ := sql.Open("sqlite3", ":memory:")
+	initDatabaseTables(conn, "")
+	ratingsdb = RatingsDB{
+		db: conn,
+	}
+}
+
+func newRating() *pb.OrderCompletion_Rating {
+	return &pb.OrderCompletion_Rating{
+		RatingData: &pb.OrderCompletion_Rating_RatingData{
+			RatingKey: []byte("key"),
+			VendorID:  &pb.ID{PeerID: "QmVendor"},
+			Overall:   4,
+			Quality:   5,
+			Review:    "Arrived quickly",
+		},
+		Signature: []byte("sig"),
+	}
+}
+
+func TestRatingsDB_Put(t *testing.T) {
+	err := ratingsdb.Put("QmRatingPut", "QmVendor", newRating())
+	if err != nil {
+		t.Error(err)
+	}
+	stmt, err := ratingsdb.db.Prepare("select peerID from ratings where hash=?")
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	defer stmt.Close()
+	var peerID string
+	err = stmt.QueryRow("QmRatingPut").Scan(&peerID)
+	if err != nil {
+		t.Error(err)
+	}
+	if peerID != "QmVendor" {
+		t.Error("Rating put failed to put correct data")
+	}
+}
+
+func TestRatingsDB_Get(t *testing.T) {
+	err := ratingsdb.Put("QmRatingGet", "QmVendor", newRating())
+	if err != nil {
+		t.Error(err)
+	}
+	rating, err := ratingsdb.Get("QmRatingGet")
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	if rating.RatingData.Overall != 4 || rating.RatingData.Review != "Arrived quickly" || string(rating.Signature) != "sig" {
+		t.Error("Rating get returned incorrect data")
+	}
+	_, err = ratingsdb.Get("QmMissing")
+	if err == nil {
+		t.Error("Expected an error getting a rating which is not cached")
+	}
+}
@@ -0,0 +1,87 @@
+package db
+
+import (
+	"database/sql"
+	"sync"
+	"time"
+
+	"github.com/OpenBazaar/jsonpb"
+	"github.com/OpenBazaar/openbazaar-go/pb"
+	"github.com/golang/protobuf/ptypes"
+)
+
+type ReportsDB struct {
+	db   *sql.DB
+	lock sync.RWMutex
+}
+
+func (r *ReportsDB) Put(report *pb.Report) error {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	m := jsonpb.Marshaler{
+		EnumsAsInts:  false,
+		EmitDefaults: false,
+		Indent:       "",
+		OrigName:     false,
+	}
+	out, err := m.MarshalToString(report)
+	if err != nil {
+		return err
+	}
+	timestamp := time.Now()
+	if report.Timestamp != nil {
+		if ts, err := ptypes.Timestamp(report.Timestamp); err == nil {
+			timestamp = ts
+		}
+	}
+	tx, err := r.db.Begin()
+	if err != nil {
+		return err
+	}
+	stmt, err := tx.Prepare("insert or ignore into reports(reportID, reporterID, listingHash, peerID, report, timestamp) values(?,?,?,?,?,?)")
+	if err != nil {
+		return err
+	}
+	defer stmt.Close()
+	_, err = stmt.Exec(report.ReportId, report.ReporterID, report.ListingHash, report.PeerID, out, int(timestamp.Unix()))
+	if err != nil {
+		tx.Rollback()
+		return err
+	}
+	tx.Commit()
+	return nil
+}
+
+func (r *ReportsDB) GetAll() ([]*pb.Report, error) {
+	r.lock.RLock()
+	defer r.lock.RUnlock()
+	rows, err := r.db.Query("select report from reports order by timestamp desc, rowid desc")
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var ret []*pb.Report
+	for rows.Next() {
+		var reportBytes []byte
+		if err := rows.Scan(&reportBytes); err != nil {
+			return ret, err
+		}
+		report := new(pb.Report)
+		if err := jsonpb.UnmarshalString(string(reportBytes), report); err != nil {
+			log.Error(err)
+			continue
+		}
+		ret = append(ret, report)
+	}
+	return ret, nil
+}
+
+func (r *ReportsDB) Delete(reportID string) error {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	_, err := r.db.Exec("delete from reports where reportID=?", reportID)
+	if err != nil {
+		return err
+	}
+	return nil
+}
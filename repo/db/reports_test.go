@@ -0,0 +1,113 @@
+package db
+
+import (
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/OpenBazaar/openbazaar-go/pb"
+	"github.com/golang/protobuf/ptypes"
+)
+
+var reportsdb ReportsDB
+
+func init() {
+	conn, _ := sql.Open("sqlite3", ":memory:")
+	initDatabaseTables(conn, "")
+	reportsdb = ReportsDB{
+		db: conn,
+	}
+}
+
+func TestReportsDB_Put(t *testing.T) {
+	ts, _ := ptypes.TimestampProto(time.Now())
+	report := &pb.Report{
+		ReportId:    "put",
+		ReporterID:  "QmReporter",
+		ListingHash: "QmListingput",
+		PeerID:      "QmVendor",
+		Reason:      pb.Report_COUNTERFEIT,
+		Evidence:    "Listed as genuine but the photos show a replica",
+		Timestamp:   ts,
+		Signature:   []byte("sig"),
+	}
+	err := reportsdb.Put(report)
+	if err != nil {
+		t.Error(err)
+	}
+	stmt, err := reportsdb.db.Prepare("select reporterID, listingHash, peerID from reports where reportID=?")
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	defer stmt.Close()
+	var reporterID, listingHash, peerID string
+	err = stmt.QueryRow("put").Scan(&reporterID, &listingHash, &peerID)
+	if err != nil {
+		t.Error(err)
+	}
+	if reporterID != "QmReporter" || listingHash != "QmListingput" || peerID != "QmVendor" {
+		t.Error("Report put failed to put correct data")
+	}
+}
+
+func TestReportsDB_GetAll(t *testing.T) {
+	now := time.Now()
+	ts, _ := ptypes.TimestampProto(now.Add(-time.Hour))
+	report := &pb.Report{
+		ReportId:    "older",
+		ReporterID:  "QmReporter",
+		ListingHash: "QmListingolder",
+		PeerID:      "QmVendor",
+		Reason:      pb.Report_COUNTERFEIT,
+		Evidence:    "Listed as genuine but the photos show a replica",
+		Timestamp:   ts,
+		Signature:   []byte("sig"),
+	}
+	reportsdb.Put(report)
+	report.ReportId = "newer"
+	report.ListingHash = "QmListingnewer"
+	report.Timestamp, _ = ptypes.TimestampProto(now.Add(time.Hour))
+	reportsdb.Put(report)
+	reports, err := reportsdb.GetAll()
+	if err != nil {
+		t.Error(err)
+	}
+	if len(reports) < 2 {
+		t.Fatal("GetAll returned incorrect number of reports")
+	}
+	if reports[0].ReportId != "newer" {
+		t.Error("GetAll failed to return the newest report first")
+	}
+	if reports[0].Reason != pb.Report_COUNTERFEIT {
+		t.Error("GetAll returned incorrect reason")
+	}
+}
+
+func TestReportsDB_Delete(t *testing.T) {
+	ts, _ := ptypes.TimestampProto(time.Now())
+	report := &pb.Report{
+		ReportId:    "delete",
+		ReporterID:  "QmReporter",
+		ListingHash: "QmListingdelete",
+		PeerID:      "QmVendor",
+		Reason:      pb.Report_COUNTERFEIT,
+		Evidence:    "Listed as genuine but the photos show a replica",
+		Timestamp:   ts,
+		Signature:   []byte("sig"),
+	}
+	reportsdb.Put(report)
+	err := reportsdb.Delete("delete")
+	if err != nil {
+		t.Error(err)
+	}
+	reports, err := reportsdb.GetAll()
+	if err != nil {
+		t.Error(err)
+	}
+	for _, r := range reports {
+		if r.ReportId == "delete" {
+			t.Error("Failed to delete report")
+		}
+	}
+}
@@ -0,0 +1,100 @@
+package db
+
+import (
+	"database/sql"
+	"sync"
+
+	"github.com/OpenBazaar/jsonpb"
+	"github.com/OpenBazaar/openbazaar-go/pb"
+)
+
+type ResolutionTemplatesDB struct {
+	db   *sql.DB
+	lock sync.RWMutex
+}
+
+func (r *ResolutionTemplatesDB) Put(template *pb.ResolutionTemplate) error {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	m := jsonpb.Marshaler{
+		EnumsAsInts:  false,
+		EmitDefaults: false,
+		Indent:       "    ",
+		OrigName:     false,
+	}
+	out, err := m.MarshalToString(template)
+	if err != nil {
+		return err
+	}
+	tx, err := r.db.Begin()
+	if err != nil {
+		return err
+	}
+	stmt, err := tx.Prepare("insert or replace into resolutiontemplates(name, template) values(?,?)")
+	if err != nil {
+		return err
+	}
+	defer stmt.Close()
+	_, err = stmt.Exec(template.Name, out)
+	if err != nil {
+		tx.Rollback()
+		return err
+	}
+	tx.Commit()
+	return nil
+}
+
+func (r *ResolutionTemplatesDB) Get(name string) (*pb.ResolutionTemplate, error) {
+	r.lock.RLock()
+	defer r.lock.RUnlock()
+	stmt, err := r.db.Prepare("select template from resolutiontemplates where name=?")
+	if err != nil {
+		return nil, err
+	}
+	defer stmt.Close()
+	var templateBytes []byte
+	err = stmt.QueryRow(name).Scan(&templateBytes)
+	if err != nil {
+		return nil, err
+	}
+	template := new(pb.ResolutionTemplate)
+	err = jsonpb.UnmarshalString(string(templateBytes), template)
+	if err != nil {
+		return nil, err
+	}
+	return template, nil
+}
+
+func (r *ResolutionTemplatesDB) GetAll() ([]*pb.ResolutionTemplate, error) {
+	r.lock.RLock()
+	defer r.lock.RUnlock()
+	rows, err := r.db.Query("select template from resolutiontemplates order by name asc")
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var ret []*pb.ResolutionTemplate
+	for rows.Next() {
+		var templateBytes []byte
+		if err := rows.Scan(&templateBytes); err != nil {
+			return ret, err
+		}
+		template := new(pb.ResolutionTemplate)
+		if err := jsonpb.UnmarshalString(string(templateBytes), template); err != nil {
+			log.Error(err)
+			continue
+		}
+		ret = append(ret, template)
+	}
+	return ret, nil
+}
+
+func (r *ResolutionTemplatesDB) Delete(name string) error {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	_, err := r.db.Exec("delete from resolutiontemplates where name=?", name)
+	if err != nil {
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,146 @@
+package db
+
+import (
+	"database/sql"
+	"testing"
+
+	"github.com/OpenBazaar/openbazaar-go/pb"
+)
+
+var rtdb ResolutionTemplatesDB
+
+func init() {
+	conn, _ := sql.Open("sqlite3", ":memory:")
+	initDatabaseTables(conn, "")
+	rtdb = ResolutionTemplatesDB{
+		db: conn,
+	}
+}
+
+func TestResolutionTemplatesDB_Put(t *testing.T) {
+	template := &pb.ResolutionTemplate{
+		Name:             "put",
+		BuyerPercentage:  100,
+		VendorPercentage: 0,
+		Resolution:       "Ruling for put",
+	}
+	err := rtdb.Put(template)
+	if err != nil {
+		t.Error(err)
+	}
+	stmt, err := rtdb.db.Prepare("select name from resolutiontemplates where name=?")
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	defer stmt.Close()
+	var name string
+	err = stmt.QueryRow("put").Scan(&name)
+	if err != nil {
+		t.Error(err)
+	}
+	if name != "put" {
+		t.Error("Resolution template put failed to put correct name")
+	}
+}
+
+func TestResolutionTemplatesDB_Get(t *testing.T) {
+	template := &pb.ResolutionTemplate{
+		Name:             "get",
+		BuyerPercentage:  50,
+		VendorPercentage: 50,
+		Resolution:       "Ruling for get",
+	}
+	err := rtdb.Put(template)
+	if err != nil {
+		t.Error(err)
+	}
+	template, err = rtdb.Get("get")
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	if template.Name != "get" {
+		t.Error("Returned incorrect template name")
+	}
+	if template.BuyerPercentage != 50 || template.VendorPercentage != 50 {
+		t.Error("Returned incorrect percentages")
+	}
+	if template.Resolution != "Ruling for get" {
+		t.Error("Returned incorrect resolution")
+	}
+	_, err = rtdb.Get("missing")
+	if err == nil {
+		t.Error("Get of a missing template should return an error")
+	}
+}
+
+func TestResolutionTemplatesDB_PutReplaces(t *testing.T) {
+	template := &pb.ResolutionTemplate{
+		Name:             "replace",
+		BuyerPercentage:  100,
+		VendorPercentage: 0,
+		Resolution:       "Ruling for replace",
+	}
+	err := rtdb.Put(template)
+	if err != nil {
+		t.Error(err)
+	}
+	template.BuyerPercentage = 25
+	template.VendorPercentage = 75
+	err = rtdb.Put(template)
+	if err != nil {
+		t.Error(err)
+	}
+	template, err = rtdb.Get("replace")
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	if template.BuyerPercentage != 25 {
+		t.Error("Put failed to replace existing template")
+	}
+}
+
+func TestResolutionTemplatesDB_GetAll(t *testing.T) {
+	template := &pb.ResolutionTemplate{
+		Name:             "all1",
+		BuyerPercentage:  100,
+		VendorPercentage: 0,
+		Resolution:       "Ruling for all1",
+	}
+	rtdb.Put(template)
+	template.Name = "all2"
+	rtdb.Put(template)
+	templates, err := rtdb.GetAll()
+	if err != nil {
+		t.Error(err)
+	}
+	found := 0
+	for _, r := range templates {
+		if r.Name == "all1" || r.Name == "all2" {
+			found++
+		}
+	}
+	if found != 2 {
+		t.Error("GetAll returned incorrect templates")
+	}
+}
+
+func TestResolutionTemplatesDB_Delete(t *testing.T) {
+	template := &pb.ResolutionTemplate{
+		Name:             "delete",
+		BuyerPercentage:  100,
+		VendorPercentage: 0,
+		Resolution:       "Ruling for delete",
+	}
+	rtdb.Put(template)
+	err := rtdb.Delete("delete")
+	if err != nil {
+		t.Error(err)
+	}
+	_, err = rtdb.Get("delete")
+	if err == nil {
+		t.Error("Failed to delete resolution template")
+	}
+}
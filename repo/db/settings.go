@@ -101,12 +101,18 @@ func (s *SettingsDB) Update(settings repo.SettingsData) error {
 	if settings.StoreModerators == nil {
 		settings.StoreModerators = current.StoreModerators
 	}
+	if settings.BlocklistSubscriptions == nil {
+		settings.BlocklistSubscriptions = current.BlocklistSubscriptions
+	}
 	if settings.MisPaymentBuffer == nil {
 		settings.MisPaymentBuffer = current.MisPaymentBuffer
 	}
 	if settings.SMTPSettings == nil {
 		settings.SMTPSettings = current.SMTPSettings
 	}
+	if settings.DisputeDeadlines == nil {
+		settings.DisputeDeadlines = current.DisputeDeadlines
+	}
 	err = s.Put(settings)
 	if err != nil {
 		return err
@@ -0,0 +1,100 @@
+package db
+
+import (
+	"database/sql"
+	"sync"
+
+	"github.com/OpenBazaar/jsonpb"
+	"github.com/OpenBazaar/openbazaar-go/pb"
+)
+
+type ShippingProfilesDB struct {
+	db   *sql.DB
+	lock sync.RWMutex
+}
+
+func (s *ShippingProfilesDB) Put(profile *pb.ShippingProfile) error {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	m := jsonpb.Marshaler{
+		EnumsAsInts:  false,
+		EmitDefaults: false,
+		Indent:       "    ",
+		OrigName:     false,
+	}
+	out, err := m.MarshalToString(profile)
+	if err != nil {
+		return err
+	}
+	tx, err := s.db.Begin()
+	if err != nil {
+		return err
+	}
+	stmt, err := tx.Prepare("insert or replace into shippingprofiles(name, profile) values(?,?)")
+	if err != nil {
+		return err
+	}
+	defer stmt.Close()
+	_, err = stmt.Exec(profile.Name, out)
+	if err != nil {
+		tx.Rollback()
+		return err
+	}
+	tx.Commit()
+	return nil
+}
+
+func (s *ShippingProfilesDB) Get(name string) (*pb.ShippingProfile, error) {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+	stmt, err := s.db.Prepare("select profile from shippingprofiles where name=?")
+	if err != nil {
+		return nil, err
+	}
+	defer stmt.Close()
+	var profileBytes []byte
+	err = stmt.QueryRow(name).Scan(&profileBytes)
+	if err != nil {
+		return nil, err
+	}
+	profile := new(pb.ShippingProfile)
+	err = jsonpb.UnmarshalString(string(profileBytes), profile)
+	if err != nil {
+		return nil, err
+	}
+	return profile, nil
+}
+
+func (s *ShippingProfilesDB) GetAll() ([]*pb.ShippingProfile, error) {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+	rows, err := s.db.Query("select profile from shippingprofiles order by name asc")
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var ret []*pb.ShippingProfile
+	for rows.Next() {
+		var profileBytes []byte
+		if err := rows.Scan(&profileBytes); err != nil {
+			return ret, err
+		}
+		profile := new(pb.ShippingProfile)
+		if err := jsonpb.UnmarshalString(string(profileBytes), profile); err != nil {
+			log.Error(err)
+			continue
+		}
+		ret = append(ret, profile)
+	}
+	return ret, nil
+}
+
+func (s *ShippingProfilesDB) Delete(name string) error {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	_, err := s.db.Exec("delete from shippingprofiles where name=?", name)
+	if err != nil {
+		return err
+	}
+	return nil
+}
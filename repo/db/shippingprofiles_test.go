@@ -0,0 +1,178 @@
+package db
+
+import (
+	"database/sql"
+	"testing"
+
+	"github.com/OpenBazaar/openbazaar-go/pb"
+)
+
+var spdb ShippingProfilesDB
+
+func init() {
+	conn, _ := sql.Open("sqlite3", ":memory:")
+	initDatabaseTables(conn, "")
+	spdb = ShippingProfilesDB{
+		db: conn,
+	}
+}
+
+func TestShippingProfilesDB_Put(t *testing.T) {
+	profile := &pb.ShippingProfile{
+		Name: "put",
+		ShippingOptions: []*pb.Listing_ShippingOption{
+			{
+				Name:    "Domestic",
+				Type:    pb.Listing_ShippingOption_FIXED_PRICE,
+				Regions: []pb.CountryCode{pb.CountryCode_UNITED_STATES},
+				Services: []*pb.Listing_ShippingOption_Service{
+					{Name: "Standard", Price: 100, EstimatedDelivery: "3 days"},
+				},
+			},
+		},
+	}
+	err := spdb.Put(profile)
+	if err != nil {
+		t.Error(err)
+	}
+	stmt, err := spdb.db.Prepare("select name from shippingprofiles where name=?")
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	defer stmt.Close()
+	var name string
+	err = stmt.QueryRow("put").Scan(&name)
+	if err != nil {
+		t.Error(err)
+	}
+	if name != "put" {
+		t.Error("Shipping profile put failed to put correct name")
+	}
+}
+
+func TestShippingProfilesDB_Get(t *testing.T) {
+	profile := &pb.ShippingProfile{
+		Name: "get",
+		ShippingOptions: []*pb.Listing_ShippingOption{
+			{
+				Name:    "Domestic",
+				Type:    pb.Listing_ShippingOption_FIXED_PRICE,
+				Regions: []pb.CountryCode{pb.CountryCode_UNITED_STATES},
+				Services: []*pb.Listing_ShippingOption_Service{
+					{Name: "Standard", Price: 250, EstimatedDelivery: "3 days"},
+				},
+			},
+		},
+	}
+	err := spdb.Put(profile)
+	if err != nil {
+		t.Error(err)
+	}
+	ret, err := spdb.Get("get")
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	if ret.Name != "get" {
+		t.Error("Returned incorrect profile name")
+	}
+	if len(ret.ShippingOptions) != 1 || ret.ShippingOptions[0].Services[0].Price != 250 {
+		t.Error("Returned incorrect shipping options")
+	}
+	_, err = spdb.Get("missing")
+	if err == nil {
+		t.Error("Get of a missing profile should return an error")
+	}
+}
+
+func TestShippingProfilesDB_PutReplaces(t *testing.T) {
+	profile := &pb.ShippingProfile{
+		Name: "replace",
+		ShippingOptions: []*pb.Listing_ShippingOption{
+			{
+				Name:    "Domestic",
+				Type:    pb.Listing_ShippingOption_FIXED_PRICE,
+				Regions: []pb.CountryCode{pb.CountryCode_UNITED_STATES},
+				Services: []*pb.Listing_ShippingOption_Service{
+					{Name: "Standard", Price: 100, EstimatedDelivery: "3 days"},
+				},
+			},
+		},
+	}
+	err := spdb.Put(profile)
+	if err != nil {
+		t.Error(err)
+	}
+	profile.ShippingOptions[0].Services[0].Price = 500
+	err = spdb.Put(profile)
+	if err != nil {
+		t.Error(err)
+	}
+	ret, err := spdb.Get("replace")
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	if ret.ShippingOptions[0].Services[0].Price != 500 {
+		t.Error("Put failed to replace existing profile")
+	}
+}
+
+func TestShippingProfilesDB_GetAll(t *testing.T) {
+	profile := &pb.ShippingProfile{
+		Name: "all1",
+		ShippingOptions: []*pb.Listing_ShippingOption{
+			{
+				Name:    "Domestic",
+				Type:    pb.Listing_ShippingOption_FIXED_PRICE,
+				Regions: []pb.CountryCode{pb.CountryCode_UNITED_STATES},
+				Services: []*pb.Listing_ShippingOption_Service{
+					{Name: "Standard", Price: 100, EstimatedDelivery: "3 days"},
+				},
+			},
+		},
+	}
+	spdb.Put(profile)
+	profile.Name = "all2"
+	profile.ShippingOptions[0].Services[0].Price = 200
+	spdb.Put(profile)
+	profiles, err := spdb.GetAll()
+	if err != nil {
+		t.Error(err)
+	}
+	found := 0
+	for _, p := range profiles {
+		if p.Name == "all1" || p.Name == "all2" {
+			found++
+		}
+	}
+	if found != 2 {
+		t.Error("GetAll returned incorrect profiles")
+	}
+}
+
+func TestShippingProfilesDB_Delete(t *testing.T) {
+	profile := &pb.ShippingProfile{
+		Name: "delete",
+		ShippingOptions: []*pb.Listing_ShippingOption{
+			{
+				Name:    "Domestic",
+				Type:    pb.Listing_ShippingOption_FIXED_PRICE,
+				Regions: []pb.CountryCode{pb.CountryCode_UNITED_STATES},
+				Services: []*pb.Listing_ShippingOption_Service{
+					{Name: "Standard", Price: 100, EstimatedDelivery: "3 days"},
+				},
+			},
+		},
+	}
+	spdb.Put(profile)
+	err := spdb.Delete("delete")
+	if err != nil {
+		t.Error(err)
+	}
+	_, err = spdb.Get("delete")
+	if err == nil {
+		t.Error("Failed to delete shipping profile")
+	}
+}
@@ -0,0 +1,53 @@
+package db
+
+import (
+	"database/sql"
+	"sync"
+	"time"
+)
+
+type SocialProofsDB struct {
+	db   *sql.DB
+	lock sync.RWMutex
+}
+
+func (s *SocialProofsDB) Put(peerID, accountType, username, proof string, verified bool) error {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	tx, err := s.db.Begin()
+	if err != nil {
+		return err
+	}
+	stmt, err := tx.Prepare("insert or replace into socialproofs(peerID, type, username, proof, verified, timestamp) values(?,?,?,?,?,?)")
+	if err != nil {
+		return err
+	}
+	defer stmt.Close()
+	verifiedInt := 0
+	if verified {
+		verifiedInt = 1
+	}
+	_, err = stmt.Exec(peerID, accountType, username, proof, verifiedInt, int(time.Now().Unix()))
+	if err != nil {
+		tx.Rollback()
+		return err
+	}
+	tx.Commit()
+	return nil
+}
+
+func (s *SocialProofsDB) Get(peerID, accountType, username, proof string) (bool, time.Time, error) {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+	stmt, err := s.db.Prepare("select verified, timestamp from socialproofs where peerID=? and type=? and username=? and proof=?")
+	if err != nil {
+		return false, time.Time{}, err
+	}
+	defer stmt.Close()
+	var verifiedInt, timestamp int
+	err = stmt.QueryRow(peerID, accountType, username, proof).Scan(&verifiedInt, &timestamp)
+	if err != nil {
+		return false, time.Time{}, err
+	}
+	return verifiedInt > 0, time.Unix(int64(timestamp), 0), nil
+}
@@ -0,0 +1,68 @@
+package db
+
+import (
+	"database/sql"
+	"testing"
+)
+
+var socialproofsdb SocialProofsDB
+
+func init() {
+	conn, _ := sql.Open("sqlite3", ":memory:")
+	initDatabaseTables(conn, "")
+	socialproofsdb = SocialProofsDB{
+		db: conn,
+	}
+}
+
+func TestSocialProofsDB_Put(t *testing.T) {
+	err := socialproofsdb.Put("QmPut", "twitter", "alice", "https://twitter.com/alice/status/1", true)
+	if err != nil {
+		t.Error(err)
+	}
+	stmt, err := socialproofsdb.db.Prepare("select proof, verified from socialproofs where peerID=? and type=? and username=?")
+	if err != nil {
+		t.Error(err)
+	}
+	defer stmt.Close()
+	var proof string
+	var verified int
+	err = stmt.QueryRow("QmPut", "twitter", "alice").Scan(&proof, &verified)
+	if err != nil {
+		t.Error(err)
+	}
+	if proof != "https://twitter.com/alice/status/1" || verified != 1 {
+		t.Error("Social proof returned incorrect values")
+	}
+}
+
+func TestSocialProofsDB_Get(t *testing.T) {
+	err := socialproofsdb.Put("QmGet", "twitter", "alice", "https://twitter.com/alice/status/1", true)
+	if err != nil {
+		t.Error(err)
+	}
+	verified, checked, err := socialproofsdb.Get("QmGet", "twitter", "alice", "https://twitter.com/alice/status/1")
+	if err != nil {
+		t.Error(err)
+	}
+	if !verified || checked.IsZero() {
+		t.Error("Social proof returned incorrect values")
+	}
+
+	// A new proof replaces the result for the old one
+	err = socialproofsdb.Put("QmGet", "twitter", "alice", "https://twitter.com/alice/status/2", false)
+	if err != nil {
+		t.Error(err)
+	}
+	_, _, err = socialproofsdb.Get("QmGet", "twitter", "alice", "https://twitter.com/alice/status/1")
+	if err == nil {
+		t.Error("Expected the result for the replaced proof to be gone")
+	}
+	verified, _, err = socialproofsdb.Get("QmGet", "twitter", "alice", "https://twitter.com/alice/status/2")
+	if err != nil {
+		t.Error(err)
+	}
+	if verified {
+		t.Error("Social proof returned incorrect values")
+	}
+}
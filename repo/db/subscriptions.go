@@ -0,0 +1,108 @@
+package db
+
+import (
+	"database/sql"
+	"sync"
+	"time"
+
+	"github.com/OpenBazaar/jsonpb"
+	"github.com/OpenBazaar/openbazaar-go/pb"
+	"github.com/golang/protobuf/ptypes"
+)
+
+type SubscriptionsDB struct {
+	db   *sql.DB
+	lock sync.RWMutex
+}
+
+func (s *SubscriptionsDB) Put(subscription *pb.Subscription) error {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	m := jsonpb.Marshaler{
+		EnumsAsInts:  false,
+		EmitDefaults: false,
+		Indent:       "    ",
+		OrigName:     false,
+	}
+	out, err := m.MarshalToString(subscription)
+	if err != nil {
+		return err
+	}
+	created := time.Now()
+	if subscription.Created != nil {
+		if ts, err := ptypes.Timestamp(subscription.Created); err == nil {
+			created = ts
+		}
+	}
+	tx, err := s.db.Begin()
+	if err != nil {
+		return err
+	}
+	stmt, err := tx.Prepare("insert or replace into subscriptions(id, buyerID, vendorID, status, subscription, timestamp) values(?,?,?,?,?,?)")
+	if err != nil {
+		return err
+	}
+	defer stmt.Close()
+	_, err = stmt.Exec(subscription.Id, subscription.BuyerID, subscription.VendorID, int(subscription.Status), out, int(created.Unix()))
+	if err != nil {
+		tx.Rollback()
+		return err
+	}
+	tx.Commit()
+	return nil
+}
+
+func (s *SubscriptionsDB) Get(id string) (*pb.Subscription, error) {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+	stmt, err := s.db.Prepare("select subscription from subscriptions where id=?")
+	if err != nil {
+		return nil, err
+	}
+	defer stmt.Close()
+	var subBytes []byte
+	err = stmt.QueryRow(id).Scan(&subBytes)
+	if err != nil {
+		return nil, err
+	}
+	subscription := new(pb.Subscription)
+	err = jsonpb.UnmarshalString(string(subBytes), subscription)
+	if err != nil {
+		return nil, err
+	}
+	return subscription, nil
+}
+
+func (s *SubscriptionsDB) GetAll() ([]*pb.Subscription, error) {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+	rows, err := s.db.Query("select subscription from subscriptions order by timestamp desc")
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var ret []*pb.Subscription
+	for rows.Next() {
+		var subBytes []byte
+		if err := rows.Scan(&subBytes); err != nil {
+			return ret, err
+		}
+		subscription := new(pb.Subscription)
+		if err := jsonpb.UnmarshalString(string(subBytes), subscription); err != nil {
+			log.Error(err)
+			continue
+		}
+		ret = append(ret, subscription)
+	}
+	return ret, nil
+}
+
+func (s *SubscriptionsDB) Delete(id string) error {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	_, err := s.db.Exec("delete from subscriptions where id=?", id)
+	if err != nil {
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,132 @@
+package db
+
+import (
+	"database/sql"
+	"testing"
+
+	"github.com/OpenBazaar/openbazaar-go/pb"
+)
+
+var subdb SubscriptionsDB
+
+func init() {
+	conn, _ := sql.Open("sqlite3", ":memory:")
+	initDatabaseTables(conn, "")
+	subdb = SubscriptionsDB{
+		db: conn,
+	}
+}
+
+func TestSubscriptionsDB_Put(t *testing.T) {
+	sub := &pb.Subscription{
+		Id:              "put",
+		BuyerID:         "QmBuyer",
+		VendorID:        "QmVendor",
+		ListingSlug:     "coffee",
+		BillingInterval: 30,
+		SpendingCap:     100000,
+	}
+	err := subdb.Put(sub)
+	if err != nil {
+		t.Error(err)
+	}
+	stmt, err := subdb.db.Prepare("select vendorID, status from subscriptions where id=?")
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	defer stmt.Close()
+	var vendorID string
+	var status int
+	err = stmt.QueryRow("put").Scan(&vendorID, &status)
+	if err != nil {
+		t.Error(err)
+	}
+	if vendorID != "QmVendor" {
+		t.Error("Subscription put failed to put correct vendor ID")
+	}
+	if status != int(pb.Subscription_ACTIVE) {
+		t.Error("Subscription put failed to put correct status")
+	}
+}
+
+func TestSubscriptionsDB_Get(t *testing.T) {
+	sub := &pb.Subscription{
+		Id:              "get",
+		BuyerID:         "QmBuyer",
+		VendorID:        "QmVendor",
+		ListingSlug:     "coffee",
+		BillingInterval: 30,
+		SpendingCap:     100000,
+	}
+	sub.OrderIDs = []string{"QmOrder1"}
+	subdb.Put(sub)
+	sub.Status = pb.Subscription_PAUSED
+	sub.OrderIDs = append(sub.OrderIDs, "QmOrder2")
+	err := subdb.Put(sub)
+	if err != nil {
+		t.Error(err)
+	}
+	ret, err := subdb.Get("get")
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	if ret.Status != pb.Subscription_PAUSED || len(ret.OrderIDs) != 2 {
+		t.Error("Put failed to replace existing subscription")
+	}
+	if ret.BillingInterval != 30 || ret.ListingSlug != "coffee" {
+		t.Error("Returned incorrect subscription")
+	}
+	_, err = subdb.Get("missing")
+	if err == nil {
+		t.Error("Get of a missing subscription should return an error")
+	}
+}
+
+func TestSubscriptionsDB_GetAll(t *testing.T) {
+	sub := &pb.Subscription{
+		Id:              "all1",
+		BuyerID:         "QmBuyer",
+		VendorID:        "QmVendor",
+		ListingSlug:     "coffee",
+		BillingInterval: 30,
+		SpendingCap:     100000,
+	}
+	subdb.Put(sub)
+	sub.Id = "all2"
+	subdb.Put(sub)
+	subs, err := subdb.GetAll()
+	if err != nil {
+		t.Error(err)
+	}
+	found := 0
+	for _, s := range subs {
+		if s.Id == "all1" || s.Id == "all2" {
+			found++
+		}
+	}
+	if found != 2 {
+		t.Error("GetAll returned incorrect subscriptions")
+	}
+}
+
+func TestSubscriptionsDB_Delete(t *testing.T) {
+	sub := &pb.Subscription{
+		Id:              "delete",
+		BuyerID:         "QmBuyer",
+		VendorID:        "QmVendor",
+		ListingSlug:     "coffee",
+		BillingInterval: 30,
+		SpendingCap:     100000,
+	}
+	subdb.Put(sub)
+	err := subdb.Delete("delete")
+	if err != nil {
+		t.Error(err)
+	}
+	_, err = subdb.Get("delete")
+	if err == nil {
+		t.Error("Failed to delete subscription")
+	}
+}
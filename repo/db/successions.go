@@ -0,0 +1,67 @@
+package db
+
+import (
+	"database/sql"
+	"sync"
+	"time"
+
+	"github.com/OpenBazaar/jsonpb"
+	"github.com/OpenBazaar/openbazaar-go/pb"
+)
+
+type SuccessionsDB struct {
+	db   *sql.DB
+	lock sync.RWMutex
+}
+
+func (s *SuccessionsDB) Put(predecessorID, successorID string, chain *pb.IdentityChain) error {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	m := jsonpb.Marshaler{
+		EnumsAsInts:  false,
+		EmitDefaults: false,
+		Indent:       "",
+		OrigName:     false,
+	}
+	out, err := m.MarshalToString(chain)
+	if err != nil {
+		return err
+	}
+	tx, err := s.db.Begin()
+	if err != nil {
+		return err
+	}
+	stmt, err := tx.Prepare("insert or replace into successions(predecessorID, successorID, chain, timestamp) values(?,?,?,?)")
+	if err != nil {
+		return err
+	}
+	defer stmt.Close()
+	_, err = stmt.Exec(predecessorID, successorID, out, int(time.Now().Unix()))
+	if err != nil {
+		tx.Rollback()
+		return err
+	}
+	tx.Commit()
+	return nil
+}
+
+func (s *SuccessionsDB) Get(predecessorID string) (string, *pb.IdentityChain, error) {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+	stmt, err := s.db.Prepare("select successorID, chain from successions where predecessorID=?")
+	if err != nil {
+		return "", nil, err
+	}
+	defer stmt.Close()
+	var successorID string
+	var chainBytes []byte
+	err = stmt.QueryRow(predecessorID).Scan(&successorID, &chainBytes)
+	if err != nil {
+		return "", nil, err
+	}
+	chain := new(pb.IdentityChain)
+	if err := jsonpb.UnmarshalString(string(chainBytes), chain); err != nil {
+		return "", nil, err
+	}
+	return successorID, chain, nil
+}
@@ -0,0 +1,65 @@
+package db
+
+import (
+	"database/sql"
+	"testing"
+
+	"github.com/OpenBazaar/openbazaar-go/pb"
+)
+
+var successionsdb SuccessionsDB
+
+func init() {
+	conn, _ := sql.Open("sqlite3", ":memory:")
+	initDatabaseTables(conn, "")
+	successionsdb = SuccessionsDB{
+		db: conn,
+	}
+}
+
+func TestSuccessionsDB_Put(t *testing.T) {
+	chain := &pb.IdentityChain{Successions: []*pb.IdentitySuccession{{PredecessorID: "QmOld", SuccessorID: "QmNew"}}}
+	err := successionsdb.Put("QmOld", "QmNew", chain)
+	if err != nil {
+		t.Error(err)
+	}
+	stmt, err := successionsdb.db.Prepare("select successorID from successions where predecessorID=?")
+	if err != nil {
+		t.Error(err)
+	}
+	defer stmt.Close()
+	var successorID string
+	err = stmt.QueryRow("QmOld").Scan(&successorID)
+	if err != nil {
+		t.Error(err)
+	}
+	if successorID != "QmNew" {
+		t.Error("Succession returned incorrect successor")
+	}
+}
+
+func TestSuccessionsDB_Get(t *testing.T) {
+	chain := &pb.IdentityChain{Successions: []*pb.IdentitySuccession{{PredecessorID: "QmFirst", SuccessorID: "QmSecond"}}}
+	err := successionsdb.Put("QmFirst", "QmSecond", chain)
+	if err != nil {
+		t.Error(err)
+	}
+
+	// A later rotation replaces the successor
+	chain.Successions = append(chain.Successions, &pb.IdentitySuccession{PredecessorID: "QmSecond", SuccessorID: "QmThird"})
+	err = successionsdb.Put("QmFirst", "QmThird", chain)
+	if err != nil {
+		t.Error(err)
+	}
+	successorID, ret, err := successionsdb.Get("QmFirst")
+	if err != nil {
+		t.Error(err)
+	}
+	if successorID != "QmThird" || len(ret.Successions) != 2 || ret.Successions[1].SuccessorID != "QmThird" {
+		t.Error("Succession returned incorrect values")
+	}
+	_, _, err = successionsdb.Get("QmMissing")
+	if err == nil {
+		t.Error("Expected an error for a peer without a successor")
+	}
+}
@@ -0,0 +1,144 @@
+package db
+
+import (
+	"database/sql"
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/OpenBazaar/jsonpb"
+	"github.com/OpenBazaar/openbazaar-go/pb"
+)
+
+type VouchersDB struct {
+	db   *sql.DB
+	lock sync.RWMutex
+}
+
+func (v *VouchersDB) Put(voucher *pb.Voucher) error {
+	v.lock.Lock()
+	defer v.lock.Unlock()
+	m := jsonpb.Marshaler{
+		EnumsAsInts:  false,
+		EmitDefaults: false,
+		Indent:       "    ",
+		OrigName:     false,
+	}
+	out, err := m.MarshalToString(voucher)
+	if err != nil {
+		return err
+	}
+	tx, err := v.db.Begin()
+	if err != nil {
+		return err
+	}
+	stmt, err := tx.Prepare("insert into vouchers(id, codeHash, buyerID, amount, balance, voucher, timestamp) values(?,?,?,?,?,?,?)")
+	if err != nil {
+		return err
+	}
+	defer stmt.Close()
+	_, err = stmt.Exec(voucher.Id, voucher.CodeHash, voucher.BuyerID, int64(voucher.Amount), int64(voucher.Amount), out, int(time.Now().Unix()))
+	if err != nil {
+		tx.Rollback()
+		return err
+	}
+	tx.Commit()
+	return nil
+}
+
+func (v *VouchersDB) Get(id string) (*pb.VoucherBalance, error) {
+	v.lock.RLock()
+	defer v.lock.RUnlock()
+	return v.getOne("select voucher, balance from vouchers where id=?", id)
+}
+
+func (v *VouchersDB) GetByCodeHash(hash string) (*pb.VoucherBalance, error) {
+	v.lock.RLock()
+	defer v.lock.RUnlock()
+	if hash == "" {
+		return nil, sql.ErrNoRows
+	}
+	return v.getOne("select voucher, balance from vouchers where codeHash=?", hash)
+}
+
+func (v *VouchersDB) getOne(query string, arg string) (*pb.VoucherBalance, error) {
+	stmt, err := v.db.Prepare(query)
+	if err != nil {
+		return nil, err
+	}
+	defer stmt.Close()
+	var voucherBytes []byte
+	var balance int64
+	err = stmt.QueryRow(arg).Scan(&voucherBytes, &balance)
+	if err != nil {
+		return nil, err
+	}
+	voucher := new(pb.Voucher)
+	err = jsonpb.UnmarshalString(string(voucherBytes), voucher)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.VoucherBalance{Voucher: voucher, Balance: uint64(balance)}, nil
+}
+
+func (v *VouchersDB) GetAll() ([]*pb.VoucherBalance, error) {
+	v.lock.RLock()
+	defer v.lock.RUnlock()
+	rows, err := v.db.Query("select voucher, balance from vouchers order by timestamp desc")
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var ret []*pb.VoucherBalance
+	for rows.Next() {
+		var voucherBytes []byte
+		var balance int64
+		if err := rows.Scan(&voucherBytes, &balance); err != nil {
+			return ret, err
+		}
+		voucher := new(pb.Voucher)
+		if err := jsonpb.UnmarshalString(string(voucherBytes), voucher); err != nil {
+			log.Error(err)
+			continue
+		}
+		ret = append(ret, &pb.VoucherBalance{Voucher: voucher, Balance: uint64(balance)})
+	}
+	return ret, nil
+}
+
+func (v *VouchersDB) Redeem(id string, amount uint64) error {
+	v.lock.Lock()
+	defer v.lock.Unlock()
+	res, err := v.db.Exec("update vouchers set balance=balance-? where id=? and balance>=?", int64(amount), id, int64(amount))
+	if err != nil {
+		return err
+	}
+	affected, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if affected == 0 {
+		return errors.New("Insufficient voucher balance")
+	}
+	return nil
+}
+
+func (v *VouchersDB) Restore(id string, amount uint64) error {
+	v.lock.Lock()
+	defer v.lock.Unlock()
+	_, err := v.db.Exec("update vouchers set balance=min(balance+?, amount) where id=?", int64(amount), id)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+func (v *VouchersDB) Delete(id string) error {
+	v.lock.Lock()
+	defer v.lock.Unlock()
+	_, err := v.db.Exec("delete from vouchers where id=?", id)
+	if err != nil {
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,129 @@
+package db
+
+import (
+	"database/sql"
+	"testing"
+
+	"github.com/OpenBazaar/openbazaar-go/pb"
+)
+
+var vdb VouchersDB
+
+func init() {
+	conn, _ := sql.Open("sqlite3", ":memory:")
+	initDatabaseTables(conn, "")
+	vdb = VouchersDB{
+		db: conn,
+	}
+}
+
+func TestVouchersDB_Put(t *testing.T) {
+	err := vdb.Put(&pb.Voucher{Id: "put", Amount: 1000, BuyerID: "QmBuyer"})
+	if err != nil {
+		t.Error(err)
+	}
+	stmt, err := vdb.db.Prepare("select buyerID, balance from vouchers where id=?")
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	defer stmt.Close()
+	var buyerID string
+	var balance int
+	err = stmt.QueryRow("put").Scan(&buyerID, &balance)
+	if err != nil {
+		t.Error(err)
+	}
+	if buyerID != "QmBuyer" {
+		t.Error("Voucher put failed to put correct buyer ID")
+	}
+	if balance != 1000 {
+		t.Error("Voucher put failed to set the initial balance")
+	}
+	err = vdb.Put(&pb.Voucher{Id: "put", Amount: 500})
+	if err == nil {
+		t.Error("Put should not replace an existing voucher")
+	}
+}
+
+func TestVouchersDB_Get(t *testing.T) {
+	vdb.Put(&pb.Voucher{Id: "get", Amount: 2000, CodeHash: "QmCode", Memo: "Gift card"})
+	vb, err := vdb.Get("get")
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	if vb.Voucher.Memo != "Gift card" || vb.Balance != 2000 {
+		t.Error("Returned incorrect voucher")
+	}
+	vb, err = vdb.GetByCodeHash("QmCode")
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	if vb.Voucher.Id != "get" {
+		t.Error("Returned incorrect voucher for code hash")
+	}
+	_, err = vdb.GetByCodeHash("")
+	if err == nil {
+		t.Error("An empty code hash should not match any voucher")
+	}
+	_, err = vdb.Get("missing")
+	if err == nil {
+		t.Error("Get of a missing voucher should return an error")
+	}
+}
+
+func TestVouchersDB_RedeemAndRestore(t *testing.T) {
+	vdb.Put(&pb.Voucher{Id: "redeem", Amount: 1000})
+	err := vdb.Redeem("redeem", 600)
+	if err != nil {
+		t.Error(err)
+	}
+	err = vdb.Redeem("redeem", 600)
+	if err == nil {
+		t.Error("Redeem should fail when the balance is insufficient")
+	}
+	vb, _ := vdb.Get("redeem")
+	if vb.Balance != 400 {
+		t.Error("Redeem set incorrect balance")
+	}
+	err = vdb.Restore("redeem", 800)
+	if err != nil {
+		t.Error(err)
+	}
+	vb, _ = vdb.Get("redeem")
+	if vb.Balance != 1000 {
+		t.Error("Restore should not raise the balance above the voucher amount")
+	}
+}
+
+func TestVouchersDB_GetAll(t *testing.T) {
+	vdb.Put(&pb.Voucher{Id: "all1", Amount: 100})
+	vdb.Put(&pb.Voucher{Id: "all2", Amount: 200})
+	vouchers, err := vdb.GetAll()
+	if err != nil {
+		t.Error(err)
+	}
+	found := 0
+	for _, vb := range vouchers {
+		if vb.Voucher.Id == "all1" || vb.Voucher.Id == "all2" {
+			found++
+		}
+	}
+	if found != 2 {
+		t.Error("GetAll returned incorrect vouchers")
+	}
+}
+
+func TestVouchersDB_Delete(t *testing.T) {
+	vdb.Put(&pb.Voucher{Id: "delete", Amount: 100})
+	err := vdb.Delete("delete")
+	if err != nil {
+		t.Error(err)
+	}
+	_, err = vdb.Get("delete")
+	if err == nil {
+		t.Error("Failed to delete voucher")
+	}
+}
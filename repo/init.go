@@ -18,6 +18,8 @@ import (
 var log = logging.MustGetLogger("repo")
 var ErrRepoExists = errors.New("IPFS configuration file exists. Reinitializing would overwrite your keys. Use -f to force overwrite.")
 
+const seedPassphrase = "Secret Passphrase"
+
 func DoInit(repoRoot string, nBitsForKeypair int, testnet bool, password string, mnemonic string, dbInit func(string, []byte, string) error) error {
 	if err := maybeCreateOBDirectories(repoRoot); err != nil {
 		return err
@@ -42,7 +44,7 @@ func DoInit(repoRoot string, nBitsForKeypair int, testnet bool, password string,
 			return err
 		}
 	}
-	seed := bip39.NewSeed(mnemonic, "Secret Passphrase")
+	seed := bip39.NewSeed(mnemonic, seedPassphrase)
 	fmt.Printf("Generating Ed25519 keypair...")
 	identityKey, err := ipfs.IdentityKeyFromSeed(seed, nBitsForKeypair)
 	if err != nil {
@@ -72,6 +74,16 @@ func DoInit(repoRoot string, nBitsForKeypair int, testnet bool, password string,
 	return initializeIpnsKeyspace(repoRoot, identityKey)
 }
 
+// Derive the identity key the mnemonic gives after the identity key has been rotated
+// a number of times. Rotation 0 is the key the repo was initialized with.
+func IdentityKeyFromMnemonic(mnemonic string, rotation uint32) ([]byte, error) {
+	if mnemonic == "" {
+		return nil, errors.New("No mnemonic to derive the identity key from")
+	}
+	seed := bip39.NewSeed(mnemonic, seedPassphrase)
+	return ipfs.IdentityKeyFromSeedAtRotation(seed, rotation, 4096)
+}
+
 func maybeCreateOBDirectories(repoRoot string) error {
 	if err := os.MkdirAll(path.Join(repoRoot, "root"), os.ModePerm); err != nil {
 		return err
@@ -5,20 +5,22 @@ import (
 )
 
 type SettingsData struct {
-	PaymentDataInQR    *bool              `json:"paymentDataInQR"`
-	ShowNotifications  *bool              `json:"showNotifications"`
-	ShowNsfw           *bool              `json:"showNsfw"`
-	ShippingAddresses  *[]ShippingAddress `json:"shippingAddresses"`
-	LocalCurrency      *string            `json:"localCurrency"`
-	Country            *string            `json:"country"`
-	Language           *string            `json:"language"`
-	TermsAndConditions *string            `json:"termsAndConditions"`
-	RefundPolicy       *string            `json:"refundPolicy"`
-	BlockedNodes       *[]string          `json:"blockedNodes"`
-	StoreModerators    *[]string          `json:"storeModerators"`
-	MisPaymentBuffer   *float32           `json:"mispaymentBuffer"`
-	SMTPSettings       *SMTPSettings      `json:"smtpSettings"`
-	Version            *string            `json:"version"`
+	PaymentDataInQR        *bool              `json:"paymentDataInQR"`
+	ShowNotifications      *bool              `json:"showNotifications"`
+	ShowNsfw               *bool              `json:"showNsfw"`
+	ShippingAddresses      *[]ShippingAddress `json:"shippingAddresses"`
+	LocalCurrency          *string            `json:"localCurrency"`
+	Country                *string            `json:"country"`
+	Language               *string            `json:"language"`
+	TermsAndConditions     *string            `json:"termsAndConditions"`
+	RefundPolicy           *string            `json:"refundPolicy"`
+	BlockedNodes           *[]string          `json:"blockedNodes"`
+	StoreModerators        *[]string          `json:"storeModerators"`
+	BlocklistSubscriptions *[]string          `json:"blocklistSubscriptions"`
+	MisPaymentBuffer       *float32           `json:"mispaymentBuffer"`
+	SMTPSettings           *SMTPSettings      `json:"smtpSettings"`
+	DisputeDeadlines       *DisputeDeadlines  `json:"disputeDeadlines"`
+	Version                *string            `json:"version"`
 }
 
 type ShippingAddress struct {
@@ -42,6 +44,12 @@ type SMTPSettings struct {
 	RecipientEmail string `json:"recipientEmail"`
 }
 
+// Hours allowed for each phase of a dispute
+type DisputeDeadlines struct {
+	CounterpartyUpdate uint32 `json:"counterpartyUpdate"`
+	ModeratorDecision  uint32 `json:"moderatorDecision"`
+}
+
 type Coupon struct {
 	Slug string
 	Code string
@@ -120,3 +128,16 @@ type Case struct {
 	Read               bool      `json:"read"`
 	UnreadChatMessages int       `json:"unreadChatMessages"`
 }
+
+type FeedEvent struct {
+	ID           int       `json:"id"`
+	Type         string    `json:"type"`
+	PeerId       string    `json:"peerId"`
+	Slug         string    `json:"slug,omitempty"`
+	Title        string    `json:"title,omitempty"`
+	Thumbnail    string    `json:"thumbnail,omitempty"`
+	CurrencyCode string    `json:"currencyCode,omitempty"`
+	OldPrice     uint64    `json:"oldPrice,omitempty"`
+	Price        uint64    `json:"price,omitempty"`
+	Timestamp    time.Time `json:"timestamp"`
+}
@@ -1,6 +1,8 @@
 package test
 
 import (
+	"path"
+
 	// "github.com/ipfs/go-ipfs/thirdparty/testutil"
 	"github.com/OpenBazaar/openbazaar-go/core"
 	"github.com/OpenBazaar/openbazaar-go/ipfs"
@@ -70,6 +72,7 @@ func NewNode() (*core.OpenBazaarNode, error) {
 		Datastore:  repository.DB,
 		Wallet:     wallet,
 		BanManager: net.NewBanManager([]peer.ID{}),
+		Resolver:   core.NewResolverChain(core.ResolverCacheTTL, core.NewPetnameResolver(path.Join(GetRepoPath(), core.PetnamesFile))),
 	}
 
 	node.Service = service.New(node, ctx, repository.DB)